@@ -1610,3 +1610,63 @@ func TestListChannelBookmarksForChannel(t *testing.T) {
 		require.NotEmpty(t, bookmarks)
 	})
 }
+
+func TestListBrokenChannelBookmarksForChannel(t *testing.T) {
+	os.Setenv("MM_FEATUREFLAGS_ChannelBookmarks", "true")
+	defer os.Unsetenv("MM_FEATUREFLAGS_ChannelBookmarks")
+
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	err := th.App.SetPhase2PermissionsMigrationStatus(true)
+	require.NoError(t, err)
+
+	t.Run("should not work without a license", func(t *testing.T) {
+		_, _, err := th.Client.ListBrokenChannelBookmarksForChannel(context.Background(), th.BasicChannel.Id)
+		CheckErrorID(t, err, "api.channel.bookmark.channel_bookmark.license.error")
+	})
+
+	th.App.Srv().SetLicense(model.NewTestLicense())
+	th.Context.Session().UserId = th.BasicUser.Id // set the user for the session
+
+	createBookmark := func(name string, status model.ChannelBookmarkLinkStatus) *model.ChannelBookmarkWithFileInfo {
+		b, appErr := th.App.CreateChannelBookmark(th.Context, &model.ChannelBookmark{
+			ChannelId:   th.BasicChannel.Id,
+			DisplayName: name,
+			Type:        model.ChannelBookmarkLink,
+			LinkUrl:     "https://sample.com",
+		}, "")
+		require.Nil(t, appErr)
+
+		b.LinkStatus = status
+		require.NoError(t, th.App.Srv().Store().ChannelBookmark().Update(b.ChannelBookmark))
+		return b
+	}
+
+	broken := createBookmark("broken", model.ChannelBookmarkLinkStatusBroken)
+	createBookmark("ok", model.ChannelBookmarkLinkStatusOk)
+	createBookmark("unchecked", "")
+
+	t.Run("lists the bookmarks with broken links", func(t *testing.T) {
+		bookmarks, resp, err := th.Client.ListBrokenChannelBookmarksForChannel(context.Background(), th.BasicChannel.Id)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+		require.Len(t, bookmarks, 1)
+		require.Equal(t, broken.Id, bookmarks[0].Id)
+		require.Equal(t, model.ChannelBookmarkLinkStatusBroken, bookmarks[0].LinkStatus)
+	})
+
+	t.Run("requires permission to edit the bookmarks", func(t *testing.T) {
+		manageBookmarks := model.ChannelModeratedPermissions[4]
+		th.PatchChannelModerationsForMembers(th.BasicChannel.Id, manageBookmarks, false)
+		defer th.PatchChannelModerationsForMembers(th.BasicChannel.Id, manageBookmarks, true)
+
+		_, resp, err := th.Client.ListBrokenChannelBookmarksForChannel(context.Background(), th.BasicChannel.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		bookmarks, resp, err := th.SystemAdminClient.ListBrokenChannelBookmarksForChannel(context.Background(), th.BasicChannel.Id)
+		require.NoError(t, err)
+		CheckOKStatus(t, resp)
+		require.Len(t, bookmarks, 1)
+	})
+}
@@ -10,6 +10,8 @@ import (
 	"reflect"
 	"strings"
 
+	"github.com/gorilla/mux"
+
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/i18n"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
@@ -36,6 +38,9 @@ func (api *API) InitConfig() {
 	api.BaseRoutes.APIRoot.Handle("/config/reload", api.APISessionRequired(configReload)).Methods(http.MethodPost)
 	api.BaseRoutes.APIRoot.Handle("/config/client", api.APIHandler(getClientConfig)).Methods(http.MethodGet)
 	api.BaseRoutes.APIRoot.Handle("/config/environment", api.APISessionRequired(getEnvironmentConfig)).Methods(http.MethodGet)
+	api.BaseRoutes.APIRoot.Handle("/config/history", api.APISessionRequired(getConfigHistory)).Methods(http.MethodGet)
+	api.BaseRoutes.APIRoot.Handle("/config/history/{config_id:[A-Za-z0-9]+}/diff", api.APISessionRequired(getConfigVersionDiff)).Methods(http.MethodGet)
+	api.BaseRoutes.APIRoot.Handle("/config/history/{config_id:[A-Za-z0-9]+}/restore", api.APISessionRequired(restoreConfigVersion)).Methods(http.MethodPost)
 }
 
 func init() {
@@ -134,68 +139,25 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	cfg, err = config.Merge(appCfg, cfg, &utils.MergeConfig{
-		StructFieldFilter: func(structField reflect.StructField, base, patch reflect.Value) bool {
-			return writeFilter(c, structField)
-		},
-	})
-	if err != nil {
-		c.Err = model.NewAppError("updateConfig", "api.config.update_config.restricted_merge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	cfg = prepareConfigUpdate(c, "updateConfig", appCfg, cfg)
+	if c.Err != nil {
 		return
 	}
 
-	// Do not allow plugin uploads to be toggled through the API
-	*cfg.PluginSettings.EnableUploads = *appCfg.PluginSettings.EnableUploads
-
-	// Do not allow certificates to be changed through the API
-	// This shallow-copies the slice header. So be careful if there are concurrent
-	// modifications to the slice.
-	cfg.PluginSettings.SignaturePublicKeyFiles = appCfg.PluginSettings.SignaturePublicKeyFiles
-
-	// Do not allow marketplace URL to be toggled through the API if EnableUploads are disabled.
-	if cfg.PluginSettings.EnableUploads != nil && !*appCfg.PluginSettings.EnableUploads {
-		*cfg.PluginSettings.MarketplaceURL = *appCfg.PluginSettings.MarketplaceURL
-	}
-
-	// There are some settings that cannot be changed in a cloud env
-	if c.App.Channels().License().IsCloud() {
-		// Both of them cannot be nil since cfg.SetDefaults is called earlier for cfg,
-		// and appCfg is the existing earlier config and if it's nil, server sets a default value.
-		if *appCfg.ComplianceSettings.Directory != *cfg.ComplianceSettings.Directory {
-			c.Err = model.NewAppError("updateConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "ComplianceSettings.Directory"}, "", http.StatusForbidden)
-			return
-		}
-	}
-
-	// if ES autocomplete was enabled, we need to make sure that index has been checked.
-	// we need to stop enabling ES autocomplete otherwise.
-	if !*appCfg.ElasticsearchSettings.EnableAutocomplete && *cfg.ElasticsearchSettings.EnableAutocomplete {
-		if !c.App.SearchEngine().ElasticsearchEngine.IsAutocompletionEnabled() {
-			c.Err = model.NewAppError("updateConfig", "api.config.update.elasticsearch.autocomplete_cannot_be_enabled_error", nil, "", http.StatusBadRequest)
-			return
-		}
-	}
-
-	c.App.HandleMessageExportConfig(cfg, appCfg)
-
 	if appErr := cfg.IsValid(); appErr != nil {
 		c.Err = appErr
 		return
 	}
 
-	oldCfg, newCfg, appErr := c.App.SaveConfig(cfg, true)
+	oldCfg, newCfg, appErr := c.App.SaveConfigWithAuthor(cfg, true, c.AppContext.Session().UserId)
 	if appErr != nil {
 		c.Err = appErr
 		return
 	}
 
-	// If the config for default server locale has changed, reinitialize the server's translations.
-	if oldCfg.LocalizationSettings.DefaultServerLocale != newCfg.LocalizationSettings.DefaultServerLocale {
-		s := newCfg.LocalizationSettings
-		if err = i18n.InitTranslations(*s.DefaultServerLocale, *s.DefaultClientLocale); err != nil {
-			c.Err = model.NewAppError("updateConfig", "api.config.update_config.translations.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
-			return
-		}
+	reloadTranslationsOnLocaleChange(c, "updateConfig", oldCfg, newCfg)
+	if c.Err != nil {
+		return
 	}
 
 	diffs, err := config.Diff(oldCfg, newCfg)
@@ -238,6 +200,79 @@ func updateConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// prepareConfigUpdate merges cfg into the active configuration appCfg, only keeping the settings
+// the session is allowed to write, and reverts the settings that cannot be changed through the
+// API. It is used by the handlers replacing the whole configuration and sets c.Err when the
+// update isn't allowed.
+func prepareConfigUpdate(c *Context, where string, appCfg, cfg *model.Config) *model.Config {
+	// Local mode isn't restricted by the permissions of a session.
+	if !c.AppContext.Session().IsUnrestricted() {
+		var err error
+		cfg, err = config.Merge(appCfg, cfg, &utils.MergeConfig{
+			StructFieldFilter: func(structField reflect.StructField, base, patch reflect.Value) bool {
+				return writeFilter(c, structField)
+			},
+		})
+		if err != nil {
+			c.Err = model.NewAppError(where, "api.config.update_config.restricted_merge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			return nil
+		}
+	}
+
+	// Do not allow plugin uploads to be toggled through the API
+	*cfg.PluginSettings.EnableUploads = *appCfg.PluginSettings.EnableUploads
+
+	// Do not allow the OCR commands to be changed through the API, they are run on the server
+	*cfg.FileSettings.OCRCommand = *appCfg.FileSettings.OCRCommand
+	*cfg.FileSettings.OCRPDFRasterizeCommand = *appCfg.FileSettings.OCRPDFRasterizeCommand
+
+	// Do not allow certificates to be changed through the API
+	// This shallow-copies the slice header. So be careful if there are concurrent
+	// modifications to the slice.
+	cfg.PluginSettings.SignaturePublicKeyFiles = appCfg.PluginSettings.SignaturePublicKeyFiles
+
+	// Do not allow marketplace URL to be toggled through the API if EnableUploads are disabled.
+	if cfg.PluginSettings.EnableUploads != nil && !*appCfg.PluginSettings.EnableUploads {
+		*cfg.PluginSettings.MarketplaceURL = *appCfg.PluginSettings.MarketplaceURL
+	}
+
+	// There are some settings that cannot be changed in a cloud env
+	if c.App.Channels().License().IsCloud() {
+		// Both of them cannot be nil since cfg.SetDefaults is called earlier for cfg,
+		// and appCfg is the existing earlier config and if it's nil, server sets a default value.
+		if *appCfg.ComplianceSettings.Directory != *cfg.ComplianceSettings.Directory {
+			c.Err = model.NewAppError(where, "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "ComplianceSettings.Directory"}, "", http.StatusForbidden)
+			return nil
+		}
+	}
+
+	// if ES autocomplete was enabled, we need to make sure that index has been checked.
+	// we need to stop enabling ES autocomplete otherwise.
+	if !*appCfg.ElasticsearchSettings.EnableAutocomplete && *cfg.ElasticsearchSettings.EnableAutocomplete {
+		if !c.App.SearchEngine().ElasticsearchEngine.IsAutocompletionEnabled() {
+			c.Err = model.NewAppError(where, "api.config.update.elasticsearch.autocomplete_cannot_be_enabled_error", nil, "", http.StatusBadRequest)
+			return nil
+		}
+	}
+
+	c.App.HandleMessageExportConfig(cfg, appCfg)
+
+	return cfg
+}
+
+// reloadTranslationsOnLocaleChange reinitializes the server's translations when the default
+// server locale has changed, setting c.Err on failure.
+func reloadTranslationsOnLocaleChange(c *Context, where string, oldCfg, newCfg *model.Config) {
+	if oldCfg.LocalizationSettings.DefaultServerLocale == newCfg.LocalizationSettings.DefaultServerLocale {
+		return
+	}
+
+	s := newCfg.LocalizationSettings
+	if err := i18n.InitTranslations(*s.DefaultServerLocale, *s.DefaultClientLocale); err != nil {
+		c.Err = model.NewAppError(where, "api.config.update_config.translations.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+}
+
 func getClientConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	format := r.URL.Query().Get("format")
 
@@ -306,6 +341,16 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Do not allow the OCR commands to be changed through the API, they are run on the server
+	if cfg.FileSettings.OCRCommand != nil && *cfg.FileSettings.OCRCommand != *appCfg.FileSettings.OCRCommand {
+		c.Err = model.NewAppError("patchConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "FileSettings.OCRCommand"}, "", http.StatusForbidden)
+		return
+	}
+	if cfg.FileSettings.OCRPDFRasterizeCommand != nil && *cfg.FileSettings.OCRPDFRasterizeCommand != *appCfg.FileSettings.OCRPDFRasterizeCommand {
+		c.Err = model.NewAppError("patchConfig", "api.config.update_config.not_allowed_security.app_error", map[string]any{"Name": "FileSettings.OCRPDFRasterizeCommand"}, "", http.StatusForbidden)
+		return
+	}
+
 	// Do not allow marketplace URL to be toggled if plugin uploads are disabled.
 	if cfg.PluginSettings.MarketplaceURL != nil && cfg.PluginSettings.EnableUploads != nil {
 		// Breaking it down to 2 conditions to make it simple.
@@ -341,7 +386,7 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	oldCfg, newCfg, appErr := c.App.SaveConfig(updatedCfg, true)
+	oldCfg, newCfg, appErr := c.App.SaveConfigWithAuthor(updatedCfg, true, c.AppContext.Session().UserId)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -385,6 +430,123 @@ func patchConfig(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getConfigHistory(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	perPage := c.Params.PerPage
+	if r.URL.Query().Get("per_page") == "" {
+		perPage = model.ConfigHistoryDefaultPerPage
+	} else if perPage > model.ConfigHistoryMaxPerPage {
+		perPage = model.ConfigHistoryMaxPerPage
+	}
+
+	entries, appErr := c.App.GetConfigHistory(c.Params.Page, perPage)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if err := json.NewEncoder(w).Encode(entries); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getConfigVersionDiff(c *Context, w http.ResponseWriter, r *http.Request) {
+	configID := mux.Vars(r)["config_id"]
+	if !model.IsValidId(configID) {
+		c.SetInvalidURLParam("config_id")
+		return
+	}
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	changes, appErr := c.App.GetConfigVersionDiff(configID)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if err := json.NewEncoder(w).Encode(changes); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func restoreConfigVersion(c *Context, w http.ResponseWriter, r *http.Request) {
+	configID := mux.Vars(r)["config_id"]
+	if !model.IsValidId(configID) {
+		c.SetInvalidURLParam("config_id")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("restoreConfigVersion", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "config_id", configID)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionManageSystem) {
+		c.SetPermissionError(model.PermissionManageSystem)
+		return
+	}
+
+	if !c.AppContext.Session().IsUnrestricted() && *c.App.Config().ExperimentalSettings.RestrictSystemAdmin {
+		c.Err = model.NewAppError("restoreConfigVersion", "api.restricted_system_admin", nil, "", http.StatusForbidden)
+		return
+	}
+
+	cfg, appErr := c.App.GetConfigVersion(configID)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	cfg.SetDefaults()
+
+	// Restoring goes through the same protections as updating the configuration.
+	appCfg := c.App.Config()
+	cfg = prepareConfigUpdate(c, "restoreConfigVersion", appCfg, cfg)
+	if c.Err != nil {
+		return
+	}
+
+	if appErr = cfg.IsValid(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	oldCfg, newCfg, appErr := c.App.SaveConfigWithAuthor(cfg, true, c.AppContext.Session().UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	reloadTranslationsOnLocaleChange(c, "restoreConfigVersion", oldCfg, newCfg)
+	if c.Err != nil {
+		return
+	}
+
+	diffs, err := config.Diff(oldCfg, newCfg)
+	if err != nil {
+		c.Err = model.NewAppError("restoreConfigVersion", "api.config.restore_config.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+	auditRec.AddEventPriorState(&diffs)
+	auditRec.AddEventObjectType("config")
+	auditRec.Success()
+
+	c.App.SanitizedConfig(newCfg)
+
+	w.Header().Set("Cache-Control", "no-cache, no-store, must-revalidate")
+	if err := json.NewEncoder(w).Encode(newCfg); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func makeFilterConfigByPermission(accessType filterType) func(c *Context, structField reflect.StructField) bool {
 	return func(c *Context, structField reflect.StructField) bool {
 		if structField.Type.Kind() == reflect.Struct {
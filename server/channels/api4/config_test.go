@@ -202,6 +202,20 @@ func TestUpdateConfig(t *testing.T) {
 			assert.Equal(t, oldEnableUploads, *th.App.Config().PluginSettings.EnableUploads)
 		})
 
+		t.Run("Should not be able to modify FileSettings.OCRCommand", func(t *testing.T) {
+			oldOCRCommand := *th.App.Config().FileSettings.OCRCommand
+			oldRasterizeCommand := *th.App.Config().FileSettings.OCRPDFRasterizeCommand
+			*cfg.FileSettings.OCRCommand = "/bin/sh"
+			*cfg.FileSettings.OCRPDFRasterizeCommand = "/bin/sh"
+
+			cfg, _, err = client.UpdateConfig(context.Background(), cfg)
+			require.NoError(t, err)
+			assert.Equal(t, oldOCRCommand, *cfg.FileSettings.OCRCommand)
+			assert.Equal(t, oldRasterizeCommand, *cfg.FileSettings.OCRPDFRasterizeCommand)
+			assert.Equal(t, oldOCRCommand, *th.App.Config().FileSettings.OCRCommand)
+			assert.Equal(t, oldRasterizeCommand, *th.App.Config().FileSettings.OCRPDFRasterizeCommand)
+		})
+
 		t.Run("Should not be able to modify PluginSettings.SignaturePublicKeyFiles", func(t *testing.T) {
 			oldPublicKeys := th.App.Config().PluginSettings.SignaturePublicKeyFiles
 			cfg.PluginSettings.SignaturePublicKeyFiles = append(cfg.PluginSettings.SignaturePublicKeyFiles, "new_signature")
@@ -770,6 +784,26 @@ func TestPatchConfig(t *testing.T) {
 				CheckForbiddenStatus(t, resp)
 			}
 		})
+
+		t.Run("not allowing to change the OCR command via api", func(t *testing.T) {
+			oldOCRCommand := *th.App.Config().FileSettings.OCRCommand
+			defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.FileSettings.OCRCommand = oldOCRCommand })
+
+			config := model.Config{FileSettings: model.FileSettings{
+				OCRCommand: model.NewPointer("/bin/sh"),
+			}}
+
+			updatedConfig, resp, err := client.PatchConfig(context.Background(), &config)
+			if client == th.LocalClient {
+				require.NoError(t, err)
+				CheckOKStatus(t, resp)
+				assert.Equal(t, "/bin/sh", *updatedConfig.FileSettings.OCRCommand)
+			} else {
+				require.Error(t, err)
+				CheckForbiddenStatus(t, resp)
+				assert.Equal(t, oldOCRCommand, *th.App.Config().FileSettings.OCRCommand)
+			}
+		})
 	})
 
 	t.Run("Should not be able to modify PluginSettings.MarketplaceURL if EnableUploads is disabled", func(t *testing.T) {
@@ -839,6 +873,50 @@ func TestPatchConfig(t *testing.T) {
 	})
 }
 
+func TestConfigHistory(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+	client := th.Client
+
+	t.Run("as system user", func(t *testing.T) {
+		_, resp, err := client.GetConfigHistory(context.Background(), 0, 10)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.GetConfigVersionDiff(context.Background(), model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.RestoreConfigVersion(context.Background(), model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		// The test server keeps its configuration in memory, which has no history.
+		_, resp, err := client.GetConfigHistory(context.Background(), 0, 10)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+
+		_, resp, err = client.GetConfigVersionDiff(context.Background(), model.NewId())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+
+		_, resp, err = client.RestoreConfigVersion(context.Background(), model.NewId())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	}, "as system admin and local mode")
+
+	t.Run("as restricted system admin", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalSettings.RestrictSystemAdmin = true })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalSettings.RestrictSystemAdmin = false })
+
+		_, resp, err := th.SystemAdminClient.RestoreConfigVersion(context.Background(), model.NewId())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestMigrateConfig(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
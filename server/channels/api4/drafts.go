@@ -9,6 +9,8 @@ import (
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/app"
+	"github.com/mattermost/mattermost/server/v8/channels/audit"
 )
 
 func (api *API) InitDrafts() {
@@ -18,6 +20,15 @@ func (api *API) InitDrafts() {
 
 	api.BaseRoutes.ChannelForUser.Handle("/drafts/{thread_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteDraft)).Methods(http.MethodDelete)
 	api.BaseRoutes.ChannelForUser.Handle("/drafts", api.APISessionRequired(deleteDraft)).Methods(http.MethodDelete)
+
+	api.BaseRoutes.Drafts.Handle("/shared", api.APISessionRequired(createSharedDraft)).Methods(http.MethodPost)
+	api.BaseRoutes.Drafts.Handle("/shared", api.APISessionRequired(getSharedDrafts)).Methods(http.MethodGet)
+	api.BaseRoutes.Drafts.Handle("/shared/{shared_draft_id:[A-Za-z0-9]+}", api.APISessionRequired(getSharedDraft)).Methods(http.MethodGet)
+	api.BaseRoutes.Drafts.Handle("/shared/{shared_draft_id:[A-Za-z0-9]+}", api.APISessionRequired(updateSharedDraft)).Methods(http.MethodPut)
+	api.BaseRoutes.Drafts.Handle("/shared/{shared_draft_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteSharedDraft)).Methods(http.MethodDelete)
+	api.BaseRoutes.Drafts.Handle("/shared/{shared_draft_id:[A-Za-z0-9]+}/members", api.APISessionRequired(addSharedDraftMembers)).Methods(http.MethodPost)
+	api.BaseRoutes.Drafts.Handle("/shared/{shared_draft_id:[A-Za-z0-9]+}/members/{user_id:[A-Za-z0-9]+}", api.APISessionRequired(removeSharedDraftMember)).Methods(http.MethodDelete)
+	api.BaseRoutes.Drafts.Handle("/shared/{shared_draft_id:[A-Za-z0-9]+}/publish", api.APISessionRequired(publishSharedDraft)).Methods(http.MethodPost)
 }
 
 func upsertDraft(c *Context, w http.ResponseWriter, r *http.Request) {
@@ -142,3 +153,229 @@ func deleteDraft(c *Context, w http.ResponseWriter, r *http.Request) {
 
 	ReturnStatusOK(w)
 }
+
+func createSharedDraft(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !*c.App.Config().ServiceSettings.AllowSyncedDrafts {
+		c.Err = model.NewAppError("createSharedDraft", "api.drafts.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return
+	}
+
+	var draft model.SharedDraft
+	if jsonErr := json.NewDecoder(r.Body).Decode(&draft); jsonErr != nil {
+		c.SetInvalidParamWithErr("shared_draft", jsonErr)
+		return
+	}
+
+	draft.Id = ""
+	draft.CreatorId = c.AppContext.Session().UserId
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), draft.ChannelId, model.PermissionCreatePost) {
+		c.SetPermissionError(model.PermissionCreatePost)
+		return
+	}
+
+	savedDraft, err := c.App.CreateSharedDraft(c.AppContext, &draft, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(savedDraft); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getSharedDrafts(c *Context, w http.ResponseWriter, r *http.Request) {
+	drafts, err := c.App.GetSharedDraftsForUser(c.AppContext.Session().UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(drafts); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+// getSharedDraftForMember returns the shared draft of the request, as long as the session user is one
+// of its members.
+func getSharedDraftForMember(c *Context) *model.SharedDraft {
+	c.RequireSharedDraftId()
+	if c.Err != nil {
+		return nil
+	}
+
+	draft, err := c.App.GetSharedDraft(c.Params.SharedDraftId)
+	if err != nil {
+		c.Err = err
+		return nil
+	}
+
+	if !draft.HasMember(c.AppContext.Session().UserId) {
+		c.Err = model.NewAppError("getSharedDraftForMember", "api.drafts.shared.not_member.app_error", nil, "", http.StatusForbidden)
+		return nil
+	}
+
+	return draft
+}
+
+func getSharedDraft(c *Context, w http.ResponseWriter, r *http.Request) {
+	draft := getSharedDraftForMember(c)
+	if c.Err != nil {
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(draft); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func updateSharedDraft(c *Context, w http.ResponseWriter, r *http.Request) {
+	var patch model.SharedDraftPatch
+	if jsonErr := json.NewDecoder(r.Body).Decode(&patch); jsonErr != nil {
+		c.SetInvalidParamWithErr("patch", jsonErr)
+		return
+	}
+
+	if patch.UpdateAt == 0 {
+		c.SetInvalidParam("update_at")
+		return
+	}
+
+	draft := getSharedDraftForMember(c)
+	if c.Err != nil {
+		return
+	}
+
+	updatedDraft, err := c.App.UpdateSharedDraft(c.AppContext, draft, &patch, c.AppContext.Session().UserId, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(updatedDraft); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteSharedDraft(c *Context, w http.ResponseWriter, r *http.Request) {
+	draft := getSharedDraftForMember(c)
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteSharedDraft", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameterAuditable(auditRec, "shared_draft", draft)
+
+	if draft.CreatorId != c.AppContext.Session().UserId {
+		c.Err = model.NewAppError("deleteSharedDraft", "api.drafts.shared.delete.not_creator.app_error", nil, "", http.StatusForbidden)
+		return
+	}
+
+	if err := c.App.DeleteSharedDraft(c.AppContext, draft, r.Header.Get(model.ConnectionId)); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func addSharedDraftMembers(c *Context, w http.ResponseWriter, r *http.Request) {
+	var userIDs []string
+	if jsonErr := json.NewDecoder(r.Body).Decode(&userIDs); jsonErr != nil || len(userIDs) == 0 {
+		c.SetInvalidParamWithErr("user_ids", jsonErr)
+		return
+	}
+
+	for _, userID := range userIDs {
+		if !model.IsValidId(userID) {
+			c.SetInvalidParam("user_ids")
+			return
+		}
+	}
+
+	draft := getSharedDraftForMember(c)
+	if c.Err != nil {
+		return
+	}
+
+	updatedDraft, err := c.App.AddSharedDraftMembers(c.AppContext, draft, userIDs, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(updatedDraft); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func removeSharedDraftMember(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	draft := getSharedDraftForMember(c)
+	if c.Err != nil {
+		return
+	}
+
+	// Members can leave the draft, but only its creator can remove someone else.
+	if c.Params.UserId != c.AppContext.Session().UserId && draft.CreatorId != c.AppContext.Session().UserId {
+		c.Err = model.NewAppError("removeSharedDraftMember", "api.drafts.shared.remove_member.not_creator.app_error", nil, "", http.StatusForbidden)
+		return
+	}
+
+	updatedDraft, err := c.App.RemoveSharedDraftMember(c.AppContext, draft, c.Params.UserId, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(updatedDraft); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func publishSharedDraft(c *Context, w http.ResponseWriter, r *http.Request) {
+	var publish struct {
+		UpdateAt int64 `json:"update_at"`
+	}
+	if jsonErr := json.NewDecoder(r.Body).Decode(&publish); jsonErr != nil || publish.UpdateAt <= 0 {
+		c.SetInvalidParamWithErr("update_at", jsonErr)
+		return
+	}
+
+	draft := getSharedDraftForMember(c)
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("publishSharedDraft", audit.Fail)
+	defer c.LogAuditRecWithLevel(auditRec, app.LevelContent)
+	audit.AddEventParameterAuditable(auditRec, "shared_draft", draft)
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), draft.ChannelId, model.PermissionCreatePost) {
+		c.SetPermissionError(model.PermissionCreatePost)
+		return
+	}
+
+	rp, err := c.App.PublishSharedDraft(c.AppContext, draft, publish.UpdateAt, c.AppContext.Session().UserId, c.AppContext.Session().Id, r.Header.Get(model.ConnectionId))
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(rp)
+	auditRec.AddEventObjectType("post")
+
+	w.WriteHeader(http.StatusCreated)
+	if err := rp.EncodeJSON(w); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
@@ -5,6 +5,7 @@ package api4
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"testing"
 	"time"
@@ -226,3 +227,103 @@ func TestDeleteDraft(t *testing.T) {
 	assert.Equal(t, draft2.ChannelId, draftResp[0].ChannelId)
 	assert.Len(t, draftResp, 1)
 }
+
+func TestSharedDrafts(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.AllowSyncedDrafts = true })
+
+	client := th.Client
+	client2 := th.CreateClient()
+	th.LoginBasic2WithClient(client2)
+
+	draft, resp, err := client.CreateSharedDraft(context.Background(), &model.SharedDraft{
+		ChannelId: th.BasicChannel.Id,
+		Message:   "original",
+		UserIds:   []string{th.BasicUser2.Id},
+	})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.BasicUser.Id, draft.CreatorId)
+	assert.Equal(t, []string{th.BasicUser.Id, th.BasicUser2.Id}, draft.UserIds)
+
+	t.Run("members can get the draft", func(t *testing.T) {
+		drafts, _, err := client2.GetSharedDrafts(context.Background())
+		require.NoError(t, err)
+		require.Len(t, drafts, 1)
+		assert.Equal(t, draft.Id, drafts[0].Id)
+
+		received, _, err := client2.GetSharedDraft(context.Background(), draft.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "original", received.Message)
+	})
+
+	t.Run("non members can't get the draft", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.GetSharedDraft(context.Background(), draft.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("members can't be outside of the channel", func(t *testing.T) {
+		user := th.CreateUser()
+		th.LinkUserToTeam(user, th.BasicTeam)
+
+		_, resp, err := client.AddSharedDraftMembers(context.Background(), draft.Id, []string{user.Id})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("stale edits are rejected", func(t *testing.T) {
+		message := "edited by user2"
+		edited, _, err := client2.UpdateSharedDraft(context.Background(), draft.Id, &model.SharedDraftPatch{
+			UpdateAt: draft.UpdateAt,
+			Message:  &message,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, message, edited.Message)
+		assert.Equal(t, th.BasicUser2.Id, edited.LastEditedBy)
+
+		staleMessage := "edited by user1"
+		_, resp, err := client.UpdateSharedDraft(context.Background(), draft.Id, &model.SharedDraftPatch{
+			UpdateAt: draft.UpdateAt,
+			Message:  &staleMessage,
+		})
+		require.Error(t, err)
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		draft = edited
+	})
+
+	t.Run("only the creator can delete the draft", func(t *testing.T) {
+		resp, err := client2.DeleteSharedDraft(context.Background(), draft.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("publish as the current user", func(t *testing.T) {
+		_, resp, err := client2.PublishSharedDraft(context.Background(), draft.Id, draft.UpdateAt-1)
+		require.Error(t, err)
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+
+		post, resp, err := client2.PublishSharedDraft(context.Background(), draft.Id, draft.UpdateAt)
+		require.NoError(t, err)
+		CheckCreatedStatus(t, resp)
+		assert.Equal(t, th.BasicUser2.Id, post.UserId)
+		assert.Equal(t, th.BasicChannel.Id, post.ChannelId)
+		assert.Equal(t, draft.Message, post.Message)
+
+		_, resp, err = client.GetSharedDraft(context.Background(), draft.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("disabled by configuration", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.AllowSyncedDrafts = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.AllowSyncedDrafts = true })
+
+		_, resp, err := client.GetSharedDrafts(context.Background())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
@@ -38,6 +38,11 @@ func (api *API) InitPost() {
 	api.BaseRoutes.Post.Handle("/patch", api.APISessionRequired(patchPost)).Methods(http.MethodPut)
 	api.BaseRoutes.PostForUser.Handle("/set_unread", api.APISessionRequired(setPostUnread)).Methods(http.MethodPost)
 	api.BaseRoutes.PostForUser.Handle("/reminder", api.APISessionRequired(setPostReminder)).Methods(http.MethodPost)
+	api.BaseRoutes.PostForUser.Handle("/reminder/snooze", api.APISessionRequired(snoozePostReminder)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/reminders", api.APISessionRequired(getPostReminders)).Methods(http.MethodGet)
+	api.BaseRoutes.User.Handle("/reminders", api.APISessionRequired(createStandalonePostReminder)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/reminders/{post_id:[A-Za-z0-9]+}", api.APISessionRequired(reschedulePostReminder)).Methods(http.MethodPut)
+	api.BaseRoutes.User.Handle("/reminders/{post_id:[A-Za-z0-9]+}", api.APISessionRequired(deletePostReminder)).Methods(http.MethodDelete)
 
 	api.BaseRoutes.Post.Handle("/pin", api.APISessionRequired(pinPost)).Methods(http.MethodPost)
 	api.BaseRoutes.Post.Handle("/unpin", api.APISessionRequired(unpinPost)).Methods(http.MethodPost)
@@ -45,6 +50,11 @@ func (api *API) InitPost() {
 	api.BaseRoutes.PostForUser.Handle("/ack", api.APISessionRequired(acknowledgePost)).Methods(http.MethodPost)
 	api.BaseRoutes.PostForUser.Handle("/ack", api.APISessionRequired(unacknowledgePost)).Methods(http.MethodDelete)
 
+	api.BaseRoutes.Post.Handle("/escalation", api.APISessionRequired(getPostEscalation)).Methods(http.MethodGet)
+	api.BaseRoutes.Post.Handle("/escalation", api.APISessionRequired(setPostEscalation)).Methods(http.MethodPut)
+	api.BaseRoutes.Post.Handle("/escalation", api.APISessionRequired(cancelPostEscalation)).Methods(http.MethodDelete)
+	api.BaseRoutes.Post.Handle("/escalation/escalate", api.APISessionRequired(escalatePost)).Methods(http.MethodPost)
+
 	api.BaseRoutes.Post.Handle("/move", api.APISessionRequired(moveThread)).Methods(http.MethodPost)
 }
 
@@ -965,8 +975,7 @@ func setPostUnread(c *Context, w http.ResponseWriter, r *http.Request) {
 	props := model.MapBoolFromJSON(r.Body)
 	collapsedThreadsSupported := props["collapsed_threads_supported"]
 
-	if c.AppContext.Session().UserId != c.Params.UserId && !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
-		c.SetPermissionError(model.PermissionEditOtherUsers)
+	if !postReminderUserPermissionCheck(c) {
 		return
 	}
 	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionReadChannelContent) {
@@ -990,8 +999,7 @@ func setPostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if c.AppContext.Session().UserId != c.Params.UserId && !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
-		c.SetPermissionError(model.PermissionEditOtherUsers)
+	if !postReminderUserPermissionCheck(c) {
 		return
 	}
 	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionReadChannelContent) {
@@ -1005,7 +1013,10 @@ func setPostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	appErr := c.App.SetPostReminder(c.AppContext, c.Params.PostId, c.Params.UserId, reminder.TargetTime)
+	reminder.PostId = c.Params.PostId
+	reminder.UserId = c.Params.UserId
+	reminder.Message = ""
+	appErr := c.App.SetPostReminder(c.AppContext, &reminder)
 	if appErr != nil {
 		c.Err = appErr
 		return
@@ -1014,6 +1025,139 @@ func setPostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+// postReminderUserPermissionCheck checks that the session can manage the reminders of the user of the request.
+func postReminderUserPermissionCheck(c *Context) bool {
+	if c.AppContext.Session().UserId != c.Params.UserId && !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return false
+	}
+	return true
+}
+
+func getPostReminders(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !postReminderUserPermissionCheck(c) {
+		return
+	}
+
+	reminders, appErr := c.App.GetPostRemindersForUser(c.Params.UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(reminders); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func createStandalonePostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !postReminderUserPermissionCheck(c) {
+		return
+	}
+
+	var reminder model.PostReminder
+	if jsonErr := json.NewDecoder(r.Body).Decode(&reminder); jsonErr != nil {
+		c.SetInvalidParamWithErr("reminder", jsonErr)
+		return
+	}
+	reminder.UserId = c.Params.UserId
+
+	savedReminder, appErr := c.App.CreateStandalonePostReminder(c.AppContext, &reminder)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(savedReminder); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func reschedulePostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	if !postReminderUserPermissionCheck(c) {
+		return
+	}
+
+	var reminder model.PostReminder
+	if jsonErr := json.NewDecoder(r.Body).Decode(&reminder); jsonErr != nil {
+		c.SetInvalidParamWithErr("target_time", jsonErr)
+		return
+	}
+
+	updatedReminder, appErr := c.App.ReschedulePostReminder(c.AppContext, c.Params.PostId, c.Params.UserId, reminder.TargetTime)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(updatedReminder); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deletePostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireUserId().RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	if !postReminderUserPermissionCheck(c) {
+		return
+	}
+
+	if appErr := c.App.DeletePostReminder(c.Params.PostId, c.Params.UserId); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	ReturnStatusOK(w)
+}
+
+func snoozePostReminder(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequirePostId().RequireUserId()
+	if c.Err != nil {
+		return
+	}
+
+	if !postReminderUserPermissionCheck(c) {
+		return
+	}
+
+	var snooze struct {
+		Minutes int `json:"minutes"`
+	}
+	if jsonErr := json.NewDecoder(r.Body).Decode(&snooze); jsonErr != nil {
+		c.SetInvalidParamWithErr("minutes", jsonErr)
+		return
+	}
+
+	reminder, appErr := c.App.SnoozePostReminder(c.AppContext, c.Params.PostId, c.Params.UserId, snooze.Minutes)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(reminder); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func saveIsPinnedPost(c *Context, w http.ResponseWriter, isPinned bool) {
 	c.RequirePostId()
 	if c.Err != nil {
@@ -1140,6 +1284,176 @@ func unacknowledgePost(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func getPostEscalation(c *Context, w http.ResponseWriter, r *http.Request) {
+	// license check
+	permissionErr := minimumProfessionalLicense(c)
+	if permissionErr != nil {
+		c.Err = permissionErr
+		return
+	}
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionReadChannelContent) {
+		c.SetPermissionError(model.PermissionReadChannelContent)
+		return
+	}
+
+	escalation, appErr := c.App.GetPostEscalation(c.Params.PostId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+	if escalation == nil {
+		c.Err = model.NewAppError("getPostEscalation", "api.post.escalation.not_found.app_error", nil, "", http.StatusNotFound)
+		return
+	}
+
+	writePostEscalation(c, w, escalation)
+}
+
+func setPostEscalation(c *Context, w http.ResponseWriter, r *http.Request) {
+	// license check
+	permissionErr := minimumProfessionalLicense(c)
+	if permissionErr != nil {
+		c.Err = permissionErr
+		return
+	}
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	var escalation model.PostEscalation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&escalation); jsonErr != nil {
+		c.SetInvalidParamWithErr("escalation", jsonErr)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("setPostEscalation", audit.Fail)
+	audit.AddEventParameter(auditRec, "post_id", c.Params.PostId)
+	audit.AddEventParameterAuditable(auditRec, "escalation", &escalation)
+	defer c.LogAuditRec(auditRec)
+
+	post := postEscalationPermissionCheck(c)
+	if c.Err != nil {
+		return
+	}
+
+	saved, appErr := c.App.SetPostEscalation(c.AppContext, post, &escalation, c.AppContext.Session().UserId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(saved)
+
+	writePostEscalation(c, w, saved)
+}
+
+func cancelPostEscalation(c *Context, w http.ResponseWriter, r *http.Request) {
+	// license check
+	permissionErr := minimumProfessionalLicense(c)
+	if permissionErr != nil {
+		c.Err = permissionErr
+		return
+	}
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("cancelPostEscalation", audit.Fail)
+	audit.AddEventParameter(auditRec, "post_id", c.Params.PostId)
+	defer c.LogAuditRec(auditRec)
+
+	postEscalationPermissionCheck(c)
+	if c.Err != nil {
+		return
+	}
+
+	escalation, appErr := c.App.CancelPostEscalation(c.AppContext, c.Params.PostId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(escalation)
+
+	writePostEscalation(c, w, escalation)
+}
+
+func escalatePost(c *Context, w http.ResponseWriter, r *http.Request) {
+	// license check
+	permissionErr := minimumProfessionalLicense(c)
+	if permissionErr != nil {
+		c.Err = permissionErr
+		return
+	}
+	c.RequirePostId()
+	if c.Err != nil {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("escalatePost", audit.Fail)
+	audit.AddEventParameter(auditRec, "post_id", c.Params.PostId)
+	defer c.LogAuditRec(auditRec)
+
+	postEscalationPermissionCheck(c)
+	if c.Err != nil {
+		return
+	}
+
+	escalation, appErr := c.App.EscalatePost(c.AppContext, c.Params.PostId)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(escalation)
+
+	writePostEscalation(c, w, escalation)
+}
+
+// postEscalationPermissionCheck returns the post whose escalation is being managed, which is
+// allowed to its author and to the users allowed to edit the posts of others in the channel.
+func postEscalationPermissionCheck(c *Context) *model.Post {
+	if !c.App.SessionHasPermissionToChannelByPost(*c.AppContext.Session(), c.Params.PostId, model.PermissionReadChannelContent) {
+		c.SetPermissionError(model.PermissionReadChannelContent)
+		return nil
+	}
+
+	post, appErr := c.App.GetSinglePost(c.AppContext, c.Params.PostId, false)
+	if appErr != nil {
+		c.Err = appErr
+		return nil
+	}
+
+	if post.UserId != c.AppContext.Session().UserId && !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), post.ChannelId, model.PermissionEditOthersPosts) {
+		c.SetPermissionError(model.PermissionEditOthersPosts)
+		return nil
+	}
+
+	return post
+}
+
+func writePostEscalation(c *Context, w http.ResponseWriter, escalation *model.PostEscalation) {
+	js, err := json.Marshal(escalation)
+	if err != nil {
+		c.Err = model.NewAppError("writePostEscalation", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		return
+	}
+
+	if _, err := w.Write(js); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func moveThread(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequirePostId()
 	if c.Err != nil {
@@ -4390,6 +4390,89 @@ func TestPostReminder(t *testing.T) {
 	require.Truef(t, caught, "User should have received %s event", model.WebsocketEventEphemeralMessage)
 }
 
+func TestManagePostReminders(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	client := th.Client
+	targetTime := time.Now().Add(time.Hour).Unix()
+
+	_, err := client.SetPostReminder(context.Background(), &model.PostReminder{
+		TargetTime: targetTime,
+		PostId:     th.BasicPost.Id,
+		UserId:     th.BasicUser.Id,
+	})
+	require.NoError(t, err)
+
+	standalone, resp, err := client.CreateStandalonePostReminder(context.Background(), &model.PostReminder{
+		TargetTime: targetTime + 60,
+		Message:    "Water the plants",
+		Recurrence: model.PostReminderRecurrenceWeekdays,
+		UserId:     th.BasicUser.Id,
+	})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, th.BasicUser.Id, standalone.UserId)
+
+	t.Run("list", func(t *testing.T) {
+		reminders, _, err := client.GetPostReminders(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		require.Len(t, reminders, 2)
+		assert.Equal(t, th.BasicPost.Id, reminders[0].PostId)
+		assert.Equal(t, standalone.PostId, reminders[1].PostId)
+		assert.Equal(t, "Water the plants", reminders[1].Message)
+	})
+
+	t.Run("the reminders of another user can't be managed", func(t *testing.T) {
+		_, resp, err := client.GetPostReminders(context.Background(), th.BasicUser2.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		resp, err = client.DeletePostReminder(context.Background(), th.BasicUser2.Id, th.BasicPost.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("a message is required for a standalone reminder", func(t *testing.T) {
+		_, resp, err := client.CreateStandalonePostReminder(context.Background(), &model.PostReminder{
+			TargetTime: targetTime,
+			UserId:     th.BasicUser.Id,
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("reschedule", func(t *testing.T) {
+		reminder, _, err := client.ReschedulePostReminder(context.Background(), th.BasicUser.Id, th.BasicPost.Id, targetTime+3600)
+		require.NoError(t, err)
+		assert.Equal(t, targetTime+3600, reminder.TargetTime)
+
+		_, resp, err := client.ReschedulePostReminder(context.Background(), th.BasicUser.Id, model.NewId(), targetTime)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		_, err := client.DeletePostReminder(context.Background(), th.BasicUser.Id, th.BasicPost.Id)
+		require.NoError(t, err)
+
+		resp, err := client.DeletePostReminder(context.Background(), th.BasicUser.Id, th.BasicPost.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+
+		reminders, _, err := client.GetPostReminders(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+		require.Len(t, reminders, 1)
+		assert.Equal(t, standalone.PostId, reminders[0].PostId)
+	})
+
+	t.Run("only a reminder message can be snoozed", func(t *testing.T) {
+		_, resp, err := client.SnoozePostReminder(context.Background(), th.BasicUser.Id, th.BasicPost.Id, 20)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
+
 func TestPostGetInfo(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -4743,3 +4826,91 @@ func TestUnacknowledgePost(t *testing.T) {
 	require.Error(t, err)
 	CheckUnauthorizedStatus(t, resp)
 }
+
+func TestPostEscalation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuProfessional))
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.AllowPostEscalations = true
+	})
+	client := th.Client
+
+	group := th.CreateGroup()
+	post := th.CreatePost()
+	escalation := &model.PostEscalation{
+		Levels: model.PostEscalationLevels{
+			{DelayMinutes: 5, UserIds: []string{th.BasicUser2.Id}},
+		},
+		FallbackGroupId:      group.Id,
+		FallbackDelayMinutes: 10,
+	}
+
+	t.Run("get without escalation", func(t *testing.T) {
+		_, resp, err := client.GetPostEscalation(context.Background(), post.Id)
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("set, get, escalate and cancel", func(t *testing.T) {
+		saved, _, err := client.SetPostEscalation(context.Background(), post.Id, escalation)
+		require.NoError(t, err)
+		require.Equal(t, post.Id, saved.PostId)
+		require.Equal(t, th.BasicUser.Id, saved.CreatorId)
+		require.Equal(t, model.PostEscalationStatusActive, saved.Status)
+
+		fetched, _, err := client.GetPostEscalation(context.Background(), post.Id)
+		require.NoError(t, err)
+		require.Equal(t, saved, fetched)
+
+		escalated, _, err := client.EscalatePost(context.Background(), post.Id)
+		require.NoError(t, err)
+		require.Equal(t, 1, escalated.NotifiedLevels)
+
+		canceled, _, err := client.CancelPostEscalation(context.Background(), post.Id)
+		require.NoError(t, err)
+		require.Equal(t, model.PostEscalationStatusCanceled, canceled.Status)
+
+		_, resp, err := client.EscalatePost(context.Background(), post.Id)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("invalid escalation", func(t *testing.T) {
+		invalid := *escalation
+		invalid.FallbackGroupId = ""
+		_, resp, err := client.SetPostEscalation(context.Background(), post.Id, &invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("other users can read but not manage the escalation", func(t *testing.T) {
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, _, err := th.Client.GetPostEscalation(context.Background(), post.Id)
+		require.NoError(t, err)
+
+		_, resp, err := th.Client.SetPostEscalation(context.Background(), post.Id, escalation)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = th.Client.CancelPostEscalation(context.Background(), post.Id)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("users allowed to edit others posts can manage the escalation", func(t *testing.T) {
+		_, _, err := th.SystemAdminClient.SetPostEscalation(context.Background(), post.Id, escalation)
+		require.NoError(t, err)
+	})
+
+	t.Run("without license", func(t *testing.T) {
+		th.App.Srv().SetLicense(nil)
+		defer th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuProfessional))
+
+		_, resp, err := client.GetPostEscalation(context.Background(), post.Id)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+}
@@ -69,6 +69,7 @@ func remoteClusterPing(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	ping.RecvAt = model.GetMillis()
+	ping.Capabilities = model.RemoteCapabilities
 
 	if metrics := c.App.Metrics(); metrics != nil {
 		metrics.IncrementRemoteClusterMsgReceivedCounter(rc.RemoteId)
@@ -20,6 +20,7 @@ func (api *API) InitScheduledPost() {
 	api.BaseRoutes.Posts.Handle("/schedule", api.APISessionRequired(createSchedulePost)).Methods(http.MethodPost)
 	api.BaseRoutes.Posts.Handle("/schedule/{scheduled_post_id:[A-Za-z0-9]+}", api.APISessionRequired(updateScheduledPost)).Methods(http.MethodPut)
 	api.BaseRoutes.Posts.Handle("/schedule/{scheduled_post_id:[A-Za-z0-9]+}", api.APISessionRequired(deleteScheduledPost)).Methods(http.MethodDelete)
+	api.BaseRoutes.Posts.Handle("/schedule/{scheduled_post_id:[A-Za-z0-9]+}/series/{action:pause|resume|skip|end}", api.APISessionRequired(updateScheduledPostSeries)).Methods(http.MethodPost)
 	api.BaseRoutes.Posts.Handle("/scheduled/team/{team_id:[A-Za-z0-9]+}", api.APISessionRequired(getTeamScheduledPosts)).Methods(http.MethodGet)
 }
 
@@ -227,3 +228,44 @@ func deleteScheduledPost(c *Context, w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+func updateScheduledPostSeries(c *Context, w http.ResponseWriter, r *http.Request) {
+	requireScheduledPostsEnabled(c)
+	if c.Err != nil {
+		return
+	}
+
+	scheduledPostId := mux.Vars(r)["scheduled_post_id"]
+	if scheduledPostId == "" {
+		c.SetInvalidURLParam("scheduled_post_id")
+		return
+	}
+
+	action := model.ScheduledPostSeriesAction(mux.Vars(r)["action"])
+	if !action.IsValid() {
+		c.SetInvalidURLParam("action")
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("updateScheduledPostSeries", audit.Fail)
+	defer c.LogAuditRecWithLevel(auditRec, app.LevelContent)
+	audit.AddEventParameter(auditRec, "scheduledPostId", scheduledPostId)
+	audit.AddEventParameter(auditRec, "action", string(action))
+
+	userId := c.AppContext.Session().UserId
+	connectionID := r.Header.Get(model.ConnectionId)
+	scheduledPost, appErr := c.App.UpdateScheduledPostSeries(c.AppContext, userId, scheduledPostId, action, connectionID)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddEventResultState(scheduledPost)
+	auditRec.AddEventObjectType("scheduledPost")
+
+	if err := json.NewEncoder(w).Encode(scheduledPost); err != nil {
+		mlog.Error("failed to encode scheduled post to return API response", mlog.Err(err))
+		return
+	}
+}
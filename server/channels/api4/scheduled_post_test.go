@@ -71,3 +71,63 @@ func TestCreateScheduledPost(t *testing.T) {
 		require.Nil(t, createdScheduledPost)
 	})
 }
+
+func TestUpdateScheduledPostSeries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.Srv().SetLicense(model.NewTestLicenseSKU(model.LicenseShortSkuProfessional))
+
+	client := th.Client
+
+	scheduledPost := &model.ScheduledPost{
+		Draft: model.Draft{
+			CreateAt:  model.GetMillis(),
+			UserId:    th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			Message:   "this is a recurring scheduled post",
+		},
+		ScheduledAt: model.GetMillis() + 100000, // 100 seconds in the future
+		Recurrence:  "0 9 * * 1-5",
+	}
+	createdScheduledPost, _, err := client.CreateScheduledPost(context.Background(), scheduledPost)
+	require.NoError(t, err)
+	require.Equal(t, "0 9 * * 1-5", createdScheduledPost.Recurrence)
+
+	t.Run("pause", func(t *testing.T) {
+		updatedScheduledPost, _, err := client.UpdateScheduledPostSeries(context.Background(), createdScheduledPost.Id, model.ScheduledPostSeriesPause)
+		require.NoError(t, err)
+		require.True(t, updatedScheduledPost.RecurrencePaused)
+	})
+
+	t.Run("invalid action", func(t *testing.T) {
+		_, resp, err := client.UpdateScheduledPostSeries(context.Background(), createdScheduledPost.Id, "restart")
+		require.Error(t, err)
+		CheckNotFoundStatus(t, resp)
+	})
+
+	t.Run("other users cannot update the series", func(t *testing.T) {
+		th.LoginBasic2()
+		defer th.LoginBasic()
+
+		_, resp, err := client.UpdateScheduledPostSeries(context.Background(), createdScheduledPost.Id, model.ScheduledPostSeriesEnd)
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+
+	t.Run("should reject invalid recurrence rules", func(t *testing.T) {
+		invalidScheduledPost := &model.ScheduledPost{
+			Draft: model.Draft{
+				CreateAt:  model.GetMillis(),
+				UserId:    th.BasicUser.Id,
+				ChannelId: th.BasicChannel.Id,
+				Message:   "this is a recurring scheduled post",
+			},
+			ScheduledAt: model.GetMillis() + 100000, // 100 seconds in the future
+			Recurrence:  "*/5 * * * *",
+		}
+		_, resp, err := client.CreateScheduledPost(context.Background(), invalidScheduledPost)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+}
@@ -15,6 +15,7 @@ import (
 func (api *API) InitSharedChannels() {
 	api.BaseRoutes.SharedChannels.Handle("/{team_id:[A-Za-z0-9]+}", api.APISessionRequired(getSharedChannels)).Methods(http.MethodGet)
 	api.BaseRoutes.SharedChannels.Handle("/remote_info/{remote_id:[A-Za-z0-9]+}", api.APISessionRequired(getRemoteClusterInfo)).Methods(http.MethodGet)
+	api.BaseRoutes.SharedChannels.Handle("/{channel_id:[A-Za-z0-9]+}/remote_users/search", api.APISessionRequired(searchRemoteUsers)).Methods(http.MethodGet)
 
 	api.BaseRoutes.SharedChannelRemotes.Handle("", api.APISessionRequired(getSharedChannelRemotesByRemoteCluster)).Methods(http.MethodGet)
 	api.BaseRoutes.ChannelForRemote.Handle("/invite", api.APISessionRequired(inviteRemoteClusterToChannel)).Methods(http.MethodPost)
@@ -97,6 +98,43 @@ func getRemoteClusterInfo(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func searchRemoteUsers(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireChannelId()
+	if c.Err != nil {
+		return
+	}
+
+	// make sure remote cluster service is enabled.
+	if _, appErr := c.App.GetRemoteClusterService(); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if !c.App.SessionHasPermissionToChannel(c.AppContext, *c.AppContext.Session(), c.Params.ChannelId, model.PermissionReadChannel) {
+		c.SetPermissionError(model.PermissionReadChannel)
+		return
+	}
+
+	users, appErr := c.App.SearchRemoteUsers(c.Params.ChannelId, r.URL.Query().Get("term"))
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	for _, user := range users {
+		c.App.SanitizeProfile(user, c.IsSystemAdmin())
+	}
+
+	b, err := json.Marshal(users)
+	if err != nil {
+		c.SetJSONEncodingError(err)
+		return
+	}
+	if _, err := w.Write(b); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func getSharedChannelRemotesByRemoteCluster(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireRemoteId()
 	if c.Err != nil {
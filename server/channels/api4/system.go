@@ -45,6 +45,8 @@ func (api *API) InitSystem() {
 	api.BaseRoutes.System.Handle("/timezones", api.APISessionRequired(getSupportedTimezones)).Methods(http.MethodGet)
 
 	api.BaseRoutes.APIRoot.Handle("/audits", api.APISessionRequired(getAudits)).Methods(http.MethodGet)
+	api.BaseRoutes.APIRoot.Handle("/audits/records", api.APISessionRequired(searchAuditRecords)).Methods(http.MethodGet)
+	api.BaseRoutes.APIRoot.Handle("/audits/records/verify", api.APISessionRequired(verifyAuditRecords)).Methods(http.MethodGet)
 	api.BaseRoutes.APIRoot.Handle("/notifications/test", api.APISessionRequired(testNotifications)).Methods(http.MethodPost)
 	api.BaseRoutes.APIRoot.Handle("/email/test", api.APISessionRequired(testEmail)).Methods(http.MethodPost)
 	api.BaseRoutes.APIRoot.Handle("/site_url/test", api.APISessionRequired(testSiteURL)).Methods(http.MethodPost)
@@ -63,6 +65,7 @@ func (api *API) InitSystem() {
 	api.BaseRoutes.APIRoot.Handle("/redirect_location", api.APISessionRequiredTrustRequester(getRedirectLocation)).Methods(http.MethodGet)
 
 	api.BaseRoutes.APIRoot.Handle("/notifications/ack", api.APISessionRequired(pushNotificationAck)).Methods(http.MethodPost)
+	api.BaseRoutes.APIRoot.Handle("/notifications/web_push/public_key", api.APISessionRequired(getWebPushPublicKey)).Methods(http.MethodGet)
 
 	api.BaseRoutes.APIRoot.Handle("/server_busy", api.APISessionRequired(setServerBusy)).Methods(http.MethodPost)
 	api.BaseRoutes.APIRoot.Handle("/server_busy", api.APISessionRequired(getServerBusyExpires)).Methods(http.MethodGet)
@@ -243,6 +246,18 @@ func testNotifications(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func getWebPushPublicKey(c *Context, w http.ResponseWriter, r *http.Request) {
+	publicKey, appErr := c.App.GetWebPushPublicKey()
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(publicKey); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func testEmail(c *Context, w http.ResponseWriter, r *http.Request) {
 	var cfg *model.Config
 	err := json.NewDecoder(r.Body).Decode(&cfg)
@@ -328,6 +343,79 @@ func getAudits(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func searchAuditRecords(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("searchAuditRecords", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionReadAudits) {
+		c.SetPermissionError(model.PermissionReadAudits)
+		return
+	}
+
+	query := r.URL.Query()
+	opts := model.AuditRecordSearchOpts{
+		UserId:    query.Get("user_id"),
+		EventName: query.Get("event_name"),
+		Status:    query.Get("status"),
+		Page:      c.Params.Page,
+		PerPage:   c.Params.PerPage,
+	}
+
+	for param, value := range map[string]*int64{"start_time": &opts.StartTime, "end_time": &opts.EndTime} {
+		if query.Get(param) == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(query.Get(param), 10, 64)
+		if err != nil || parsed < 0 {
+			c.SetInvalidParam(param)
+			return
+		}
+		*value = parsed
+	}
+
+	records, appErr := c.App.SearchAuditRecords(c.AppContext, opts)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	audit.AddEventParameter(auditRec, "user_id", opts.UserId)
+	audit.AddEventParameter(auditRec, "event_name", opts.EventName)
+	audit.AddEventParameter(auditRec, "status", opts.Status)
+	audit.AddEventParameter(auditRec, "start_time", opts.StartTime)
+	audit.AddEventParameter(auditRec, "end_time", opts.EndTime)
+	audit.AddEventParameter(auditRec, "page", opts.Page)
+
+	if err := json.NewEncoder(w).Encode(records); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func verifyAuditRecords(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("verifyAuditRecords", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionReadAudits) {
+		c.SetPermissionError(model.PermissionReadAudits)
+		return
+	}
+
+	result, appErr := c.App.VerifyAuditRecords(c.AppContext)
+	if appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("valid", result.Valid)
+	auditRec.AddMeta("checked", result.Checked)
+
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func databaseRecycle(c *Context, w http.ResponseWriter, r *http.Request) {
 	if !c.App.SessionHasPermissionTo(*c.AppContext.Session(), model.PermissionRecycleDatabaseConnections) {
 		c.SetPermissionError(model.PermissionRecycleDatabaseConnections)
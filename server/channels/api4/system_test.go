@@ -142,6 +142,67 @@ func TestGetAudits(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestSearchAuditRecords(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+	client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.DatabaseEnabled = true })
+	defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ExperimentalAuditSettings.DatabaseEnabled = false })
+
+	_, _, err := th.SystemAdminClient.GetAudits(context.Background(), 0, 1, "")
+	require.NoError(t, err)
+	require.NoError(t, th.App.Srv().Audit.Flush())
+
+	t.Run("filter by user and event", func(t *testing.T) {
+		records, _, err := th.SystemAdminClient.SearchAuditRecords(context.Background(), model.AuditRecordSearchOpts{
+			UserId:    th.SystemAdminUser.Id,
+			EventName: "getAudits",
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, records)
+		for _, record := range records {
+			assert.Equal(t, th.SystemAdminUser.Id, record.UserId)
+			assert.Equal(t, "getAudits", record.EventName)
+		}
+	})
+
+	t.Run("filter by time range", func(t *testing.T) {
+		records, _, err := th.SystemAdminClient.SearchAuditRecords(context.Background(), model.AuditRecordSearchOpts{
+			EventName: "getAudits",
+			EndTime:   1,
+		})
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+
+	t.Run("invalid time range", func(t *testing.T) {
+		_, resp, err := th.SystemAdminClient.SearchAuditRecords(context.Background(), model.AuditRecordSearchOpts{
+			StartTime: 2000,
+			EndTime:   1000,
+		})
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("verify chain", func(t *testing.T) {
+		result, _, err := th.SystemAdminClient.VerifyAuditRecords(context.Background())
+		require.NoError(t, err)
+		require.True(t, result.Valid, result.Reason)
+		require.NotZero(t, result.Checked)
+	})
+
+	t.Run("no permission", func(t *testing.T) {
+		_, resp, err := client.SearchAuditRecords(context.Background(), model.AuditRecordSearchOpts{})
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+
+		_, resp, err = client.VerifyAuditRecords(context.Background())
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+	})
+}
+
 func TestEmailTest(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -61,9 +61,15 @@ func (api *API) InitUser() {
 
 	api.BaseRoutes.User.Handle("/mfa", api.APISessionRequiredMfa(updateUserMfa)).Methods(http.MethodPut)
 	api.BaseRoutes.User.Handle("/mfa/generate", api.APISessionRequiredMfa(generateMfaSecret)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/webauthn/credentials/options", api.APISessionRequiredMfa(getWebAuthnRegistrationOptions)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/webauthn/credentials", api.APISessionRequiredMfa(registerWebAuthnCredential)).Methods(http.MethodPost)
+	api.BaseRoutes.User.Handle("/webauthn/credentials", api.APISessionRequiredMfa(getWebAuthnCredentials)).Methods(http.MethodGet)
+	api.BaseRoutes.User.Handle("/webauthn/credentials/{credential_id:[A-Za-z0-9]+}", api.APISessionRequiredMfa(deleteWebAuthnCredential)).Methods(http.MethodDelete)
 
 	api.BaseRoutes.Users.Handle("/login", api.APIHandler(login)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/login/desktop_token", api.RateLimitedHandler(api.APIHandler(loginWithDesktopToken), model.RateLimitSettings{PerSec: model.NewPointer(2), MaxBurst: model.NewPointer(1)})).Methods(http.MethodPost)
+	api.BaseRoutes.Users.Handle("/login/webauthn/options", api.RateLimitedHandler(api.APIHandler(getWebAuthnLoginOptions), model.RateLimitSettings{PerSec: model.NewPointer(2), MaxBurst: model.NewPointer(5)})).Methods(http.MethodPost)
+	api.BaseRoutes.Users.Handle("/login/passkey", api.APIHandler(loginWithPasskey)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/login/switch", api.APIHandler(switchAccountType)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/login/cws", api.APIHandlerTrustRequester(loginCWS)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/logout", api.APIHandler(logout)).Methods(http.MethodPost)
@@ -76,6 +82,8 @@ func (api *API) InitUser() {
 	api.BaseRoutes.User.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsForUser)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/sessions/revoke/all", api.APISessionRequired(revokeAllSessionsAllUsers)).Methods(http.MethodPost)
 	api.BaseRoutes.Users.Handle("/sessions/device", api.APISessionRequired(handleDeviceProps)).Methods(http.MethodPut)
+	api.BaseRoutes.Users.Handle("/sessions/web_push", api.APISessionRequired(attachWebPushSubscription)).Methods(http.MethodPut)
+	api.BaseRoutes.Users.Handle("/sessions/web_push", api.APISessionRequired(detachWebPushSubscription)).Methods(http.MethodDelete)
 	api.BaseRoutes.User.Handle("/audits", api.APISessionRequired(getUserAudits)).Methods(http.MethodGet)
 
 	api.BaseRoutes.User.Handle("/tokens", api.APISessionRequired(createUserAccessToken)).Methods(http.MethodPost)
@@ -1715,6 +1723,136 @@ func generateMfaSecret(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// checkWebAuthnCredentialsAccess allows users to manage their own security keys and passkeys, and
+// admins to list and delete those of the users they may edit.
+func checkWebAuthnCredentialsAccess(c *Context) bool {
+	c.RequireUserId()
+	if c.Err != nil {
+		return false
+	}
+
+	if c.AppContext.Session().IsOAuth {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		c.Err.DetailedError += ", attempted access by oauth app"
+		return false
+	}
+
+	if !c.App.SessionHasPermissionToUser(*c.AppContext.Session(), c.Params.UserId) {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return false
+	}
+
+	return true
+}
+
+// checkWebAuthnRegistrationAccess only allows users to register security keys and passkeys for
+// themselves, since the authenticator registered is the one of the session's user.
+func checkWebAuthnRegistrationAccess(c *Context) bool {
+	if !checkWebAuthnCredentialsAccess(c) {
+		return false
+	}
+
+	if c.Params.UserId != c.AppContext.Session().UserId {
+		c.SetPermissionError(model.PermissionEditOtherUsers)
+		return false
+	}
+
+	return true
+}
+
+func getWebAuthnRegistrationOptions(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !checkWebAuthnRegistrationAccess(c) {
+		return
+	}
+
+	options, err := c.App.GetWebAuthnRegistrationOptions(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	if err := json.NewEncoder(w).Encode(options); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func registerWebAuthnCredential(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !checkWebAuthnRegistrationAccess(c) {
+		return
+	}
+
+	var registration model.WebAuthnRegistration
+	if err := json.NewDecoder(r.Body).Decode(&registration); err != nil {
+		c.SetInvalidParamWithErr("credential", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("registerWebAuthnCredential", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "name", registration.Name)
+
+	credential, err := c.App.RegisterWebAuthnCredential(c.AppContext, c.Params.UserId, &registration)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	// The credential was just used by the user, which authenticates their session with it.
+	if c.Params.UserId == c.AppContext.Session().UserId {
+		if err := c.App.SetExtraSessionProps(c.AppContext.Session(), map[string]string{model.SessionPropWebAuthn: "true"}); err != nil {
+			c.Err = err
+			return
+		}
+		c.App.ClearSessionCacheForUser(c.AppContext.Session().UserId)
+	}
+
+	auditRec.Success()
+	auditRec.AddMeta("credential_id", credential.Id)
+
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(credential); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func getWebAuthnCredentials(c *Context, w http.ResponseWriter, r *http.Request) {
+	if !checkWebAuthnCredentialsAccess(c) {
+		return
+	}
+
+	credentials, err := c.App.GetWebAuthnCredentials(c.Params.UserId)
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(credentials); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
+func deleteWebAuthnCredential(c *Context, w http.ResponseWriter, r *http.Request) {
+	c.RequireWebAuthnCredentialId()
+	if !checkWebAuthnCredentialsAccess(c) {
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("deleteWebAuthnCredential", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "user_id", c.Params.UserId)
+	audit.AddEventParameter(auditRec, "credential_id", c.Params.WebAuthnCredentialId)
+
+	if err := c.App.DeleteWebAuthnCredential(c.AppContext, c.Params.UserId, c.Params.WebAuthnCredentialId); err != nil {
+		c.Err = err
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
 func updatePassword(c *Context, w http.ResponseWriter, r *http.Request) {
 	c.RequireUserId()
 	if c.Err != nil {
@@ -1837,65 +1975,127 @@ func sendPasswordReset(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
-func login(c *Context, w http.ResponseWriter, r *http.Request) {
-	// Mask all sensitive errors, with the exception of the following
-	defer func() {
-		if c.Err == nil {
-			return
-		}
+// maskLoginError masks all sensitive errors of a login, with the exception of the following
+func maskLoginError(c *Context) {
+	if c.Err == nil {
+		return
+	}
 
-		unmaskedErrors := []string{
-			"mfa.validate_token.authenticate.app_error",
-			"api.user.check_user_mfa.bad_code.app_error",
-			"api.user.login.blank_pwd.app_error",
-			"api.user.login.bot_login_forbidden.app_error",
-			"api.user.login.remote_users.login.error",
-			"api.user.login.client_side_cert.certificate.app_error",
-			"api.user.login.inactive.app_error",
-			"api.user.login.not_verified.app_error",
-			"api.user.check_user_login_attempts.too_many.app_error",
-			"app.team.join_user_to_team.max_accounts.app_error",
-			"store.sql_user.save.max_accounts.app_error",
-		}
+	unmaskedErrors := []string{
+		"mfa.validate_token.authenticate.app_error",
+		"api.user.check_user_mfa.bad_code.app_error",
+		"api.user.check_user_mfa.webauthn_required.app_error",
+		"api.user.login.blank_pwd.app_error",
+		"api.user.login.bot_login_forbidden.app_error",
+		"api.user.login.remote_users.login.error",
+		"api.user.login.client_side_cert.certificate.app_error",
+		"api.user.login.inactive.app_error",
+		"api.user.login.not_verified.app_error",
+		"api.user.check_user_login_attempts.too_many.app_error",
+		"app.team.join_user_to_team.max_accounts.app_error",
+		"store.sql_user.save.max_accounts.app_error",
+	}
 
-		maskError := true
+	maskError := true
 
-		for _, unmaskedError := range unmaskedErrors {
-			if c.Err.Id == unmaskedError {
-				maskError = false
-			}
+	for _, unmaskedError := range unmaskedErrors {
+		if c.Err.Id == unmaskedError {
+			maskError = false
 		}
+	}
 
-		if !maskError {
-			return
-		}
+	if !maskError {
+		return
+	}
 
-		config := c.App.Config()
-		enableUsername := *config.EmailSettings.EnableSignInWithUsername
-		enableEmail := *config.EmailSettings.EnableSignInWithEmail
-		samlEnabled := *config.SamlSettings.Enable
-		gitlabEnabled := *config.GitLabSettings.Enable
-		openidEnabled := *config.OpenIdSettings.Enable
-		googleEnabled := *config.GoogleSettings.Enable
-		office365Enabled := *config.Office365Settings.Enable
+	config := c.App.Config()
+	enableUsername := *config.EmailSettings.EnableSignInWithUsername
+	enableEmail := *config.EmailSettings.EnableSignInWithEmail
+	samlEnabled := *config.SamlSettings.Enable
+	gitlabEnabled := *config.GitLabSettings.Enable
+	openidEnabled := *config.OpenIdSettings.Enable
+	googleEnabled := *config.GoogleSettings.Enable
+	office365Enabled := *config.Office365Settings.Enable
 
-		if samlEnabled || gitlabEnabled || googleEnabled || office365Enabled || openidEnabled {
-			c.Err = model.NewAppError("login", "api.user.login.invalid_credentials_sso", nil, "", http.StatusUnauthorized)
-			return
-		}
+	if samlEnabled || gitlabEnabled || googleEnabled || office365Enabled || openidEnabled {
+		c.Err = model.NewAppError("login", "api.user.login.invalid_credentials_sso", nil, "", http.StatusUnauthorized)
+		return
+	}
 
-		if enableUsername && !enableEmail {
-			c.Err = model.NewAppError("login", "api.user.login.invalid_credentials_username", nil, "", http.StatusUnauthorized)
-			return
+	if enableUsername && !enableEmail {
+		c.Err = model.NewAppError("login", "api.user.login.invalid_credentials_username", nil, "", http.StatusUnauthorized)
+		return
+	}
+
+	if !enableUsername && enableEmail {
+		c.Err = model.NewAppError("login", "api.user.login.invalid_credentials_email", nil, "", http.StatusUnauthorized)
+		return
+	}
+
+	c.Err = model.NewAppError("login", "api.user.login.invalid_credentials_email_username", nil, "", http.StatusUnauthorized)
+}
+
+// checkLoginAllowed rejects the logins of users who authenticated but may not log in.
+func checkLoginAllowed(c *Context, user *model.User) *model.AppError {
+	if user.IsGuest() {
+		if c.App.Channels().License() == nil {
+			return model.NewAppError("login", "api.user.login.guest_accounts.license.error", nil, "", http.StatusUnauthorized)
 		}
+		if !*c.App.Config().GuestAccountsSettings.Enable {
+			return model.NewAppError("login", "api.user.login.guest_accounts.disabled.error", nil, "", http.StatusUnauthorized)
+		}
+	}
 
-		if !enableUsername && enableEmail {
-			c.Err = model.NewAppError("login", "api.user.login.invalid_credentials_email", nil, "", http.StatusUnauthorized)
-			return
+	if user.IsRemote() {
+		return model.NewAppError("login", "api.user.login.remote_users.login.error", nil, "", http.StatusUnauthorized)
+	}
+
+	return nil
+}
+
+// completeLogin creates the session of an authenticated user and prepares the user for the response.
+// The session is marked as authenticated with a security key or passkey when webAuthn is set.
+func completeLogin(c *Context, w http.ResponseWriter, r *http.Request, user *model.User, deviceId string, webAuthn bool) bool {
+	isMobileDevice := utils.IsMobileRequest(r)
+	session, err := c.App.DoLogin(c.AppContext, w, r, user, deviceId, isMobileDevice, false, false)
+	if err != nil {
+		c.Err = err
+		return false
+	}
+
+	if webAuthn {
+		if err := c.App.SetExtraSessionProps(session, map[string]string{model.SessionPropWebAuthn: "true"}); err != nil {
+			c.Err = err
+			return false
 		}
+		c.App.AddSessionToCache(session)
+	}
+	c.AppContext = c.AppContext.WithSession(session)
 
-		c.Err = model.NewAppError("login", "api.user.login.invalid_credentials_email_username", nil, "", http.StatusUnauthorized)
-	}()
+	c.LogAuditWithUserId(user.Id, "success")
+
+	if r.Header.Get(model.HeaderRequestedWith) == model.HeaderRequestedWithXML {
+		c.App.AttachSessionCookies(c.AppContext, w, r)
+	}
+
+	userTermsOfService, err := c.App.GetUserTermsOfService(user.Id)
+	if err != nil && err.StatusCode != http.StatusNotFound {
+		c.Err = err
+		return false
+	}
+
+	if userTermsOfService != nil {
+		user.TermsOfServiceId = userTermsOfService.TermsOfServiceId
+		user.TermsOfServiceCreateAt = userTermsOfService.CreateAt
+	}
+
+	user.Sanitize(map[string]bool{})
+
+	return true
+}
+
+func login(c *Context, w http.ResponseWriter, r *http.Request) {
+	defer maskLoginError(c)
 
 	props := model.MapFromJSON(r.Body)
 	id := props["id"]
@@ -1939,50 +2139,54 @@ func login(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 	auditRec.AddEventResultState(user)
 
-	if user.IsGuest() {
-		if c.App.Channels().License() == nil {
-			c.Err = model.NewAppError("login", "api.user.login.guest_accounts.license.error", nil, "", http.StatusUnauthorized)
-			return
-		}
-		if !*c.App.Config().GuestAccountsSettings.Enable {
-			c.Err = model.NewAppError("login", "api.user.login.guest_accounts.disabled.error", nil, "", http.StatusUnauthorized)
-			return
-		}
-	}
-
-	if user.IsRemote() {
-		c.Err = model.NewAppError("login", "api.user.login.remote_users.login.error", nil, "", http.StatusUnauthorized)
+	if c.Err = checkLoginAllowed(c, user); c.Err != nil {
 		return
 	}
 
 	c.LogAuditWithUserId(user.Id, "authenticated")
 
-	isMobileDevice := utils.IsMobileRequest(r)
-	session, err := c.App.DoLogin(c.AppContext, w, r, user, deviceId, isMobileDevice, false, false)
-	if err != nil {
-		c.Err = err
+	if !completeLogin(c, w, r, user, deviceId, c.App.IsWebAuthnLogin(user, mfaToken)) {
 		return
 	}
-	c.AppContext = c.AppContext.WithSession(session)
 
-	c.LogAuditWithUserId(user.Id, "success")
+	auditRec.Success()
+	if err := json.NewEncoder(w).Encode(user); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
 
-	if r.Header.Get(model.HeaderRequestedWith) == model.HeaderRequestedWithXML {
-		c.App.AttachSessionCookies(c.AppContext, w, r)
+func loginWithPasskey(c *Context, w http.ResponseWriter, r *http.Request) {
+	defer maskLoginError(c)
+
+	var props struct {
+		DeviceId   string                   `json:"device_id"`
+		Credential *model.WebAuthnAssertion `json:"credential"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&props); err != nil || props.Credential == nil {
+		c.SetInvalidParamWithErr("credential", err)
+		return
 	}
 
-	userTermsOfService, err := c.App.GetUserTermsOfService(user.Id)
-	if err != nil && err.StatusCode != http.StatusNotFound {
+	auditRec := c.MakeAuditRecord("loginWithPasskey", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+	audit.AddEventParameter(auditRec, "device_id", props.DeviceId)
+
+	user, err := c.App.AuthenticateUserWithPasskey(c.AppContext, props.Credential)
+	if err != nil {
 		c.Err = err
 		return
 	}
+	auditRec.AddEventResultState(user)
 
-	if userTermsOfService != nil {
-		user.TermsOfServiceId = userTermsOfService.TermsOfServiceId
-		user.TermsOfServiceCreateAt = userTermsOfService.CreateAt
+	if c.Err = checkLoginAllowed(c, user); c.Err != nil {
+		return
 	}
 
-	user.Sanitize(map[string]bool{})
+	c.LogAuditWithUserId(user.Id, "authenticated with passkey")
+
+	if !completeLogin(c, w, r, user, props.DeviceId, true) {
+		return
+	}
 
 	auditRec.Success()
 	if err := json.NewEncoder(w).Encode(user); err != nil {
@@ -1990,6 +2194,23 @@ func login(c *Context, w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func getWebAuthnLoginOptions(c *Context, w http.ResponseWriter, r *http.Request) {
+	defer maskLoginError(c)
+
+	props := model.MapFromJSON(r.Body)
+
+	options, err := c.App.GetWebAuthnLoginOptions(c.AppContext, props["login_id"], props["password"])
+	if err != nil {
+		c.Err = err
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-cache")
+	if err := json.NewEncoder(w).Encode(options); err != nil {
+		c.Logger.Warn("Error while writing response", mlog.Err(err))
+	}
+}
+
 func loginWithDesktopToken(c *Context, w http.ResponseWriter, r *http.Request) {
 	props := model.MapFromJSON(r.Body)
 	token := props["token"]
@@ -2278,6 +2499,38 @@ func handleDeviceProps(c *Context, w http.ResponseWriter, r *http.Request) {
 	ReturnStatusOK(w)
 }
 
+func attachWebPushSubscription(c *Context, w http.ResponseWriter, r *http.Request) {
+	var subscription *model.WebPushSubscription
+	if err := json.NewDecoder(r.Body).Decode(&subscription); err != nil || subscription == nil {
+		c.SetInvalidParamWithErr("subscription", err)
+		return
+	}
+
+	auditRec := c.MakeAuditRecord("attachWebPushSubscription", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if appErr := c.App.AttachWebPushSubscription(c.AppContext.Session(), subscription); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
+func detachWebPushSubscription(c *Context, w http.ResponseWriter, r *http.Request) {
+	auditRec := c.MakeAuditRecord("detachWebPushSubscription", audit.Fail)
+	defer c.LogAuditRec(auditRec)
+
+	if appErr := c.App.DetachWebPushSubscription(c.AppContext.Session()); appErr != nil {
+		c.Err = appErr
+		return
+	}
+
+	auditRec.Success()
+	ReturnStatusOK(w)
+}
+
 func attachDeviceId(c *Context, w http.ResponseWriter, r *http.Request, deviceId string) {
 	auditRec := c.MakeAuditRecord("attachDeviceId", audit.Fail)
 	defer c.LogAuditRec(auditRec)
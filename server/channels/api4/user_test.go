@@ -6,6 +6,7 @@ package api4
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"image/png"
@@ -29,6 +30,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/utils/testutils"
 	"github.com/mattermost/mattermost/server/v8/einterfaces/mocks"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/mail"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/webauthn/webauthntest"
 
 	_ "github.com/mattermost/mattermost/server/v8/channels/app/oauthproviders/gitlab"
 )
@@ -3479,6 +3481,141 @@ func TestGenerateMfaSecret(t *testing.T) {
 	CheckUnauthorizedStatus(t, resp)
 }
 
+func TestWebAuthnCredentials(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	siteURL := "http://localhost:8065"
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.SiteURL = siteURL
+		*cfg.ServiceSettings.EnableMultifactorAuthentication = true
+	})
+
+	_, resp, err := th.Client.GetWebAuthnRegistrationOptions(context.Background(), th.BasicUser.Id)
+	require.Error(t, err)
+	CheckNotImplementedStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableWebAuthn = true })
+
+	_, resp, err = th.Client.GetWebAuthnRegistrationOptions(context.Background(), th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	_, resp, err = th.SystemAdminClient.GetWebAuthnRegistrationOptions(context.Background(), th.BasicUser.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	authenticator := webauthntest.NewAuthenticator(true)
+	options, _, err := th.Client.GetWebAuthnRegistrationOptions(context.Background(), th.BasicUser.Id)
+	require.NoError(t, err)
+
+	credential, resp, err := th.Client.RegisterWebAuthnCredential(context.Background(), th.BasicUser.Id, &model.WebAuthnRegistration{
+		Name:        "Security key",
+		Attestation: authenticator.Register(siteURL, options),
+	})
+	require.NoError(t, err)
+	CheckCreatedStatus(t, resp)
+	assert.Equal(t, "Security key", credential.Name)
+	assert.Empty(t, credential.PublicKey)
+
+	session, appErr := th.App.GetSession(th.Client.AuthToken)
+	require.Nil(t, appErr)
+	assert.Equal(t, "true", session.Props[model.SessionPropWebAuthn], "registering a credential should authenticate the session with it")
+
+	credentials, _, err := th.Client.GetWebAuthnCredentials(context.Background(), th.BasicUser.Id)
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+	assert.Equal(t, credential.Id, credentials[0].Id)
+
+	_, resp, err = th.Client.GetWebAuthnCredentials(context.Background(), th.BasicUser2.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	credentials, _, err = th.SystemAdminClient.GetWebAuthnCredentials(context.Background(), th.BasicUser.Id)
+	require.NoError(t, err)
+	assert.Len(t, credentials, 1)
+
+	t.Run("login with the security key", func(t *testing.T) {
+		client := th.CreateClient()
+
+		_, _, err := client.Login(context.Background(), th.BasicUser.Email, th.BasicUser.Password)
+		CheckErrorID(t, err, "api.user.check_user_mfa.webauthn_required.app_error")
+
+		options, _, err := client.GetWebAuthnLoginOptions(context.Background(), th.BasicUser.Email, th.BasicUser.Password)
+		require.NoError(t, err)
+		require.Len(t, options.AllowCredentials, 1)
+
+		user, _, err := client.LoginWithWebAuthn(context.Background(), th.BasicUser.Email, th.BasicUser.Password, authenticator.Assert(siteURL, options))
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Id, user.Id)
+
+		session, appErr := th.App.GetSession(client.AuthToken)
+		require.Nil(t, appErr)
+		assert.Equal(t, "true", session.Props[model.SessionPropWebAuthn])
+	})
+
+	t.Run("login with the passkey", func(t *testing.T) {
+		client := th.CreateClient()
+
+		_, resp, err := client.GetWebAuthnLoginOptions(context.Background(), "", "")
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnablePasskeyLogin = true })
+
+		options, _, err := client.GetWebAuthnLoginOptions(context.Background(), "", "")
+		require.NoError(t, err)
+
+		user, _, err := client.LoginWithPasskey(context.Background(), authenticator.Assert(siteURL, options), "")
+		require.NoError(t, err)
+		assert.Equal(t, th.BasicUser.Id, user.Id)
+
+		_, _, err = client.LoginWithPasskey(context.Background(), authenticator.Assert(siteURL, options), "")
+		CheckErrorID(t, err, "api.user.login_passkey.invalid.app_error")
+	})
+
+	t.Run("enforced security keys", func(t *testing.T) {
+		th.App.Srv().SetLicense(model.NewTestLicense("mfa"))
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.EnforceMultifactorAuthentication = true
+			*cfg.ServiceSettings.EnforceWebAuthn = true
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.EnforceMultifactorAuthentication = false
+			*cfg.ServiceSettings.EnforceWebAuthn = false
+		})
+
+		_, _, err := th.Client.GetTeam(context.Background(), th.BasicTeam.Id, "")
+		require.NoError(t, err)
+
+		session, appErr := th.App.CreateSession(th.Context, &model.Session{UserId: th.BasicUser.Id, Roles: th.BasicUser.Roles})
+		require.Nil(t, appErr)
+		client := th.CreateClient()
+		client.AuthToken = session.Token
+		client.AuthType = model.HeaderBearer
+
+		_, resp, err := client.GetTeam(context.Background(), th.BasicTeam.Id, "")
+		require.Error(t, err)
+		CheckForbiddenStatus(t, resp)
+		CheckErrorID(t, err, "api.context.webauthn_required.app_error")
+
+		// Security keys can still be managed to comply
+		_, _, err = client.GetWebAuthnCredentials(context.Background(), th.BasicUser.Id)
+		require.NoError(t, err)
+	})
+
+	resp, err = th.Client.DeleteWebAuthnCredential(context.Background(), th.BasicUser.Id, model.NewId())
+	require.Error(t, err)
+	CheckNotFoundStatus(t, resp)
+
+	_, err = th.Client.DeleteWebAuthnCredential(context.Background(), th.BasicUser.Id, credential.Id)
+	require.NoError(t, err)
+
+	user, appErr := th.App.GetUser(th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.False(t, user.MfaActive)
+}
+
 func TestUpdateUserPassword(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -3946,6 +4083,67 @@ func TestAttachDeviceId(t *testing.T) {
 	})
 }
 
+func TestWebPushSubscription(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	subscription := &model.WebPushSubscription{
+		Endpoint: "https://push.example.com/send/abc",
+		Keys: model.WebPushSubscriptionKeys{
+			P256dh: "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+			Auth:   "BTBZMqHH6r4Tts7J_aSIgg",
+		},
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EnableWebPushNotifications = false })
+
+		_, resp, err := th.Client.GetWebPushPublicKey(context.Background())
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+
+		resp, err = th.Client.AttachWebPushSubscription(context.Background(), subscription)
+		require.Error(t, err)
+		CheckNotImplementedStatus(t, resp)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.EmailSettings.EnableWebPushNotifications = true })
+
+	t.Run("get public key", func(t *testing.T) {
+		publicKey, _, err := th.Client.GetWebPushPublicKey(context.Background())
+		require.NoError(t, err)
+		key, err := base64.RawURLEncoding.DecodeString(publicKey.PublicKey)
+		require.NoError(t, err)
+		assert.Len(t, key, 65)
+	})
+
+	t.Run("invalid subscription", func(t *testing.T) {
+		invalid := *subscription
+		invalid.Endpoint = "http://push.example.com/send/abc"
+		resp, err := th.Client.AttachWebPushSubscription(context.Background(), &invalid)
+		require.Error(t, err)
+		CheckBadRequestStatus(t, resp)
+	})
+
+	t.Run("attach and detach", func(t *testing.T) {
+		_, err := th.Client.AttachWebPushSubscription(context.Background(), subscription)
+		require.NoError(t, err)
+
+		session, appErr := th.App.GetSession(th.Client.AuthToken)
+		require.Nil(t, appErr)
+		var attached model.WebPushSubscription
+		require.NoError(t, json.Unmarshal([]byte(session.Props[model.SessionPropWebPushSubscription]), &attached))
+		assert.Equal(t, *subscription, attached)
+
+		_, err = th.Client.DetachWebPushSubscription(context.Background())
+		require.NoError(t, err)
+
+		session, appErr = th.App.GetSession(th.Client.AuthToken)
+		require.Nil(t, appErr)
+		assert.Empty(t, session.Props[model.SessionPropWebPushSubscription])
+	})
+}
+
 func TestGetUserAudits(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
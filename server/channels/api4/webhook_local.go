@@ -24,6 +24,7 @@ func (api *API) InitWebhookLocal() {
 	api.BaseRoutes.OutgoingHook.Handle("", api.APILocal(getOutgoingHook)).Methods(http.MethodGet)
 	api.BaseRoutes.OutgoingHook.Handle("", api.APILocal(updateOutgoingHook)).Methods(http.MethodPut)
 	api.BaseRoutes.OutgoingHook.Handle("", api.APILocal(deleteOutgoingHook)).Methods(http.MethodDelete)
+	api.BaseRoutes.OutgoingHook.Handle("/deliveries", api.APILocal(getOutgoingHookDeliveries)).Methods(http.MethodGet)
 }
 
 func localCreateIncomingHook(c *Context, w http.ResponseWriter, r *http.Request) {
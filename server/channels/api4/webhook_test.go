@@ -5,6 +5,7 @@ package api4
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1062,6 +1063,89 @@ func TestRegenOutgoingHookToken(t *testing.T) {
 	CheckNotImplementedStatus(t, resp)
 }
 
+func TestRegenOutgoingHookSecret(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = true })
+
+	hook := &model.OutgoingWebhook{ChannelId: th.BasicChannel.Id, TeamId: th.BasicChannel.TeamId, CallbackURLs: []string{"http://nowhere.com"}}
+	rhook, _, err := th.SystemAdminClient.CreateOutgoingWebhook(context.Background(), hook)
+	require.NoError(t, err)
+	require.NotEmpty(t, rhook.Secret)
+
+	_, resp, err := th.SystemAdminClient.RegenOutgoingHookSecret(context.Background(), "junk")
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	regenHook, _, err := th.SystemAdminClient.RegenOutgoingHookSecret(context.Background(), rhook.Id)
+	require.NoError(t, err)
+	require.NotEqual(t, rhook.Secret, regenHook.Secret, "regen didn't work properly")
+	require.Equal(t, rhook.Token, regenHook.Token)
+
+	_, resp, err = client.RegenOutgoingHookSecret(context.Background(), rhook.Id)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = false })
+	_, resp, err = th.SystemAdminClient.RegenOutgoingHookSecret(context.Background(), rhook.Id)
+	require.Error(t, err)
+	CheckNotImplementedStatus(t, resp)
+}
+
+func TestGetOutgoingWebhookDeliveries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+	client := th.Client
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = true })
+
+	hook := &model.OutgoingWebhook{ChannelId: th.BasicChannel.Id, TeamId: th.BasicChannel.TeamId, CallbackURLs: []string{"http://nowhere.com"}}
+	rhook, _, err := th.SystemAdminClient.CreateOutgoingWebhook(context.Background(), hook)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = th.App.Srv().Store().Webhook().SaveOutgoingDelivery(&model.OutgoingWebhookDelivery{
+			HookId:      rhook.Id,
+			CallbackURL: "http://nowhere.com",
+			ContentType: "application/json",
+			Status:      model.OutgoingWebhookDeliveryStatusFailed,
+			StatusCode:  http.StatusBadGateway,
+			CreateAt:    model.GetMillis() + int64(i),
+		})
+		require.NoError(t, err)
+	}
+
+	th.TestForSystemAdminAndLocal(t, func(t *testing.T, client *model.Client4) {
+		deliveries, _, err := client.GetOutgoingWebhookDeliveries(context.Background(), rhook.Id, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 3)
+		for _, delivery := range deliveries {
+			assert.Equal(t, rhook.Id, delivery.HookId)
+			assert.Empty(t, delivery.Payload)
+		}
+		assert.GreaterOrEqual(t, deliveries[0].CreateAt, deliveries[1].CreateAt)
+
+		deliveries, _, err = client.GetOutgoingWebhookDeliveries(context.Background(), rhook.Id, 1, 2)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+	})
+
+	_, resp, err := th.SystemAdminClient.GetOutgoingWebhookDeliveries(context.Background(), "junk", 0, 10)
+	require.Error(t, err)
+	CheckBadRequestStatus(t, resp)
+
+	_, resp, err = client.GetOutgoingWebhookDeliveries(context.Background(), rhook.Id, 0, 10)
+	require.Error(t, err)
+	CheckForbiddenStatus(t, resp)
+
+	th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableOutgoingWebhooks = false })
+	_, resp, err = th.SystemAdminClient.GetOutgoingWebhookDeliveries(context.Background(), rhook.Id, 0, 10)
+	require.Error(t, err)
+	CheckNotImplementedStatus(t, resp)
+}
+
 func TestUpdateOutgoingHook(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -54,10 +54,18 @@ type AppIface interface {
 	AddPublicKey(name string, key io.Reader) *model.AppError
 	// AddUserToChannel adds a user to a given channel.
 	AddUserToChannel(c request.CTX, user *model.User, channel *model.Channel, skipTeamMemberIntegrityCheck bool) (*model.ChannelMember, *model.AppError)
+	// AttachWebPushSubscription attaches a browser's push subscription to its session, so that
+	// push notifications for the session's user are sent to it.
+	AttachWebPushSubscription(session *model.Session, subscription *model.WebPushSubscription) *model.AppError
+	// AuthenticateUserWithPasskey logs in the user whose passkey made the assertion, without a password.
+	// The passkey must have verified the user, which makes it count for both factors.
+	AuthenticateUserWithPasskey(rctx request.CTX, assertion *model.WebAuthnAssertion) (*model.User, *model.AppError)
 	// Caller must close the first return value
 	ExportFileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
 	// Caller must close the first return value
 	FileReader(path string) (filestore.ReadCloseSeeker, *model.AppError)
+	// CancelPostEscalation stops escalating the notifications of the post.
+	CancelPostEscalation(rctx request.CTX, postID string) (*model.PostEscalation, *model.AppError)
 	// ChannelMembersMinusGroupMembers returns the set of users in the given channel minus the set of users in the given
 	// groups.
 	//
@@ -71,6 +79,9 @@ type AppIface interface {
 	// If includeRemovedMembers is true, then channel members who left or were removed from the channel will
 	// be included; otherwise, they will be excluded.
 	ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, *model.AppError)
+	// CheckChannelBookmarkLinks revalidates the links of the bookmarks that haven't been checked
+	// recently, flagging the broken ones and refreshing the preview of the others.
+	CheckChannelBookmarkLinks(rctx request.CTX) error
 	// CheckProviderAttributes returns the empty string if the patch can be applied without
 	// overriding attributes set by the user's login provider; otherwise, the name of the offending
 	// field is returned.
@@ -102,11 +113,19 @@ type AppIface interface {
 	// CreateGuest creates a guest and sets several fields of the returned User struct to
 	// their zero values.
 	CreateGuest(c request.CTX, user *model.User) (*model.User, *model.AppError)
+	// CreateStandalonePostReminder creates a reminder about its own message rather than about a post.
+	CreateStandalonePostReminder(rctx request.CTX, reminder *model.PostReminder) (*model.PostReminder, *model.AppError)
 	// CreateUser creates a user and sets several fields of the returned User struct to
 	// their zero values.
 	CreateUser(c request.CTX, user *model.User) (*model.User, *model.AppError)
 	// Creates and stores FileInfos for a post created before the FileInfos table existed.
 	MigrateFilenamesToFileInfos(rctx request.CTX, post *model.Post) []*model.FileInfo
+	// DecodeIncomingWebhookPayload decodes the body of a request to an incoming webhook, rendering it
+	// through the hook's payload template if it has one.
+	DecodeIncomingWebhookPayload(hook *model.IncomingWebhook, payload []byte) (*model.IncomingWebhookRequest, *model.AppError)
+	// DeduplicateFiles moves the content of the files uploaded before content deduplication was
+	// enabled to blobs, then removes the blobs no longer referenced from the file store.
+	DeduplicateFiles(rctx request.CTX) error
 	// DefaultChannelNames returns the list of system-wide default channel names.
 	//
 	// By default the list will be (not necessarily in this order):
@@ -127,11 +146,16 @@ type AppIface interface {
 	DeletePersistentNotification(c request.CTX, post *model.Post) *model.AppError
 	// DeletePublicKey will delete plugin public key from the config.
 	DeletePublicKey(name string) *model.AppError
+	// DeleteWebAuthnCredential removes a credential of the user. Removing the last credential of a user
+	// without an authenticator app turns off multi-factor authentication for them.
+	DeleteWebAuthnCredential(rctx request.CTX, userID, credentialID string) *model.AppError
 	// DemoteUserToGuest Convert user's roles and all his membership's roles from
 	// regular user roles to guest roles.
 	DemoteUserToGuest(c request.CTX, user *model.User) *model.AppError
 	// DetachPlugin allows the server to bind to an existing plugin instance launched elsewhere.
 	DetachPlugin(pluginId string) *model.AppError
+	// DetachWebPushSubscription removes the push subscription attached to the session, if any.
+	DetachWebPushSubscription(session *model.Session) *model.AppError
 	// DisablePlugin will set the config for an installed plugin to disabled, triggering deactivation if active.
 	// Notifies cluster peers through config change.
 	DisablePlugin(id string) *model.AppError
@@ -144,6 +168,8 @@ type AppIface interface {
 	// EnsureBot provides similar functionality with the plugin-api BotService. It doesn't accept
 	// any ensureBotOptions hence it is not required for now.
 	EnsureBot(rctx request.CTX, pluginID string, bot *model.Bot) (string, error)
+	// EscalatePost notifies the next level of the escalation right away, without waiting for its delay.
+	EscalatePost(rctx request.CTX, postID string) (*model.PostEscalation, *model.AppError)
 	// Expand announcements in incoming webhooks from Slack. Those announcements
 	// can be found in the text attribute, or in the pretext, text, title and value
 	// attributes of the attachment structure. The Slack attachment structure is
@@ -171,6 +197,9 @@ type AppIface interface {
 	GetBot(rctx request.CTX, botUserId string, includeDeleted bool) (*model.Bot, *model.AppError)
 	// GetBots returns the requested page of bots.
 	GetBots(rctx request.CTX, options *model.BotGetOptions) (model.BotList, *model.AppError)
+	// GetChannelBookmarksWithBrokenLinks returns the channel's link bookmarks whose link was found
+	// to be broken the last time it was checked.
+	GetChannelBookmarksWithBrokenLinks(channelId string) ([]*model.ChannelBookmarkWithFileInfo, *model.AppError)
 	// GetChannelGroupUsers returns the users who are associated to the channel via GroupChannels and GroupMembers.
 	GetChannelGroupUsers(channelID string) ([]*model.User, *model.AppError)
 	// GetChannelModerationsForChannel Gets a channels ChannelModerations from either the higherScoped roles or from the channel scheme roles.
@@ -179,6 +208,14 @@ type AppIface interface {
 	GetClusterPluginStatuses() (model.PluginStatuses, *model.AppError)
 	// GetConfigFile proxies access to the given configuration file to the underlying config store.
 	GetConfigFile(name string) ([]byte, error)
+	// GetConfigHistory returns a page of the saved configurations, most recent first, each along
+	// with the settings it changed compared to the configuration saved before it.
+	GetConfigHistory(page, perPage int) ([]*model.ConfigHistoryEntry, *model.AppError)
+	// GetConfigVersion returns a copy of the given saved configuration.
+	GetConfigVersion(id string) (*model.Config, *model.AppError)
+	// GetConfigVersionDiff returns the settings that restoring the given saved configuration would
+	// change in the active configuration.
+	GetConfigVersionDiff(id string) ([]*model.ConfigChange, *model.AppError)
 	// GetEmojiStaticURL returns a relative static URL for system default emojis,
 	// and the API route for custom ones. Errors if not found or if custom and deleted.
 	GetEmojiStaticURL(c request.CTX, emojiName string) (string, *model.AppError)
@@ -191,6 +228,9 @@ type AppIface interface {
 	GetFilteredUsersStats(options *model.UserCountOptions) (*model.UsersStats, *model.AppError)
 	// GetGroupsByTeam returns the paged list and the total count of group associated to the given team.
 	GetGroupsByTeam(teamID string, opts model.GroupSearchOpts) ([]*model.GroupWithSchemeAdmin, int, *model.AppError)
+	// GetIncomingWebhookForRequest returns the hook a request to an incoming webhook posts with,
+	// once the request is counted against the hook's rate limit.
+	GetIncomingWebhookForRequest(c request.CTX, hookID string) (*model.IncomingWebhook, *model.AppError)
 	// GetKnownUsers returns the list of user ids of users with any direct
 	// relationship with a user. That means any user sharing any channel, including
 	// direct and group channels.
@@ -217,6 +257,8 @@ type AppIface interface {
 	// To get the plugins environment when the plugins are disabled, manually acquire the plugins
 	// lock instead.
 	GetPluginsEnvironment() *plugin.Environment
+	// GetPostEscalation returns the escalation of the post, or nil if the post was never escalated.
+	GetPostEscalation(postID string) (*model.PostEscalation, *model.AppError)
 	// GetPostsByIds response bool value indicates, if the post is inaccessible due to cloud plan's limit.
 	GetPostsByIds(postIDs []string) ([]*model.Post, int64, *model.AppError)
 	// GetPostsUsage returns the total posts count rounded down to the most
@@ -247,11 +289,24 @@ type AppIface interface {
 	GetTotalUsersStats(viewRestrictions *model.ViewUsersRestrictions) (*model.UsersStats, *model.AppError)
 	// GetUserStatusesByIds used by apiV4
 	GetUserStatusesByIds(userIDs []string) ([]*model.Status, *model.AppError)
+	// GetWebAuthnLoginOptions challenges a login with a WebAuthn credential. Given the login ID and
+	// password of a user, it returns the credentials of the user to use as a second factor, once the
+	// password has been verified. Without a login ID, it starts a passwordless login with a passkey.
+	GetWebAuthnLoginOptions(rctx request.CTX, loginID, password string) (*model.WebAuthnRequestOptions, *model.AppError)
+	// GetWebAuthnRegistrationOptions starts the registration of a security key or passkey by the user.
+	GetWebAuthnRegistrationOptions(userID string) (*model.WebAuthnCreationOptions, *model.AppError)
+	// GetWebPushPublicKey returns the server's VAPID public key, which browsers need to subscribe
+	// to web push notifications.
+	GetWebPushPublicKey() (*model.WebPushPublicKey, *model.AppError)
+	// HandleIncomingWebhook posts the request with the hook, as returned by GetIncomingWebhookForRequest.
+	HandleIncomingWebhook(c request.CTX, hook *model.IncomingWebhook, req *model.IncomingWebhookRequest) *model.AppError
 	// HasRemote returns whether a given channelID is present in the channel remotes or not.
 	HasRemote(channelID string, remoteID string) (bool, error)
 	// InstallPlugin unpacks and installs a plugin but does not enable or activate it unless the the
 	// plugin was already enabled.
 	InstallPlugin(pluginFile io.ReadSeeker, replace bool) (*model.Manifest, *model.AppError)
+	// IsWebAuthnLogin tells whether a successful login of the user was made with a WebAuthn second factor.
+	IsWebAuthnLogin(user *model.User, mfaToken string) bool
 	// LogAuditRec logs an audit record using default LvlAuditCLI.
 	LogAuditRec(rctx request.CTX, rec *audit.Record, err error)
 	// LogAuditRecWithLevel logs an audit record using specified Level.
@@ -299,31 +354,59 @@ type AppIface interface {
 	// PopulateWebConnConfig checks if the connection id already exists in the hub,
 	// and if so, accordingly populates the other fields of the webconn.
 	PopulateWebConnConfig(s *model.Session, cfg *platform.WebConnConfig, seqVal string) (*platform.WebConnConfig, error)
+	// ProcessPostEscalations notifies the next level of the escalations that weren't
+	// acknowledged in time, stopping the ones acknowledged in the meantime. The level of an
+	// escalation failing to be processed is skipped, so that it doesn't hold up the others.
+	ProcessPostEscalations(rctx request.CTX) error
 	// PromoteGuestToUser Convert user's roles and all his membership's roles from
 	// guest roles to regular user roles.
 	PromoteGuestToUser(c request.CTX, user *model.User, requestorId string) *model.AppError
+	// PublishSharedDraft posts the content of the draft to its channel as the given user. The
+	// draft is deleted beforehand, so that it's published only once, and the publication is
+	// rejected with a conflict when someone else updated the draft since updateAt.
+	PublishSharedDraft(c request.CTX, draft *model.SharedDraft, updateAt int64, userID, sessionID, connectionID string) (*model.Post, *model.AppError)
 	// ReattachPlugin allows the server to bind to an existing plugin instance launched elsewhere.
 	ReattachPlugin(manifest *model.Manifest, pluginReattachConfig *model.PluginReattachConfig) *model.AppError
+	// RegisterWebAuthnCredential verifies and saves the credential created by the authenticator of
+	// the user. Registering a first credential turns on multi-factor authentication for the user.
+	RegisterWebAuthnCredential(rctx request.CTX, userID string, registration *model.WebAuthnRegistration) (*model.WebAuthnCredential, *model.AppError)
+	// RemoveSharedChannelMember removes a user from a shared channel following a membership change
+	// received from a remote. Unlike RemoveUserFromChannel no system message is posted, since the
+	// message posted by the remote is synchronized along with the rest of the channel.
+	RemoveSharedChannelMember(c request.CTX, userID string, channel *model.Channel) *model.AppError
 	// Removes a listener function by the unique ID returned when AddConfigListener was called
 	RemoveConfigListener(id string)
 	// RenameChannel is used to rename the channel Name and the DisplayName fields
 	RenameChannel(c request.CTX, channel *model.Channel, newChannelName string, newDisplayName string) (*model.Channel, *model.AppError)
 	// RenameTeam is used to rename the team Name and the DisplayName fields
 	RenameTeam(team *model.Team, newTeamName string, newDisplayName string) (*model.Team, *model.AppError)
+	// ReschedulePostReminder moves a pending reminder of the user to the given time.
+	ReschedulePostReminder(rctx request.CTX, postID, userID string, targetTime int64) (*model.PostReminder, *model.AppError)
 	// ResolvePersistentNotification stops the persistent notifications, if a loggedInUserID(except the post owner) reacts, reply or ack on the post.
 	// Post-owner can only delete the original post to stop the notifications.
 	ResolvePersistentNotification(c request.CTX, post *model.Post, loggedInUserID string) *model.AppError
+	// RetryOutgoingWebhookDeliveries resends outgoing webhook deliveries whose retry is due
+	// and prunes delivery log entries past their retention period.
+	RetryOutgoingWebhookDeliveries(c request.CTX) error
 	// RevokeSessionsFromAllUsers will go through all the sessions active
 	// in the server and revoke them
 	RevokeSessionsFromAllUsers() *model.AppError
+	// RotateFileEncryptionKeys re-encrypts the data keys of the stored files with the primary
+	// encryption key, and encrypts the files stored before encryption was enabled.
+	RotateFileEncryptionKeys(rctx request.CTX) error
 	// SanitizedConfig sanitizes a given configuration for a system admin without any secrets.
 	SanitizedConfig(cfg *model.Config)
 	// SaveConfig replaces the active configuration, optionally notifying cluster peers.
 	SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bool) (*model.Config, *model.Config, *model.AppError)
+	// SaveConfigWithAuthor is like SaveConfig, additionally recording the user who made the change
+	// in the configuration history.
+	SaveConfigWithAuthor(newCfg *model.Config, sendConfigChangeClusterMessage bool, userID string) (*model.Config, *model.Config, *model.AppError)
 	// SearchAllChannels returns a list of channels, the total count of the results of the search (if the paginate search option is true), and an error.
 	SearchAllChannels(c request.CTX, term string, opts model.ChannelSearchOpts) (model.ChannelListWithTeamData, int64, *model.AppError)
 	// SearchAllTeams returns a team list and the total count of the results
 	SearchAllTeams(searchOpts *model.TeamSearch) ([]*model.Team, int64, *model.AppError)
+	// SearchRemoteUsers searches the user directories of the remotes a shared channel is shared with.
+	SearchRemoteUsers(channelID string, term string) ([]*model.User, *model.AppError)
 	// SessionHasPermissionToChannels returns true only if user has access to all channels.
 	SessionHasPermissionToChannels(c request.CTX, session model.Session, channelIDs []string, permission *model.Permission) bool
 	// SessionHasPermissionToManageBot returns nil if the session has access to manage the given bot.
@@ -334,6 +417,9 @@ type AppIface interface {
 	SessionHasPermissionToTeams(c request.CTX, session model.Session, teamIDs []string, permission *model.Permission) bool
 	// SessionIsRegistered determines if a specific session has been registered
 	SessionIsRegistered(session model.Session) bool
+	// SetPostEscalation starts escalating the notifications of a root post until it gets
+	// acknowledged, replacing any previous escalation of the post.
+	SetPostEscalation(rctx request.CTX, post *model.Post, escalation *model.PostEscalation, creatorID string) (*model.PostEscalation, *model.AppError)
 	// SetSessionExpireInHours sets the session's expiry the specified number of hours
 	// relative to either the session creation date or the current time, depending
 	// on the `ExtendSessionOnActivity` config setting.
@@ -345,6 +431,9 @@ type AppIface interface {
 	// status to away if needed. Used by the WS to set status to away if an 'online' device disconnects
 	// while an 'away' device is still connected
 	SetStatusLastActivityAt(userID string, activityAt int64)
+	// SnoozePostReminder reminds the user again, in the given number of minutes, of the reminder
+	// sent to them as reminderPostID by the system bot.
+	SnoozePostReminder(rctx request.CTX, reminderPostID, userID string, minutes int) (*model.PostReminder, *model.AppError)
 	// SyncLdap starts an LDAP sync job.
 	// If includeRemovedMembers is true, then members who left or were removed from a team/channel will
 	// be re-added; otherwise, they will not be re-added.
@@ -394,12 +483,19 @@ type AppIface interface {
 	UpdateDNDStatusOfUsers()
 	// UpdateProductNotices is called periodically from a scheduled worker to fetch new notices and update the cache
 	UpdateProductNotices() *model.AppError
+	// UpdateScheduledPostSeries pauses, resumes, skips the next occurrence of, or ends a recurring
+	// scheduled post. Ending a series turns its pending occurrence into a regular scheduled post.
+	// Skipping the last occurrence of a series deletes the scheduled post.
+	UpdateScheduledPostSeries(rctx request.CTX, userId, scheduledPostId string, action model.ScheduledPostSeriesAction, connectionId string) (*model.ScheduledPost, *model.AppError)
 	// UpdateSharedChannelCursor updates the cursor for the specified channelID and remoteID.
 	// This can be used to manually set the point of last sync, either forward to skip older posts,
 	// or backward to re-sync history.
 	// This call by itself does not force a re-sync - a change to channel contents or a call to
 	// SyncSharedChannel are needed to force a sync.
 	UpdateSharedChannelCursor(channelID, remoteID string, cursor model.GetPostsSinceForSyncCursor) error
+	// UpdateSharedDraft applies the edit of the user to the draft. The edit is rejected with
+	// a conflict when someone else updated the draft since the version it's based on.
+	UpdateSharedDraft(c request.CTX, draft *model.SharedDraft, patch *model.SharedDraftPatch, userID, connectionID string) (*model.SharedDraft, *model.AppError)
 	// UpdateViewedProductNotices is called from the frontend to mark a set of notices as 'viewed' by user
 	UpdateViewedProductNotices(userID string, noticeIds []string) *model.AppError
 	// UpdateViewedProductNoticesForNewUser is called when new user is created to mark all current notices for this
@@ -420,6 +516,9 @@ type AppIface interface {
 	UserIsInAdminRoleGroup(userID, syncableID string, syncableType model.GroupSyncableType) (bool, *model.AppError)
 	// ValidateUserPermissionsOnChannels filters channelIds based on whether userId is authorized to manage channel members. Unauthorized channels are removed from the returned list.
 	ValidateUserPermissionsOnChannels(c request.CTX, userId string, channelIds []string) []string
+	// VerifyAuditRecords walks the whole audit record chain and reports the first record whose
+	// sequence number, previous hash or hash does not match.
+	VerifyAuditRecords(rctx request.CTX) (*model.AuditRecordVerification, *model.AppError)
 	// VerifyPlugin checks that the given signature corresponds to the given plugin and matches a trusted certificate.
 	VerifyPlugin(plugin, signature io.ReadSeeker) *model.AppError
 	// validateMoveOrCopy performs validation on a provided post list to determine
@@ -440,6 +539,7 @@ type AppIface interface {
 	AddSamlPrivateCertificate(fileData *multipart.FileHeader) *model.AppError
 	AddSamlPublicCertificate(fileData *multipart.FileHeader) *model.AppError
 	AddSessionToCache(session *model.Session)
+	AddSharedDraftMembers(c request.CTX, draft *model.SharedDraft, userIDs []string, connectionID string) (*model.SharedDraft, *model.AppError)
 	AddTeamMember(c request.CTX, teamID, userID string) (*model.TeamMember, *model.AppError)
 	AddTeamMemberByInviteId(c request.CTX, inviteId, userID string) (*model.TeamMember, *model.AppError)
 	AddTeamMemberByToken(c request.CTX, userID, tokenID string) (*model.TeamMember, *model.AppError)
@@ -538,6 +638,7 @@ type AppIface interface {
 	CreateSamlRelayToken(extra string) (*model.Token, *model.AppError)
 	CreateScheme(scheme *model.Scheme) (*model.Scheme, *model.AppError)
 	CreateSession(c request.CTX, session *model.Session) (*model.Session, *model.AppError)
+	CreateSharedDraft(c request.CTX, draft *model.SharedDraft, connectionID string) (*model.SharedDraft, *model.AppError)
 	CreateSidebarCategory(c request.CTX, userID, teamID string, newCategory *model.SidebarCategoryWithChannels) (*model.SidebarCategoryWithChannels, *model.AppError)
 	CreateTeam(c request.CTX, team *model.Team) (*model.Team, *model.AppError)
 	CreateTeamWithUser(c request.CTX, team *model.Team, userID string) (*model.Team, *model.AppError)
@@ -576,6 +677,7 @@ type AppIface interface {
 	DeleteOutgoingWebhook(hookID string) *model.AppError
 	DeletePluginKey(pluginID string, key string) *model.AppError
 	DeletePost(rctx request.CTX, postID, deleteByID string) (*model.Post, *model.AppError)
+	DeletePostReminder(postID, userID string) *model.AppError
 	DeletePreferences(c request.CTX, userID string, preferences model.Preferences) *model.AppError
 	DeleteReactionForPost(c request.CTX, reaction *model.Reaction) *model.AppError
 	DeleteRemoteCluster(remoteClusterId string) (bool, *model.AppError)
@@ -583,6 +685,7 @@ type AppIface interface {
 	DeleteScheduledPost(rctx request.CTX, userId, scheduledPostId, connectionId string) (*model.ScheduledPost, *model.AppError)
 	DeleteScheme(schemeId string) (*model.Scheme, *model.AppError)
 	DeleteSharedChannelRemote(id string) (bool, error)
+	DeleteSharedDraft(c request.CTX, draft *model.SharedDraft, connectionID string) *model.AppError
 	DeleteSidebarCategory(c request.CTX, userID, teamID, categoryId string) *model.AppError
 	DeleteToken(token *model.Token) *model.AppError
 	DisableAutoResponder(rctx request.CTX, userID string, asAdmin bool) *model.AppError
@@ -689,6 +792,7 @@ type AppIface interface {
 	GetEmojiByName(c request.CTX, emojiName string) (*model.Emoji, *model.AppError)
 	GetEmojiImage(c request.CTX, emojiId string) ([]byte, string, *model.AppError)
 	GetEmojiList(c request.CTX, page, perPage int, sort string) ([]*model.Emoji, *model.AppError)
+	GetEscalationsForPostList(list *model.PostList) (map[string]*model.PostEscalation, *model.AppError)
 	GetFile(rctx request.CTX, fileID string) ([]byte, *model.AppError)
 	GetFileInfo(rctx request.CTX, fileID string) (*model.FileInfo, *model.AppError)
 	GetFileInfos(rctx request.CTX, page, perPage int, opt *model.GetFileInfosOptions) ([]*model.FileInfo, *model.AppError)
@@ -752,6 +856,7 @@ type AppIface interface {
 	GetOpenGraphMetadata(requestURL string) ([]byte, error)
 	GetOrCreateDirectChannel(c request.CTX, userID, otherUserID string, channelOptions ...model.ChannelOption) (*model.Channel, *model.AppError)
 	GetOutgoingWebhook(hookID string) (*model.OutgoingWebhook, *model.AppError)
+	GetOutgoingWebhookDeliveries(hookID string, page, perPage int) ([]*model.OutgoingWebhookDelivery, *model.AppError)
 	GetOutgoingWebhooksForChannelPageByUser(channelID string, userID string, page, perPage int) ([]*model.OutgoingWebhook, *model.AppError)
 	GetOutgoingWebhooksForTeamPage(teamID string, page, perPage int) ([]*model.OutgoingWebhook, *model.AppError)
 	GetOutgoingWebhooksForTeamPageByUser(teamID string, userID string, page, perPage int) ([]*model.OutgoingWebhook, *model.AppError)
@@ -767,6 +872,8 @@ type AppIface interface {
 	GetPostIdBeforeTime(channelID string, time int64, collapsedThreads bool) (string, *model.AppError)
 	GetPostIfAuthorized(c request.CTX, postID string, session *model.Session, includeDeleted bool) (*model.Post, *model.AppError)
 	GetPostInfo(c request.CTX, postID string) (*model.PostInfo, *model.AppError)
+	GetPostReminder(postID, userID string) (*model.PostReminder, *model.AppError)
+	GetPostRemindersForUser(userID string) ([]*model.PostReminder, *model.AppError)
 	GetPostThread(postID string, opts model.GetPostsOptions, userID string) (*model.PostList, *model.AppError)
 	GetPosts(channelID string, offset int, limit int) (*model.PostList, *model.AppError)
 	GetPostsAfterPost(options model.GetPostsOptions) (*model.PostList, *model.AppError)
@@ -820,6 +927,8 @@ type AppIface interface {
 	GetSharedChannelRemotesStatus(channelID string) ([]*model.SharedChannelRemoteStatus, error)
 	GetSharedChannels(page int, perPage int, opts model.SharedChannelFilterOpts) ([]*model.SharedChannel, *model.AppError)
 	GetSharedChannelsCount(opts model.SharedChannelFilterOpts) (int64, error)
+	GetSharedDraft(id string) (*model.SharedDraft, *model.AppError)
+	GetSharedDraftsForUser(userID string) ([]*model.SharedDraft, *model.AppError)
 	GetSidebarCategories(c request.CTX, userID string, opts *store.SidebarCategorySearchOpts) (*model.OrderedSidebarCategories, *model.AppError)
 	GetSidebarCategoriesForTeamForUser(c request.CTX, userID, teamID string) (*model.OrderedSidebarCategories, *model.AppError)
 	GetSidebarCategory(c request.CTX, categoryId string) (*model.SidebarCategoryWithChannels, *model.AppError)
@@ -899,12 +1008,12 @@ type AppIface interface {
 	GetUsersWithoutTeamPage(options *model.UserGetOptions, asAdmin bool) ([]*model.User, *model.AppError)
 	GetVerifyEmailToken(token string) (*model.Token, *model.AppError)
 	GetViewUsersRestrictions(c request.CTX, userID string) (*model.ViewUsersRestrictions, *model.AppError)
+	GetWebAuthnCredentials(userID string) ([]*model.WebAuthnCredential, *model.AppError)
 	HTTPService() httpservice.HTTPService
 	HandleCommandResponse(c request.CTX, command *model.Command, args *model.CommandArgs, response *model.CommandResponse, builtIn bool) (*model.CommandResponse, *model.AppError)
 	HandleCommandResponsePost(c request.CTX, command *model.Command, args *model.CommandArgs, response *model.CommandResponse, builtIn bool) (*model.Post, *model.AppError)
 	HandleCommandWebhook(c request.CTX, hookID string, response *model.CommandResponse) *model.AppError
 	HandleImages(rctx request.CTX, previewPathList []string, thumbnailPathList []string, fileData [][]byte)
-	HandleIncomingWebhook(c request.CTX, hookID string, req *model.IncomingWebhookRequest) *model.AppError
 	HandleMessageExportConfig(cfg *model.Config, appCfg *model.Config)
 	HasPermissionTo(askingUserId string, permission *model.Permission) bool
 	HasPermissionToChannel(c request.CTX, askingUserId string, channelID string, permission *model.Permission) bool
@@ -937,6 +1046,7 @@ type AppIface interface {
 	IsPersistentNotificationsEnabled() bool
 	IsPhase2MigrationCompleted() *model.AppError
 	IsPluginActive(pluginName string) (bool, error)
+	IsPostEscalationEnabled() bool
 	IsPostPriorityEnabled() bool
 	IsUserSignUpAllowed() *model.AppError
 	JoinChannel(c request.CTX, channel *model.Channel, userID string) *model.AppError
@@ -1011,11 +1121,13 @@ type AppIface interface {
 	ReadFile(path string) ([]byte, *model.AppError)
 	RecycleDatabaseConnection(rctx request.CTX)
 	RegenCommandToken(cmd *model.Command) (*model.Command, *model.AppError)
+	RegenOutgoingWebhookSecret(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError)
 	RegenOutgoingWebhookToken(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError)
 	RegenerateOAuthAppSecret(app *model.OAuthApp) (*model.OAuthApp, *model.AppError)
 	RegenerateTeamInviteId(teamID string) (*model.Team, *model.AppError)
 	RegisterPerformanceReport(rctx request.CTX, report *model.PerformanceReport) *model.AppError
 	RegisterPluginCommand(pluginID string, command *model.Command) error
+	RegisterPluginContentExtractor(pluginID string, extractor *model.PluginContentExtractor) error
 	RegisterPluginForSharedChannels(rctx request.CTX, opts model.RegisterPluginOpts) (remoteID string, err error)
 	ReloadConfig() error
 	RemoveAllDeactivatedMembersFromChannel(c request.CTX, channel *model.Channel) *model.AppError
@@ -1033,6 +1145,7 @@ type AppIface interface {
 	RemoveSamlIdpCertificate() *model.AppError
 	RemoveSamlPrivateCertificate() *model.AppError
 	RemoveSamlPublicCertificate() *model.AppError
+	RemoveSharedDraftMember(c request.CTX, draft *model.SharedDraft, userID, connectionID string) (*model.SharedDraft, *model.AppError)
 	RemoveTeamIcon(teamID string) *model.AppError
 	RemoveTeamsFromRetentionPolicy(policyID string, teamIDs []string) *model.AppError
 	RemoveUserFromChannel(c request.CTX, userIDToRemove string, removerUserId string, channel *model.Channel) *model.AppError
@@ -1072,6 +1185,7 @@ type AppIface interface {
 	SaveUserTermsOfService(userID, termsOfServiceId string, accepted bool) *model.AppError
 	SchemesIterator(scope string, batchSize int) func() []*model.Scheme
 	SearchArchivedChannels(c request.CTX, teamID string, term string, userID string) (model.ChannelList, *model.AppError)
+	SearchAuditRecords(rctx request.CTX, opts model.AuditRecordSearchOpts) ([]*model.AuditRecord, *model.AppError)
 	SearchChannels(c request.CTX, teamID string, term string) (model.ChannelList, *model.AppError)
 	SearchChannelsForUser(c request.CTX, userID, teamID, term string) (model.ChannelList, *model.AppError)
 	SearchChannelsUserNotIn(c request.CTX, teamID string, userID string, term string) (model.ChannelList, *model.AppError)
@@ -1130,7 +1244,7 @@ type AppIface interface {
 	SetPluginKey(pluginID string, key string, value []byte) *model.AppError
 	SetPluginKeyWithExpiry(pluginID string, key string, value []byte, expireInSeconds int64) *model.AppError
 	SetPluginKeyWithOptions(pluginID string, key string, value []byte, options model.PluginKVSetOptions) (bool, *model.AppError)
-	SetPostReminder(rctx request.CTX, postID, userID string, targetTime int64) *model.AppError
+	SetPostReminder(rctx request.CTX, reminder *model.PostReminder) *model.AppError
 	SetProfileImage(c request.CTX, userID string, imageData *multipart.FileHeader) *model.AppError
 	SetProfileImageFromFile(c request.CTX, userID string, file io.Reader) *model.AppError
 	SetProfileImageFromMultiPartFile(c request.CTX, userID string, file multipart.File) *model.AppError
@@ -1171,6 +1285,7 @@ type AppIface interface {
 	TriggerWebhook(c request.CTX, payload *model.OutgoingWebhookPayload, hook *model.OutgoingWebhook, post *model.Post, channel *model.Channel)
 	UninviteRemoteFromChannel(channelID, remoteID string) error
 	UnregisterPluginCommand(pluginID, teamID, trigger string)
+	UnregisterPluginContentExtractor(pluginID string)
 	UnregisterPluginForSharedChannels(pluginID string) error
 	UnshareChannel(channelID string) (bool, error)
 	UpdateActive(c request.CTX, user *model.User, active bool) (*model.User, *model.AppError)
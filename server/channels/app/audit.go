@@ -56,6 +56,55 @@ func (a *App) GetAuditsPage(rctx request.CTX, userID string, page int, perPage i
 	return audits, nil
 }
 
+// auditRecordVerifyBatchSize is the number of audit records read at a time while verifying the chain.
+const auditRecordVerifyBatchSize = 1000
+
+func (a *App) SearchAuditRecords(rctx request.CTX, opts model.AuditRecordSearchOpts) ([]*model.AuditRecord, *model.AppError) {
+	if opts.PerPage <= 0 {
+		opts.PerPage = model.AuditRecordSearchDefaultLimit
+	}
+
+	if opts.StartTime > 0 && opts.EndTime > 0 && opts.StartTime > opts.EndTime {
+		return nil, model.NewAppError("SearchAuditRecords", "app.audit.search_records.time_range.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	records, err := a.Srv().Store().Audit().SearchRecords(opts)
+	if err != nil {
+		var outErr *store.ErrOutOfBounds
+		switch {
+		case errors.As(err, &outErr):
+			return nil, model.NewAppError("SearchAuditRecords", "app.audit.get.limit.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+		default:
+			return nil, model.NewAppError("SearchAuditRecords", "app.audit.search_records.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+	return records, nil
+}
+
+// VerifyAuditRecords walks the whole audit record chain and reports the first record whose
+// sequence number, previous hash or hash does not match.
+func (a *App) VerifyAuditRecords(rctx request.CTX) (*model.AuditRecordVerification, *model.AppError) {
+	result := &model.AuditRecordVerification{Valid: true}
+
+	var prev *model.AuditRecord
+	for {
+		records, err := a.Srv().Store().Audit().GetRecordsAfter(result.LastSequence, auditRecordVerifyBatchSize)
+		if err != nil {
+			return nil, model.NewAppError("VerifyAuditRecords", "app.audit.verify_records.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		if !model.VerifyAuditRecordChain(prev, records, result) {
+			rctx.Logger().Warn("Audit record chain is broken", mlog.Int("sequence", result.BrokenSequence), mlog.String("reason", result.Reason))
+			return result, nil
+		}
+
+		if len(records) < auditRecordVerifyBatchSize {
+			return result, nil
+		}
+		prev = records[len(records)-1]
+	}
+}
+
 // LogAuditRec logs an audit record using default LvlAuditCLI.
 func (a *App) LogAuditRec(rctx request.CTX, rec *audit.Record, err error) {
 	a.LogAuditRecWithLevel(rctx, rec, mlog.LvlAuditCLI, err)
@@ -148,6 +197,25 @@ func (s *Server) configureAudit(adt *audit.Audit, bAllowAdvancedLogging bool) er
 	return adt.Configure(cfg)
 }
 
+// configureAuditDatabase enables or disables persisting audit records to the database.
+func (s *Server) configureAuditDatabase(adt *audit.Audit, cfg *model.Config) {
+	if !*cfg.ExperimentalAuditSettings.DatabaseEnabled {
+		if adt.DatabaseTarget() != nil {
+			adt.SetDatabaseTarget(nil)
+		}
+		return
+	}
+
+	if adt.DatabaseTarget() != nil {
+		return
+	}
+
+	target := audit.NewDatabaseTarget(s.Store().Audit(), audit.DefMaxQueueSize)
+	target.OnQueueFull = s.onAuditTargetQueueFull
+	target.OnError = s.onAuditError
+	adt.SetDatabaseTarget(target)
+}
+
 func (s *Server) onAuditTargetQueueFull(qname string, maxQSize int) bool {
 	s.Log().Error("Audit queue full, dropping record.", mlog.String("qname", qname), mlog.Int("queueSize", maxQSize))
 	return true // drop it
@@ -113,9 +113,33 @@ func (a *App) CheckPasswordAndAllCriteria(rctx request.CTX, userID string, passw
 		return err
 	}
 
+	a.rehashPasswordIfNeeded(rctx, user, password)
+
 	return nil
 }
 
+// rehashPasswordIfNeeded replaces the hash of the user's password once it's known to be correct
+// if it wasn't generated with the configured hashing algorithm and parameters, e.g. to upgrade
+// bcrypt hashes to argon2id. Failing to do so doesn't prevent the user from logging in.
+func (a *App) rehashPasswordIfNeeded(rctx request.CTX, user *model.User, password string) {
+	if !a.ch.srv.userService.PasswordNeedsRehash(user.Password) {
+		return
+	}
+
+	hash, err := a.ch.srv.userService.HashPassword(password)
+	if err != nil {
+		rctx.Logger().Warn("Failed to rehash password", mlog.String("user_id", user.Id), mlog.Err(err))
+		return
+	}
+
+	if err := a.Srv().Store().User().UpdatePasswordHash(user.Id, user.Password, hash); err != nil {
+		rctx.Logger().Warn("Failed to update password hash", mlog.String("user_id", user.Id), mlog.Err(err))
+		return
+	}
+
+	a.InvalidateCacheForUser(user.Id)
+}
+
 // This to be used for places we check the users password when they are already logged in
 func (a *App) DoubleCheckPassword(rctx request.CTX, user *model.User, password string) *model.AppError {
 	if err := checkUserLoginAttempts(user, *a.Config().ServiceSettings.MaximumLoginAttempts); err != nil {
@@ -221,6 +245,22 @@ func (a *App) CheckUserMfa(rctx request.CTX, user *model.User, token string) *mo
 		return model.NewAppError("CheckUserMfa", "mfa.mfa_disabled.app_error", nil, "", http.StatusNotImplemented)
 	}
 
+	if model.IsWebAuthnAssertion(token) {
+		return a.checkUserWebAuthn(rctx, user, token)
+	}
+
+	// A security key is required of users without an authenticator app, and of all users once
+	// security keys are enforced, as soon as they registered one.
+	if user.MfaSecret == "" || (*a.Config().ServiceSettings.EnableWebAuthn && *a.Config().ServiceSettings.EnforceWebAuthn) {
+		credentials, appErr := a.GetWebAuthnCredentials(user.Id)
+		if appErr != nil {
+			return appErr
+		}
+		if len(credentials) > 0 {
+			return model.NewAppError("CheckUserMfa", "api.user.check_user_mfa.webauthn_required.app_error", nil, "", http.StatusUnauthorized)
+		}
+	}
+
 	ok, err := mfa.New(a.Srv().Store().User()).ValidateToken(user, token)
 	if err != nil {
 		return model.NewAppError("CheckUserMfa", "mfa.validate_token.authenticate.app_error", nil, "", http.StatusBadRequest).Wrap(err)
@@ -8,11 +8,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/dgryski/dgoogauth"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -60,6 +62,61 @@ func TestParseAuthTokenFromRequest(t *testing.T) {
 	}
 }
 
+func TestCheckPasswordAndAllCriteriaRehash(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	password := "newpassword1"
+	require.Nil(t, th.App.UpdatePassword(th.Context, th.BasicUser, password))
+
+	user, appErr := th.App.GetUser(th.BasicUser.Id)
+	require.Nil(t, appErr)
+	require.True(t, strings.HasPrefix(user.Password, "$2a$"), "passwords should be hashed with bcrypt by default")
+	bcryptHash := user.Password
+
+	t.Run("hashes are kept when they match the configuration", func(t *testing.T) {
+		require.Nil(t, th.App.CheckPasswordAndAllCriteria(th.Context, th.BasicUser.Id, password, ""))
+
+		user, appErr := th.App.GetUser(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, bcryptHash, user.Password)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.PasswordSettings.HashingAlgorithm = model.PasswordHashingAlgorithmArgon2id
+		*cfg.PasswordSettings.Argon2idMemoryKiB = 64
+		*cfg.PasswordSettings.Argon2idIterations = 1
+	})
+
+	t.Run("a wrong password doesn't rehash", func(t *testing.T) {
+		require.NotNil(t, th.App.CheckPasswordAndAllCriteria(th.Context, th.BasicUser.Id, "wrongpassword", ""))
+
+		user, appErr := th.App.GetUser(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, bcryptHash, user.Password)
+	})
+
+	t.Run("bcrypt hashes are upgraded on login", func(t *testing.T) {
+		require.Nil(t, th.App.CheckPasswordAndAllCriteria(th.Context, th.BasicUser.Id, password, ""))
+
+		user, appErr := th.App.GetUser(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.True(t, strings.HasPrefix(user.Password, "$argon2id$v=19$m=64,t=1,p=1$"), user.Password)
+
+		require.Nil(t, th.App.CheckPasswordAndAllCriteria(th.Context, th.BasicUser.Id, password, ""))
+		require.NotNil(t, th.App.CheckPasswordAndAllCriteria(th.Context, th.BasicUser.Id, "wrongpassword", ""))
+	})
+
+	t.Run("new passwords are hashed with argon2id", func(t *testing.T) {
+		require.Nil(t, th.App.UpdatePassword(th.Context, th.BasicUser2, "newpassword2"))
+
+		user, appErr := th.App.GetUser(th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.True(t, strings.HasPrefix(user.Password, "$argon2id$"), user.Password)
+		require.Nil(t, th.App.CheckPasswordAndAllCriteria(th.Context, th.BasicUser2.Id, "newpassword2", ""))
+	})
+}
+
 func TestCheckPasswordAndAllCriteria(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -34,6 +34,9 @@ func (a *App) GetBookmark(bookmarkId string, includeDeleted bool) (*model.Channe
 func (a *App) CreateChannelBookmark(c request.CTX, newBookmark *model.ChannelBookmark, connectionId string) (*model.ChannelBookmarkWithFileInfo, *model.AppError) {
 	newBookmark.OwnerId = c.Session().UserId //ensure that the bookmark is being created by the user who owns the session
 	newBookmark.Id = ""                      // ensure that creating a new bookmark generates a new ID
+	newBookmark.LinkStatus = ""              // the link is only checked by the server
+	newBookmark.LinkCheckedAt = 0
+	newBookmark.RemoteId = nil // local changes are synchronized to every shared channel remote
 	bookmark, err := a.Srv().Store().ChannelBookmark().Save(newBookmark, true)
 	if err != nil {
 		return nil, model.NewAppError("CreateChannelBookmark", "app.channel.bookmark.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -51,6 +54,7 @@ func (a *App) CreateChannelBookmark(c request.CTX, newBookmark *model.ChannelBoo
 
 func (a *App) UpdateChannelBookmark(c request.CTX, updateBookmark *model.ChannelBookmarkWithFileInfo, connectionId string) (*model.UpdateChannelBookmarkResponse, *model.AppError) {
 	response := &model.UpdateChannelBookmarkResponse{}
+	updateBookmark.RemoteId = nil // local changes are synchronized to every shared channel remote
 	if updateBookmark.OwnerId == c.Session().UserId {
 		isAnotherFile := updateBookmark.FileInfo != nil && updateBookmark.FileId != "" && updateBookmark.FileId != updateBookmark.FileInfo.Id
 
@@ -0,0 +1,187 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dyatlov/go-opengraph/opengraph"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/httpservice"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const (
+	channelBookmarkLinkCheckBatchSize = 100
+	// channelBookmarkLinkCheckInterval is how long a link is considered valid after being checked.
+	channelBookmarkLinkCheckInterval = 24 * time.Hour
+)
+
+// GetChannelBookmarksWithBrokenLinks returns the channel's link bookmarks whose link was found
+// to be broken the last time it was checked.
+func (a *App) GetChannelBookmarksWithBrokenLinks(channelId string) ([]*model.ChannelBookmarkWithFileInfo, *model.AppError) {
+	bookmarks, appErr := a.GetChannelBookmarks(channelId, 0)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	broken := []*model.ChannelBookmarkWithFileInfo{}
+	for _, bookmark := range bookmarks {
+		if bookmark.LinkStatus == model.ChannelBookmarkLinkStatusBroken {
+			broken = append(broken, bookmark)
+		}
+	}
+
+	return broken, nil
+}
+
+// CheckChannelBookmarkLinks revalidates the links of the bookmarks that haven't been checked
+// recently, flagging the broken ones and refreshing the preview of the others.
+func (a *App) CheckChannelBookmarkLinks(rctx request.CTX) error {
+	checkedBefore := model.GetMillis() - channelBookmarkLinkCheckInterval.Milliseconds()
+	for {
+		bookmarks, err := a.Srv().Store().ChannelBookmark().GetLinkBookmarksToCheck(checkedBefore, channelBookmarkLinkCheckBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "failed to get channel bookmarks to check")
+		}
+
+		// Every bookmark of the batch is marked as checked, even the ones failing to update,
+		// so that the next batch doesn't return them again.
+		unchanged := make([]string, 0, len(bookmarks))
+		for _, bookmark := range bookmarks {
+			if !a.checkChannelBookmarkLink(rctx, bookmark) {
+				unchanged = append(unchanged, bookmark.Id)
+			}
+		}
+
+		if err := a.Srv().Store().ChannelBookmark().UpdateLinkCheckedAt(unchanged, model.GetMillis()); err != nil {
+			return errors.Wrap(err, "failed to mark channel bookmarks as checked")
+		}
+
+		if len(bookmarks) < channelBookmarkLinkCheckBatchSize {
+			return nil
+		}
+	}
+}
+
+// checkChannelBookmarkLink fetches the bookmark's link, saving and broadcasting the bookmark
+// if its status or preview changed. It returns whether the bookmark was saved.
+func (a *App) checkChannelBookmarkLink(rctx request.CTX, bookmark *model.ChannelBookmarkWithFileInfo) bool {
+	status, og := a.fetchChannelBookmarkLink(rctx, bookmark.LinkUrl)
+	if status == "" {
+		// The link couldn't be checked, so keep its last known status.
+		status = bookmark.LinkStatus
+	}
+
+	updated := bookmark.ChannelBookmark.Clone()
+	updated.LinkStatus = status
+	if og != nil {
+		refreshChannelBookmarkPreview(updated, og)
+	}
+
+	if *updated == *bookmark.ChannelBookmark {
+		return false
+	}
+
+	updated.LinkCheckedAt = model.GetMillis()
+	updated.UpdateAt = updated.LinkCheckedAt
+	if err := a.Srv().Store().ChannelBookmark().UpdateLinkCheck(updated, bookmark.UpdateAt); err != nil {
+		var cErr *store.ErrConflict
+		if errors.As(err, &cErr) {
+			// The bookmark was edited while its link was checked, it will be checked again.
+			rctx.Logger().Debug("Skipping channel bookmark changed during its link check", mlog.String("bookmark_id", bookmark.Id))
+			return false
+		}
+		rctx.Logger().Warn("Failed to update checked channel bookmark", mlog.String("bookmark_id", bookmark.Id), mlog.Err(err))
+		return false
+	}
+
+	response := &model.UpdateChannelBookmarkResponse{Updated: updated.ToBookmarkWithFileInfo(bookmark.FileInfo)}
+	bookmarkJSON, err := json.Marshal(response)
+	if err != nil {
+		rctx.Logger().Warn("Failed to encode checked channel bookmark", mlog.String("bookmark_id", bookmark.Id), mlog.Err(err))
+		return true
+	}
+
+	message := model.NewWebSocketEvent(model.WebsocketEventChannelBookmarkUpdated, "", updated.ChannelId, "", nil, "")
+	message.Add("bookmarks", string(bookmarkJSON))
+	a.Publish(message)
+
+	return true
+}
+
+// fetchChannelBookmarkLink requests the link, returning an empty status when it can't tell
+// whether the link works, and its OpenGraph metadata when the link can be previewed.
+func (a *App) fetchChannelBookmarkLink(rctx request.CTX, linkURL string) (model.ChannelBookmarkLinkStatus, *opengraph.OpenGraph) {
+	req, err := http.NewRequestWithContext(rctx.Context(), http.MethodGet, linkURL, nil)
+	if err != nil {
+		return model.ChannelBookmarkLinkStatusBroken, nil
+	}
+	req.Header.Add("Accept", "text/html")
+	req.Header.Add("Accept-Language", *a.Config().LocalizationSettings.DefaultServerLocale)
+
+	client := a.HTTPService().MakeClient(false)
+	client.Timeout = time.Duration(*a.Config().ExperimentalSettings.LinkMetadataTimeoutMilliseconds) * time.Millisecond
+
+	res, err := client.Do(req)
+	if err != nil {
+		var netErr net.Error
+		if errors.Is(err, httpservice.ErrAddressForbidden) || (errors.As(err, &netErr) && netErr.Timeout()) {
+			rctx.Logger().Debug("Unable to check channel bookmark link", mlog.String("link", linkURL), mlog.Err(err))
+			return "", nil
+		}
+		return model.ChannelBookmarkLinkStatusBroken, nil
+	}
+	defer res.Body.Close()
+
+	// Pages requiring authentication or rate limiting the server still exist.
+	if res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone || res.StatusCode >= http.StatusInternalServerError {
+		return model.ChannelBookmarkLinkStatusBroken, nil
+	}
+	if res.StatusCode >= http.StatusBadRequest {
+		return model.ChannelBookmarkLinkStatusOk, nil
+	}
+
+	contentType := res.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "text/html") || !a.isLinkAllowedForPreview(rctx, linkURL) {
+		return model.ChannelBookmarkLinkStatusOk, nil
+	}
+
+	return model.ChannelBookmarkLinkStatusOk, model.TruncateOpenGraph(a.parseOpenGraphMetadata(linkURL, res.Body, contentType))
+}
+
+// refreshChannelBookmarkPreview sets the bookmark's image, unless it already has one, and its
+// name, unless it was given a custom one, from the link's OpenGraph metadata.
+func refreshChannelBookmarkPreview(bookmark *model.ChannelBookmark, og *opengraph.OpenGraph) {
+	if title := strings.TrimSpace(og.Title); title != "" && bookmark.DisplayName == bookmark.LinkUrl {
+		if utf8.RuneCountInString(title) > model.DisplayNameMaxRunes {
+			title = string([]rune(title)[:model.DisplayNameMaxRunes])
+		}
+		bookmark.DisplayName = title
+	}
+
+	if bookmark.ImageUrl != "" {
+		return
+	}
+
+	for _, image := range og.Images {
+		imageURL := image.SecureURL
+		if imageURL == "" {
+			imageURL = image.URL
+		}
+		if model.IsValidHTTPURL(imageURL) && utf8.RuneCountInString(imageURL) <= model.LinkMaxRunes {
+			bookmark.ImageUrl = imageURL
+			break
+		}
+	}
+}
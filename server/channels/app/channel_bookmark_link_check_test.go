@@ -0,0 +1,119 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dyatlov/go-opengraph/opengraph"
+	ogImage "github.com/dyatlov/go-opengraph/opengraph/types/image"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestRefreshChannelBookmarkPreview(t *testing.T) {
+	og := &opengraph.OpenGraph{
+		Title:  "Mattermost",
+		Images: []*ogImage.Image{{URL: "not a url"}, {URL: "https://mattermost.com/logo.png"}},
+	}
+
+	t.Run("default name", func(t *testing.T) {
+		bookmark := &model.ChannelBookmark{DisplayName: "https://mattermost.com", LinkUrl: "https://mattermost.com"}
+		refreshChannelBookmarkPreview(bookmark, og)
+		assert.Equal(t, "Mattermost", bookmark.DisplayName)
+		assert.Equal(t, "https://mattermost.com/logo.png", bookmark.ImageUrl)
+	})
+
+	t.Run("custom name", func(t *testing.T) {
+		bookmark := &model.ChannelBookmark{DisplayName: "Home page", LinkUrl: "https://mattermost.com"}
+		refreshChannelBookmarkPreview(bookmark, og)
+		assert.Equal(t, "Home page", bookmark.DisplayName)
+		assert.Equal(t, "https://mattermost.com/logo.png", bookmark.ImageUrl)
+	})
+
+	t.Run("custom image", func(t *testing.T) {
+		bookmark := &model.ChannelBookmark{DisplayName: "https://mattermost.com", LinkUrl: "https://mattermost.com", ImageUrl: "https://example.com/icon.png"}
+		refreshChannelBookmarkPreview(bookmark, og)
+		assert.Equal(t, "Mattermost", bookmark.DisplayName)
+		assert.Equal(t, "https://example.com/icon.png", bookmark.ImageUrl)
+	})
+}
+
+func TestCheckChannelBookmarkLinks(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/page":
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte(`<html><head><meta property="og:title" content="Page title"><meta property="og:image" content="https://example.com/image.png"></head></html>`))
+		case "/private":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.1"
+	})
+
+	th.Context.Session().UserId = th.BasicUser.Id
+	createLinkBookmark := func(linkURL string) *model.ChannelBookmarkWithFileInfo {
+		bookmark := createBookmark(linkURL, model.ChannelBookmarkLink, th.BasicChannel.Id, "")
+		bookmark.LinkUrl = linkURL
+		created, appErr := th.App.CreateChannelBookmark(th.Context, bookmark, "")
+		require.Nil(t, appErr)
+		return created
+	}
+
+	page := createLinkBookmark(server.URL + "/page")
+	private := createLinkBookmark(server.URL + "/private")
+	missing := createLinkBookmark(server.URL + "/missing")
+
+	require.NoError(t, th.App.CheckChannelBookmarkLinks(th.Context))
+
+	t.Run("refreshes the preview of working links", func(t *testing.T) {
+		bookmark, appErr := th.App.GetBookmark(page.Id, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.ChannelBookmarkLinkStatusOk, bookmark.LinkStatus)
+		assert.NotZero(t, bookmark.LinkCheckedAt)
+		assert.Equal(t, "Page title", bookmark.DisplayName)
+		assert.Equal(t, "https://example.com/image.png", bookmark.ImageUrl)
+	})
+
+	t.Run("keeps links requiring authentication", func(t *testing.T) {
+		bookmark, appErr := th.App.GetBookmark(private.Id, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.ChannelBookmarkLinkStatusOk, bookmark.LinkStatus)
+	})
+
+	t.Run("flags broken links", func(t *testing.T) {
+		bookmark, appErr := th.App.GetBookmark(missing.Id, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.ChannelBookmarkLinkStatusBroken, bookmark.LinkStatus)
+
+		broken, appErr := th.App.GetChannelBookmarksWithBrokenLinks(th.BasicChannel.Id)
+		require.Nil(t, appErr)
+		require.Len(t, broken, 1)
+		assert.Equal(t, missing.Id, broken[0].Id)
+	})
+
+	t.Run("skips recently checked links", func(t *testing.T) {
+		before, appErr := th.App.GetBookmark(missing.Id, false)
+		require.Nil(t, appErr)
+
+		require.NoError(t, th.App.CheckChannelBookmarkLinks(th.Context))
+
+		after, appErr := th.App.GetBookmark(missing.Id, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, before.LinkCheckedAt, after.LinkCheckedAt)
+	})
+}
@@ -4,6 +4,7 @@
 package app
 
 import (
+	"crypto/ecdsa"
 	"runtime"
 	"strings"
 	"sync"
@@ -37,9 +38,12 @@ type Channels struct {
 	exportFilestore filestore.FileBackend
 
 	postActionCookieSecret []byte
+	webPushVAPIDKey        *ecdsa.PrivateKey
 
 	pluginCommandsLock            sync.RWMutex
 	pluginCommands                []*PluginCommand
+	pluginContentExtractorsLock   sync.RWMutex
+	pluginContentExtractors       map[string]*model.PluginContentExtractor
 	pluginsLock                   sync.RWMutex
 	pluginsEnvironment            *plugin.Environment
 	pluginConfigListenerID        string
@@ -196,6 +200,10 @@ func (ch *Channels) Start() error {
 		return errors.Wrapf(err, "unable to ensure PostAction cookie secret")
 	}
 
+	if err := ch.ensureWebPushVAPIDKey(); err != nil {
+		return errors.Wrapf(err, "unable to ensure web push VAPID key")
+	}
+
 	return nil
 }
 
@@ -5,6 +5,7 @@ package app
 
 import (
 	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"encoding/json"
 	"net/url"
@@ -133,6 +134,82 @@ func (ch *Channels) ensurePostActionCookieSecret() error {
 	return nil
 }
 
+// ensureWebPushVAPIDKey ensures that the key used to authenticate with browser push services
+// exists and future calls to WebPushVAPIDKey will always return a valid key, same on all
+// servers in the cluster.
+func (ch *Channels) ensureWebPushVAPIDKey() error {
+	if ch.webPushVAPIDKey != nil {
+		return nil
+	}
+
+	var key *model.SystemAsymmetricSigningKey
+
+	value, err := ch.srv.Store().System().GetByName(model.SystemWebPushVAPIDKey)
+	if err == nil {
+		if err := json.Unmarshal([]byte(value.Value), &key); err != nil {
+			return err
+		}
+	}
+
+	// If we don't already have a key, try to generate one.
+	if key == nil {
+		newECDSAKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return err
+		}
+		newKey := &model.SystemAsymmetricSigningKey{
+			ECDSAKey: &model.SystemECDSAKey{
+				Curve: "P-256",
+				X:     newECDSAKey.X,
+				Y:     newECDSAKey.Y,
+				D:     newECDSAKey.D,
+			},
+		}
+		system := &model.System{
+			Name: model.SystemWebPushVAPIDKey,
+		}
+		v, err := json.Marshal(newKey)
+		if err != nil {
+			return err
+		}
+		system.Value = string(v)
+		// If we were able to save the key, use it, otherwise log the error.
+		if err = ch.srv.Store().System().Save(system); err != nil {
+			mlog.Warn("Failed to save WebPushVAPIDKey", mlog.Err(err))
+		} else {
+			key = newKey
+		}
+	}
+
+	// If we weren't able to save a new key above, another server must have beat us to it. Get the
+	// key from the database, and if that fails, error out.
+	if key == nil {
+		value, err := ch.srv.Store().System().GetByName(model.SystemWebPushVAPIDKey)
+		if err != nil {
+			return err
+		}
+
+		if err := json.Unmarshal([]byte(value.Value), &key); err != nil {
+			return err
+		}
+	}
+
+	// VAPID requires ES256, so only P-256 keys are supported.
+	if key.ECDSAKey == nil || key.ECDSAKey.Curve != "P-256" {
+		return errors.New("unsupported web push VAPID key")
+	}
+
+	ch.webPushVAPIDKey = &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     key.ECDSAKey.X,
+			Y:     key.ECDSAKey.Y,
+		},
+		D: key.ECDSAKey.D,
+	}
+	return nil
+}
+
 func (s *Server) ensureInstallationDate() error {
 	_, appErr := s.platform.GetSystemInstallDate()
 	if appErr == nil {
@@ -244,6 +321,12 @@ func (a *App) SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bo
 	return a.Srv().platform.SaveConfig(newCfg, sendConfigChangeClusterMessage)
 }
 
+// SaveConfigWithAuthor is like SaveConfig, additionally recording the user who made the change
+// in the configuration history.
+func (a *App) SaveConfigWithAuthor(newCfg *model.Config, sendConfigChangeClusterMessage bool, userID string) (*model.Config, *model.Config, *model.AppError) {
+	return a.Srv().platform.SaveConfigWithAuthor(newCfg, sendConfigChangeClusterMessage, userID)
+}
+
 func (a *App) HandleMessageExportConfig(cfg *model.Config, appCfg *model.Config) {
 	// If the Message Export feature has been toggled in the System Console, rewrite the ExportFromTimestamp field to an
 	// appropriate value. The rewriting occurs here to ensure it doesn't affect values written to the config file
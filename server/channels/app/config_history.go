@@ -0,0 +1,100 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/config"
+)
+
+// GetConfigHistory returns a page of the saved configurations, most recent first, each along
+// with the settings it changed compared to the configuration saved before it.
+func (a *App) GetConfigHistory(page, perPage int) ([]*model.ConfigHistoryEntry, *model.AppError) {
+	// Fetch one extra version so that the oldest entry of the page can be compared with its predecessor.
+	versions, err := a.Srv().platform.GetConfigHistory(page*perPage, perPage+1)
+	if err != nil {
+		return nil, configHistoryAppError("GetConfigHistory", err)
+	}
+
+	entries := make([]*model.ConfigHistoryEntry, 0, perPage)
+	for i, version := range versions {
+		if i == perPage {
+			break
+		}
+
+		entry := &model.ConfigHistoryEntry{
+			Id:       version.Id,
+			CreateAt: version.CreateAt,
+			UserId:   version.Author,
+			Active:   version.Active,
+			Changes:  []*model.ConfigChange{},
+		}
+
+		if i+1 < len(versions) {
+			diffs, err := config.Diff(versions[i+1].Config, version.Config)
+			if err != nil {
+				return nil, model.NewAppError("GetConfigHistory", "app.config.history.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			entry.Changes = configChangesFromDiffs(diffs)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// GetConfigVersionDiff returns the settings that restoring the given saved configuration would
+// change in the active configuration.
+func (a *App) GetConfigVersionDiff(id string) ([]*model.ConfigChange, *model.AppError) {
+	version, err := a.Srv().platform.GetConfigVersion(id)
+	if err != nil {
+		return nil, configHistoryAppError("GetConfigVersionDiff", err)
+	}
+
+	diffs, err := config.Diff(a.Srv().platform.GetConfigStore().GetNoEnv(), version.Config)
+	if err != nil {
+		return nil, model.NewAppError("GetConfigVersionDiff", "app.config.history.diff.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return configChangesFromDiffs(diffs), nil
+}
+
+// GetConfigVersion returns a copy of the given saved configuration.
+func (a *App) GetConfigVersion(id string) (*model.Config, *model.AppError) {
+	version, err := a.Srv().platform.GetConfigVersion(id)
+	if err != nil {
+		return nil, configHistoryAppError("GetConfigVersion", err)
+	}
+
+	return version.Config.Clone(), nil
+}
+
+func configHistoryAppError(where string, err error) *model.AppError {
+	switch {
+	case errors.Is(err, config.ErrHistoryNotSupported):
+		return model.NewAppError(where, "app.config.history.not_supported.app_error", nil, "", http.StatusNotImplemented).Wrap(err)
+	case errors.Is(err, config.ErrVersionNotFound):
+		return model.NewAppError(where, "app.config.history.version_not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+	default:
+		return model.NewAppError(where, "app.config.history.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+}
+
+// configChangesFromDiffs converts config diffs to their API representation, redacting
+// sensitive values.
+func configChangesFromDiffs(diffs config.ConfigDiffs) []*model.ConfigChange {
+	changes := make([]*model.ConfigChange, 0, len(diffs))
+	for _, d := range diffs.Sanitize() {
+		changes = append(changes, &model.ConfigChange{
+			Path:      d.Path,
+			BaseVal:   d.BaseVal,
+			ActualVal: d.ActualVal,
+		})
+	}
+	return changes
+}
@@ -5,6 +5,7 @@ package email
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"html/template"
 	"io"
@@ -22,6 +23,9 @@ import (
 
 const (
 	EmailBatchingTaskName = "Email Batching"
+
+	// dailyDigestHour is the hour, in the timezone of the user, at which daily digests are sent.
+	dailyDigestHour = 8
 )
 
 type postData struct {
@@ -169,7 +173,10 @@ func (job *EmailBatchingJob) checkPendingNotifications(now time.Time, handler fu
 		// get how long we need to wait to send notifications to the user
 		var interval int64
 		preference, err := job.service.store.Preference().Get(userID, model.PreferenceCategoryNotifications, model.PreferenceNameEmailInterval)
-		if err != nil {
+		if err == nil && preference.Value == model.PreferenceEmailIntervalDailyAsSeconds {
+			job.checkDailyDigest(userID, notifications, now, handler)
+			continue
+		} else if err != nil {
 			// use the default batching interval if an error occurs while fetching user preferences
 			interval, _ = strconv.ParseInt(model.PreferenceEmailIntervalBatchingSeconds, 10, 64)
 		} else {
@@ -237,6 +244,59 @@ func (job *EmailBatchingJob) checkPendingNotifications(now time.Time, handler fu
 	}
 }
 
+// checkDailyDigest sends the notifications of a user who chose a daily digest once the digest
+// hour following the first of them has passed. Unlike shorter intervals, viewing a channel only
+// drops the notifications of that channel, as the user is likely to view some during the day.
+func (job *EmailBatchingJob) checkDailyDigest(userID string, notifications []*batchedNotification, now time.Time, handler func(string, []*batchedNotification)) {
+	user, err := job.service.userService.GetUser(userID)
+	if err != nil {
+		mlog.Warn("Unable to find the recipient of a daily email digest", mlog.String("user_id", userID), mlog.Err(err))
+		delete(job.pendingNotifications, userID)
+		return
+	}
+
+	if now.Before(dailyDigestDueAt(notifications[0].post.CreateAt, user.GetTimezoneLocation())) {
+		return
+	}
+	delete(job.pendingNotifications, userID)
+
+	lastViewedAt := make(map[string]int64)
+	unread := make([]*batchedNotification, 0, len(notifications))
+	for _, notification := range notifications {
+		channelID := notification.post.ChannelId
+		if _, ok := lastViewedAt[channelID]; !ok {
+			member, err := job.service.store.Channel().GetMember(context.Background(), channelID, userID)
+			if err != nil {
+				// The user might have left the channel since.
+				lastViewedAt[channelID] = now.UnixMilli()
+			} else {
+				lastViewedAt[channelID] = member.LastViewedAt
+			}
+		}
+
+		if lastViewedAt[channelID] < notification.post.CreateAt {
+			unread = append(unread, notification)
+		}
+	}
+
+	if len(unread) == 0 {
+		mlog.Debug("Deleted daily digest notifications for user", mlog.String("user_id", userID))
+		return
+	}
+
+	handler(userID, unread)
+}
+
+// dailyDigestDueAt returns the first digest hour after the given time, in the given location.
+func dailyDigestDueAt(since int64, loc *time.Location) time.Time {
+	start := time.UnixMilli(since).In(loc)
+	due := time.Date(start.Year(), start.Month(), start.Day(), dailyDigestHour, 0, 0, 0, loc)
+	if !due.After(start) {
+		due = due.AddDate(0, 0, 1)
+	}
+	return due
+}
+
 /**
 * If the name is longer than i characters, replace remaining characters with ...
  */
@@ -349,7 +409,19 @@ func (es *Service) sendBatchedEmailNotification(userID string, notifications []*
 
 	formattedTime := utils.GetFormattedPostTime(user, notifications[0].post, useMilitaryTime, translateFunc)
 
-	subject := translateFunc("api.email_batching.send_batched_email_notification.subject", len(notifications), map[string]any{
+	dailyDigest := false
+	if preference, err := es.store.Preference().Get(userID, model.PreferenceCategoryNotifications, model.PreferenceNameEmailInterval); err == nil {
+		dailyDigest = preference.Value == model.PreferenceEmailIntervalDailyAsSeconds
+	}
+
+	subjectID := "api.email_batching.send_batched_email_notification.subject"
+	titleID := "api.email_batching.send_batched_email_notification.title"
+	if dailyDigest {
+		subjectID = "api.email_batching.send_batched_email_notification.daily_subject"
+		titleID = "api.email_batching.send_batched_email_notification.daily_title"
+	}
+
+	subject := translateFunc(subjectID, len(notifications), map[string]any{
 		"SiteName": es.config().TeamSettings.SiteName,
 		"Year":     formattedTime.Year,
 		"Month":    formattedTime.Month,
@@ -358,7 +430,7 @@ func (es *Service) sendBatchedEmailNotification(userID string, notifications []*
 
 	data := es.NewEmailTemplateData(user.Locale)
 	data.Props["SiteURL"] = siteURL
-	data.Props["Title"] = translateFunc("api.email_batching.send_batched_email_notification.title", len(notifications)-1)
+	data.Props["Title"] = translateFunc(titleID, len(notifications)-1)
 	data.Props["SubTitle"] = translateFunc("api.email_batching.send_batched_email_notification.subTitle")
 	data.Props["Button"] = translateFunc("api.email_batching.send_batched_email_notification.button")
 	data.Props["ButtonURL"] = siteURL
@@ -283,3 +283,86 @@ func TestCheckPendingNotificationsCantParseInterval(t *testing.T) {
 
 	require.Nil(t, job.pendingNotifications[th.BasicUser.Id], "should have sent queued post")
 }
+
+/**
+ * Ensures that notifications of users who chose a daily digest are held until the digest hour
+ */
+func TestCheckPendingNotificationsDailyInterval(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	job := NewEmailBatchingJob(th.service, 128)
+
+	nErr := th.store.Preference().Save(model.Preferences{{
+		UserId:   th.BasicUser.Id,
+		Category: model.PreferenceCategoryNotifications,
+		Name:     model.PreferenceNameEmailInterval,
+		Value:    model.PreferenceEmailIntervalDailyAsSeconds,
+	}})
+	require.NoError(t, nErr)
+
+	otherChannel := th.createChannel(th.BasicTeam, string(model.ChannelTypeOpen))
+	th.addUserToChannel(otherChannel, th.BasicUser)
+
+	// the basic channel is viewed after its post was created, but not the other channel
+	channelMember, err := th.store.Channel().GetMember(context.Background(), th.BasicChannel.Id, th.BasicUser.Id)
+	require.NoError(t, err)
+	channelMember.LastViewedAt = 11000000
+	_, err = th.store.Channel().UpdateMember(th.Context, channelMember)
+	require.NoError(t, err)
+
+	channelMember, err = th.store.Channel().GetMember(context.Background(), otherChannel.Id, th.BasicUser.Id)
+	require.NoError(t, err)
+	channelMember.LastViewedAt = 9999000
+	_, err = th.store.Channel().UpdateMember(th.Context, channelMember)
+	require.NoError(t, err)
+
+	job.pendingNotifications[th.BasicUser.Id] = []*batchedNotification{
+		{
+			post: &model.Post{
+				UserId:    th.BasicUser.Id,
+				ChannelId: th.BasicChannel.Id,
+				CreateAt:  10000000,
+			},
+			teamName: th.BasicTeam.Name,
+		},
+		{
+			post: &model.Post{
+				UserId:    th.BasicUser.Id,
+				ChannelId: otherChannel.Id,
+				CreateAt:  10000001,
+			},
+			teamName: th.BasicTeam.Name,
+		},
+	}
+
+	var sent []*batchedNotification
+	handler := func(_ string, notifications []*batchedNotification) {
+		sent = notifications
+	}
+
+	// notifications should not be sent before the digest hour, even though a channel was viewed
+	job.checkPendingNotifications(time.Unix(13600, 0), handler)
+	require.Len(t, job.pendingNotifications[th.BasicUser.Id], 2, "shouldn't have sent queued posts")
+	require.Nil(t, sent)
+
+	// notifications should be sent after the digest hour, leaving out the viewed channel
+	job.checkPendingNotifications(time.Unix(dailyDigestHour*60*60+1, 0), handler)
+	require.Nil(t, job.pendingNotifications[th.BasicUser.Id], "should have sent queued posts")
+	require.Len(t, sent, 1)
+	assert.Equal(t, otherChannel.Id, sent[0].post.ChannelId)
+}
+
+func TestDailyDigestDueAt(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	before := time.Date(2024, time.March, 4, dailyDigestHour-1, 30, 0, 0, loc)
+	assert.Equal(t, time.Date(2024, time.March, 4, dailyDigestHour, 0, 0, 0, loc), dailyDigestDueAt(before.UnixMilli(), loc))
+
+	after := time.Date(2024, time.March, 4, dailyDigestHour+1, 0, 0, 0, loc)
+	assert.Equal(t, time.Date(2024, time.March, 5, dailyDigestHour, 0, 0, 0, loc), dailyDigestDueAt(after.UnixMilli(), loc))
+
+	atHour := time.Date(2024, time.March, 4, dailyDigestHour, 0, 0, 0, loc)
+	assert.Equal(t, time.Date(2024, time.March, 5, dailyDigestHour, 0, 0, 0, loc), dailyDigestDueAt(atHour.UnixMilli(), loc))
+}
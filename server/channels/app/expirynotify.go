@@ -17,7 +17,7 @@ const (
 
 // NotifySessionsExpired is called periodically from the job server to notify any mobile sessions that have expired.
 func (a *App) NotifySessionsExpired() error {
-	if !a.canSendPushNotifications() {
+	if !a.canSendToPushProxy() {
 		return nil
 	}
 
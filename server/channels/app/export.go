@@ -160,6 +160,13 @@ func (a *App) BulkExport(ctx request.CTX, writer io.Writer, outPath string, job
 	}
 	profilePictures = append(profilePictures, botPPs...)
 
+	if opts.IncludeIntegrations {
+		ctx.Logger().Info("Bulk export: exporting integrations")
+		if err = a.exportIntegrations(ctx, job, writer, opts.IncludeIntegrationSecrets); err != nil {
+			return err
+		}
+	}
+
 	ctx.Logger().Info("Bulk export: exporting posts")
 	attachments, err := a.exportAllPosts(ctx, job, writer, opts.IncludeAttachments, opts.IncludeArchivedChannels)
 	if err != nil {
@@ -487,6 +494,8 @@ func (a *App) exportAllUsers(ctx request.CTX, job *model.Job, writer io.Writer,
 						pref.Value = model.PreferenceEmailIntervalFifteen
 					case model.PreferenceEmailIntervalHourAsSeconds:
 						pref.Value = model.PreferenceEmailIntervalHour
+					case model.PreferenceEmailIntervalDailyAsSeconds:
+						pref.Value = model.PreferenceEmailIntervalDaily
 					case "0":
 						pref.Value = ""
 					}
@@ -601,6 +610,292 @@ func (a *App) exportAllBots(ctx request.CTX, job *model.Job, writer io.Writer, i
 	return profilePictures, nil
 }
 
+// exportNames resolves and caches the names that integrations are exported with. An empty
+// name means the referenced object no longer exists or is deleted.
+type exportNames struct {
+	a        *App
+	ctx      request.CTX
+	teams    map[string]string
+	channels map[string]string
+	users    map[string]string
+}
+
+func (a *App) newExportNames(ctx request.CTX) *exportNames {
+	return &exportNames{
+		a:        a,
+		ctx:      ctx,
+		teams:    make(map[string]string),
+		channels: make(map[string]string),
+		users:    make(map[string]string),
+	}
+}
+
+func (n *exportNames) team(teamID string) (string, *model.AppError) {
+	if name, ok := n.teams[teamID]; ok {
+		return name, nil
+	}
+
+	var name string
+	team, err := n.a.Srv().Store().Team().Get(teamID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return "", model.NewAppError("exportIntegrations", "app.team.get.find.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	} else if team.DeleteAt == 0 {
+		name = team.Name
+	}
+
+	n.teams[teamID] = name
+	return name, nil
+}
+
+func (n *exportNames) channel(channelID string) (string, *model.AppError) {
+	if name, ok := n.channels[channelID]; ok {
+		return name, nil
+	}
+
+	var name string
+	channel, err := n.a.Srv().Store().Channel().Get(channelID, true)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return "", model.NewAppError("exportIntegrations", "app.channel.get.find.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	} else if channel.DeleteAt == 0 {
+		name = channel.Name
+	}
+
+	n.channels[channelID] = name
+	return name, nil
+}
+
+func (n *exportNames) user(userID string) (string, *model.AppError) {
+	if name, ok := n.users[userID]; ok {
+		return name, nil
+	}
+
+	var name string
+	user, err := n.a.Srv().Store().User().Get(n.ctx.Context(), userID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return "", model.NewAppError("exportIntegrations", "app.user.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	} else {
+		name = user.Username
+	}
+
+	n.users[userID] = name
+	return name, nil
+}
+
+// exportIntegrations writes the incoming and outgoing webhooks, custom slash commands and OAuth
+// apps. Unless includeSecrets is set, the webhook ids, tokens and client secrets are left out so
+// that the importing server generates new ones.
+func (a *App) exportIntegrations(ctx request.CTX, job *model.Job, writer io.Writer, includeSecrets bool) *model.AppError {
+	names := a.newExportNames(ctx)
+
+	if err := a.exportIncomingWebhooks(ctx, job, writer, names, includeSecrets); err != nil {
+		return err
+	}
+
+	if err := a.exportOutgoingWebhooks(ctx, job, writer, names, includeSecrets); err != nil {
+		return err
+	}
+
+	if err := a.exportCommands(ctx, job, writer, names, includeSecrets); err != nil {
+		return err
+	}
+
+	return a.exportOAuthApps(ctx, job, writer, names, includeSecrets)
+}
+
+func (a *App) exportIncomingWebhooks(ctx request.CTX, job *model.Job, writer io.Writer, names *exportNames, includeSecrets bool) *model.AppError {
+	const pageSize = 1000
+	cnt := 0
+	for page := 0; ; page++ {
+		hooks, err := a.Srv().Store().Webhook().GetIncomingList(page*pageSize, pageSize)
+		if err != nil {
+			return model.NewAppError("exportIncomingWebhooks", "app.webhooks.get_incoming.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		cnt += len(hooks)
+		updateJobProgress(ctx.Logger(), a.Srv().Store(), job, "incoming_webhooks_exported", cnt)
+
+		for _, hook := range hooks {
+			teamName, appErr := names.team(hook.TeamId)
+			if appErr != nil {
+				return appErr
+			}
+			channelName, appErr := names.channel(hook.ChannelId)
+			if appErr != nil {
+				return appErr
+			}
+			username, appErr := names.user(hook.UserId)
+			if appErr != nil {
+				return appErr
+			}
+
+			if teamName == "" || channelName == "" || username == "" {
+				ctx.Logger().Warn("Skipping incoming webhook with a missing team, channel or user", mlog.String("webhook_id", hook.Id))
+				continue
+			}
+
+			if err := a.exportWriteLine(writer, ImportLineFromIncomingWebhook(hook, teamName, channelName, username, includeSecrets)); err != nil {
+				return err
+			}
+		}
+
+		if len(hooks) < pageSize {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (a *App) exportOutgoingWebhooks(ctx request.CTX, job *model.Job, writer io.Writer, names *exportNames, includeSecrets bool) *model.AppError {
+	const pageSize = 1000
+	cnt := 0
+	for page := 0; ; page++ {
+		hooks, err := a.Srv().Store().Webhook().GetOutgoingList(page*pageSize, pageSize)
+		if err != nil {
+			return model.NewAppError("exportOutgoingWebhooks", "app.webhooks.get_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		cnt += len(hooks)
+		updateJobProgress(ctx.Logger(), a.Srv().Store(), job, "outgoing_webhooks_exported", cnt)
+
+		for _, hook := range hooks {
+			teamName, appErr := names.team(hook.TeamId)
+			if appErr != nil {
+				return appErr
+			}
+			creatorUsername, appErr := names.user(hook.CreatorId)
+			if appErr != nil {
+				return appErr
+			}
+
+			var channelName string
+			if hook.ChannelId != "" {
+				if channelName, appErr = names.channel(hook.ChannelId); appErr != nil {
+					return appErr
+				}
+				if channelName == "" {
+					ctx.Logger().Warn("Skipping outgoing webhook with a missing channel", mlog.String("webhook_id", hook.Id))
+					continue
+				}
+			}
+
+			if teamName == "" || creatorUsername == "" {
+				ctx.Logger().Warn("Skipping outgoing webhook with a missing team or creator", mlog.String("webhook_id", hook.Id))
+				continue
+			}
+
+			if err := a.exportWriteLine(writer, ImportLineFromOutgoingWebhook(hook, teamName, channelName, creatorUsername, includeSecrets)); err != nil {
+				return err
+			}
+		}
+
+		if len(hooks) < pageSize {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (a *App) exportCommands(ctx request.CTX, job *model.Job, writer io.Writer, names *exportNames, includeSecrets bool) *model.AppError {
+	afterId := strings.Repeat("0", 26)
+	cnt := 0
+	for {
+		teams, err := a.Srv().Store().Team().GetAllForExportAfter(1000, afterId)
+		if err != nil {
+			return model.NewAppError("exportCommands", "app.team.get_all.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		if len(teams) == 0 {
+			break
+		}
+
+		for _, team := range teams {
+			afterId = team.Id
+
+			if team.DeleteAt != 0 {
+				continue
+			}
+
+			commands, err := a.Srv().Store().Command().GetByTeam(team.Id)
+			if err != nil {
+				return model.NewAppError("exportCommands", "app.command.listteamcommands.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+
+			for _, command := range commands {
+				creatorUsername, appErr := names.user(command.CreatorId)
+				if appErr != nil {
+					return appErr
+				}
+
+				if creatorUsername == "" {
+					ctx.Logger().Warn("Skipping command with a missing creator", mlog.String("command_id", command.Id))
+					continue
+				}
+
+				if err := a.exportWriteLine(writer, ImportLineFromCommand(command, team.Name, creatorUsername, includeSecrets)); err != nil {
+					return err
+				}
+				cnt++
+			}
+		}
+
+		updateJobProgress(ctx.Logger(), a.Srv().Store(), job, "commands_exported", cnt)
+	}
+
+	return nil
+}
+
+func (a *App) exportOAuthApps(ctx request.CTX, job *model.Job, writer io.Writer, names *exportNames, includeSecrets bool) *model.AppError {
+	const pageSize = 1000
+	cnt := 0
+	for page := 0; ; page++ {
+		apps, err := a.Srv().Store().OAuth().GetApps(page*pageSize, pageSize)
+		if err != nil {
+			return model.NewAppError("exportOAuthApps", "app.oauth.get_apps.find.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		cnt += len(apps)
+		updateJobProgress(ctx.Logger(), a.Srv().Store(), job, "oauth_apps_exported", cnt)
+
+		for _, app := range apps {
+			// Apps registered by the Apps framework are recreated when the app is installed.
+			if app.MattermostAppID != "" {
+				continue
+			}
+
+			creatorUsername, appErr := names.user(app.CreatorId)
+			if appErr != nil {
+				return appErr
+			}
+
+			if creatorUsername == "" {
+				ctx.Logger().Warn("Skipping OAuth app with a missing creator", mlog.String("client_id", app.Id))
+				continue
+			}
+
+			if err := a.exportWriteLine(writer, ImportLineFromOAuthApp(app, creatorUsername, includeSecrets)); err != nil {
+				return err
+			}
+		}
+
+		if len(apps) < pageSize {
+			break
+		}
+	}
+
+	return nil
+}
+
 func (a *App) buildUserTeamAndChannelMemberships(c request.CTX, userID string, includeArchivedChannels bool) (*[]imports.UserTeamImportData, *model.AppError) {
 	var memberships []imports.UserTeamImportData
 
@@ -379,3 +379,108 @@ func ImportFollowerFromThreadMember(threadMember *model.ThreadMembershipForExpor
 		UnreadMentions: &threadMember.UnreadMentions,
 	}
 }
+
+func ImportLineFromIncomingWebhook(hook *model.IncomingWebhook, teamName, channelName, username string, includeSecrets bool) *imports.LineImportData {
+	data := &imports.IncomingWebhookImportData{
+		Team:               &teamName,
+		Channel:            &channelName,
+		User:               &username,
+		DisplayName:        &hook.DisplayName,
+		Description:        &hook.Description,
+		Username:           &hook.Username,
+		IconURL:            &hook.IconURL,
+		ChannelLocked:      &hook.ChannelLocked,
+		RateLimitPerMinute: &hook.RateLimitPerMinute,
+		RateLimitBurst:     &hook.RateLimitBurst,
+		PayloadTemplate:    &hook.PayloadTemplate,
+	}
+
+	if includeSecrets {
+		data.Id = &hook.Id
+	}
+
+	return &imports.LineImportData{
+		Type:            "incoming_webhook",
+		IncomingWebhook: data,
+	}
+}
+
+func ImportLineFromOutgoingWebhook(hook *model.OutgoingWebhook, teamName, channelName, creatorUsername string, includeSecrets bool) *imports.LineImportData {
+	triggerWords := []string(hook.TriggerWords)
+	callbackURLs := []string(hook.CallbackURLs)
+	data := &imports.OutgoingWebhookImportData{
+		Team:         &teamName,
+		Creator:      &creatorUsername,
+		TriggerWords: &triggerWords,
+		TriggerWhen:  &hook.TriggerWhen,
+		CallbackURLs: &callbackURLs,
+		DisplayName:  &hook.DisplayName,
+		Description:  &hook.Description,
+		ContentType:  &hook.ContentType,
+		Username:     &hook.Username,
+		IconURL:      &hook.IconURL,
+	}
+
+	if channelName != "" {
+		data.Channel = &channelName
+	}
+
+	if includeSecrets {
+		data.Token = &hook.Token
+		data.Secret = &hook.Secret
+	}
+
+	return &imports.LineImportData{
+		Type:            "outgoing_webhook",
+		OutgoingWebhook: data,
+	}
+}
+
+func ImportLineFromCommand(command *model.Command, teamName, creatorUsername string, includeSecrets bool) *imports.LineImportData {
+	data := &imports.CommandImportData{
+		Team:             &teamName,
+		Creator:          &creatorUsername,
+		Trigger:          &command.Trigger,
+		Method:           &command.Method,
+		URL:              &command.URL,
+		Username:         &command.Username,
+		IconURL:          &command.IconURL,
+		AutoComplete:     &command.AutoComplete,
+		AutoCompleteDesc: &command.AutoCompleteDesc,
+		AutoCompleteHint: &command.AutoCompleteHint,
+		DisplayName:      &command.DisplayName,
+		Description:      &command.Description,
+	}
+
+	if includeSecrets {
+		data.Token = &command.Token
+	}
+
+	return &imports.LineImportData{
+		Type:    "command",
+		Command: data,
+	}
+}
+
+func ImportLineFromOAuthApp(app *model.OAuthApp, creatorUsername string, includeSecrets bool) *imports.LineImportData {
+	callbackURLs := []string(app.CallbackUrls)
+	data := &imports.OAuthAppImportData{
+		Creator:      &creatorUsername,
+		Name:         &app.Name,
+		Description:  &app.Description,
+		IconURL:      &app.IconURL,
+		CallbackURLs: &callbackURLs,
+		Homepage:     &app.Homepage,
+		IsTrusted:    &app.IsTrusted,
+	}
+
+	if includeSecrets {
+		data.ClientId = &app.Id
+		data.ClientSecret = &app.ClientSecret
+	}
+
+	return &imports.LineImportData{
+		Type:     "oauth_app",
+		OAuthApp: data,
+	}
+}
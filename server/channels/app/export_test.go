@@ -978,6 +978,123 @@ func TestExportArchivedChannels(t *testing.T) {
 	require.True(t, found, "archived channel not found after import")
 }
 
+func TestExportIntegrations(t *testing.T) {
+	setupIntegrations := func(t *testing.T, th *TestHelper) (*model.IncomingWebhook, *model.OutgoingWebhook, *model.Command, *model.OAuthApp) {
+		incomingHook, err := th.App.Srv().Store().Webhook().SaveIncoming(&model.IncomingWebhook{
+			UserId:      th.BasicUser.Id,
+			TeamId:      th.BasicTeam.Id,
+			ChannelId:   th.BasicChannel.Id,
+			DisplayName: "incoming",
+		})
+		require.NoError(t, err)
+
+		outgoingHook, err := th.App.Srv().Store().Webhook().SaveOutgoing(&model.OutgoingWebhook{
+			CreatorId:    th.BasicUser.Id,
+			TeamId:       th.BasicTeam.Id,
+			ChannelId:    th.BasicChannel.Id,
+			CallbackURLs: []string{"http://example.com/outgoing"},
+			DisplayName:  "outgoing",
+		})
+		require.NoError(t, err)
+
+		command, err := th.App.Srv().Store().Command().Save(&model.Command{
+			CreatorId: th.BasicUser.Id,
+			TeamId:    th.BasicTeam.Id,
+			Trigger:   "exported",
+			Method:    model.CommandMethodPost,
+			URL:       "http://example.com/command",
+		})
+		require.NoError(t, err)
+
+		oauthApp, err := th.App.Srv().Store().OAuth().SaveApp(&model.OAuthApp{
+			CreatorId:    th.BasicUser.Id,
+			Name:         "exported",
+			CallbackUrls: []string{"http://example.com/callback"},
+			Homepage:     "http://example.com",
+		})
+		require.NoError(t, err)
+
+		return incomingHook, outgoingHook, command, oauthApp
+	}
+
+	t.Run("secrets are carried over", func(t *testing.T) {
+		th1 := Setup(t).InitBasic()
+		defer th1.TearDown()
+
+		incomingHook, outgoingHook, command, oauthApp := setupIntegrations(t, th1)
+
+		var b bytes.Buffer
+		appErr := th1.App.BulkExport(th1.Context, &b, "somePath", nil, model.BulkExportOpts{
+			IncludeIntegrations:       true,
+			IncludeIntegrationSecrets: true,
+		})
+		require.Nil(t, appErr)
+
+		th2 := Setup(t)
+		defer th2.TearDown()
+		appErr, i := th2.App.BulkImport(th2.Context, &b, nil, false, 5)
+		require.Nil(t, appErr)
+		assert.Equal(t, 0, i)
+
+		importedIncomingHook, err := th2.App.Srv().Store().Webhook().GetIncoming(incomingHook.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, incomingHook.DisplayName, importedIncomingHook.DisplayName)
+
+		team, err := th2.App.Srv().Store().Team().GetByName(th1.BasicTeam.Name)
+		require.NoError(t, err)
+
+		outgoingHooks, err := th2.App.Srv().Store().Webhook().GetOutgoingByTeam(team.Id, -1, -1)
+		require.NoError(t, err)
+		require.Len(t, outgoingHooks, 1)
+		assert.Equal(t, outgoingHook.Token, outgoingHooks[0].Token)
+		assert.Equal(t, outgoingHook.Secret, outgoingHooks[0].Secret)
+
+		importedCommand, err := th2.App.Srv().Store().Command().GetByTrigger(team.Id, command.Trigger)
+		require.NoError(t, err)
+		assert.Equal(t, command.Token, importedCommand.Token)
+
+		importedApp, err := th2.App.Srv().Store().OAuth().GetApp(oauthApp.Id)
+		require.NoError(t, err)
+		assert.Equal(t, oauthApp.ClientSecret, importedApp.ClientSecret)
+	})
+
+	t.Run("secrets are regenerated", func(t *testing.T) {
+		th1 := Setup(t).InitBasic()
+		defer th1.TearDown()
+
+		incomingHook, _, command, oauthApp := setupIntegrations(t, th1)
+
+		var b bytes.Buffer
+		appErr := th1.App.BulkExport(th1.Context, &b, "somePath", nil, model.BulkExportOpts{
+			IncludeIntegrations: true,
+		})
+		require.Nil(t, appErr)
+
+		th2 := Setup(t)
+		defer th2.TearDown()
+		appErr, i := th2.App.BulkImport(th2.Context, &b, nil, false, 5)
+		require.Nil(t, appErr)
+		assert.Equal(t, 0, i)
+
+		_, err := th2.App.Srv().Store().Webhook().GetIncoming(incomingHook.Id, false)
+		require.Error(t, err)
+
+		team, err := th2.App.Srv().Store().Team().GetByName(th1.BasicTeam.Name)
+		require.NoError(t, err)
+
+		incomingHooks, err := th2.App.Srv().Store().Webhook().GetIncomingByTeam(team.Id, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, incomingHooks, 1)
+
+		importedCommand, err := th2.App.Srv().Store().Command().GetByTrigger(team.Id, command.Trigger)
+		require.NoError(t, err)
+		assert.NotEqual(t, command.Token, importedCommand.Token)
+
+		_, err = th2.App.Srv().Store().OAuth().GetApp(oauthApp.Id)
+		require.Error(t, err)
+	})
+}
+
 func TestExportRoles(t *testing.T) {
 	t.Run("defaults", func(t *testing.T) {
 		th1 := Setup(t).InitBasic()
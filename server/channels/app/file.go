@@ -10,6 +10,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
+	"hash"
 	"image"
 	"io"
 	"math"
@@ -19,6 +20,7 @@ import (
 	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -770,7 +772,15 @@ func (a *App) UploadFileX(c request.CTX, channelID, name string, input io.Reader
 		}
 	}
 
-	written, aerr := t.writeFile(io.MultiReader(t.buf, t.limitedInput), t.fileinfo.Path)
+	// The content is hashed while being written so that it doesn't have to be read again.
+	var contentHash hash.Hash
+	content := io.MultiReader(t.buf, t.limitedInput)
+	if a.isContentDeduplicationEnabled() {
+		contentHash = model.NewFileContentHash()
+		content = io.TeeReader(content, contentHash)
+	}
+
+	written, aerr := t.writeFile(content, t.fileinfo.Path)
 	if aerr != nil {
 		return nil, aerr
 	}
@@ -783,6 +793,9 @@ func (a *App) UploadFileX(c request.CTX, channelID, name string, input io.Reader
 	}
 
 	t.fileinfo.Size = written
+	if contentHash != nil {
+		t.fileinfo.ContentHash = model.FileContentHashString(contentHash)
+	}
 
 	file, aerr := a.FileReader(t.fileinfo.Path)
 	if aerr != nil {
@@ -804,6 +817,8 @@ func (a *App) UploadFileX(c request.CTX, channelID, name string, input io.Reader
 		t.postprocessImage(file)
 	}
 
+	a.deduplicateUploadedFile(c, t.fileinfo)
+
 	if _, err := t.saveToDatabase(c, t.fileinfo); err != nil {
 		var appErr *model.AppError
 		switch {
@@ -1064,6 +1079,13 @@ func (a *App) DoUploadFileExpectModification(c request.CTX, now time.Time, rawTe
 		return nil, data, err
 	}
 
+	if a.isContentDeduplicationEnabled() {
+		contentHash := model.NewFileContentHash()
+		contentHash.Write(data)
+		info.ContentHash = model.FileContentHashString(contentHash)
+	}
+	a.deduplicateUploadedFile(c, info)
+
 	if _, err := a.Srv().Store().FileInfo().Save(c, info); err != nil {
 		var appErr *model.AppError
 		switch {
@@ -1457,8 +1479,13 @@ func (a *App) SearchFilesInTeamForUser(c request.CTX, terms string, userId strin
 }
 
 func (a *App) ExtractContentFromFileInfo(rctx request.CTX, fileInfo *model.FileInfo) error {
-	// We don't process images.
-	if fileInfo.IsImage() {
+	fileSettings := a.Config().FileSettings
+	pluginExtractors := a.pluginContentExtractors(rctx)
+
+	// Images are only processed through OCR or plugins.
+	if fileInfo.IsImage() && !*fileSettings.EnableOCR && !slices.ContainsFunc(pluginExtractors, func(e docextractor.Extractor) bool {
+		return e.Match(fileInfo.Name)
+	}) {
 		return nil
 	}
 
@@ -1467,9 +1494,15 @@ func (a *App) ExtractContentFromFileInfo(rctx request.CTX, fileInfo *model.FileI
 		return errors.Wrap(aerr, "failed to open file for extract file content")
 	}
 	defer file.Close()
-	text, err := docextractor.Extract(rctx.Logger(), fileInfo.Name, file, docextractor.ExtractSettings{
-		ArchiveRecursion: *a.Config().FileSettings.ArchiveRecursion,
-	})
+	text, err := docextractor.ExtractWithExtraExtractors(rctx.Logger(), fileInfo.Name, file, docextractor.ExtractSettings{
+		ArchiveRecursion:       *fileSettings.ArchiveRecursion,
+		OCREnabled:             *fileSettings.EnableOCR,
+		OCRCommand:             *fileSettings.OCRCommand,
+		OCRLanguages:           *fileSettings.OCRLanguages,
+		OCRPDFRasterizeCommand: *fileSettings.OCRPDFRasterizeCommand,
+		OCRMaxPages:            *fileSettings.OCRMaxPages,
+		OCRTimeout:             time.Duration(*fileSettings.OCRTimeoutSeconds) * time.Second,
+	}, pluginExtractors)
 	if err != nil {
 		return errors.Wrap(err, "failed to extract file content")
 	}
@@ -1620,7 +1653,10 @@ func (a *App) PermanentDeleteFilesByPost(rctx request.CTX, postID string) *model
 
 func (a *App) RemoveFilesFromFileStore(rctx request.CTX, fileInfos []*model.FileInfo) {
 	for _, info := range fileInfos {
-		a.RemoveFileFromFileStore(rctx, info.Path)
+		// Deduplicated content is shared with other files and removed once no longer referenced.
+		if info.ContentHash == "" {
+			a.RemoveFileFromFileStore(rctx, info.Path)
+		}
 		if info.PreviewPath != "" {
 			a.RemoveFileFromFileStore(rctx, info.PreviewPath)
 		}
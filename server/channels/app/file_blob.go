@@ -0,0 +1,193 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+const (
+	fileDeduplicationBatchSize = 100
+
+	// fileBlobOrphanGracePeriod is how long a blob must have gone unreferenced and untouched before
+	// it is removed. Uploads dropping their content for an existing blob touch it first, which gives
+	// them that long to save the FileInfo referencing it.
+	fileBlobOrphanGracePeriod = time.Hour
+)
+
+func (a *App) isContentDeduplicationEnabled() bool {
+	return *a.Config().FileSettings.EnableContentDeduplication
+}
+
+func (a *App) blobFileBackend() *filestore.BlobFileBackend {
+	return filestore.NewBlobFileBackend(a.FileBackend())
+}
+
+// storeFileBlob moves the content of a newly uploaded file, not yet saved to the database, to the
+// blob addressed by its content hash, or drops it if that blob already exists. The reference on the
+// blob is added when the FileInfo is saved.
+func (a *App) storeFileBlob(rctx request.CTX, info *model.FileInfo) *model.AppError {
+	backend := a.blobFileBackend()
+
+	contentHash := info.ContentHash
+	if contentHash == "" {
+		var err error
+		if contentHash, err = backend.ContentHash(info.Path); err != nil {
+			return model.NewAppError("storeFileBlob", "api.file.file_reader.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	// The upload is only dropped for a blob known to the database, once touched so that it isn't
+	// removed as orphaned before the FileInfo referencing it is saved.
+	touched, err := a.Srv().Store().FileInfo().TouchBlob(contentHash)
+	if err != nil {
+		return model.NewAppError("storeFileBlob", "app.file_info.touch_blob.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	exists := false
+	if touched {
+		if exists, err = backend.BlobExists(contentHash); err != nil {
+			return model.NewAppError("storeFileBlob", "api.file.file_exists.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	blobPath := model.FileBlobPath(contentHash)
+	if exists {
+		if appErr := a.RemoveFile(info.Path); appErr != nil {
+			rctx.Logger().Warn("Failed to remove deduplicated file", mlog.String("path", info.Path), mlog.Err(appErr))
+		}
+	} else if blobPath, err = backend.MoveToBlob(info.Path, contentHash); err != nil {
+		// A blob unknown to the database may be left over by an upload whose FileInfo wasn't
+		// saved, it is overwritten with the same content.
+		return model.NewAppError("storeFileBlob", "api.file.move_file.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	info.Path = blobPath
+	info.ContentHash = contentHash
+
+	return nil
+}
+
+// deduplicateUploadedFile stores the content of a newly uploaded file as a blob when content
+// deduplication is enabled. The file is kept at its original path if that fails.
+func (a *App) deduplicateUploadedFile(rctx request.CTX, info *model.FileInfo) {
+	if !a.isContentDeduplicationEnabled() {
+		info.ContentHash = ""
+		return
+	}
+
+	if appErr := a.storeFileBlob(rctx, info); appErr != nil {
+		rctx.Logger().Warn("Failed to deduplicate uploaded file", mlog.String("file_id", info.Id), mlog.Err(appErr))
+		info.ContentHash = ""
+	}
+}
+
+// DeduplicateFiles moves the content of the files uploaded before content deduplication was
+// enabled to blobs, then removes the blobs no longer referenced from the file store.
+func (a *App) DeduplicateFiles(rctx request.CTX) error {
+	if err := a.deduplicateExistingFiles(rctx); err != nil {
+		return err
+	}
+
+	return a.removeOrphanedFileBlobs(rctx)
+}
+
+func (a *App) deduplicateExistingFiles(rctx request.CTX) error {
+	// Copied FileInfos share their path and are all moved with the first one.
+	done := make(map[string]bool)
+
+	afterID := ""
+	for {
+		infos, err := a.Srv().Store().FileInfo().GetWithoutContentHash(afterID, fileDeduplicationBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to get files to deduplicate: %w", err)
+		}
+
+		for _, info := range infos {
+			if done[info.Path] {
+				continue
+			}
+			done[info.Path] = true
+
+			if err := a.deduplicateExistingFile(rctx, info); err != nil {
+				rctx.Logger().Warn("Failed to deduplicate file", mlog.String("file_id", info.Id), mlog.Err(err))
+			}
+		}
+
+		if len(infos) < fileDeduplicationBatchSize {
+			return nil
+		}
+		afterID = infos[len(infos)-1].Id
+	}
+}
+
+func (a *App) deduplicateExistingFile(rctx request.CTX, info *model.FileInfo) error {
+	backend := a.blobFileBackend()
+
+	contentHash, err := backend.ContentHash(info.Path)
+	if err != nil {
+		return err
+	}
+
+	// The content is copied rather than moved so that the file stays readable until the
+	// FileInfos point at the blob.
+	blobPath, err := backend.CopyToBlob(info.Path, contentHash)
+	if err != nil {
+		return fmt.Errorf("failed to copy file to blob: %w", err)
+	}
+
+	if _, err := a.Srv().Store().FileInfo().SetContentHash(rctx, info.Path, contentHash, blobPath); err != nil {
+		return fmt.Errorf("failed to set content hash: %w", err)
+	}
+
+	if info.PostId != "" {
+		a.Srv().Store().FileInfo().InvalidateFileInfosForPostCache(info.PostId, true)
+		a.Srv().Store().FileInfo().InvalidateFileInfosForPostCache(info.PostId, false)
+	}
+
+	if appErr := a.RemoveFile(info.Path); appErr != nil {
+		return appErr
+	}
+
+	return nil
+}
+
+func (a *App) removeOrphanedFileBlobs(rctx request.CTX) error {
+	backend := a.blobFileBackend()
+	updatedBefore := model.GetMillisForTime(time.Now().Add(-fileBlobOrphanGracePeriod))
+
+	for {
+		blobs, err := a.Srv().Store().FileInfo().GetOrphanedBlobs(updatedBefore, fileDeduplicationBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to get orphaned file blobs: %w", err)
+		}
+
+		for _, blob := range blobs {
+			deleted, err := a.Srv().Store().FileInfo().PermanentDeleteBlob(blob.Hash, updatedBefore)
+			if err != nil {
+				return fmt.Errorf("failed to delete file blob: %w", err)
+			}
+
+			// The blob was referenced or touched again in the meantime.
+			if !deleted {
+				continue
+			}
+
+			if err := backend.RemoveBlob(blob.Hash); err != nil {
+				rctx.Logger().Warn("Failed to remove file blob", mlog.String("path", blob.Path), mlog.Err(err))
+			}
+		}
+
+		if len(blobs) < fileDeduplicationBatchSize {
+			return nil
+		}
+	}
+}
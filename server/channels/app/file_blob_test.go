@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestContentDeduplication(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	data := []byte("deduplicated content")
+	h := model.NewFileContentHash()
+	h.Write(data)
+	contentHash := model.FileContentHashString(h)
+	blobPath := model.FileBlobPath(contentHash)
+
+	t.Run("disabled", func(t *testing.T) {
+		info, appErr := th.App.UploadFileX(th.Context, th.BasicChannel.Id, "file.txt", bytes.NewReader(data), UploadFileSetTeamId(th.BasicTeam.Id), UploadFileSetUserId(th.BasicUser.Id))
+		require.Nil(t, appErr)
+		assert.Empty(t, info.ContentHash)
+		assert.NotEqual(t, blobPath, info.Path)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.FileSettings.EnableContentDeduplication = true
+	})
+
+	var infos []*model.FileInfo
+	t.Run("uploads share a blob", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			info, appErr := th.App.UploadFileX(th.Context, th.BasicChannel.Id, "file.txt", bytes.NewReader(data), UploadFileSetTeamId(th.BasicTeam.Id), UploadFileSetUserId(th.BasicUser.Id))
+			require.Nil(t, appErr)
+			assert.Equal(t, contentHash, info.ContentHash)
+			assert.Equal(t, blobPath, info.Path)
+			infos = append(infos, info)
+		}
+
+		info, appErr := th.App.DoUploadFile(th.Context, model.GetTimeForMillis(model.GetMillis()), th.BasicTeam.Id, th.BasicChannel.Id, th.BasicUser.Id, "other.txt", data, false)
+		require.Nil(t, appErr)
+		assert.Equal(t, blobPath, info.Path)
+		infos = append(infos, info)
+
+		content, appErr := th.App.ReadFile(blobPath)
+		require.Nil(t, appErr)
+		assert.Equal(t, data, content)
+	})
+
+	t.Run("existing files are deduplicated", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.FileSettings.EnableContentDeduplication = false
+		})
+		info, appErr := th.App.UploadFileX(th.Context, th.BasicChannel.Id, "file.txt", bytes.NewReader(data), UploadFileSetTeamId(th.BasicTeam.Id), UploadFileSetUserId(th.BasicUser.Id))
+		require.Nil(t, appErr)
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.FileSettings.EnableContentDeduplication = true
+		})
+
+		require.NoError(t, th.App.DeduplicateFiles(th.Context))
+
+		migrated, err := th.App.Srv().Store().FileInfo().Get(info.Id)
+		require.NoError(t, err)
+		assert.Equal(t, contentHash, migrated.ContentHash)
+		assert.Equal(t, blobPath, migrated.Path)
+
+		exists, appErr := th.App.FileExists(info.Path)
+		require.Nil(t, appErr)
+		assert.False(t, exists, "the original file should be removed")
+
+		infos = append(infos, migrated)
+	})
+
+	t.Run("deleting files keeps the shared blob", func(t *testing.T) {
+		th.App.RemoveFilesFromFileStore(th.Context, infos[:1])
+		require.NoError(t, th.App.Srv().Store().FileInfo().PermanentDelete(th.Context, infos[0].Id))
+
+		exists, appErr := th.App.FileExists(blobPath)
+		require.Nil(t, appErr)
+		assert.True(t, exists)
+	})
+}
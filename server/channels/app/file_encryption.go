@@ -0,0 +1,55 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+// RotateFileEncryptionKeys re-encrypts the data keys of the stored files with the primary
+// encryption key, and encrypts the files stored before encryption was enabled.
+func (a *App) RotateFileEncryptionKeys(rctx request.CTX) error {
+	backend, ok := a.FileBackend().(*filestore.EncryptedFileBackend)
+	if !ok {
+		return nil
+	}
+
+	paths, err := backend.ListDirectoryRecursively("")
+	if err != nil {
+		return fmt.Errorf("failed to list files: %w", err)
+	}
+
+	// A dedicated export store writes exports unencrypted, and may share the directory of the
+	// file store. Otherwise exports go through the file store and are encrypted like any file.
+	exportDirectory := ""
+	if *a.Config().FileSettings.DedicatedExportStore {
+		exportDirectory = filepath.Clean(*a.Config().ExportSettings.Directory) + "/"
+	}
+
+	var rotated int
+	for _, path := range paths {
+		if exportDirectory != "" && strings.HasPrefix(path, exportDirectory) {
+			continue
+		}
+
+		changed, err := backend.RotateKey(path)
+		if err != nil {
+			rctx.Logger().Warn("Failed to rotate the encryption key of file", mlog.String("path", path), mlog.Err(err))
+			continue
+		}
+		if changed {
+			rotated++
+		}
+	}
+
+	rctx.Logger().Info("Rotated file encryption keys", mlog.String("key_id", backend.PrimaryKeyID()), mlog.Int("files", rotated))
+
+	return nil
+}
@@ -364,6 +364,26 @@ func (a *App) importLine(c request.CTX, line imports.LineImportData, dryRun bool
 			return model.NewAppError("BulkImport", "app.import.import_line.null_emoji.error", nil, "", http.StatusBadRequest)
 		}
 		return a.importEmoji(c, line.Emoji, dryRun)
+	case line.Type == "incoming_webhook":
+		if line.IncomingWebhook == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_incoming_webhook.error", nil, "", http.StatusBadRequest)
+		}
+		return a.importIncomingWebhook(c, line.IncomingWebhook, dryRun)
+	case line.Type == "outgoing_webhook":
+		if line.OutgoingWebhook == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_outgoing_webhook.error", nil, "", http.StatusBadRequest)
+		}
+		return a.importOutgoingWebhook(c, line.OutgoingWebhook, dryRun)
+	case line.Type == "command":
+		if line.Command == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_command.error", nil, "", http.StatusBadRequest)
+		}
+		return a.importCommand(c, line.Command, dryRun)
+	case line.Type == "oauth_app":
+		if line.OAuthApp == nil {
+			return model.NewAppError("BulkImport", "app.import.import_line.null_oauth_app.error", nil, "", http.StatusBadRequest)
+		}
+		return a.importOAuthApp(c, line.OAuthApp, dryRun)
 	default:
 		return model.NewAppError("BulkImport", "app.import.import_line.unknown_line_type.error", map[string]any{"Type": line.Type}, "", http.StatusBadRequest)
 	}
@@ -813,6 +813,8 @@ func (a *App) importUser(rctx request.CTX, data *imports.UserImportData, dryRun
 				intervalSeconds = model.PreferenceEmailIntervalFifteenAsSeconds
 			case model.PreferenceEmailIntervalHour:
 				intervalSeconds = model.PreferenceEmailIntervalHourAsSeconds
+			case model.PreferenceEmailIntervalDaily:
+				intervalSeconds = model.PreferenceEmailIntervalDailyAsSeconds
 			}
 		}
 		if intervalSeconds != "" {
@@ -2620,3 +2622,427 @@ func (a *App) extractThreadMembers(line *imports.LineImportWorkerData, users map
 
 	return threadMemberships, 0, nil
 }
+
+func (a *App) getTeamForIntegrationImport(teamName string) (*model.Team, *model.AppError) {
+	team, err := a.Srv().Store().Team().GetByName(strings.ToLower(teamName))
+	if err != nil {
+		return nil, model.NewAppError("BulkImport", "app.import.import_integration.team_not_found.error", map[string]any{"TeamName": teamName}, "", http.StatusBadRequest).Wrap(err)
+	}
+	return team, nil
+}
+
+func (a *App) getUserForIntegrationImport(username string) (*model.User, *model.AppError) {
+	user, err := a.Srv().Store().User().GetByUsername(username)
+	if err != nil {
+		return nil, model.NewAppError("BulkImport", "app.import.import_integration.user_not_found.error", map[string]any{"Username": username}, "", http.StatusBadRequest).Wrap(err)
+	}
+	return user, nil
+}
+
+func (a *App) importIncomingWebhook(rctx request.CTX, data *imports.IncomingWebhookImportData, dryRun bool) *model.AppError {
+	var fields []mlog.Field
+	if data != nil && data.Team != nil && data.Channel != nil {
+		fields = append(fields, mlog.String("team_name", *data.Team), mlog.String("channel_name", *data.Channel))
+	}
+	rctx.Logger().Info("Validating incoming webhook", fields...)
+
+	if err := imports.ValidateIncomingWebhookImportData(data); err != nil {
+		return err
+	}
+
+	// If this is a Dry Run, do not continue any further.
+	if dryRun {
+		return nil
+	}
+
+	rctx.Logger().Info("Importing incoming webhook", fields...)
+
+	team, appErr := a.getTeamForIntegrationImport(*data.Team)
+	if appErr != nil {
+		return appErr
+	}
+
+	channel, err := a.Srv().Store().Channel().GetByName(team.Id, strings.ToLower(*data.Channel), true)
+	if err != nil {
+		return model.NewAppError("BulkImport", "app.import.import_integration.channel_not_found.error", map[string]any{"ChannelName": *data.Channel}, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	user, appErr := a.getUserForIntegrationImport(*data.User)
+	if appErr != nil {
+		return appErr
+	}
+
+	var hook *model.IncomingWebhook
+	if data.Id != nil {
+		hook, err = a.Srv().Store().Webhook().GetIncoming(*data.Id, false)
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if !errors.As(err, &nfErr) {
+				return model.NewAppError("BulkImport", "app.webhooks.get_incoming.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			hook = &model.IncomingWebhook{Id: *data.Id}
+		}
+	} else {
+		channelHooks, err := a.Srv().Store().Webhook().GetIncomingByChannel(channel.Id)
+		if err != nil {
+			return model.NewAppError("BulkImport", "app.webhooks.get_incoming_by_channel.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		// Without an id, a hook is considered to be already imported when it posts to the same
+		// channel as the same user under the same display name.
+		var displayName string
+		if data.DisplayName != nil {
+			displayName = *data.DisplayName
+		}
+		for _, existing := range channelHooks {
+			if existing.UserId == user.Id && existing.DisplayName == displayName {
+				hook = existing
+				break
+			}
+		}
+		if hook == nil {
+			hook = &model.IncomingWebhook{}
+		}
+	}
+	alreadyExists := hook.CreateAt != 0
+
+	hook.TeamId = team.Id
+	hook.ChannelId = channel.Id
+	hook.UserId = user.Id
+	if data.DisplayName != nil {
+		hook.DisplayName = *data.DisplayName
+	}
+	if data.Description != nil {
+		hook.Description = *data.Description
+	}
+	if data.Username != nil {
+		hook.Username = *data.Username
+	}
+	if data.IconURL != nil {
+		hook.IconURL = *data.IconURL
+	}
+	if data.ChannelLocked != nil {
+		hook.ChannelLocked = *data.ChannelLocked
+	}
+	if data.RateLimitPerMinute != nil {
+		hook.RateLimitPerMinute = *data.RateLimitPerMinute
+	}
+	if data.RateLimitBurst != nil {
+		hook.RateLimitBurst = *data.RateLimitBurst
+	}
+	if data.PayloadTemplate != nil {
+		hook.PayloadTemplate = *data.PayloadTemplate
+	}
+
+	if alreadyExists {
+		if _, err := a.Srv().Store().Webhook().UpdateIncoming(hook); err != nil {
+			return model.NewAppError("BulkImport", "app.webhooks.update_incoming.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		return nil
+	}
+
+	if _, err := a.Srv().Store().Webhook().ImportIncoming(hook); err != nil {
+		var appErr *model.AppError
+		if errors.As(err, &appErr) {
+			return appErr
+		}
+		return model.NewAppError("BulkImport", "app.webhooks.save_incoming.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+func (a *App) importOutgoingWebhook(rctx request.CTX, data *imports.OutgoingWebhookImportData, dryRun bool) *model.AppError {
+	var fields []mlog.Field
+	if data != nil && data.Team != nil {
+		fields = append(fields, mlog.String("team_name", *data.Team))
+	}
+	rctx.Logger().Info("Validating outgoing webhook", fields...)
+
+	if err := imports.ValidateOutgoingWebhookImportData(data); err != nil {
+		return err
+	}
+
+	// If this is a Dry Run, do not continue any further.
+	if dryRun {
+		return nil
+	}
+
+	rctx.Logger().Info("Importing outgoing webhook", fields...)
+
+	team, appErr := a.getTeamForIntegrationImport(*data.Team)
+	if appErr != nil {
+		return appErr
+	}
+
+	var channelID string
+	if data.Channel != nil && *data.Channel != "" {
+		channel, err := a.Srv().Store().Channel().GetByName(team.Id, strings.ToLower(*data.Channel), true)
+		if err != nil {
+			return model.NewAppError("BulkImport", "app.import.import_integration.channel_not_found.error", map[string]any{"ChannelName": *data.Channel}, "", http.StatusBadRequest).Wrap(err)
+		}
+		channelID = channel.Id
+	}
+
+	creator, appErr := a.getUserForIntegrationImport(*data.Creator)
+	if appErr != nil {
+		return appErr
+	}
+
+	callbackURLs := model.StringArray(*data.CallbackURLs)
+
+	teamHooks, err := a.Srv().Store().Webhook().GetOutgoingByTeam(team.Id, -1, -1)
+	if err != nil {
+		return model.NewAppError("BulkImport", "app.webhooks.get_outgoing_by_team.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	// Outgoing webhooks have no name to match on, so a hook is considered to be already imported
+	// when it has the same token or, without a token, the same channel and callback URLs.
+	var hook *model.OutgoingWebhook
+	for _, existing := range teamHooks {
+		if data.Token != nil && *data.Token != "" {
+			if existing.Token == *data.Token {
+				hook = existing
+				break
+			}
+		} else if existing.ChannelId == channelID && existing.CallbackURLs.Equals(callbackURLs) {
+			hook = existing
+			break
+		}
+	}
+	alreadyExists := hook != nil
+	if !alreadyExists {
+		hook = &model.OutgoingWebhook{}
+	}
+
+	hook.TeamId = team.Id
+	hook.ChannelId = channelID
+	hook.CreatorId = creator.Id
+	hook.CallbackURLs = callbackURLs
+	if data.TriggerWords != nil {
+		hook.TriggerWords = *data.TriggerWords
+	}
+	if data.TriggerWhen != nil {
+		hook.TriggerWhen = *data.TriggerWhen
+	}
+	if data.DisplayName != nil {
+		hook.DisplayName = *data.DisplayName
+	}
+	if data.Description != nil {
+		hook.Description = *data.Description
+	}
+	if data.ContentType != nil {
+		hook.ContentType = *data.ContentType
+	}
+	if data.Username != nil {
+		hook.Username = *data.Username
+	}
+	if data.IconURL != nil {
+		hook.IconURL = *data.IconURL
+	}
+	if data.Token != nil {
+		hook.Token = *data.Token
+	}
+	if data.Secret != nil {
+		hook.Secret = *data.Secret
+	}
+
+	if alreadyExists {
+		if _, err := a.Srv().Store().Webhook().UpdateOutgoing(hook); err != nil {
+			return model.NewAppError("BulkImport", "app.webhooks.update_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		return nil
+	}
+
+	if _, err := a.Srv().Store().Webhook().SaveOutgoing(hook); err != nil {
+		var appErr *model.AppError
+		if errors.As(err, &appErr) {
+			return appErr
+		}
+		return model.NewAppError("BulkImport", "app.webhooks.save_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+func (a *App) importCommand(rctx request.CTX, data *imports.CommandImportData, dryRun bool) *model.AppError {
+	var fields []mlog.Field
+	if data != nil && data.Team != nil && data.Trigger != nil {
+		fields = append(fields, mlog.String("team_name", *data.Team), mlog.String("trigger", *data.Trigger))
+	}
+	rctx.Logger().Info("Validating command", fields...)
+
+	if err := imports.ValidateCommandImportData(data); err != nil {
+		return err
+	}
+
+	// If this is a Dry Run, do not continue any further.
+	if dryRun {
+		return nil
+	}
+
+	rctx.Logger().Info("Importing command", fields...)
+
+	team, appErr := a.getTeamForIntegrationImport(*data.Team)
+	if appErr != nil {
+		return appErr
+	}
+
+	creator, appErr := a.getUserForIntegrationImport(*data.Creator)
+	if appErr != nil {
+		return appErr
+	}
+
+	trigger := strings.ToLower(*data.Trigger)
+
+	command, err := a.Srv().Store().Command().GetByTrigger(team.Id, trigger)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return model.NewAppError("BulkImport", "app.command.getcommand.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		command = &model.Command{}
+	}
+	alreadyExists := command.Id != ""
+
+	command.TeamId = team.Id
+	command.CreatorId = creator.Id
+	command.Trigger = trigger
+	command.Method = *data.Method
+	command.URL = *data.URL
+	if data.Username != nil {
+		command.Username = *data.Username
+	}
+	if data.IconURL != nil {
+		command.IconURL = *data.IconURL
+	}
+	if data.AutoComplete != nil {
+		command.AutoComplete = *data.AutoComplete
+	}
+	if data.AutoCompleteDesc != nil {
+		command.AutoCompleteDesc = *data.AutoCompleteDesc
+	}
+	if data.AutoCompleteHint != nil {
+		command.AutoCompleteHint = *data.AutoCompleteHint
+	}
+	if data.DisplayName != nil {
+		command.DisplayName = *data.DisplayName
+	}
+	if data.Description != nil {
+		command.Description = *data.Description
+	}
+	if data.Token != nil {
+		command.Token = *data.Token
+	}
+
+	if alreadyExists {
+		if _, err := a.Srv().Store().Command().Update(command); err != nil {
+			return model.NewAppError("BulkImport", "app.command.updatecommand.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		return nil
+	}
+
+	if _, err := a.Srv().Store().Command().Save(command); err != nil {
+		var appErr *model.AppError
+		if errors.As(err, &appErr) {
+			return appErr
+		}
+		return model.NewAppError("BulkImport", "app.command.createcommand.internal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+func (a *App) importOAuthApp(rctx request.CTX, data *imports.OAuthAppImportData, dryRun bool) *model.AppError {
+	var fields []mlog.Field
+	if data != nil && data.Name != nil {
+		fields = append(fields, mlog.String("oauth_app_name", *data.Name))
+	}
+	rctx.Logger().Info("Validating OAuth app", fields...)
+
+	if err := imports.ValidateOAuthAppImportData(data); err != nil {
+		return err
+	}
+
+	// If this is a Dry Run, do not continue any further.
+	if dryRun {
+		return nil
+	}
+
+	rctx.Logger().Info("Importing OAuth app", fields...)
+
+	creator, appErr := a.getUserForIntegrationImport(*data.Creator)
+	if appErr != nil {
+		return appErr
+	}
+
+	var app *model.OAuthApp
+	if data.ClientId != nil {
+		existing, err := a.Srv().Store().OAuth().GetApp(*data.ClientId)
+		if err != nil {
+			var nfErr *store.ErrNotFound
+			if !errors.As(err, &nfErr) {
+				return model.NewAppError("BulkImport", "app.oauth.get_app.find.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		} else {
+			app = existing
+		}
+	} else {
+		// Without a client id, an app of the same creator and name is considered to be already imported.
+		apps, err := a.Srv().Store().OAuth().GetAppByUser(creator.Id, 0, 10000)
+		if err != nil {
+			return model.NewAppError("BulkImport", "app.oauth.get_app_by_user.find.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		for _, existing := range apps {
+			if existing.Name == *data.Name {
+				app = existing
+				break
+			}
+		}
+	}
+	alreadyExists := app != nil
+	if !alreadyExists {
+		app = &model.OAuthApp{}
+		if data.ClientId != nil {
+			app.Id = *data.ClientId
+		}
+	}
+
+	app.CreatorId = creator.Id
+	app.Name = *data.Name
+	app.Homepage = *data.Homepage
+	app.CallbackUrls = *data.CallbackURLs
+	if data.Description != nil {
+		app.Description = *data.Description
+	}
+	if data.IconURL != nil {
+		app.IconURL = *data.IconURL
+	}
+	if data.IsTrusted != nil {
+		app.IsTrusted = *data.IsTrusted
+	}
+	if data.ClientSecret != nil {
+		app.ClientSecret = *data.ClientSecret
+	}
+
+	if alreadyExists {
+		if _, err := a.Srv().Store().OAuth().UpdateApp(app); err != nil {
+			var appErr *model.AppError
+			if errors.As(err, &appErr) {
+				return appErr
+			}
+			return model.NewAppError("BulkImport", "app.oauth.update_app.updating.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		return nil
+	}
+
+	if _, err := a.Srv().Store().OAuth().ImportApp(app); err != nil {
+		var appErr *model.AppError
+		if errors.As(err, &appErr) {
+			return appErr
+		}
+		return model.NewAppError("BulkImport", "app.oauth.save_app.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
@@ -4993,6 +4993,105 @@ func TestImportImportEmoji(t *testing.T) {
 	require.ErrorIs(t, appErr.Unwrap(), utils.ErrSizeLimitExceeded)
 }
 
+func TestImportImportIncomingWebhook(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	data := imports.IncomingWebhookImportData{
+		Team:    model.NewPointer(th.BasicTeam.Name),
+		Channel: model.NewPointer(th.BasicChannel.Name),
+	}
+	appErr := th.App.importIncomingWebhook(th.Context, &data, true)
+	require.NotNil(t, appErr, "Invalid incoming webhook should have failed dry run")
+
+	data.User = model.NewPointer(th.BasicUser.Username)
+	data.Id = model.NewPointer(model.NewId())
+	data.DisplayName = model.NewPointer("imported")
+	appErr = th.App.importIncomingWebhook(th.Context, &data, true)
+	require.Nil(t, appErr, "Valid incoming webhook should have passed dry run")
+
+	_, err := th.App.Srv().Store().Webhook().GetIncoming(*data.Id, false)
+	require.Error(t, err, "Incoming webhook should not have been imported")
+
+	appErr = th.App.importIncomingWebhook(th.Context, &data, false)
+	require.Nil(t, appErr)
+
+	hook, err := th.App.Srv().Store().Webhook().GetIncoming(*data.Id, false)
+	require.NoError(t, err)
+	assert.Equal(t, th.BasicChannel.Id, hook.ChannelId)
+	assert.Equal(t, th.BasicUser.Id, hook.UserId)
+	assert.Equal(t, "imported", hook.DisplayName)
+
+	data.DisplayName = model.NewPointer("updated")
+	appErr = th.App.importIncomingWebhook(th.Context, &data, false)
+	require.Nil(t, appErr, "Second run should have succeeded")
+
+	hook, err = th.App.Srv().Store().Webhook().GetIncoming(*data.Id, false)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", hook.DisplayName)
+
+	t.Run("without an id", func(t *testing.T) {
+		data := imports.IncomingWebhookImportData{
+			Team:        model.NewPointer(th.BasicTeam.Name),
+			Channel:     model.NewPointer(th.BasicChannel.Name),
+			User:        model.NewPointer(th.BasicUser.Username),
+			DisplayName: model.NewPointer("no id"),
+			Description: model.NewPointer("first"),
+		}
+		appErr := th.App.importIncomingWebhook(th.Context, &data, false)
+		require.Nil(t, appErr)
+
+		data.Description = model.NewPointer("second")
+		appErr = th.App.importIncomingWebhook(th.Context, &data, false)
+		require.Nil(t, appErr, "Second run should have succeeded")
+
+		hooks, err := th.App.Srv().Store().Webhook().GetIncomingByChannel(th.BasicChannel.Id)
+		require.NoError(t, err)
+		var matching []*model.IncomingWebhook
+		for _, hook := range hooks {
+			if hook.DisplayName == "no id" {
+				matching = append(matching, hook)
+			}
+		}
+		require.Len(t, matching, 1, "The second run should have updated the hook")
+		assert.Equal(t, "second", matching[0].Description)
+	})
+
+	data.Channel = model.NewPointer("missing-channel")
+	appErr = th.App.importIncomingWebhook(th.Context, &data, false)
+	require.NotNil(t, appErr, "Import should have failed due to a missing channel")
+}
+
+func TestImportImportCommand(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	data := imports.CommandImportData{
+		Team:    model.NewPointer(th.BasicTeam.Name),
+		Creator: model.NewPointer(th.BasicUser.Username),
+		Trigger: model.NewPointer("Imported"),
+		Method:  model.NewPointer(model.CommandMethodPost),
+		URL:     model.NewPointer("http://example.com/command"),
+	}
+	appErr := th.App.importCommand(th.Context, &data, false)
+	require.Nil(t, appErr)
+
+	command, err := th.App.Srv().Store().Command().GetByTrigger(th.BasicTeam.Id, "imported")
+	require.NoError(t, err)
+	assert.NotEmpty(t, command.Token, "A token should have been generated")
+
+	data.Token = model.NewPointer(model.NewId())
+	data.URL = model.NewPointer("http://example.com/updated")
+	appErr = th.App.importCommand(th.Context, &data, false)
+	require.Nil(t, appErr, "Second run should have succeeded")
+
+	updated, err := th.App.Srv().Store().Command().GetByTrigger(th.BasicTeam.Id, "imported")
+	require.NoError(t, err)
+	assert.Equal(t, command.Id, updated.Id)
+	assert.Equal(t, *data.Token, updated.Token)
+	assert.Equal(t, "http://example.com/updated", updated.URL)
+}
+
 func TestImportAttachment(t *testing.T) {
 	th := Setup(t)
 	defer th.TearDown()
@@ -0,0 +1,320 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package imports
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// ArchiveImportFilename is the name of the JSONL file in the archives written by ArchiveWriter.
+const ArchiveImportFilename = "import.jsonl"
+
+// ArchiveWriter writes a bulk import archive that can be validated with mmctl and processed by the
+// import job: the JSONL file with the import lines, followed by the attached files in the data
+// directory. All the lines must be written before the first attachment.
+type ArchiveWriter struct {
+	zw          *zip.Writer
+	lines       io.Writer
+	attachments map[string]bool
+}
+
+func NewArchiveWriter(w io.Writer) (*ArchiveWriter, error) {
+	zw := zip.NewWriter(w)
+	lines, err := zw.Create(ArchiveImportFilename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", ArchiveImportFilename, err)
+	}
+
+	return &ArchiveWriter{
+		zw:          zw,
+		lines:       lines,
+		attachments: make(map[string]bool),
+	}, nil
+}
+
+// WriteLine adds a line to the JSONL file of the archive.
+func (aw *ArchiveWriter) WriteLine(line *LineImportData) error {
+	if aw.lines == nil {
+		return errors.New("cannot write import lines after the attachments")
+	}
+
+	b, err := json.Marshal(line)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s line: %w", line.Type, err)
+	}
+	if _, err := aw.lines.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("failed to write %s line: %w", line.Type, err)
+	}
+	return nil
+}
+
+// WriteAttachment adds a file to the data directory of the archive. The path is relative to the
+// data directory, as referenced by AttachmentImportData.Path. Paths already written are skipped.
+func (aw *ArchiveWriter) WriteAttachment(filePath string, r io.Reader) error {
+	filePath = path.Clean(filePath)
+	if path.IsAbs(filePath) || strings.HasPrefix(filePath, "..") {
+		return fmt.Errorf("invalid attachment path %q", filePath)
+	}
+
+	aw.lines = nil
+	if aw.attachments[filePath] {
+		return nil
+	}
+
+	w, err := aw.zw.Create(path.Join(model.ExportDataDir, filePath))
+	if err != nil {
+		return fmt.Errorf("failed to create attachment %s: %w", filePath, err)
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("failed to write attachment %s: %w", filePath, err)
+	}
+	aw.attachments[filePath] = true
+	return nil
+}
+
+// Close finishes the archive. It doesn't close the underlying writer.
+func (aw *ArchiveWriter) Close() error {
+	return aw.zw.Close()
+}
+
+// ConvertedExport holds the import data converted from the export of another product, grouped in
+// the order the bulk import expects the lines, along with the entities that couldn't be mapped.
+type ConvertedExport struct {
+	Teams          []*TeamImportData
+	Channels       []*ChannelImportData
+	Users          []*UserImportData
+	Posts          []*PostImportData
+	DirectChannels []*DirectChannelImportData
+	DirectPosts    []*DirectPostImportData
+
+	Unmapped []UnmappedEntity
+}
+
+// UnmappedEntity is an entity of a third party export that was left out of a conversion.
+type UnmappedEntity struct {
+	Kind   string `json:"kind"`
+	Id     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+// ConversionReport summarizes a ConvertedExport.
+type ConversionReport struct {
+	Teams          int `json:"teams"`
+	Channels       int `json:"channels"`
+	Users          int `json:"users"`
+	Posts          int `json:"posts"`
+	Replies        int `json:"replies"`
+	DirectChannels int `json:"direct_channels"`
+	DirectPosts    int `json:"direct_posts"`
+	Reactions      int `json:"reactions"`
+	Attachments    int `json:"attachments"`
+
+	Unmapped []UnmappedEntity `json:"unmapped"`
+}
+
+// AddUnmapped records an entity that was left out of the conversion.
+func (e *ConvertedExport) AddUnmapped(kind, id, reason string) {
+	e.Unmapped = append(e.Unmapped, UnmappedEntity{Kind: kind, Id: id, Reason: reason})
+}
+
+// Report counts the converted entities.
+func (e *ConvertedExport) Report() *ConversionReport {
+	report := &ConversionReport{
+		Teams:          len(e.Teams),
+		Channels:       len(e.Channels),
+		Users:          len(e.Users),
+		Posts:          len(e.Posts),
+		DirectChannels: len(e.DirectChannels),
+		DirectPosts:    len(e.DirectPosts),
+		Unmapped:       append([]UnmappedEntity{}, e.Unmapped...),
+	}
+
+	count := func(reactions *[]ReactionImportData, attachments *[]AttachmentImportData) {
+		if reactions != nil {
+			report.Reactions += len(*reactions)
+		}
+		if attachments != nil {
+			report.Attachments += len(*attachments)
+		}
+	}
+	countReplies := func(replies *[]ReplyImportData) {
+		if replies == nil {
+			return
+		}
+		report.Replies += len(*replies)
+		for _, reply := range *replies {
+			count(reply.Reactions, reply.Attachments)
+		}
+	}
+	for _, post := range e.Posts {
+		count(post.Reactions, post.Attachments)
+		countReplies(post.Replies)
+	}
+	for _, post := range e.DirectPosts {
+		count(post.Reactions, post.Attachments)
+		countReplies(post.Replies)
+	}
+	return report
+}
+
+// WriteArchive writes the converted data as a bulk import archive. The content of the attachments
+// is read from their Data field.
+func (e *ConvertedExport) WriteArchive(w io.Writer) error {
+	aw, err := NewArchiveWriter(w)
+	if err != nil {
+		return err
+	}
+
+	version := 1
+	lines := []*LineImportData{{Type: "version", Version: &version}}
+	for _, team := range e.Teams {
+		lines = append(lines, &LineImportData{Type: "team", Team: team})
+	}
+	for _, channel := range e.Channels {
+		lines = append(lines, &LineImportData{Type: "channel", Channel: channel})
+	}
+	for _, user := range e.Users {
+		lines = append(lines, &LineImportData{Type: "user", User: user})
+	}
+	for _, post := range e.Posts {
+		lines = append(lines, &LineImportData{Type: "post", Post: post})
+	}
+	for _, channel := range e.DirectChannels {
+		lines = append(lines, &LineImportData{Type: "direct_channel", DirectChannel: channel})
+	}
+	for _, post := range e.DirectPosts {
+		lines = append(lines, &LineImportData{Type: "direct_post", DirectPost: post})
+	}
+	for _, line := range lines {
+		if err := aw.WriteLine(line); err != nil {
+			return err
+		}
+	}
+
+	writeAttachments := func(attachments *[]AttachmentImportData) error {
+		if attachments == nil {
+			return nil
+		}
+		for _, attachment := range *attachments {
+			if attachment.Path == nil || attachment.Data == nil {
+				continue
+			}
+			if err := writeZipFileAttachment(aw, *attachment.Path, attachment.Data); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	writeAllAttachments := func(attachments *[]AttachmentImportData, replies *[]ReplyImportData) error {
+		if err := writeAttachments(attachments); err != nil {
+			return err
+		}
+		if replies == nil {
+			return nil
+		}
+		for _, reply := range *replies {
+			if err := writeAttachments(reply.Attachments); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, post := range e.Posts {
+		if err := writeAllAttachments(post.Attachments, post.Replies); err != nil {
+			return err
+		}
+	}
+	for _, post := range e.DirectPosts {
+		if err := writeAllAttachments(post.Attachments, post.Replies); err != nil {
+			return err
+		}
+	}
+
+	return aw.Close()
+}
+
+func writeZipFileAttachment(aw *ArchiveWriter, filePath string, file *zip.File) error {
+	r, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open attachment %s: %w", file.Name, err)
+	}
+	defer r.Close()
+
+	return aw.WriteAttachment(filePath, r)
+}
+
+// CleanChannelName turns a display name from another product into a valid channel name, or
+// returns an empty string when nothing usable is left.
+func CleanChannelName(displayName string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(strings.TrimSpace(displayName)) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '_':
+			b.WriteRune(c)
+		default:
+			b.WriteRune('-')
+		}
+	}
+
+	name := strings.Trim(b.String(), "-_")
+	for strings.Contains(name, "--") {
+		name = strings.ReplaceAll(name, "--", "-")
+	}
+	if len(name) > model.ChannelNameMaxLength {
+		name = strings.Trim(name[:model.ChannelNameMaxLength], "-_")
+	}
+	if len(name) < 2 || !model.IsValidChannelIdentifier(name) {
+		return ""
+	}
+	return name
+}
+
+// CleanUsername turns a username or an email address from another product into a valid
+// username, or returns an empty string when nothing usable is left.
+func CleanUsername(username string) string {
+	username, _, _ = strings.Cut(username, "@")
+
+	var b strings.Builder
+	for _, c := range strings.ToLower(strings.TrimSpace(username)) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '.', c == '-', c == '_':
+			b.WriteRune(c)
+		default:
+			b.WriteRune('-')
+		}
+	}
+
+	name := strings.Trim(b.String(), "-")
+	if len(name) > model.UserNameMaxLength {
+		name = strings.Trim(name[:model.UserNameMaxLength], "-")
+	}
+	if !model.IsValidUsername(name) {
+		return ""
+	}
+	return name
+}
+
+// UniqueName returns name, or name followed by a numeric suffix when it is already used, while
+// keeping within maxLength. The returned name is added to used.
+func UniqueName(used map[string]bool, name string, maxLength int) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		suffix := fmt.Sprintf("-%d", i)
+		base := name
+		if len(base)+len(suffix) > maxLength {
+			base = base[:maxLength-len(suffix)]
+		}
+		candidate = base + suffix
+	}
+	used[candidate] = true
+	return candidate
+}
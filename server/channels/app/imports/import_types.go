@@ -13,19 +13,23 @@ import (
 // Import Data Models
 
 type LineImportData struct {
-	Type          string                   `json:"type"`
-	Role          *RoleImportData          `json:"role,omitempty"`
-	Scheme        *SchemeImportData        `json:"scheme,omitempty"`
-	Team          *TeamImportData          `json:"team,omitempty"`
-	Channel       *ChannelImportData       `json:"channel,omitempty"`
-	User          *UserImportData          `json:"user,omitempty"`
-	Bot           *BotImportData           `json:"bot,omitempty"`
-	Post          *PostImportData          `json:"post,omitempty"`
-	DirectChannel *DirectChannelImportData `json:"direct_channel,omitempty"`
-	DirectPost    *DirectPostImportData    `json:"direct_post,omitempty"`
-	Emoji         *EmojiImportData         `json:"emoji,omitempty"`
-	Version       *int                     `json:"version,omitempty"`
-	Info          *VersionInfoImportData   `json:"info,omitempty"`
+	Type            string                     `json:"type"`
+	Role            *RoleImportData            `json:"role,omitempty"`
+	Scheme          *SchemeImportData          `json:"scheme,omitempty"`
+	Team            *TeamImportData            `json:"team,omitempty"`
+	Channel         *ChannelImportData         `json:"channel,omitempty"`
+	User            *UserImportData            `json:"user,omitempty"`
+	Bot             *BotImportData             `json:"bot,omitempty"`
+	Post            *PostImportData            `json:"post,omitempty"`
+	DirectChannel   *DirectChannelImportData   `json:"direct_channel,omitempty"`
+	DirectPost      *DirectPostImportData      `json:"direct_post,omitempty"`
+	Emoji           *EmojiImportData           `json:"emoji,omitempty"`
+	IncomingWebhook *IncomingWebhookImportData `json:"incoming_webhook,omitempty"`
+	OutgoingWebhook *OutgoingWebhookImportData `json:"outgoing_webhook,omitempty"`
+	Command         *CommandImportData         `json:"command,omitempty"`
+	OAuthApp        *OAuthAppImportData        `json:"oauth_app,omitempty"`
+	Version         *int                       `json:"version,omitempty"`
+	Info            *VersionInfoImportData     `json:"info,omitempty"`
 }
 
 type VersionInfoImportData struct {
@@ -174,6 +178,72 @@ type EmojiImportData struct {
 	Data  *zip.File `json:"-"`
 }
 
+// IncomingWebhookImportData is an incoming webhook. The id is the secret part of the webhook
+// URL; when missing, a new one is generated.
+type IncomingWebhookImportData struct {
+	Id                 *string `json:"id,omitempty"`
+	Team               *string `json:"team"`
+	Channel            *string `json:"channel"`
+	User               *string `json:"user"`
+	DisplayName        *string `json:"display_name,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	Username           *string `json:"username,omitempty"`
+	IconURL            *string `json:"icon_url,omitempty"`
+	ChannelLocked      *bool   `json:"channel_locked,omitempty"`
+	RateLimitPerMinute *int    `json:"rate_limit_per_minute,omitempty"`
+	RateLimitBurst     *int    `json:"rate_limit_burst,omitempty"`
+	PayloadTemplate    *string `json:"payload_template,omitempty"`
+}
+
+// OutgoingWebhookImportData is an outgoing webhook. The token and the secret are generated
+// when missing.
+type OutgoingWebhookImportData struct {
+	Team         *string   `json:"team"`
+	Channel      *string   `json:"channel,omitempty"`
+	Creator      *string   `json:"creator"`
+	TriggerWords *[]string `json:"trigger_words,omitempty"`
+	TriggerWhen  *int      `json:"trigger_when,omitempty"`
+	CallbackURLs *[]string `json:"callback_urls"`
+	DisplayName  *string   `json:"display_name,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	ContentType  *string   `json:"content_type,omitempty"`
+	Username     *string   `json:"username,omitempty"`
+	IconURL      *string   `json:"icon_url,omitempty"`
+	Token        *string   `json:"token,omitempty"`
+	Secret       *string   `json:"secret,omitempty"`
+}
+
+// CommandImportData is a custom slash command. The token is generated when missing.
+type CommandImportData struct {
+	Team             *string `json:"team"`
+	Creator          *string `json:"creator"`
+	Trigger          *string `json:"trigger"`
+	Method           *string `json:"method"`
+	URL              *string `json:"url"`
+	Username         *string `json:"username,omitempty"`
+	IconURL          *string `json:"icon_url,omitempty"`
+	AutoComplete     *bool   `json:"auto_complete,omitempty"`
+	AutoCompleteDesc *string `json:"auto_complete_desc,omitempty"`
+	AutoCompleteHint *string `json:"auto_complete_hint,omitempty"`
+	DisplayName      *string `json:"display_name,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	Token            *string `json:"token,omitempty"`
+}
+
+// OAuthAppImportData is an OAuth 2.0 application. The client id and secret are generated
+// when missing.
+type OAuthAppImportData struct {
+	Creator      *string   `json:"creator"`
+	Name         *string   `json:"name"`
+	Description  *string   `json:"description,omitempty"`
+	IconURL      *string   `json:"icon_url,omitempty"`
+	CallbackURLs *[]string `json:"callback_urls"`
+	Homepage     *string   `json:"homepage"`
+	IsTrusted    *bool     `json:"is_trusted,omitempty"`
+	ClientId     *string   `json:"client_id,omitempty"`
+	ClientSecret *string   `json:"client_secret,omitempty"`
+}
+
 type ReactionImportData struct {
 	User      *string `json:"user"`
 	CreateAt  *int64  `json:"create_at"`
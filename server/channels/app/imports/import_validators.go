@@ -653,6 +653,110 @@ func ValidateEmojiImportData(data *EmojiImportData) *model.AppError {
 	return nil
 }
 
+func ValidateIncomingWebhookImportData(data *IncomingWebhookImportData) *model.AppError {
+	if data == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_incoming_webhook_import_data.empty.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Id != nil && !model.IsValidId(*data.Id) {
+		return model.NewAppError("BulkImport", "app.import.validate_incoming_webhook_import_data.id_invalid.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Team == nil || *data.Team == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_integration_import_data.team_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Channel == nil || *data.Channel == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_incoming_webhook_import_data.channel_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.User == nil || *data.User == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_integration_import_data.creator_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func ValidateOutgoingWebhookImportData(data *OutgoingWebhookImportData) *model.AppError {
+	if data == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_outgoing_webhook_import_data.empty.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Team == nil || *data.Team == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_integration_import_data.team_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Creator == nil || *data.Creator == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_integration_import_data.creator_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if (data.Channel == nil || *data.Channel == "") && (data.TriggerWords == nil || len(*data.TriggerWords) == 0) {
+		return model.NewAppError("BulkImport", "app.import.validate_outgoing_webhook_import_data.triggers_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.CallbackURLs == nil || len(*data.CallbackURLs) == 0 {
+		return model.NewAppError("BulkImport", "app.import.validate_integration_import_data.callback_urls_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func ValidateCommandImportData(data *CommandImportData) *model.AppError {
+	if data == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_command_import_data.empty.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Team == nil || *data.Team == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_integration_import_data.team_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Creator == nil || *data.Creator == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_integration_import_data.creator_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Trigger == nil || *data.Trigger == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_command_import_data.trigger_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Method == nil || (*data.Method != model.CommandMethodGet && *data.Method != model.CommandMethodPost) {
+		return model.NewAppError("BulkImport", "app.import.validate_command_import_data.method_invalid.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.URL == nil || *data.URL == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_command_import_data.url_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func ValidateOAuthAppImportData(data *OAuthAppImportData) *model.AppError {
+	if data == nil {
+		return model.NewAppError("BulkImport", "app.import.validate_oauth_app_import_data.empty.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.ClientId != nil && !model.IsValidId(*data.ClientId) {
+		return model.NewAppError("BulkImport", "app.import.validate_oauth_app_import_data.client_id_invalid.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Creator == nil || *data.Creator == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_integration_import_data.creator_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Name == nil || *data.Name == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_oauth_app_import_data.name_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.Homepage == nil || *data.Homepage == "" {
+		return model.NewAppError("BulkImport", "app.import.validate_oauth_app_import_data.homepage_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	if data.CallbackURLs == nil || len(*data.CallbackURLs) == 0 {
+		return model.NewAppError("BulkImport", "app.import.validate_integration_import_data.callback_urls_missing.error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
 func isValidTrueOrFalseString(value string) bool {
 	return value == "true" || value == "false"
 }
@@ -678,5 +782,6 @@ func isValidCommentsNotifyLevel(notifyLevel string) bool {
 func isValidEmailBatchingInterval(emailInterval string) bool {
 	return emailInterval == model.PreferenceEmailIntervalImmediately ||
 		emailInterval == model.PreferenceEmailIntervalFifteen ||
-		emailInterval == model.PreferenceEmailIntervalHour
+		emailInterval == model.PreferenceEmailIntervalHour ||
+		emailInterval == model.PreferenceEmailIntervalDaily
 }
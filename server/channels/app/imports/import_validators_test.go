@@ -629,6 +629,9 @@ func TestImportValidateUserImportData(t *testing.T) {
 	data.EmailInterval = model.NewPointer("hour")
 	checkNoError(t, ValidateUserImportData(&data))
 
+	data.EmailInterval = model.NewPointer("daily")
+	checkNoError(t, ValidateUserImportData(&data))
+
 	//Invalid values
 	data.EmailInterval = model.NewPointer("invalid")
 	checkError(t, ValidateUserImportData(&data))
@@ -1462,6 +1465,116 @@ func TestImportValidateEmojiImportData(t *testing.T) {
 	}
 }
 
+func TestImportValidateIncomingWebhookImportData(t *testing.T) {
+	data := IncomingWebhookImportData{
+		Team:    model.NewPointer("teamname"),
+		Channel: model.NewPointer("channelname"),
+		User:    model.NewPointer("username"),
+	}
+	err := ValidateIncomingWebhookImportData(&data)
+	require.Nil(t, err, "Validation failed but should have been valid.")
+
+	data.Id = model.NewPointer(model.NewId())
+	err = ValidateIncomingWebhookImportData(&data)
+	require.Nil(t, err, "Validation failed but should have been valid.")
+
+	data.Id = model.NewPointer("not an id")
+	err = ValidateIncomingWebhookImportData(&data)
+	require.NotNil(t, err, "Should have failed due to invalid id.")
+	data.Id = nil
+
+	data.Channel = nil
+	err = ValidateIncomingWebhookImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing channel.")
+	data.Channel = model.NewPointer("channelname")
+
+	data.User = model.NewPointer("")
+	err = ValidateIncomingWebhookImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing user.")
+
+	err = ValidateIncomingWebhookImportData(nil)
+	require.NotNil(t, err, "Should have failed due to nil data.")
+}
+
+func TestImportValidateOutgoingWebhookImportData(t *testing.T) {
+	data := OutgoingWebhookImportData{
+		Team:         model.NewPointer("teamname"),
+		Creator:      model.NewPointer("username"),
+		TriggerWords: &[]string{"trigger"},
+		CallbackURLs: &[]string{"https://example.com/callback"},
+	}
+	err := ValidateOutgoingWebhookImportData(&data)
+	require.Nil(t, err, "Validation failed but should have been valid.")
+
+	data.TriggerWords = nil
+	err = ValidateOutgoingWebhookImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing channel and trigger words.")
+
+	data.Channel = model.NewPointer("channelname")
+	err = ValidateOutgoingWebhookImportData(&data)
+	require.Nil(t, err, "Validation failed but should have been valid.")
+
+	data.CallbackURLs = &[]string{}
+	err = ValidateOutgoingWebhookImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing callback urls.")
+	data.CallbackURLs = &[]string{"https://example.com/callback"}
+
+	data.Team = nil
+	err = ValidateOutgoingWebhookImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing team.")
+}
+
+func TestImportValidateCommandImportData(t *testing.T) {
+	data := CommandImportData{
+		Team:    model.NewPointer("teamname"),
+		Creator: model.NewPointer("username"),
+		Trigger: model.NewPointer("trigger"),
+		Method:  model.NewPointer(model.CommandMethodPost),
+		URL:     model.NewPointer("https://example.com/command"),
+	}
+	err := ValidateCommandImportData(&data)
+	require.Nil(t, err, "Validation failed but should have been valid.")
+
+	data.Method = model.NewPointer("X")
+	err = ValidateCommandImportData(&data)
+	require.NotNil(t, err, "Should have failed due to invalid method.")
+	data.Method = model.NewPointer(model.CommandMethodGet)
+
+	data.Trigger = model.NewPointer("")
+	err = ValidateCommandImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing trigger.")
+	data.Trigger = model.NewPointer("trigger")
+
+	data.URL = nil
+	err = ValidateCommandImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing url.")
+}
+
+func TestImportValidateOAuthAppImportData(t *testing.T) {
+	data := OAuthAppImportData{
+		Creator:      model.NewPointer("username"),
+		Name:         model.NewPointer("app"),
+		Homepage:     model.NewPointer("https://example.com"),
+		CallbackURLs: &[]string{"https://example.com/callback"},
+	}
+	err := ValidateOAuthAppImportData(&data)
+	require.Nil(t, err, "Validation failed but should have been valid.")
+
+	data.ClientId = model.NewPointer("not an id")
+	err = ValidateOAuthAppImportData(&data)
+	require.NotNil(t, err, "Should have failed due to invalid client id.")
+	data.ClientId = model.NewPointer(model.NewId())
+
+	data.Name = nil
+	err = ValidateOAuthAppImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing name.")
+	data.Name = model.NewPointer("app")
+
+	data.Homepage = model.NewPointer("")
+	err = ValidateOAuthAppImportData(&data)
+	require.NotNil(t, err, "Should have failed due to missing homepage.")
+}
+
 func checkError(t *testing.T, err *model.AppError) {
 	require.NotNil(t, err, "Should have returned an error.")
 }
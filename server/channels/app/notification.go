@@ -22,7 +22,13 @@ import (
 	"github.com/mattermost/mattermost/server/v8/platform/services/telemetry"
 )
 
+// canSendPushNotifications returns whether push notifications can be sent through the push
+// proxy or as web push notifications.
 func (a *App) canSendPushNotifications() bool {
+	return a.canSendWebPushNotifications() || a.canSendToPushProxy()
+}
+
+func (a *App) canSendToPushProxy() bool {
 	if !*a.Config().EmailSettings.SendPushNotifications {
 		a.NotificationsLog().Debug("Push notifications are disabled - server config",
 			mlog.String("status", model.NotificationStatusNotSent),
@@ -13,6 +13,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/mattermost/mattermost/server/public/plugin"
@@ -49,6 +50,7 @@ type PushNotificationsHub struct {
 	wg                *sync.WaitGroup
 	semaWg            *sync.WaitGroup
 	buffer            int
+	digest            *pushNotificationDigest
 }
 
 type PushNotification struct {
@@ -117,19 +119,6 @@ func (a *App) sendPushNotificationToAllSessions(rctx request.CTX, msg *model.Pus
 		return nil
 	}
 
-	sessions, appErr := a.getMobileAppSessions(userID)
-	if appErr != nil {
-		a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypePush, model.NotificationReasonFetchError, model.NotificationNoPlatform)
-		a.NotificationsLog().Error("Failed to send mobile app sessions",
-			mlog.String("type", model.NotificationTypePush),
-			mlog.String("status", model.NotificationStatusError),
-			mlog.String("reason", model.NotificationReasonFetchError),
-			mlog.String("user_id", userID),
-			mlog.Err(appErr),
-		)
-		return appErr
-	}
-
 	if msg == nil {
 		a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypePush, model.NotificationReasonParseError, model.NotificationNoPlatform)
 		a.NotificationsLog().Error("Failed to parse push notification",
@@ -147,6 +136,27 @@ func (a *App) sendPushNotificationToAllSessions(rctx request.CTX, msg *model.Pus
 		)
 	}
 
+	if msg.Type == model.PushTypeMessage && a.canSendWebPushNotifications() {
+		a.sendWebPushNotifications(rctx, msg, userID, skipSessionId)
+	}
+
+	if !a.canSendToPushProxy() {
+		return nil
+	}
+
+	sessions, appErr := a.getMobileAppSessions(userID)
+	if appErr != nil {
+		a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypePush, model.NotificationReasonFetchError, model.NotificationNoPlatform)
+		a.NotificationsLog().Error("Failed to send mobile app sessions",
+			mlog.String("type", model.NotificationTypePush),
+			mlog.String("status", model.NotificationStatusError),
+			mlog.String("reason", model.NotificationReasonFetchError),
+			mlog.String("user_id", userID),
+			mlog.Err(appErr),
+		)
+		return appErr
+	}
+
 	for _, session := range sessions {
 		// Don't send notifications to this session if it's expired or we want to skip it
 		if session.IsExpired() || (skipSessionId != "" && skipSessionId == session.Id) {
@@ -238,8 +248,7 @@ func (a *App) sendPushNotification(notification *PostNotification, user *model.U
 	channelName := notification.GetChannelName(nameFormat, user.Id)
 	senderName := notification.GetSenderName(nameFormat, *cfg.ServiceSettings.EnablePostUsernameOverride)
 
-	select {
-	case a.Srv().PushNotificationsHub.notificationsChan <- PushNotification{
+	pushNotification := PushNotification{
 		notificationType:   notificationTypeMessage,
 		post:               post,
 		user:               user,
@@ -249,7 +258,15 @@ func (a *App) sendPushNotification(notification *PostNotification, user *model.U
 		explicitMention:    explicitMention,
 		channelWideMention: channelWideMention,
 		replyToThreadType:  replyToThreadType,
-	}:
+	}
+
+	// Non-urgent notifications are delivered as a digest once the user's quiet hours are over.
+	if digest := a.Srv().PushNotificationsHub.digest; digest != nil && digest.hold(pushNotification, time.Now()) {
+		return
+	}
+
+	select {
+	case a.Srv().PushNotificationsHub.notificationsChan <- pushNotification:
 	case <-a.Srv().PushNotificationsHub.stopChan:
 		return
 	}
@@ -391,15 +408,18 @@ func (a *App) UpdateMobileAppBadge(userID string) {
 
 func (s *Server) createPushNotificationsHub(c request.CTX) {
 	buffer := *s.platform.Config().EmailSettings.PushNotificationBuffer
+	app := New(ServerConnector(s.Channels()))
 	hub := PushNotificationsHub{
 		notificationsChan: make(chan PushNotification, buffer),
-		app:               New(ServerConnector(s.Channels())),
+		app:               app,
 		wg:                new(sync.WaitGroup),
 		semaWg:            new(sync.WaitGroup),
 		sema:              make(chan struct{}, runtime.NumCPU()*8), // numCPU * 8 is a good amount of concurrency.
 		stopChan:          make(chan struct{}),
 		buffer:            buffer,
+		digest:            newPushNotificationDigest(app),
 	}
+	hub.digest.start(c)
 	go hub.start(c)
 	s.PushNotificationsHub = hub
 }
@@ -461,6 +481,10 @@ func (hub *PushNotificationsHub) start(c request.CTX) {
 }
 
 func (hub *PushNotificationsHub) stop() {
+	if hub.digest != nil {
+		hub.digest.stop()
+	}
+
 	// Drain the channel.
 	for i := 0; i < hub.buffer+1; i++ {
 		hub.notificationsChan <- PushNotification{
@@ -730,7 +754,7 @@ func (a *App) BuildPushNotificationMessage(c request.CTX, contentsConfig string,
 }
 
 func (a *App) SendTestPushNotification(deviceID string) string {
-	if !a.canSendPushNotifications() {
+	if !a.canSendToPushProxy() {
 		return "false"
 	}
 
@@ -0,0 +1,190 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+)
+
+const (
+	pushNotificationDigestTaskName = "Push Notification Digest"
+	pushNotificationDigestInterval = time.Minute
+)
+
+// pushNotificationDigest holds the push notifications of users in their quiet hours, and
+// delivers them as a single notification once the quiet hours are over.
+//
+// Like email batching, held notifications are kept in memory by the server that generated
+// them and are lost if it stops before the quiet hours are over.
+type pushNotificationDigest struct {
+	app *App
+
+	pendingMutex sync.Mutex
+	pending      map[string][]PushNotification
+
+	task      *model.ScheduledTask
+	taskMutex sync.Mutex
+}
+
+func newPushNotificationDigest(app *App) *pushNotificationDigest {
+	return &pushNotificationDigest{
+		app:     app,
+		pending: make(map[string][]PushNotification),
+	}
+}
+
+func (d *pushNotificationDigest) start(c request.CTX) {
+	newTask := model.CreateRecurringTask(pushNotificationDigestTaskName, func() {
+		d.deliverDue(c, time.Now())
+	}, pushNotificationDigestInterval)
+
+	d.taskMutex.Lock()
+	oldTask := d.task
+	d.task = newTask
+	d.taskMutex.Unlock()
+
+	if oldTask != nil {
+		oldTask.Cancel()
+	}
+}
+
+func (d *pushNotificationDigest) stop() {
+	d.taskMutex.Lock()
+	if task := d.task; task != nil {
+		task.Cancel()
+	}
+	d.taskMutex.Unlock()
+}
+
+// hold queues the notification if its recipient is in their quiet hours and the post isn't
+// urgent, returning whether it did.
+func (d *pushNotificationDigest) hold(notification PushNotification, now time.Time) bool {
+	post := notification.post
+	if post.IsUrgent() {
+		return false
+	}
+	if prop := post.GetProp(model.PostPropsForceNotification); prop != nil && prop != "" {
+		return false
+	}
+	if !notification.user.IsInPushQuietHours(now) {
+		return false
+	}
+
+	d.pendingMutex.Lock()
+	d.pending[notification.user.Id] = append(d.pending[notification.user.Id], notification)
+	d.pendingMutex.Unlock()
+
+	d.app.NotificationsLog().Debug("Notification held - quiet hours",
+		mlog.String("type", model.NotificationTypePush),
+		mlog.String("post_id", post.Id),
+		mlog.String("receiver_id", notification.user.Id),
+	)
+
+	return true
+}
+
+// deliverDue sends a digest to each user whose quiet hours are over.
+func (d *pushNotificationDigest) deliverDue(c request.CTX, now time.Time) {
+	// Take the held notifications out so that fetching the users and sending the digests doesn't
+	// block the notifications being held meanwhile.
+	d.pendingMutex.Lock()
+	pending := d.pending
+	d.pending = make(map[string][]PushNotification)
+	d.pendingMutex.Unlock()
+
+	stillHeld := make(map[string][]PushNotification)
+	for userID, notifications := range pending {
+		// Fetch the user again, as their quiet hours or timezone might have changed since.
+		user, appErr := d.app.GetUser(userID)
+		if appErr != nil {
+			c.Logger().Warn("Unable to get the recipient of held push notifications", mlog.String("user_id", userID), mlog.Err(appErr))
+			continue
+		}
+
+		if user.IsInPushQuietHours(now) {
+			stillHeld[userID] = notifications
+			continue
+		}
+
+		if appErr := d.app.sendPushNotificationDigest(c, user, notifications); appErr != nil {
+			c.Logger().Error("Unable to send push notification digest", mlog.String("user_id", user.Id), mlog.Err(appErr))
+		}
+	}
+
+	if len(stillHeld) == 0 {
+		return
+	}
+
+	// Put the notifications back ahead of the ones held meanwhile, keeping them in order.
+	d.pendingMutex.Lock()
+	for userID, notifications := range stillHeld {
+		d.pending[userID] = append(notifications, d.pending[userID]...)
+	}
+	d.pendingMutex.Unlock()
+}
+
+// sendPushNotificationDigest sends the notifications held during the user's quiet hours as a
+// single notification, leaving out the ones for channels the user has read since.
+func (a *App) sendPushNotificationDigest(c request.CTX, user *model.User, notifications []PushNotification) *model.AppError {
+	lastViewedAt := make(map[string]int64)
+	unread := make([]PushNotification, 0, len(notifications))
+	for _, notification := range notifications {
+		channelID := notification.channel.Id
+		if _, ok := lastViewedAt[channelID]; !ok {
+			member, err := a.Srv().Store().Channel().GetMember(context.Background(), channelID, user.Id)
+			if err != nil {
+				// The user might have left the channel since.
+				lastViewedAt[channelID] = model.GetMillis()
+			} else {
+				lastViewedAt[channelID] = member.LastViewedAt
+			}
+		}
+
+		if lastViewedAt[channelID] < notification.post.CreateAt {
+			unread = append(unread, notification)
+		}
+	}
+
+	if len(unread) == 0 {
+		return nil
+	}
+
+	latest := unread[len(unread)-1]
+	cfg := a.Config()
+	msg, appErr := a.BuildPushNotificationMessage(
+		c,
+		*cfg.EmailSettings.PushNotificationContents,
+		latest.post,
+		user,
+		latest.channel,
+		latest.channelName,
+		latest.senderName,
+		latest.explicitMention,
+		latest.channelWideMention,
+		latest.replyToThreadType,
+	)
+	if appErr != nil {
+		return appErr
+	}
+
+	if len(unread) > 1 {
+		translateFunc := i18n.GetUserTranslations(user.Locale)
+		msg.Message = translateFunc("api.push_notification.digest.message", len(unread), map[string]any{"Count": len(unread)})
+		for _, notification := range unread {
+			if notification.channel.Id != latest.channel.Id {
+				msg.ChannelName = translateFunc("api.push_notification.digest.title")
+				break
+			}
+		}
+	}
+
+	return a.sendPushNotificationToAllSessions(c, msg, user.Id, "")
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestPushNotificationDigest(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	handler := &testPushNotificationHandler{t: t}
+	pushServer := httptest.NewServer(http.HandlerFunc(handler.handleReq))
+	defer pushServer.Close()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.SendPushNotifications = true
+		*cfg.EmailSettings.PushNotificationServer = pushServer.URL
+	})
+
+	_, appErr := th.App.CreateSession(th.Context, &model.Session{
+		UserId:    th.BasicUser.Id,
+		DeviceId:  "test",
+		ExpiresAt: model.GetMillis() + 100000,
+	})
+	require.Nil(t, appErr)
+
+	// The quiet hours span the two hours around now, in the user's timezone.
+	now := time.Now().In(th.BasicUser.GetTimezoneLocation())
+	quietHours := &model.QuietHours{
+		Start: (now.Hour()*60 + now.Minute() + 23*60) % (24 * 60),
+		End:   (now.Hour()*60 + now.Minute() + 60) % (24 * 60),
+	}
+	notifyProps := th.BasicUser.NotifyProps
+	notifyProps[model.PushQuietHoursNotifyProp] = quietHours.String()
+	user, appErr := th.App.PatchUser(th.Context, th.BasicUser.Id, &model.UserPatch{NotifyProps: notifyProps}, false)
+	require.Nil(t, appErr)
+	afterQuietHours := now.Add(3 * time.Hour)
+
+	otherChannel := th.CreateChannel(th.Context, th.BasicTeam)
+
+	newNotification := func(channel *model.Channel, message string) PushNotification {
+		return PushNotification{
+			notificationType: notificationTypeMessage,
+			userID:           user.Id,
+			channelID:        channel.Id,
+			post: &model.Post{
+				Id:        model.NewId(),
+				UserId:    th.BasicUser2.Id,
+				ChannelId: channel.Id,
+				Message:   message,
+				CreateAt:  model.GetMillis() + 1000,
+			},
+			user:        user,
+			channel:     channel,
+			senderName:  th.BasicUser2.Username,
+			channelName: channel.DisplayName,
+		}
+	}
+
+	t.Run("hold", func(t *testing.T) {
+		digest := newPushNotificationDigest(th.App)
+
+		assert.True(t, digest.hold(newNotification(th.BasicChannel, "held"), now))
+		assert.False(t, digest.hold(newNotification(th.BasicChannel, "not quiet"), afterQuietHours))
+
+		urgent := newNotification(th.BasicChannel, "urgent")
+		urgent.post.Metadata = &model.PostMetadata{Priority: &model.PostPriority{Priority: model.NewPointer(model.PostPriorityUrgent)}}
+		assert.False(t, digest.hold(urgent, now))
+
+		forced := newNotification(th.BasicChannel, "forced")
+		forced.post.AddProp(model.PostPropsForceNotification, model.NewId())
+		assert.False(t, digest.hold(forced, now))
+
+		require.Len(t, digest.pending[user.Id], 1)
+		assert.Equal(t, "held", digest.pending[user.Id][0].post.Message)
+	})
+
+	t.Run("batch and deliver", func(t *testing.T) {
+		digest := newPushNotificationDigest(th.App)
+
+		require.True(t, digest.hold(newNotification(th.BasicChannel, "first"), now))
+		require.True(t, digest.hold(newNotification(otherChannel, "second"), now))
+		require.Len(t, digest.pending[user.Id], 2)
+
+		// Nothing is delivered during the quiet hours.
+		digest.deliverDue(th.Context, now)
+		require.Len(t, digest.pending[user.Id], 2)
+		require.Equal(t, 0, handler.numReqs())
+
+		digest.deliverDue(th.Context, afterQuietHours)
+		assert.Empty(t, digest.pending)
+
+		require.Eventually(t, func() bool { return handler.numReqs() == 1 }, 5*time.Second, 10*time.Millisecond)
+		notification := handler.notifications()[0]
+		assert.Contains(t, notification.Message, "2 new messages")
+		assert.Equal(t, "Notification digest", notification.ChannelName)
+	})
+
+	t.Run("leaves out the channels read since", func(t *testing.T) {
+		digest := newPushNotificationDigest(th.App)
+
+		notification := newNotification(th.BasicChannel, "read")
+		notification.post.CreateAt = 0
+		require.True(t, digest.hold(notification, now))
+
+		reqs := handler.numReqs()
+		digest.deliverDue(th.Context, afterQuietHours)
+		assert.Empty(t, digest.pending)
+		assert.Never(t, func() bool { return handler.numReqs() != reqs }, 100*time.Millisecond, 10*time.Millisecond)
+	})
+}
@@ -29,7 +29,9 @@ func (ps *PlatformService) NewClusterDiscoveryService() *ClusterDiscoveryService
 }
 
 func (ps *PlatformService) IsLeader() bool {
-	if ps.License() != nil && *ps.Config().ClusterSettings.Enable && ps.clusterIFace != nil {
+	// The Redis cluster doesn't require a license.
+	_, isRedisCluster := ps.clusterIFace.(*redisCluster)
+	if (ps.License() != nil || isRedisCluster) && *ps.Config().ClusterSettings.Enable && ps.clusterIFace != nil {
 		return ps.clusterIFace.IsLeader()
 	}
 
@@ -4,6 +4,7 @@
 package platform
 
 import (
+	"sync"
 	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -18,6 +19,7 @@ type ClusterDiscoveryService struct {
 	model.ClusterDiscovery
 	platform *PlatformService
 	stop     chan bool
+	stopOnce sync.Once
 }
 
 func (cds *ClusterDiscoveryService) Start() {
@@ -65,7 +67,10 @@ func (cds *ClusterDiscoveryService) Start() {
 }
 
 func (cds *ClusterDiscoveryService) Stop() {
-	cds.stop <- true
+	// Closing rather than sending doesn't block when the ping writer never started.
+	cds.stopOnce.Do(func() {
+		close(cds.stop)
+	})
 }
 
 func (ps *PlatformService) GetClusterId() string {
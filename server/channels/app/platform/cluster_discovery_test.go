@@ -24,4 +24,7 @@ func TestClusterDiscoveryService(t *testing.T) {
 
 	ds.Stop()
 	time.Sleep(2 * time.Second)
+
+	// Stopping again is a no-op.
+	ds.Stop()
 }
@@ -71,6 +71,12 @@ func (ps *PlatformService) IsConfigReadOnly() bool {
 // SaveConfig replaces the active configuration, optionally notifying cluster peers.
 // It returns both the previous and current configs.
 func (ps *PlatformService) SaveConfig(newCfg *model.Config, sendConfigChangeClusterMessage bool) (*model.Config, *model.Config, *model.AppError) {
+	return ps.SaveConfigWithAuthor(newCfg, sendConfigChangeClusterMessage, "")
+}
+
+// SaveConfigWithAuthor is like SaveConfig, additionally recording the user who made the
+// change in the configuration history.
+func (ps *PlatformService) SaveConfigWithAuthor(newCfg *model.Config, sendConfigChangeClusterMessage bool, authorId string) (*model.Config, *model.Config, *model.AppError) {
 	if ps.pluginEnv != nil {
 		var hookErr error
 		ps.pluginEnv.RunMultiHook(func(hooks plugin.Hooks, _ *model.Manifest) bool {
@@ -89,7 +95,7 @@ func (ps *PlatformService) SaveConfig(newCfg *model.Config, sendConfigChangeClus
 		}
 	}
 
-	oldCfg, newCfg, err := ps.configStore.Set(newCfg)
+	oldCfg, newCfg, err := ps.configStore.SetWithAuthor(newCfg, authorId)
 	if errors.Is(err, config.ErrReadOnlyConfiguration) {
 		return nil, nil, model.NewAppError("saveConfig", "ent.cluster.save_config.error", nil, "", http.StatusForbidden).Wrap(err)
 	} else if err != nil {
@@ -130,6 +136,16 @@ func (ps *PlatformService) CleanUpConfig() error {
 	return ps.configStore.CleanUp()
 }
 
+// GetConfigHistory returns the saved configurations, most recent first.
+func (ps *PlatformService) GetConfigHistory(offset, limit int) ([]*config.Version, error) {
+	return ps.configStore.GetHistory(offset, limit)
+}
+
+// GetConfigVersion returns a saved configuration.
+func (ps *PlatformService) GetConfigVersion(id string) (*config.Version, error) {
+	return ps.configStore.GetVersion(id)
+}
+
 // ConfigureLogger applies the specified configuration to a logger.
 func (ps *PlatformService) ConfigureLogger(name string, logger *mlog.Logger, logSettings *model.LogSettings, getPath func(string) string) error {
 	// Advanced logging is E20 only, however logging must be initialized before the license
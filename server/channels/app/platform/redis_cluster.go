@@ -0,0 +1,698 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+// The Redis cluster is a ClusterInterface implementation for deployments without
+// the enterprise cluster. Cluster messages are published to a channel shared by
+// all the nodes of the cluster, or to the channel of a single node, and gossip
+// requests are answered on the channel of the requesting node. The leader is the
+// node holding a lease key in Redis.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/rueidis"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/einterfaces"
+)
+
+const (
+	redisClusterKeyPrefix          = "mm_cluster:"
+	redisClusterLeaderLeaseTTL     = 15 * time.Second
+	redisClusterRequestTimeout     = 10 * time.Second
+	redisClusterResubscribeBackoff = time.Second
+	redisClusterPublishTimeout     = 5 * time.Second
+	redisClusterSendQueueSize      = 1000
+
+	// Gossip events only used by the Redis cluster.
+	redisClusterEventRequestClusterInfo  model.ClusterEvent = "redis_request_cluster_info"
+	redisClusterEventResponseClusterInfo model.ClusterEvent = "redis_response_cluster_info"
+)
+
+// Extends the lease only if it is still held by the node.
+var redisClusterRenewLeaseScript = rueidis.NewLuaScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// Releases the lease only if it is still held by the node.
+var redisClusterReleaseLeaseScript = rueidis.NewLuaScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// redisClusterEnvelope is the payload published to Redis.
+type redisClusterEnvelope struct {
+	From      string                `json:"from"`
+	RequestID string                `json:"request_id,omitempty"`
+	Response  bool                  `json:"response,omitempty"`
+	Message   *model.ClusterMessage `json:"message"`
+}
+
+// redisBus exchanges cluster messages between the nodes of a cluster through Redis pub/sub.
+type redisBus struct {
+	client rueidis.Client
+	prefix string
+	nodeID string
+	logger mlog.LoggerIFace
+
+	// handler is called with the messages sent by the other nodes, except responses.
+	handler func(env *redisClusterEnvelope)
+	// onLeaderChanged is called when the node gains or loses the leadership.
+	onLeaderChanged func()
+
+	pendingMut sync.Mutex
+	pending    map[string]chan *redisClusterEnvelope
+
+	// sendQueue holds the messages published asynchronously, in order, by sendLoop.
+	sendQueue chan *model.ClusterMessage
+
+	leader     atomic.Bool
+	subscribed atomic.Bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newRedisBus(client rueidis.Client, clusterName, nodeID string, logger mlog.LoggerIFace, handler func(env *redisClusterEnvelope), onLeaderChanged func()) *redisBus {
+	return &redisBus{
+		client:          client,
+		prefix:          redisClusterKeyPrefix + clusterName + ":",
+		nodeID:          nodeID,
+		logger:          logger,
+		handler:         handler,
+		onLeaderChanged: onLeaderChanged,
+		pending:         make(map[string]chan *redisClusterEnvelope),
+		sendQueue:       make(chan *model.ClusterMessage, redisClusterSendQueueSize),
+	}
+}
+
+func (b *redisBus) broadcastChannel() string {
+	return b.prefix + "all"
+}
+
+func (b *redisBus) nodeChannel(nodeID string) string {
+	return b.prefix + "node:" + nodeID
+}
+
+func (b *redisBus) leaderKey() string {
+	return b.prefix + "leader"
+}
+
+func (b *redisBus) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+
+	b.wg.Add(3)
+	go b.subscribeLoop(ctx)
+	go b.leaderLoop(ctx)
+	go b.sendLoop(ctx)
+}
+
+func (b *redisBus) stop() {
+	if b.cancel == nil {
+		return
+	}
+	b.cancel()
+	b.wg.Wait()
+
+	if b.leader.Load() {
+		ctx, cancel := context.WithTimeout(context.Background(), redisClusterPublishTimeout)
+		defer cancel()
+		if err := redisClusterReleaseLeaseScript.Exec(ctx, b.client, []string{b.leaderKey()}, []string{b.nodeID}).Error(); err != nil {
+			b.logger.Warn("Failed to release the cluster leadership", mlog.Err(err))
+		}
+		b.leader.Store(false)
+	}
+}
+
+func (b *redisBus) subscribeLoop(ctx context.Context) {
+	defer b.wg.Done()
+
+	subscribe := b.client.B().Subscribe().Channel(b.broadcastChannel(), b.nodeChannel(b.nodeID)).Build()
+	for {
+		b.subscribed.Store(true)
+		err := b.client.Receive(ctx, subscribe, func(msg rueidis.PubSubMessage) {
+			b.receive([]byte(msg.Message))
+		})
+		b.subscribed.Store(false)
+
+		if ctx.Err() != nil {
+			return
+		}
+		b.logger.Warn("Lost the cluster subscription, subscribing again", mlog.Err(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(redisClusterResubscribeBackoff):
+		}
+	}
+}
+
+func (b *redisBus) receive(data []byte) {
+	var env redisClusterEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		b.logger.Warn("Failed to decode the cluster message", mlog.Err(err))
+		return
+	}
+	if env.From == b.nodeID || env.Message == nil {
+		return
+	}
+
+	if env.Response {
+		b.pendingMut.Lock()
+		responses, ok := b.pending[env.RequestID]
+		b.pendingMut.Unlock()
+		if !ok {
+			return
+		}
+
+		select {
+		case responses <- &env:
+		default:
+			b.logger.Warn("Dropping a cluster response", mlog.String("event", string(env.Message.Event)))
+		}
+		return
+	}
+
+	// Requests are answered concurrently so that a slow answer doesn't hold the other messages,
+	// while the other messages are handled in order.
+	if env.RequestID != "" {
+		go b.handler(&env)
+		return
+	}
+	b.handler(&env)
+}
+
+// publish publishes the message to the channel, returning the number of subscribers which received it.
+func (b *redisBus) publish(ctx context.Context, channel string, env *redisClusterEnvelope) (int64, error) {
+	env.From = b.nodeID
+	buf, err := json.Marshal(env)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to encode the cluster message")
+	}
+
+	receivers, err := b.client.Do(ctx, b.client.B().Publish().Channel(channel).Message(rueidis.BinaryString(buf)).Build()).AsInt64()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to publish the cluster message")
+	}
+
+	return receivers, nil
+}
+
+func (b *redisBus) send(msg *model.ClusterMessage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), redisClusterPublishTimeout)
+	defer cancel()
+
+	_, err := b.publish(ctx, b.broadcastChannel(), &redisClusterEnvelope{Message: msg})
+	return err
+}
+
+// enqueue queues the message to be published by sendLoop, returning false when the queue is full.
+func (b *redisBus) enqueue(msg *model.ClusterMessage) bool {
+	select {
+	case b.sendQueue <- msg:
+		return true
+	default:
+		return false
+	}
+}
+
+// sendLoop publishes the queued messages until the bus is stopped, then publishes the messages
+// left in the queue.
+func (b *redisBus) sendLoop(ctx context.Context) {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case msg := <-b.sendQueue:
+			b.sendQueued(msg)
+		case <-ctx.Done():
+			for {
+				select {
+				case msg := <-b.sendQueue:
+					b.sendQueued(msg)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (b *redisBus) sendQueued(msg *model.ClusterMessage) {
+	if err := b.send(msg); err != nil {
+		b.logger.Error("Failed to send the cluster message", mlog.String("event", string(msg.Event)), mlog.Err(err))
+	}
+}
+
+func (b *redisBus) sendToNode(nodeID string, msg *model.ClusterMessage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), redisClusterPublishTimeout)
+	defer cancel()
+
+	receivers, err := b.publish(ctx, b.nodeChannel(nodeID), &redisClusterEnvelope{Message: msg})
+	if err != nil {
+		return err
+	}
+	if receivers == 0 {
+		return errors.Errorf("cluster node %s is not connected", nodeID)
+	}
+
+	return nil
+}
+
+// request sends the message to the other nodes and waits for their responses. The responses
+// received before the timeout are returned along with an error.
+func (b *redisBus) request(msg *model.ClusterMessage, timeout time.Duration) ([]*model.ClusterMessage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	requestID := model.NewId()
+	responses := make(chan *redisClusterEnvelope, 64)
+	b.pendingMut.Lock()
+	b.pending[requestID] = responses
+	b.pendingMut.Unlock()
+	defer func() {
+		b.pendingMut.Lock()
+		delete(b.pending, requestID)
+		b.pendingMut.Unlock()
+	}()
+
+	receivers, err := b.publish(ctx, b.broadcastChannel(), &redisClusterEnvelope{RequestID: requestID, Message: msg})
+	if err != nil {
+		return nil, err
+	}
+
+	// The node itself is subscribed to the broadcast channel but doesn't answer.
+	expected := max(int(receivers)-1, 0)
+
+	results := make([]*model.ClusterMessage, 0, expected)
+	for len(results) < expected {
+		select {
+		case env := <-responses:
+			results = append(results, env.Message)
+		case <-ctx.Done():
+			return results, errors.Errorf("received %d of %d cluster responses before the timeout", len(results), expected)
+		}
+	}
+
+	return results, nil
+}
+
+func (b *redisBus) respond(req *redisClusterEnvelope, msg *model.ClusterMessage) error {
+	ctx, cancel := context.WithTimeout(context.Background(), redisClusterPublishTimeout)
+	defer cancel()
+
+	_, err := b.publish(ctx, b.nodeChannel(req.From), &redisClusterEnvelope{RequestID: req.RequestID, Response: true, Message: msg})
+	return err
+}
+
+func (b *redisBus) leaderLoop(ctx context.Context) {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(redisClusterLeaderLeaseTTL / 3)
+	defer ticker.Stop()
+
+	for {
+		b.campaign(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// campaign renews the lease of the leader or tries to acquire it otherwise.
+func (b *redisBus) campaign(ctx context.Context) {
+	ttl := strconv.FormatInt(redisClusterLeaderLeaseTTL.Milliseconds(), 10)
+
+	var isLeader bool
+	if b.leader.Load() {
+		renewed, err := redisClusterRenewLeaseScript.Exec(ctx, b.client, []string{b.leaderKey()}, []string{b.nodeID, ttl}).AsInt64()
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			b.logger.Warn("Failed to renew the cluster leadership", mlog.Err(err))
+		}
+		isLeader = err == nil && renewed == 1
+	} else {
+		cmd := b.client.B().Set().Key(b.leaderKey()).Value(b.nodeID).Nx().PxMilliseconds(redisClusterLeaderLeaseTTL.Milliseconds()).Build()
+		err := b.client.Do(ctx, cmd).Error()
+		if err != nil && !rueidis.IsRedisNil(err) {
+			if ctx.Err() != nil {
+				return
+			}
+			b.logger.Warn("Failed to acquire the cluster leadership", mlog.Err(err))
+		}
+		isLeader = err == nil
+	}
+
+	if b.leader.Swap(isLeader) != isLeader {
+		b.logger.Info("Cluster leadership changed", mlog.Bool("is_leader", isLeader))
+		if b.onLeaderChanged != nil {
+			b.onLeaderChanged()
+		}
+	}
+}
+
+// redisCluster implements einterfaces.ClusterInterface on top of a redisBus.
+type redisCluster struct {
+	ps        *PlatformService
+	client    rueidis.Client
+	bus       *redisBus
+	discovery *ClusterDiscoveryService
+
+	handlersMut sync.RWMutex
+	handlers    map[model.ClusterEvent]einterfaces.ClusterMessageHandler
+}
+
+func redisClusterEnabled(cfg *model.Config) bool {
+	return *cfg.ClusterSettings.Enable && *cfg.ClusterSettings.EnableRedisMessageBus
+}
+
+func newRedisCluster(ps *PlatformService) (*redisCluster, error) {
+	cfg := ps.Config()
+	client, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:       []string{*cfg.CacheSettings.RedisAddress},
+		Password:          *cfg.CacheSettings.RedisPassword,
+		SelectDB:          *cfg.CacheSettings.RedisDB,
+		ForceSingleClient: true,
+		DisableCache:      true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the redis client")
+	}
+
+	rc := &redisCluster{
+		ps:        ps,
+		client:    client,
+		discovery: ps.NewClusterDiscoveryService(),
+		handlers:  make(map[model.ClusterEvent]einterfaces.ClusterMessageHandler),
+	}
+	rc.discovery.Id = model.NewId()
+	rc.discovery.Type = model.CDSTypeApp
+	rc.discovery.ClusterName = *cfg.ClusterSettings.ClusterName
+	rc.discovery.Hostname = *cfg.ClusterSettings.OverrideHostname
+	if *cfg.ClusterSettings.UseIPAddress {
+		rc.discovery.AutoFillIPAddress(*cfg.ClusterSettings.NetworkInterface, *cfg.ClusterSettings.AdvertiseAddress)
+	} else {
+		rc.discovery.AutoFillHostname()
+	}
+
+	rc.bus = newRedisBus(client, rc.discovery.ClusterName, rc.discovery.Id, ps.Log(), rc.handleMessage, ps.InvokeClusterLeaderChangedListeners)
+
+	return rc, nil
+}
+
+func (rc *redisCluster) StartInterNodeCommunication() {
+	rc.discovery.Start()
+	rc.bus.start()
+	rc.ps.Log().Info("Started the Redis cluster", mlog.String("node_id", rc.discovery.Id), mlog.String("cluster_name", rc.discovery.ClusterName))
+}
+
+func (rc *redisCluster) StopInterNodeCommunication() {
+	rc.bus.stop()
+	rc.discovery.Stop()
+	rc.client.Close()
+}
+
+func (rc *redisCluster) RegisterClusterMessageHandler(event model.ClusterEvent, crm einterfaces.ClusterMessageHandler) {
+	rc.handlersMut.Lock()
+	defer rc.handlersMut.Unlock()
+
+	rc.handlers[event] = crm
+}
+
+func (rc *redisCluster) GetClusterId() string {
+	return rc.discovery.Id
+}
+
+func (rc *redisCluster) IsLeader() bool {
+	return rc.bus.leader.Load()
+}
+
+func (rc *redisCluster) HealthScore() int {
+	if !rc.bus.subscribed.Load() {
+		return 1
+	}
+	return 0
+}
+
+func (rc *redisCluster) GetMyClusterInfo() *model.ClusterInfo {
+	info := &model.ClusterInfo{
+		Id:         rc.discovery.Id,
+		Version:    model.CurrentVersion,
+		ConfigHash: rc.ps.ClientConfigHash(),
+		IPAddress:  rc.discovery.Hostname,
+		Hostname:   rc.discovery.Hostname,
+	}
+	if schemaVersion, err := rc.ps.Store.GetDBSchemaVersion(); err == nil {
+		info.SchemaVersion = strconv.Itoa(schemaVersion)
+	}
+
+	return info
+}
+
+func (rc *redisCluster) GetClusterInfos() []*model.ClusterInfo {
+	infos := []*model.ClusterInfo{rc.GetMyClusterInfo()}
+
+	responses, err := rc.bus.request(&model.ClusterMessage{Event: redisClusterEventRequestClusterInfo}, redisClusterRequestTimeout)
+	if err != nil {
+		rc.ps.Log().Warn("Failed to get the cluster infos of all the nodes", mlog.Err(err))
+	}
+	for _, response := range responses {
+		var info model.ClusterInfo
+		if err := json.Unmarshal(response.Data, &info); err != nil {
+			rc.ps.Log().Warn("Failed to decode a cluster info", mlog.Err(err))
+			continue
+		}
+		infos = append(infos, &info)
+	}
+
+	return infos
+}
+
+// SendClusterMessage publishes the message asynchronously so that the callers, which are often
+// serving a request, don't wait for Redis.
+func (rc *redisCluster) SendClusterMessage(msg *model.ClusterMessage) {
+	if !rc.bus.enqueue(msg) {
+		rc.ps.Log().Error("Dropping a cluster message, the send queue is full", mlog.String("event", string(msg.Event)))
+	}
+}
+
+func (rc *redisCluster) SendClusterMessageToNode(nodeID string, msg *model.ClusterMessage) error {
+	return rc.bus.sendToNode(nodeID, msg)
+}
+
+func (rc *redisCluster) NotifyMsg(buf []byte) {
+	rc.bus.receive(buf)
+}
+
+func (rc *redisCluster) GetClusterStats(rctx request.CTX) ([]*model.ClusterStats, *model.AppError) {
+	responses, err := rc.bus.request(&model.ClusterMessage{Event: model.ClusterGossipEventRequestGetClusterStats}, redisClusterRequestTimeout)
+	if err != nil {
+		return nil, model.NewAppError("GetClusterStats", "app.cluster.redis.request.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	stats := make([]*model.ClusterStats, 0, len(responses))
+	for _, response := range responses {
+		var stat model.ClusterStats
+		if err := json.Unmarshal(response.Data, &stat); err != nil {
+			return nil, model.NewAppError("GetClusterStats", "app.cluster.redis.response.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		stats = append(stats, &stat)
+	}
+
+	return stats, nil
+}
+
+func (rc *redisCluster) GetLogs(rctx request.CTX, page, perPage int) ([]string, *model.AppError) {
+	logs, appErr := rc.QueryLogs(rctx, page, perPage)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	var lines []string
+	for _, nodeLines := range logs {
+		lines = append(lines, nodeLines...)
+	}
+
+	return lines, nil
+}
+
+func (rc *redisCluster) QueryLogs(rctx request.CTX, page, perPage int) (map[string][]string, *model.AppError) {
+	responses, err := rc.bus.request(&model.ClusterMessage{
+		Event: model.ClusterGossipEventRequestGetLogs,
+		Props: map[string]string{
+			"page":     strconv.Itoa(page),
+			"per_page": strconv.Itoa(perPage),
+		},
+	}, redisClusterRequestTimeout)
+	if err != nil {
+		return nil, model.NewAppError("QueryLogs", "app.cluster.redis.request.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	logs := make(map[string][]string, len(responses))
+	for _, response := range responses {
+		var lines []string
+		if err := json.Unmarshal(response.Data, &lines); err != nil {
+			return nil, model.NewAppError("QueryLogs", "app.cluster.redis.response.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		logs[response.Props["hostname"]] = lines
+	}
+
+	return logs, nil
+}
+
+// GenerateSupportPacket returns no files: the support packet only contains the data of the
+// node generating it.
+func (rc *redisCluster) GenerateSupportPacket(rctx request.CTX, options *model.SupportPacketOptions) (map[string][]model.FileData, error) {
+	return map[string][]model.FileData{}, nil
+}
+
+func (rc *redisCluster) GetPluginStatuses() (model.PluginStatuses, *model.AppError) {
+	responses, err := rc.bus.request(&model.ClusterMessage{Event: model.ClusterGossipEventRequestGetPluginStatuses}, redisClusterRequestTimeout)
+	if err != nil {
+		return nil, model.NewAppError("GetPluginStatuses", "app.cluster.redis.request.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	var statuses model.PluginStatuses
+	for _, response := range responses {
+		var nodeStatuses model.PluginStatuses
+		if err := json.Unmarshal(response.Data, &nodeStatuses); err != nil {
+			return nil, model.NewAppError("GetPluginStatuses", "app.cluster.redis.response.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		statuses = append(statuses, nodeStatuses...)
+	}
+
+	return statuses, nil
+}
+
+func (rc *redisCluster) ConfigChanged(previousConfig *model.Config, newConfig *model.Config, sendToOtherServer bool) *model.AppError {
+	if !sendToOtherServer {
+		return nil
+	}
+
+	if err := rc.bus.send(&model.ClusterMessage{Event: model.ClusterGossipEventRequestSaveConfig}); err != nil {
+		return model.NewAppError("ConfigChanged", "app.cluster.redis.request.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+func (rc *redisCluster) WebConnCountForUser(userID string) (int, *model.AppError) {
+	responses, err := rc.bus.request(&model.ClusterMessage{
+		Event: model.ClusterGossipEventRequestWebConnCount,
+		Props: map[string]string{"user_id": userID},
+	}, redisClusterRequestTimeout)
+	if err != nil {
+		return 0, model.NewAppError("WebConnCountForUser", "app.cluster.redis.request.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	count := 0
+	for _, response := range responses {
+		nodeCount, err := strconv.Atoi(string(response.Data))
+		if err != nil {
+			return 0, model.NewAppError("WebConnCountForUser", "app.cluster.redis.response.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		count += nodeCount
+	}
+
+	return count, nil
+}
+
+// handleMessage answers the gossip requests of the other nodes and dispatches the other
+// messages to the registered handlers.
+func (rc *redisCluster) handleMessage(env *redisClusterEnvelope) {
+	msg := env.Message
+
+	var response *model.ClusterMessage
+	var err error
+	switch msg.Event {
+	case redisClusterEventRequestClusterInfo:
+		response, err = newRedisClusterJSONResponse(redisClusterEventResponseClusterInfo, rc.GetMyClusterInfo())
+	case model.ClusterGossipEventRequestGetClusterStats:
+		response, err = newRedisClusterJSONResponse(model.ClusterGossipEventResponseGetClusterStats, &model.ClusterStats{
+			Id:                        rc.discovery.Id,
+			TotalWebsocketConnections: rc.ps.TotalWebsocketConnections(),
+			TotalReadDbConnections:    rc.ps.Store.TotalReadDbConnections(),
+			TotalMasterDbConnections:  rc.ps.Store.TotalMasterDbConnections(),
+		})
+	case model.ClusterGossipEventRequestGetLogs:
+		page, _ := strconv.Atoi(msg.Props["page"])
+		perPage, _ := strconv.Atoi(msg.Props["per_page"])
+		lines, appErr := rc.ps.GetLogsSkipSend(request.EmptyContext(rc.ps.Log()), page, perPage, &model.LogFilter{})
+		if appErr != nil {
+			err = appErr
+			break
+		}
+		response, err = newRedisClusterJSONResponse(model.ClusterGossipEventResponseGetLogs, lines)
+		if response != nil {
+			response.Props = map[string]string{"hostname": rc.discovery.Hostname}
+		}
+	case model.ClusterGossipEventRequestGetPluginStatuses:
+		statuses, appErr := rc.ps.GetPluginStatuses()
+		if appErr != nil {
+			// Plugins may be disabled on this node.
+			statuses = model.PluginStatuses{}
+		}
+		response, err = newRedisClusterJSONResponse(model.ClusterGossipEventResponseGetPluginStatuses, statuses)
+	case model.ClusterGossipEventRequestWebConnCount:
+		response = &model.ClusterMessage{
+			Event: model.ClusterGossipEventResponseWebConnCount,
+			Data:  []byte(strconv.Itoa(rc.ps.WebConnCountForUser(msg.Props["user_id"]))),
+		}
+	case model.ClusterGossipEventRequestSaveConfig:
+		if err := rc.ps.ReloadConfig(); err != nil {
+			rc.ps.Log().Error("Failed to reload the config changed by another cluster node", mlog.Err(err))
+		}
+		return
+	default:
+		rc.handlersMut.RLock()
+		handler, ok := rc.handlers[msg.Event]
+		rc.handlersMut.RUnlock()
+		if ok {
+			handler(msg)
+		}
+		return
+	}
+
+	if err != nil {
+		rc.ps.Log().Warn("Failed to answer the cluster request", mlog.String("event", string(msg.Event)), mlog.Err(err))
+		return
+	}
+	if env.RequestID == "" {
+		return
+	}
+	if err := rc.bus.respond(env, response); err != nil {
+		rc.ps.Log().Warn("Failed to send the cluster response", mlog.String("event", string(msg.Event)), mlog.Err(err))
+	}
+}
+
+func newRedisClusterJSONResponse(event model.ClusterEvent, v any) (*model.ClusterMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode the cluster response")
+	}
+
+	return &model.ClusterMessage{Event: event, Data: data}, nil
+}
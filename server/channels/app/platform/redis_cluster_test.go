@@ -0,0 +1,186 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package platform
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+type testRedisNode struct {
+	bus      *redisBus
+	received chan *model.ClusterMessage
+	leaderCh chan bool
+}
+
+func newTestRedisNode(t *testing.T, clusterName string) *testRedisNode {
+	t.Helper()
+
+	address := os.Getenv("MM_CACHESETTINGS_REDISADDRESS")
+	if address == "" {
+		t.Skip("MM_CACHESETTINGS_REDISADDRESS is not set")
+	}
+
+	client, err := rueidis.NewClient(rueidis.ClientOption{
+		InitAddress:       []string{address},
+		ForceSingleClient: true,
+		DisableCache:      true,
+	})
+	require.NoError(t, err)
+
+	node := &testRedisNode{
+		received: make(chan *model.ClusterMessage, 10),
+		leaderCh: make(chan bool, 10),
+	}
+	node.bus = newRedisBus(client, clusterName, model.NewId(), mlog.CreateConsoleTestLogger(t), func(env *redisClusterEnvelope) {
+		if env.RequestID == "" {
+			node.received <- env.Message
+			return
+		}
+		// Answers the requests with the node id.
+		assert.NoError(t, node.bus.respond(env, &model.ClusterMessage{Event: env.Message.Event, Data: []byte(node.bus.nodeID)}))
+	}, func() {
+		node.leaderCh <- node.bus.leader.Load()
+	})
+	node.bus.start()
+	t.Cleanup(func() {
+		node.bus.stop()
+		client.Close()
+	})
+
+	require.Eventually(t, node.bus.subscribed.Load, 5*time.Second, 10*time.Millisecond)
+
+	return node
+}
+
+func TestRedisBus(t *testing.T) {
+	clusterName := "test_" + model.NewId()
+	node1 := newTestRedisNode(t, clusterName)
+	node2 := newTestRedisNode(t, clusterName)
+	node3 := newTestRedisNode(t, clusterName)
+
+	// Let the subscriptions settle.
+	time.Sleep(100 * time.Millisecond)
+
+	t.Run("broadcast", func(t *testing.T) {
+		require.NoError(t, node1.bus.send(&model.ClusterMessage{Event: model.ClusterEventPublish, Data: []byte("hello")}))
+
+		for _, node := range []*testRedisNode{node2, node3} {
+			select {
+			case msg := <-node.received:
+				assert.Equal(t, model.ClusterEventPublish, msg.Event)
+				assert.Equal(t, "hello", string(msg.Data))
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "message not received")
+			}
+		}
+
+		select {
+		case <-node1.received:
+			require.Fail(t, "the sender received its own message")
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("queued broadcast", func(t *testing.T) {
+		for i := range 3 {
+			require.True(t, node1.bus.enqueue(&model.ClusterMessage{Event: model.ClusterEventPublish, Data: []byte(strconv.Itoa(i))}))
+		}
+
+		// The queued messages are published in order.
+		for i := range 3 {
+			select {
+			case msg := <-node2.received:
+				assert.Equal(t, strconv.Itoa(i), string(msg.Data))
+			case <-time.After(5 * time.Second):
+				require.Fail(t, "message not received")
+			}
+		}
+		for range 3 {
+			<-node3.received
+		}
+	})
+
+	t.Run("send to node", func(t *testing.T) {
+		require.NoError(t, node1.bus.sendToNode(node3.bus.nodeID, &model.ClusterMessage{Event: model.ClusterEventPluginEvent}))
+
+		select {
+		case msg := <-node3.received:
+			assert.Equal(t, model.ClusterEventPluginEvent, msg.Event)
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "message not received")
+		}
+
+		select {
+		case <-node2.received:
+			require.Fail(t, "message received by another node")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		assert.Error(t, node1.bus.sendToNode(model.NewId(), &model.ClusterMessage{Event: model.ClusterEventPluginEvent}))
+	})
+
+	t.Run("request", func(t *testing.T) {
+		responses, err := node1.bus.request(&model.ClusterMessage{Event: model.ClusterGossipEventRequestGetClusterStats}, 5*time.Second)
+		require.NoError(t, err)
+
+		nodeIDs := make([]string, 0, len(responses))
+		for _, response := range responses {
+			nodeIDs = append(nodeIDs, string(response.Data))
+		}
+		assert.ElementsMatch(t, []string{node2.bus.nodeID, node3.bus.nodeID}, nodeIDs)
+	})
+
+	t.Run("leader", func(t *testing.T) {
+		leaders := 0
+		for _, node := range []*testRedisNode{node1, node2, node3} {
+			if node.bus.leader.Load() {
+				leaders++
+			}
+		}
+		require.Equal(t, 1, leaders)
+	})
+}
+
+func TestRedisBusLeaderFailover(t *testing.T) {
+	clusterName := "test_" + model.NewId()
+	node1 := newTestRedisNode(t, clusterName)
+	require.Eventually(t, node1.bus.leader.Load, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, <-node1.leaderCh)
+
+	node2 := newTestRedisNode(t, clusterName)
+	node2.bus.campaign(context.Background())
+	assert.False(t, node2.bus.leader.Load())
+
+	// Stopping the leader releases the lease.
+	node1.bus.stop()
+	node2.bus.campaign(context.Background())
+	assert.True(t, node2.bus.leader.Load())
+	assert.True(t, <-node2.leaderCh)
+
+	ttl, err := node2.bus.client.Do(context.Background(), node2.bus.client.B().Pttl().Key(node2.bus.leaderKey()).Build()).AsInt64()
+	require.NoError(t, err)
+	assert.LessOrEqual(t, ttl, redisClusterLeaderLeaseTTL.Milliseconds())
+	assert.Greater(t, ttl, int64(0))
+}
+
+func TestRedisBusSendQueueFull(t *testing.T) {
+	bus := newRedisBus(nil, "test", model.NewId(), mlog.CreateConsoleTestLogger(t), nil, nil)
+
+	// The bus isn't started, so nothing drains the queue.
+	for range redisClusterSendQueueSize {
+		require.True(t, bus.enqueue(&model.ClusterMessage{Event: model.ClusterEventPublish}))
+	}
+	assert.False(t, bus.enqueue(&model.ClusterMessage{Event: model.ClusterEventPublish}))
+}
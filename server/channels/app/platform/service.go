@@ -162,12 +162,17 @@ func New(sc ServiceConfig, options ...Option) (*PlatformService, error) {
 	if *cacheConfig.CacheType == model.CacheTypeLRU {
 		ps.cacheProvider = cache.NewProvider()
 	} else if *cacheConfig.CacheType == model.CacheTypeRedis {
+		var localCacheTTL time.Duration
+		if *cacheConfig.EnableRedisLocalCache {
+			localCacheTTL = time.Duration(*cacheConfig.RedisLocalCacheTTLSeconds) * time.Second
+		}
 		ps.cacheProvider, err = cache.NewRedisProvider(
 			&cache.RedisOptions{
 				RedisAddr:     *cacheConfig.RedisAddress,
 				RedisPassword: *cacheConfig.RedisPassword,
 				RedisDB:       *cacheConfig.RedisDB,
 				DisableCache:  *cacheConfig.DisableClientCache,
+				LocalCacheTTL: localCacheTTL,
 			},
 		)
 	}
@@ -446,6 +451,13 @@ func (ps *PlatformService) SetLogger(logger *mlog.Logger) {
 func (ps *PlatformService) initEnterprise() {
 	if clusterInterface != nil && ps.clusterIFace == nil {
 		ps.clusterIFace = clusterInterface(ps)
+	} else if ps.clusterIFace == nil && redisClusterEnabled(ps.Config()) {
+		cluster, err := newRedisCluster(ps)
+		if err != nil {
+			ps.logger.Error("Failed to create the Redis cluster", mlog.Err(err))
+		} else {
+			ps.clusterIFace = cluster
+		}
 	}
 
 	if elasticsearchInterface != nil {
@@ -21,6 +21,14 @@ var sharedChannelEventsForSync = []model.WebsocketEventType{
 	model.WebsocketEventPostDeleted,
 	model.WebsocketEventReactionAdded,
 	model.WebsocketEventReactionRemoved,
+	model.WebsocketEventAcknowledgementAdded,
+	model.WebsocketEventAcknowledgementRemoved,
+	model.WebsocketEventChannelBookmarkCreated,
+	model.WebsocketEventChannelBookmarkUpdated,
+	model.WebsocketEventChannelBookmarkDeleted,
+	model.WebsocketEventChannelBookmarkSorted,
+	model.WebsocketEventUserAdded,
+	model.WebsocketEventUserRemoved,
 }
 
 var sharedChannelEventsForInvitation = []model.WebsocketEventType{
@@ -75,6 +75,15 @@ func (api *PluginAPI) UnregisterCommand(teamID, trigger string) error {
 	return nil
 }
 
+func (api *PluginAPI) RegisterContentExtractor(extractor *model.PluginContentExtractor) error {
+	return api.app.RegisterPluginContentExtractor(api.id, extractor)
+}
+
+func (api *PluginAPI) UnregisterContentExtractor() error {
+	api.app.UnregisterPluginContentExtractor(api.id)
+	return nil
+}
+
 func (api *PluginAPI) ExecuteSlashCommand(commandArgs *model.CommandArgs) (*model.CommandResponse, error) {
 	user, appErr := api.app.GetUser(commandArgs.UserId)
 	if appErr != nil {
@@ -0,0 +1,148 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/services/docextractor"
+)
+
+// maxPluginContentExtractionInput is the size of the largest file sent to plugins for content extraction.
+const maxPluginContentExtractionInput = 50 * 1024 * 1024 // 50MB
+
+func (a *App) RegisterPluginContentExtractor(pluginID string, extractor *model.PluginContentExtractor) error {
+	if extractor == nil {
+		return errors.New("invalid content extractor")
+	}
+
+	extractor = &model.PluginContentExtractor{
+		Extensions:    slices.Clone(extractor.Extensions),
+		TimeoutMillis: extractor.TimeoutMillis,
+	}
+	extractor.SetDefaults()
+	if appErr := extractor.IsValid(); appErr != nil {
+		return appErr
+	}
+
+	a.ch.pluginContentExtractorsLock.Lock()
+	defer a.ch.pluginContentExtractorsLock.Unlock()
+
+	if a.ch.pluginContentExtractors == nil {
+		a.ch.pluginContentExtractors = make(map[string]*model.PluginContentExtractor)
+	}
+	a.ch.pluginContentExtractors[pluginID] = extractor
+
+	return nil
+}
+
+func (a *App) UnregisterPluginContentExtractor(pluginID string) {
+	a.ch.unregisterPluginContentExtractor(pluginID)
+}
+
+func (ch *Channels) unregisterPluginContentExtractor(pluginID string) {
+	ch.pluginContentExtractorsLock.Lock()
+	defer ch.pluginContentExtractorsLock.Unlock()
+
+	delete(ch.pluginContentExtractors, pluginID)
+}
+
+// pluginContentExtractors returns the content extractors registered by the plugins, sorted by
+// plugin id so that the same plugin always handles an extension claimed by several of them.
+func (a *App) pluginContentExtractors(rctx request.CTX) []docextractor.Extractor {
+	a.ch.pluginContentExtractorsLock.RLock()
+	defer a.ch.pluginContentExtractorsLock.RUnlock()
+
+	extractors := make([]docextractor.Extractor, 0, len(a.ch.pluginContentExtractors))
+	for pluginID, extractor := range a.ch.pluginContentExtractors {
+		extractors = append(extractors, &pluginContentExtractor{
+			app:        a,
+			rctx:       rctx,
+			pluginID:   pluginID,
+			extensions: extractor.Extensions,
+			timeout:    time.Duration(extractor.TimeoutMillis) * time.Millisecond,
+		})
+	}
+	slices.SortFunc(extractors, func(a, b docextractor.Extractor) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	return extractors
+}
+
+// pluginContentExtractor is a docextractor.Extractor calling the ExtractFileContent hook of a plugin.
+type pluginContentExtractor struct {
+	app        *App
+	rctx       request.CTX
+	pluginID   string
+	extensions []string
+	timeout    time.Duration
+}
+
+func (pe *pluginContentExtractor) Name() string {
+	return "plugin:" + pe.pluginID
+}
+
+func (pe *pluginContentExtractor) Match(filename string) bool {
+	extension := strings.ToLower(strings.TrimPrefix(path.Ext(filename), "."))
+	return slices.Contains(pe.extensions, extension)
+}
+
+func (pe *pluginContentExtractor) Extract(filename string, r io.ReadSeeker) (string, error) {
+	pluginsEnvironment := pe.app.GetPluginsEnvironment()
+	if pluginsEnvironment == nil {
+		return "", errors.New("plugins are disabled")
+	}
+
+	var hooks plugin.Hooks
+	pluginsEnvironment.RunMultiPluginHook(func(h plugin.Hooks, manifest *model.Manifest) bool {
+		if manifest.Id != pe.pluginID {
+			return true
+		}
+		hooks = h
+		return false
+	}, plugin.ExtractFileContentID)
+	if hooks == nil {
+		return "", fmt.Errorf("plugin %s is not active or does not implement ExtractFileContent", pe.pluginID)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(r, maxPluginContentExtractionInput+1))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to read the file")
+	}
+	if len(content) > maxPluginContentExtractionInput {
+		return "", errors.New("the file is too large to be sent to the plugin")
+	}
+
+	type result struct {
+		text string
+		err  error
+	}
+	// Buffered so that a plugin replying after the timeout doesn't block the call forever.
+	results := make(chan result, 1)
+	go func() {
+		text, err := hooks.ExtractFileContent(pluginContext(pe.rctx), filename, content)
+		results <- result{text, err}
+	}()
+
+	select {
+	case res := <-results:
+		if res.err != nil {
+			return "", errors.Wrapf(res.err, "plugin %s failed to extract the file content", pe.pluginID)
+		}
+		return res.text, nil
+	case <-time.After(pe.timeout):
+		return "", fmt.Errorf("plugin %s timed out extracting the file content", pe.pluginID)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+)
+
+func TestPluginContentExtractors(t *testing.T) {
+	th := Setup(t)
+	defer th.TearDown()
+
+	var mockAPI plugintest.API
+	mockAPI.On("LoadPluginConfiguration", mock.Anything).Return(nil)
+	tearDown, pluginIDs, activationErrors := SetAppEnvironmentWithPlugins(t, []string{
+		`
+		package main
+
+		import (
+			"errors"
+			"strings"
+			"time"
+
+			"github.com/mattermost/mattermost/server/public/plugin"
+		)
+
+		type MyPlugin struct {
+			plugin.MattermostPlugin
+		}
+
+		func (p *MyPlugin) ExtractFileContent(c *plugin.Context, filename string, content []byte) (string, error) {
+			switch {
+			case strings.HasSuffix(filename, ".slow"):
+				time.Sleep(5 * time.Second)
+			case strings.HasSuffix(filename, ".fail"):
+				return "", errors.New("unsupported content")
+			}
+			return "extracted " + filename + ": " + strings.ToUpper(string(content)), nil
+		}
+
+		func main() {
+			plugin.ClientMain(&MyPlugin{})
+		}
+		`,
+	}, th.App, func(*model.Manifest) plugin.API { return &mockAPI })
+	defer tearDown()
+	require.Len(t, activationErrors, 1)
+	require.NoError(t, activationErrors[0])
+
+	t.Run("invalid registration", func(t *testing.T) {
+		err := th.App.RegisterPluginContentExtractor(pluginIDs[0], &model.PluginContentExtractor{})
+		require.Error(t, err)
+
+		err = th.App.RegisterPluginContentExtractor(pluginIDs[0], &model.PluginContentExtractor{Extensions: []string{"cad"}, TimeoutMillis: model.PluginContentExtractorMaxTimeoutMillis + 1})
+		require.Error(t, err)
+
+		assert.Empty(t, th.App.pluginContentExtractors(th.Context))
+	})
+
+	err := th.App.RegisterPluginContentExtractor(pluginIDs[0], &model.PluginContentExtractor{
+		Extensions:    []string{".CAD", "slow", "fail"},
+		TimeoutMillis: 500,
+	})
+	require.NoError(t, err)
+
+	extractors := th.App.pluginContentExtractors(th.Context)
+	require.Len(t, extractors, 1)
+	extractor := extractors[0]
+
+	t.Run("match", func(t *testing.T) {
+		assert.True(t, extractor.Match("drawing.cad"))
+		assert.True(t, extractor.Match("drawing.CAD"))
+		assert.False(t, extractor.Match("drawing.pdf"))
+	})
+
+	t.Run("extract", func(t *testing.T) {
+		text, err := extractor.Extract("drawing.cad", bytes.NewReader([]byte("content")))
+		require.NoError(t, err)
+		assert.Equal(t, "extracted drawing.cad: CONTENT", text)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		_, err := extractor.Extract("drawing.fail", bytes.NewReader([]byte("content")))
+		require.Error(t, err)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		_, err := extractor.Extract("drawing.slow", bytes.NewReader([]byte("content")))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timed out")
+	})
+
+	t.Run("unregister", func(t *testing.T) {
+		th.App.UnregisterPluginContentExtractor(pluginIDs[0])
+		assert.Empty(t, th.App.pluginContentExtractors(th.Context))
+	})
+}
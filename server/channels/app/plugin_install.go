@@ -591,6 +591,7 @@ func (ch *Channels) removePluginLocally(id string) *model.AppError {
 	pluginsEnvironment.Deactivate(id)
 	pluginsEnvironment.RemovePlugin(id)
 	ch.unregisterPluginCommands(id)
+	ch.unregisterPluginContentExtractor(id)
 
 	if err := os.RemoveAll(unpackedBundlePath); err != nil {
 		return model.NewAppError("removePlugin", "app.plugin.remove.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -2136,18 +2136,32 @@ func (a *App) GetEditHistoryForPost(postID string) ([]*model.Post, *model.AppErr
 	return posts, nil
 }
 
-func (a *App) SetPostReminder(rctx request.CTX, postID, userID string, targetTime int64) *model.AppError {
-	// Store the reminder in the DB
-	reminder := &model.PostReminder{
-		PostId:     postID,
-		UserId:     userID,
-		TargetTime: targetTime,
+func (a *App) SetPostReminder(rctx request.CTX, reminder *model.PostReminder) *model.AppError {
+	if appErr := reminder.IsValid(); appErr != nil {
+		return appErr
 	}
+
+	// Store the reminder in the DB
 	err := a.Srv().Store().Post().SetPostReminder(reminder)
 	if err != nil {
-		return model.NewAppError("SetPostReminder", model.NoTranslation, nil, "", http.StatusInternalServerError).Wrap(err)
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("SetPostReminder", "app.post.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("SetPostReminder", model.NoTranslation, nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	// Standalone reminders are acknowledged by whoever created them, since there is no post to reply to.
+	if reminder.IsStandalone() {
+		return nil
 	}
 
+	postID := reminder.PostId
+	userID := reminder.UserId
+	targetTime := reminder.TargetTime
+
 	metadata, err := a.Srv().Store().Post().GetPostReminderMetadata(postID)
 	if err != nil {
 		return model.NewAppError("SetPostReminder", model.NoTranslation, nil, "", http.StatusInternalServerError).Wrap(err)
@@ -2217,51 +2231,34 @@ func (a *App) CheckPostReminders(rctx request.CTX) {
 	}
 
 	// We group multiple reminders for a single user.
-	groupedReminders := make(map[string][]string)
+	groupedReminders := make(map[string][]*model.PostReminder)
 	for _, r := range reminders {
-		if groupedReminders[r.UserId] == nil {
-			groupedReminders[r.UserId] = []string{r.PostId}
-		} else {
-			groupedReminders[r.UserId] = append(groupedReminders[r.UserId], r.PostId)
-		}
+		groupedReminders[r.UserId] = append(groupedReminders[r.UserId], r)
 	}
 
-	siteURL := *a.Config().ServiceSettings.SiteURL
-	for userID, postIDs := range groupedReminders {
+	for userID, userReminders := range groupedReminders {
 		ch, appErr := a.GetOrCreateDirectChannel(request.EmptyContext(a.Log()), userID, systemBot.UserId)
 		if appErr != nil {
 			rctx.Logger().Error("Failed to get direct channel", mlog.Err(appErr))
 			return
 		}
 
-		for _, postID := range postIDs {
-			metadata, err := a.Srv().Store().Post().GetPostReminderMetadata(postID)
+		for _, reminder := range userReminders {
+			dm, err := a.makePostReminderMessage(reminder)
 			if err != nil {
-				rctx.Logger().Error("Failed to get post reminder metadata", mlog.Err(err), mlog.String("post_id", postID))
+				rctx.Logger().Error("Failed to get post reminder metadata", mlog.Err(err), mlog.String("post_id", reminder.PostId))
 				continue
 			}
-
-			T := i18n.GetUserTranslations(metadata.UserLocale)
-			dm := &model.Post{
-				ChannelId: ch.Id,
-				Message: T("app.post_reminder_dm", model.StringInterface{
-					"SiteURL":  siteURL,
-					"TeamName": metadata.TeamName,
-					"PostId":   postID,
-					"Username": metadata.Username,
-				}),
-				Type:   model.PostTypeReminder,
-				UserId: systemBot.UserId,
-				Props: model.StringInterface{
-					"team_name": metadata.TeamName,
-					"post_id":   postID,
-					"username":  metadata.Username,
-				},
-			}
+			dm.ChannelId = ch.Id
+			dm.UserId = systemBot.UserId
 
 			if _, err := a.CreatePost(request.EmptyContext(a.Log()), dm, ch, model.CreatePostFlags{SetOnline: true}); err != nil {
 				rctx.Logger().Error("Failed to post reminder message", mlog.Err(err))
 			}
+
+			if reminder.Recurrence != "" {
+				a.rescheduleRecurringPostReminder(rctx, reminder)
+			}
 		}
 	}
 }
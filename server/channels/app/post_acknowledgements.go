@@ -53,6 +53,10 @@ func (a *App) SaveAcknowledgementForPost(c request.CTX, postID, userID string) (
 		return nil, appErr
 	}
 
+	if appErr := a.resolvePostEscalation(c, post, userID); appErr != nil {
+		c.Logger().Warn("Failed to resolve post escalation", mlog.String("post_id", post.Id), mlog.Err(appErr))
+	}
+
 	// The post is always modified since the UpdateAt always changes
 	a.Srv().Store().Post().InvalidateLastPostTimeCache(channel.Id)
 
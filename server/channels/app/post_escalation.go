@@ -0,0 +1,332 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const postEscalationBatchSize = 100
+
+func (a *App) IsPostEscalationEnabled() bool {
+	return a.IsPostPriorityEnabled() && *a.Config().ServiceSettings.AllowPostEscalations
+}
+
+// GetPostEscalation returns the escalation of the post, or nil if the post was never escalated.
+func (a *App) GetPostEscalation(postID string) (*model.PostEscalation, *model.AppError) {
+	escalation, err := a.Srv().Store().PostEscalation().Get(postID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return nil, nil
+		}
+		return nil, model.NewAppError("GetPostEscalation", "app.post_escalation.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return escalation, nil
+}
+
+func (a *App) GetEscalationsForPostList(list *model.PostList) (map[string]*model.PostEscalation, *model.AppError) {
+	escalations, err := a.Srv().Store().PostEscalation().GetForPosts(list.Order)
+	if err != nil {
+		return nil, model.NewAppError("GetEscalationsForPostList", "app.post_escalation.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	escalationsMap := make(map[string]*model.PostEscalation, len(escalations))
+	for _, escalation := range escalations {
+		escalationsMap[escalation.PostId] = escalation
+	}
+
+	return escalationsMap, nil
+}
+
+// SetPostEscalation starts escalating the notifications of a root post until it gets
+// acknowledged, replacing any previous escalation of the post.
+func (a *App) SetPostEscalation(rctx request.CTX, post *model.Post, escalation *model.PostEscalation, creatorID string) (*model.PostEscalation, *model.AppError) {
+	if !a.IsPostEscalationEnabled() {
+		return nil, model.NewAppError("SetPostEscalation", "app.post_escalation.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if post.RootId != "" {
+		return nil, model.NewAppError("SetPostEscalation", "app.post_escalation.root_post.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	escalation.PostId = post.Id
+	escalation.ChannelId = post.ChannelId
+	escalation.CreatorId = creatorID
+	escalation.CreateAt = 0
+	escalation.PreSave()
+	if appErr := escalation.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.validatePostEscalationRecipients(escalation); appErr != nil {
+		return nil, appErr
+	}
+
+	saved, err := a.Srv().Store().PostEscalation().Save(escalation)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("SetPostEscalation", "app.post_escalation.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.Srv().Store().Post().InvalidateLastPostTimeCache(post.ChannelId)
+	a.sendPostEscalationEvent(rctx, saved)
+
+	return saved, nil
+}
+
+func (a *App) validatePostEscalationRecipients(escalation *model.PostEscalation) *model.AppError {
+	userIDs := make(model.StringSet)
+	groupIDs := make(model.StringSet)
+	for _, level := range escalation.AllLevels() {
+		for _, userID := range level.UserIds {
+			userIDs.Add(userID)
+		}
+		for _, groupID := range level.GroupIds {
+			groupIDs.Add(groupID)
+		}
+	}
+
+	if len(userIDs) > 0 {
+		users, appErr := a.GetUsersByIds(userIDs.Val(), &store.UserGetByIdsOpts{})
+		if appErr != nil {
+			return appErr
+		}
+		if len(users) != len(userIDs) {
+			return model.NewAppError("SetPostEscalation", "app.post_escalation.invalid_users.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	groups, appErr := a.GetGroupsByIDs(groupIDs.Val())
+	if appErr != nil {
+		return appErr
+	}
+	if len(groups) != len(groupIDs) {
+		return model.NewAppError("SetPostEscalation", "app.post_escalation.invalid_groups.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// CancelPostEscalation stops escalating the notifications of the post.
+func (a *App) CancelPostEscalation(rctx request.CTX, postID string) (*model.PostEscalation, *model.AppError) {
+	escalation, appErr := a.getActivePostEscalation("CancelPostEscalation", postID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	escalation.Stop(model.PostEscalationStatusCanceled)
+	if appErr := a.updatePostEscalation(rctx, escalation); appErr != nil {
+		return nil, appErr
+	}
+
+	return escalation, nil
+}
+
+// EscalatePost notifies the next level of the escalation right away, without waiting for its delay.
+func (a *App) EscalatePost(rctx request.CTX, postID string) (*model.PostEscalation, *model.AppError) {
+	if !a.IsPostEscalationEnabled() {
+		return nil, model.NewAppError("EscalatePost", "app.post_escalation.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	escalation, appErr := a.getActivePostEscalation("EscalatePost", postID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	post, appErr := a.GetSinglePost(rctx, postID, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.notifyPostEscalationLevel(post, escalation.NextLevel()); err != nil {
+		return nil, model.NewAppError("EscalatePost", "app.post_escalation.notify.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	escalation.Advance(model.GetMillis())
+	if appErr := a.updatePostEscalation(rctx, escalation); appErr != nil {
+		return nil, appErr
+	}
+
+	return escalation, nil
+}
+
+func (a *App) getActivePostEscalation(where, postID string) (*model.PostEscalation, *model.AppError) {
+	escalation, appErr := a.GetPostEscalation(postID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if escalation == nil || !escalation.IsActive() {
+		return nil, model.NewAppError(where, "app.post_escalation.not_active.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return escalation, nil
+}
+
+func (a *App) updatePostEscalation(rctx request.CTX, escalation *model.PostEscalation) *model.AppError {
+	if err := a.Srv().Store().PostEscalation().Update(escalation); err != nil {
+		return model.NewAppError("updatePostEscalation", "app.post_escalation.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	a.Srv().Store().Post().InvalidateLastPostTimeCache(escalation.ChannelId)
+	a.sendPostEscalationEvent(rctx, escalation)
+
+	return nil
+}
+
+// resolvePostEscalation stops the escalation of the post once acknowledged by someone other than its author.
+func (a *App) resolvePostEscalation(rctx request.CTX, post *model.Post, userID string) *model.AppError {
+	if userID == post.UserId {
+		return nil
+	}
+
+	escalation, appErr := a.GetPostEscalation(post.Id)
+	if appErr != nil || escalation == nil || !escalation.IsActive() {
+		return appErr
+	}
+
+	escalation.Stop(model.PostEscalationStatusAcknowledged)
+	return a.updatePostEscalation(rctx, escalation)
+}
+
+// ProcessPostEscalations notifies the next level of the escalations that weren't
+// acknowledged in time, stopping the ones acknowledged in the meantime. The level of an
+// escalation failing to be processed is skipped, so that it doesn't hold up the others.
+func (a *App) ProcessPostEscalations(rctx request.CTX) error {
+	for {
+		escalations, err := a.Srv().Store().PostEscalation().GetDue(model.GetMillis(), postEscalationBatchSize)
+		if err != nil {
+			return errors.Wrap(err, "failed to get due post escalations")
+		}
+
+		for _, escalation := range escalations {
+			due := *escalation
+			if err := a.processPostEscalation(rctx, escalation); err != nil {
+				rctx.Logger().Error("Failed to process post escalation, skipping its level", mlog.String("post_id", due.PostId), mlog.Int("level", due.NotifiedLevels), mlog.Err(err))
+
+				due.Advance(model.GetMillis())
+				if appErr := a.updatePostEscalation(rctx, &due); appErr != nil {
+					// Without the skip being saved, the escalation would be returned again.
+					return errors.Wrapf(appErr, "failed to skip escalation for post %s", due.PostId)
+				}
+			}
+		}
+
+		if len(escalations) < postEscalationBatchSize {
+			return nil
+		}
+	}
+}
+
+func (a *App) processPostEscalation(rctx request.CTX, escalation *model.PostEscalation) error {
+	post, err := a.Srv().Store().Post().GetSingle(rctx, escalation.PostId, false)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if !errors.As(err, &nfErr) {
+			return err
+		}
+		// The post was deleted, so there is nothing left to acknowledge.
+		escalation.Stop(model.PostEscalationStatusCanceled)
+		return a.updatePostEscalation(rctx, escalation)
+	}
+
+	acknowledgements, err := a.Srv().Store().PostAcknowledgement().GetForPost(post.Id)
+	if err != nil {
+		return err
+	}
+	for _, acknowledgement := range acknowledgements {
+		if acknowledgement.UserId != post.UserId {
+			escalation.Stop(model.PostEscalationStatusAcknowledged)
+			return a.updatePostEscalation(rctx, escalation)
+		}
+	}
+
+	if err := a.notifyPostEscalationLevel(post, escalation.NextLevel()); err != nil {
+		return err
+	}
+
+	escalation.Advance(model.GetMillis())
+	return a.updatePostEscalation(rctx, escalation)
+}
+
+// notifyPostEscalationLevel sends a persistent notification for the post to the users of the
+// level and to the members of its groups, as long as they are members of the post's channel.
+func (a *App) notifyPostEscalationLevel(post *model.Post, level *model.PostEscalationLevel) error {
+	channelsMap, teamsMap, err := a.channelTeamMapsForPosts([]*model.Post{post})
+	if err != nil {
+		return err
+	}
+	channel, ok := channelsMap[post.ChannelId]
+	if !ok {
+		return errors.Errorf("failed to find channel %s", post.ChannelId)
+	}
+	team := teamsMap[channel.TeamId]
+	if team == nil {
+		team = &model.Team{}
+	}
+
+	profileMap, err := a.Srv().Store().User().GetAllProfilesInChannel(context.Background(), channel.Id, true)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get profiles for channel %s", channel.Id)
+	}
+
+	channelNotifyProps := map[string]map[string]model.StringMap{}
+	if !channel.IsGroupOrDirect() {
+		props, err := a.Srv().Store().Channel().GetAllChannelMembersNotifyPropsForChannel(channel.Id, true)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get notify props for channel %s", channel.Id)
+		}
+		channelNotifyProps[channel.Id] = props
+	}
+
+	recipients := make(model.StringSet)
+	for _, userID := range level.UserIds {
+		recipients.Add(userID)
+	}
+	for _, groupID := range level.GroupIds {
+		members, err := a.Srv().Store().Group().GetMemberUsers(groupID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get members of group %s", groupID)
+		}
+		for _, member := range members {
+			recipients.Add(member.Id)
+		}
+	}
+
+	mentions := &MentionResults{}
+	for userID := range recipients {
+		if user, ok := profileMap[userID]; ok && !user.IsBot {
+			mentions.addMention(userID, KeywordMention)
+		}
+	}
+
+	return a.sendPersistentNotifications(post, channel, team, mentions, profileMap, channelNotifyProps)
+}
+
+func (a *App) sendPostEscalationEvent(rctx request.CTX, escalation *model.PostEscalation) {
+	message := model.NewWebSocketEvent(model.WebsocketEventPostEscalationUpdated, "", escalation.ChannelId, "", nil, "")
+
+	escalationJSON, err := json.Marshal(escalation)
+	if err != nil {
+		rctx.Logger().Warn("Failed to encode post escalation to JSON", mlog.Err(err))
+	}
+	message.Add("escalation", string(escalationJSON))
+	a.Publish(message)
+}
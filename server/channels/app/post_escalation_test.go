@@ -0,0 +1,180 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestPostEscalation(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.PostPriority = true
+		*cfg.ServiceSettings.AllowPostEscalations = true
+	})
+
+	group := th.CreateGroup()
+	_, appErr := th.App.UpsertGroupMember(group.Id, th.BasicUser2.Id)
+	require.Nil(t, appErr)
+
+	newEscalation := func() *model.PostEscalation {
+		return &model.PostEscalation{
+			Levels: model.PostEscalationLevels{
+				{DelayMinutes: 5, UserIds: []string{th.BasicUser2.Id}},
+			},
+			FallbackGroupId:      group.Id,
+			FallbackDelayMinutes: 10,
+		}
+	}
+
+	// makeDue moves the next escalation of the post to the past so that the job processes it.
+	makeDue := func(t *testing.T, postID string) {
+		escalation, appErr := th.App.GetPostEscalation(postID)
+		require.Nil(t, appErr)
+		escalation.NextEscalationAt = model.GetMillis() - 1
+		require.NoError(t, th.App.Srv().Store().PostEscalation().Update(escalation))
+	}
+
+	t.Run("should escalate until the fallback group", func(t *testing.T) {
+		post := th.CreatePost(th.BasicChannel)
+		escalation, appErr := th.App.SetPostEscalation(th.Context, post, newEscalation(), th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.PostEscalationStatusActive, escalation.Status)
+		assert.Equal(t, th.BasicUser.Id, escalation.CreatorId)
+
+		require.NoError(t, th.App.ProcessPostEscalations(th.Context))
+		escalation, appErr = th.App.GetPostEscalation(post.Id)
+		require.Nil(t, appErr)
+		assert.Zero(t, escalation.NotifiedLevels, "escalation shouldn't happen before its delay")
+
+		makeDue(t, post.Id)
+		require.NoError(t, th.App.ProcessPostEscalations(th.Context))
+		escalation, appErr = th.App.GetPostEscalation(post.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, 1, escalation.NotifiedLevels)
+		assert.Equal(t, model.PostEscalationStatusActive, escalation.Status)
+		assert.Greater(t, escalation.NextEscalationAt, model.GetMillis())
+
+		makeDue(t, post.Id)
+		require.NoError(t, th.App.ProcessPostEscalations(th.Context))
+		escalation, appErr = th.App.GetPostEscalation(post.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, 2, escalation.NotifiedLevels)
+		assert.Equal(t, model.PostEscalationStatusExhausted, escalation.Status)
+	})
+
+	t.Run("should skip the level of an escalation failing to be processed", func(t *testing.T) {
+		channel := th.CreateChannel(th.Context, th.BasicTeam)
+		failing := th.CreatePost(channel)
+		_, appErr := th.App.SetPostEscalation(th.Context, failing, newEscalation(), th.BasicUser.Id)
+		require.Nil(t, appErr)
+		post := th.CreatePost(th.BasicChannel)
+		_, appErr = th.App.SetPostEscalation(th.Context, post, newEscalation(), th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		// Without its channel, the level of the escalation can't be notified.
+		require.NoError(t, th.App.Srv().Store().Channel().PermanentDelete(th.Context, channel.Id))
+		makeDue(t, failing.Id)
+		makeDue(t, post.Id)
+		require.NoError(t, th.App.ProcessPostEscalations(th.Context))
+
+		escalation, appErr := th.App.GetPostEscalation(failing.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, 1, escalation.NotifiedLevels)
+		assert.Greater(t, escalation.NextEscalationAt, model.GetMillis())
+
+		escalation, appErr = th.App.GetPostEscalation(post.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, 1, escalation.NotifiedLevels)
+	})
+
+	t.Run("should stop once acknowledged by someone other than the author", func(t *testing.T) {
+		post := th.CreatePost(th.BasicChannel)
+		_, appErr := th.App.SetPostEscalation(th.Context, post, newEscalation(), th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.SaveAcknowledgementForPost(th.Context, post.Id, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		escalation, appErr := th.App.GetPostEscalation(post.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.PostEscalationStatusActive, escalation.Status)
+
+		_, appErr = th.App.SaveAcknowledgementForPost(th.Context, post.Id, th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		escalation, appErr = th.App.GetPostEscalation(post.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.PostEscalationStatusAcknowledged, escalation.Status)
+		assert.Zero(t, escalation.NextEscalationAt)
+	})
+
+	t.Run("should escalate manually and cancel", func(t *testing.T) {
+		post := th.CreatePost(th.BasicChannel)
+		_, appErr := th.App.SetPostEscalation(th.Context, post, newEscalation(), th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		escalation, appErr := th.App.EscalatePost(th.Context, post.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, 1, escalation.NotifiedLevels)
+
+		escalation, appErr = th.App.CancelPostEscalation(th.Context, post.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, model.PostEscalationStatusCanceled, escalation.Status)
+
+		_, appErr = th.App.EscalatePost(th.Context, post.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("should include the escalation in the post metadata", func(t *testing.T) {
+		post := th.CreatePost(th.BasicChannel)
+		_, appErr := th.App.SetPostEscalation(th.Context, post, newEscalation(), th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		clientPost := th.App.PreparePostForClient(th.Context, post, false, false, true)
+		require.NotNil(t, clientPost.Metadata.Escalation)
+		assert.Equal(t, post.Id, clientPost.Metadata.Escalation.PostId)
+	})
+
+	t.Run("should reject invalid escalations", func(t *testing.T) {
+		post := th.CreatePost(th.BasicChannel)
+
+		reply := th.CreatePostReply(post)
+		_, appErr := th.App.SetPostEscalation(th.Context, reply, newEscalation(), th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_escalation.root_post.app_error", appErr.Id)
+
+		escalation := newEscalation()
+		escalation.Levels[0].UserIds = []string{model.NewId()}
+		_, appErr = th.App.SetPostEscalation(th.Context, post, escalation, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_escalation.invalid_users.app_error", appErr.Id)
+
+		escalation = newEscalation()
+		escalation.FallbackGroupId = model.NewId()
+		_, appErr = th.App.SetPostEscalation(th.Context, post, escalation, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_escalation.invalid_groups.app_error", appErr.Id)
+	})
+
+	t.Run("should be disabled by configuration", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.AllowPostEscalations = false
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.AllowPostEscalations = true
+		})
+
+		_, appErr := th.App.SetPostEscalation(th.Context, th.CreatePost(th.BasicChannel), newEscalation(), th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotImplemented, appErr.StatusCode)
+	})
+}
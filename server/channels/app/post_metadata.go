@@ -81,6 +81,16 @@ func (a *App) PreparePostListForClient(c request.CTX, originalList *model.PostLi
 		}
 	}
 
+	if a.IsPostEscalationEnabled() {
+		escalations, _ := a.GetEscalationsForPostList(list)
+
+		for id, escalation := range escalations {
+			if post, ok := list.Posts[id]; ok {
+				post.Metadata.Escalation = escalation
+			}
+		}
+	}
+
 	return list
 }
 
@@ -157,6 +167,15 @@ func (a *App) PreparePostForClient(c request.CTX, originalPost *model.Post, isNe
 		} else {
 			post.Metadata.Acknowledgements = acknowledgements
 		}
+
+		// Post's escalation if any
+		if a.IsPostEscalationEnabled() {
+			if escalation, err := a.GetPostEscalation(post.Id); err != nil {
+				c.Logger().Warn("Failed to get post escalation for a post", mlog.String("post_id", post.Id), mlog.Err(err))
+			} else {
+				post.Metadata.Escalation = escalation
+			}
+		}
 	}
 
 	return post
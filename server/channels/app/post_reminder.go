@@ -0,0 +1,198 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+const postReminderMaxSnoozeMinutes = 7 * 24 * 60
+
+func (a *App) GetPostRemindersForUser(userID string) ([]*model.PostReminder, *model.AppError) {
+	reminders, err := a.Srv().Store().Post().GetPostRemindersForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetPostRemindersForUser", "app.post_reminder.get_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return reminders, nil
+}
+
+func (a *App) GetPostReminder(postID, userID string) (*model.PostReminder, *model.AppError) {
+	reminder, err := a.Srv().Store().Post().GetPostReminder(postID, userID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetPostReminder", "app.post_reminder.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetPostReminder", "app.post_reminder.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return reminder, nil
+}
+
+// CreateStandalonePostReminder creates a reminder about its own message rather than about a post.
+func (a *App) CreateStandalonePostReminder(rctx request.CTX, reminder *model.PostReminder) (*model.PostReminder, *model.AppError) {
+	if reminder.Message == "" {
+		return nil, model.NewAppError("CreateStandalonePostReminder", "app.post_reminder.create.missing_message.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	reminder.PostId = model.NewId()
+	if appErr := a.SetPostReminder(rctx, reminder); appErr != nil {
+		return nil, appErr
+	}
+
+	return reminder, nil
+}
+
+// ReschedulePostReminder moves a pending reminder of the user to the given time.
+func (a *App) ReschedulePostReminder(rctx request.CTX, postID, userID string, targetTime int64) (*model.PostReminder, *model.AppError) {
+	reminder, appErr := a.GetPostReminder(postID, userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	reminder.TargetTime = targetTime
+	if appErr := reminder.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.Srv().Store().Post().SetPostReminder(reminder); err != nil {
+		return nil, model.NewAppError("ReschedulePostReminder", "app.post_reminder.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return reminder, nil
+}
+
+func (a *App) DeletePostReminder(postID, userID string) *model.AppError {
+	if err := a.Srv().Store().Post().DeletePostReminder(postID, userID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeletePostReminder", "app.post_reminder.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeletePostReminder", "app.post_reminder.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// SnoozePostReminder reminds the user again, in the given number of minutes, of the reminder
+// sent to them as reminderPostID by the system bot.
+func (a *App) SnoozePostReminder(rctx request.CTX, reminderPostID, userID string, minutes int) (*model.PostReminder, *model.AppError) {
+	if minutes <= 0 || minutes > postReminderMaxSnoozeMinutes {
+		return nil, model.NewAppError("SnoozePostReminder", "app.post_reminder.snooze.invalid_duration.app_error", map[string]any{"Max": postReminderMaxSnoozeMinutes}, "", http.StatusBadRequest)
+	}
+
+	post, appErr := a.GetSinglePost(rctx, reminderPostID, false)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	systemBot, appErr := a.GetSystemBot(rctx)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	channel, appErr := a.GetChannel(rctx, post.ChannelId)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if post.Type != model.PostTypeReminder || post.UserId != systemBot.UserId || channel.Name != model.GetDMNameFromIds(userID, systemBot.UserId) {
+		return nil, model.NewAppError("SnoozePostReminder", "app.post_reminder.snooze.not_reminder.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	reminder := &model.PostReminder{
+		UserId:     userID,
+		TargetTime: time.Now().Add(time.Duration(minutes) * time.Minute).Unix(),
+	}
+
+	if reminderID, _ := post.GetProp("reminder_id").(string); reminderID != "" {
+		// The snoozed occurrence of a standalone reminder gets its own id, so that it doesn't
+		// replace the next occurrence of a recurring reminder.
+		reminder.PostId = model.NewId()
+		reminder.Message, _ = post.GetProp("message").(string)
+	} else {
+		reminder.PostId, _ = post.GetProp("post_id").(string)
+	}
+
+	if appErr := a.SetPostReminder(rctx, reminder); appErr != nil {
+		return nil, appErr
+	}
+
+	return reminder, nil
+}
+
+// makePostReminderMessage returns the message sent by the system bot for the reminder.
+func (a *App) makePostReminderMessage(reminder *model.PostReminder) (*model.Post, error) {
+	if reminder.IsStandalone() {
+		user, err := a.Srv().Store().User().Get(context.Background(), reminder.UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		T := i18n.GetUserTranslations(user.Locale)
+		return &model.Post{
+			Message: T("app.post_reminder_standalone_dm", model.StringInterface{
+				"Message": reminder.Message,
+			}),
+			Type: model.PostTypeReminder,
+			Props: model.StringInterface{
+				"reminder_id":    reminder.PostId,
+				"message":        reminder.Message,
+				"snooze_minutes": model.PostReminderSnoozeMinutes,
+			},
+		}, nil
+	}
+
+	metadata, err := a.Srv().Store().Post().GetPostReminderMetadata(reminder.PostId)
+	if err != nil {
+		return nil, err
+	}
+
+	T := i18n.GetUserTranslations(metadata.UserLocale)
+	return &model.Post{
+		Message: T("app.post_reminder_dm", model.StringInterface{
+			"SiteURL":  *a.Config().ServiceSettings.SiteURL,
+			"TeamName": metadata.TeamName,
+			"PostId":   reminder.PostId,
+			"Username": metadata.Username,
+		}),
+		Type: model.PostTypeReminder,
+		Props: model.StringInterface{
+			"team_name":      metadata.TeamName,
+			"post_id":        reminder.PostId,
+			"username":       metadata.Username,
+			"snooze_minutes": model.PostReminderSnoozeMinutes,
+		},
+	}, nil
+}
+
+// rescheduleRecurringPostReminder saves the next occurrence of a reminder that was just sent,
+// in the timezone of the user.
+func (a *App) rescheduleRecurringPostReminder(rctx request.CTX, reminder *model.PostReminder) {
+	user, err := a.Srv().Store().User().Get(context.Background(), reminder.UserId)
+	if err != nil {
+		rctx.Logger().Error("Failed to get user of recurring post reminder", mlog.String("user_id", reminder.UserId), mlog.Err(err))
+		return
+	}
+
+	next := *reminder
+	next.TargetTime = reminder.NextTargetTime(time.Now(), user.GetTimezoneLocation())
+	if err := a.Srv().Store().Post().SetPostReminder(&next); err != nil {
+		rctx.Logger().Error("Failed to reschedule recurring post reminder", mlog.String("post_id", reminder.PostId), mlog.Err(err))
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestStandalonePostReminder(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	getReminderDM := func(t *testing.T) *model.Post {
+		t.Helper()
+		systemBot, appErr := th.App.GetSystemBot(th.Context)
+		require.Nil(t, appErr)
+		ch, appErr := th.App.GetOrCreateDirectChannel(th.Context, th.BasicUser.Id, systemBot.UserId)
+		require.Nil(t, appErr)
+		posts, appErr := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: ch.Id, PerPage: 1})
+		require.Nil(t, appErr)
+		require.Len(t, posts.Order, 1)
+		return posts.Posts[posts.Order[0]]
+	}
+
+	t.Run("a message is required", func(t *testing.T) {
+		_, appErr := th.App.CreateStandalonePostReminder(th.Context, &model.PostReminder{
+			UserId:     th.BasicUser.Id,
+			TargetTime: time.Now().Add(time.Hour).Unix(),
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_reminder.create.missing_message.app_error", appErr.Id)
+	})
+
+	t.Run("a recurring reminder is sent and rescheduled", func(t *testing.T) {
+		targetTime := time.Now().Add(-time.Minute).Unix()
+		reminder, appErr := th.App.CreateStandalonePostReminder(th.Context, &model.PostReminder{
+			UserId:     th.BasicUser.Id,
+			Message:    "Water the plants",
+			Recurrence: model.PostReminderRecurrenceDaily,
+			TargetTime: targetTime,
+		})
+		require.Nil(t, appErr)
+
+		th.App.CheckPostReminders(th.Context)
+
+		dm := getReminderDM(t)
+		assert.Equal(t, model.PostTypeReminder, dm.Type)
+		assert.Contains(t, dm.Message, "Water the plants")
+		assert.Equal(t, reminder.PostId, dm.GetProp("reminder_id"))
+
+		next, appErr := th.App.GetPostReminder(reminder.PostId, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Greater(t, next.TargetTime, time.Now().Unix())
+		assert.Equal(t, model.PostReminderRecurrenceDaily, next.Recurrence)
+
+		appErr = th.App.DeletePostReminder(reminder.PostId, th.BasicUser.Id)
+		require.Nil(t, appErr)
+		_, appErr = th.App.GetPostReminder(reminder.PostId, th.BasicUser.Id)
+		require.NotNil(t, appErr)
+	})
+
+	t.Run("a sent reminder can be snoozed", func(t *testing.T) {
+		_, appErr := th.App.CreateStandalonePostReminder(th.Context, &model.PostReminder{
+			UserId:     th.BasicUser.Id,
+			Message:    "Call Bob",
+			TargetTime: time.Now().Add(-time.Minute).Unix(),
+		})
+		require.Nil(t, appErr)
+
+		th.App.CheckPostReminders(th.Context)
+		dm := getReminderDM(t)
+
+		_, appErr = th.App.SnoozePostReminder(th.Context, dm.Id, th.BasicUser.Id, 0)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_reminder.snooze.invalid_duration.app_error", appErr.Id)
+
+		_, appErr = th.App.SnoozePostReminder(th.Context, dm.Id, th.BasicUser2.Id, 20)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.post_reminder.snooze.not_reminder.app_error", appErr.Id)
+
+		_, appErr = th.App.SnoozePostReminder(th.Context, th.BasicPost.Id, th.BasicUser.Id, 20)
+		require.NotNil(t, appErr)
+
+		snoozed, appErr := th.App.SnoozePostReminder(th.Context, dm.Id, th.BasicUser.Id, 20)
+		require.Nil(t, appErr)
+		assert.Equal(t, "Call Bob", snoozed.Message)
+		assert.InDelta(t, time.Now().Add(20*time.Minute).Unix(), snoozed.TargetTime, 5)
+
+		reminders, appErr := th.App.GetPostRemindersForUser(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		require.Len(t, reminders, 1)
+		assert.Equal(t, snoozed.PostId, reminders[0].PostId)
+	})
+}
@@ -6,9 +6,12 @@ package app
 import (
 	"math"
 	"net/http"
+	"path"
+	"slices"
 	"strconv"
 	"strings"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	"github.com/throttled/throttled"
 	"github.com/throttled/throttled/store/memstore"
@@ -17,14 +20,29 @@ import (
 	"github.com/mattermost/mattermost/server/public/shared/i18n"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/v8/channels/utils"
+	"github.com/mattermost/mattermost/server/v8/platform/services/cache"
 )
 
+// RateLimitCacheName is the name of the external cache holding the rate limit state when
+// RateLimitSettings.UseCacheStore is enabled.
+const RateLimitCacheName = "rate_limit"
+
 type RateLimiter struct {
 	throttledRateLimiter *throttled.GCRARateLimiter
 	useAuth              bool
 	useIP                bool
 	header               string
 	trustedProxyIPHeader []string
+	classes              []*rateLimitClass
+}
+
+// rateLimitClass is a limiter applied to the requests matching a model.RateLimitClass.
+type rateLimitClass struct {
+	name    string
+	routes  []string
+	methods []string
+	roles   []string
+	limiter *throttled.GCRARateLimiter
 }
 
 func NewRateLimiter(settings *model.RateLimitSettings, trustedProxyIPHeader []string) (*RateLimiter, error) {
@@ -33,6 +51,12 @@ func NewRateLimiter(settings *model.RateLimitSettings, trustedProxyIPHeader []st
 		return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_memory_store"))
 	}
 
+	return NewRateLimiterWithStore(store, settings, trustedProxyIPHeader, "/")
+}
+
+// NewRateLimiterWithStore creates a rate limiter keeping its state in the given store. The routes of
+// the configured classes are resolved relative to subpath.
+func NewRateLimiterWithStore(store throttled.GCRAStore, settings *model.RateLimitSettings, trustedProxyIPHeader []string, subpath string) (*RateLimiter, error) {
 	quota := throttled.RateQuota{
 		MaxRate:  throttled.PerSec(*settings.PerSec),
 		MaxBurst: *settings.MaxBurst,
@@ -43,15 +67,75 @@ func NewRateLimiter(settings *model.RateLimitSettings, trustedProxyIPHeader []st
 		return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_rate_limiter"))
 	}
 
+	classes := make([]*rateLimitClass, 0, len(settings.Classes))
+	for _, class := range settings.Classes {
+		if class == nil {
+			continue
+		}
+
+		limiter, err := throttled.NewGCRARateLimiter(store, throttled.RateQuota{
+			MaxRate:  throttled.PerSec(*class.PerSec),
+			MaxBurst: *class.MaxBurst,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_rate_limiter"))
+		}
+
+		routes := make([]string, 0, len(class.Routes))
+		for _, route := range class.Routes {
+			if subpath != "" && subpath != "/" {
+				route = path.Join(subpath, route)
+			}
+			routes = append(routes, route)
+		}
+
+		methods := make([]string, 0, len(class.Methods))
+		for _, method := range class.Methods {
+			methods = append(methods, strings.ToUpper(method))
+		}
+
+		classes = append(classes, &rateLimitClass{
+			name:    *class.Name,
+			routes:  routes,
+			methods: methods,
+			roles:   class.Roles,
+			limiter: limiter,
+		})
+	}
+
 	return &RateLimiter{
 		throttledRateLimiter: throttledRateLimiter,
 		useAuth:              *settings.VaryByUser,
 		useIP:                *settings.VaryByRemoteAddr,
 		header:               settings.VaryByHeader,
 		trustedProxyIPHeader: trustedProxyIPHeader,
+		classes:              classes,
 	}, nil
 }
 
+// newRateLimitStore returns the external cache as a rate limit store when it is enabled in the
+// settings and supported by the cache provider, so that limits apply across the cluster. It returns
+// nil when the state should be kept in memory instead.
+func newRateLimitStore(provider cache.Provider, settings *model.RateLimitSettings) (throttled.GCRAStore, error) {
+	if !*settings.UseCacheStore || provider.Type() != model.CacheTypeRedis {
+		return nil, nil
+	}
+
+	c, err := provider.NewCache(&cache.CacheOptions{
+		Name: RateLimitCacheName,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create rate limit cache")
+	}
+
+	store, ok := c.(cache.ExternalCache)
+	if !ok {
+		return nil, nil
+	}
+
+	return store, nil
+}
+
 func (rl *RateLimiter) GenerateKey(r *http.Request) string {
 	key := ""
 
@@ -76,7 +160,11 @@ func (rl *RateLimiter) GenerateKey(r *http.Request) string {
 }
 
 func (rl *RateLimiter) RateLimitWriter(key string, w http.ResponseWriter) bool {
-	limited, context, err := rl.throttledRateLimiter.RateLimit(key, 1)
+	return rateLimitWriter(rl.throttledRateLimiter, key, w)
+}
+
+func rateLimitWriter(limiter *throttled.GCRARateLimiter, key string, w http.ResponseWriter) bool {
+	limited, context, err := limiter.RateLimit(key, 1)
 	if err != nil {
 		mlog.Error("Internal server error when rate limiting. Rate Limiting broken.", mlog.Err(err))
 		return false
@@ -99,6 +187,75 @@ func (rl *RateLimiter) UserIdRateLimit(userID string, w http.ResponseWriter) boo
 	return false
 }
 
+// ClassRateLimitByAddr applies the limits of every class without roles matching the request.
+// These classes do not need a user, so they are applied before the session is looked up and
+// requests are counted per remote address.
+func (rl *RateLimiter) ClassRateLimitByAddr(r *http.Request, w http.ResponseWriter) bool {
+	if len(rl.classes) == 0 {
+		return false
+	}
+
+	key := utils.GetIPAddress(r, rl.trustedProxyIPHeader)
+	for _, class := range rl.classes {
+		if len(class.roles) > 0 || !class.matches(r, nil) {
+			continue
+		}
+
+		if rateLimitWriter(class.limiter, class.name+":"+key, w) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClassRateLimit applies the limits of every class with roles matching the request and the
+// session's user. Requests are counted per user.
+func (rl *RateLimiter) ClassRateLimit(session *model.Session, r *http.Request, w http.ResponseWriter) bool {
+	if len(rl.classes) == 0 || session == nil || session.UserId == "" {
+		return false
+	}
+
+	for _, class := range rl.classes {
+		if len(class.roles) == 0 || !class.matches(r, session) {
+			continue
+		}
+
+		if rateLimitWriter(class.limiter, class.name+":"+session.UserId, w) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *rateLimitClass) matches(r *http.Request, session *model.Session) bool {
+	if len(c.methods) > 0 && !slices.Contains(c.methods, r.Method) {
+		return false
+	}
+
+	if len(c.routes) > 0 && !slices.ContainsFunc(c.routes, func(route string) bool {
+		return r.URL.Path == route || strings.HasPrefix(r.URL.Path, strings.TrimSuffix(route, "/")+"/")
+	}) {
+		return false
+	}
+
+	if len(c.roles) > 0 {
+		if session == nil || session.UserId == "" {
+			return false
+		}
+
+		userRoles := session.GetUserRoles()
+		if !slices.ContainsFunc(c.roles, func(role string) bool {
+			return slices.Contains(userRoles, role)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (rl *RateLimiter) RateLimitHandler(wrappedHandler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		key := rl.GenerateKey(r)
@@ -109,6 +266,84 @@ func (rl *RateLimiter) RateLimitHandler(wrappedHandler http.Handler) http.Handle
 	})
 }
 
+// IncomingWebhookRateLimiterMemoryStoreSize is the number of incoming webhooks whose rate limit
+// state, and limiter, is kept in memory.
+const IncomingWebhookRateLimiterMemoryStoreSize = 10000
+
+// IncomingWebhookRateLimiter enforces the optional per-hook quotas configured on incoming webhooks.
+// All hooks share a single store, keyed by hook id, while each hook gets its own limiter for its
+// current quota. The limiters of the least recently used hooks are evicted, which also drops the
+// ones of deleted hooks.
+type IncomingWebhookRateLimiter struct {
+	store    throttled.GCRAStore
+	limiters *lru.Cache
+}
+
+type incomingWebhookLimiter struct {
+	quota   throttled.RateQuota
+	limiter *throttled.GCRARateLimiter
+}
+
+func NewIncomingWebhookRateLimiter(store throttled.GCRAStore) (*IncomingWebhookRateLimiter, error) {
+	limiters, err := lru.New(IncomingWebhookRateLimiterMemoryStoreSize)
+	if err != nil {
+		return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_rate_limiter"))
+	}
+
+	return &IncomingWebhookRateLimiter{
+		store:    store,
+		limiters: limiters,
+	}, nil
+}
+
+func newIncomingWebhookMemoryRateLimiter() (*IncomingWebhookRateLimiter, error) {
+	store, err := memstore.New(IncomingWebhookRateLimiterMemoryStoreSize)
+	if err != nil {
+		return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_memory_store"))
+	}
+
+	return NewIncomingWebhookRateLimiter(store)
+}
+
+// RateLimit records a request to the hook and reports whether it exceeds the hook's quota.
+// Hooks without a rate limit are never limited.
+func (rl *IncomingWebhookRateLimiter) RateLimit(hook *model.IncomingWebhook) (bool, throttled.RateLimitResult, error) {
+	if !hook.HasRateLimit() {
+		return false, throttled.RateLimitResult{Limit: -1, Remaining: -1, ResetAfter: -1, RetryAfter: -1}, nil
+	}
+
+	limiter, err := rl.getLimiter(hook)
+	if err != nil {
+		return false, throttled.RateLimitResult{}, err
+	}
+
+	return limiter.RateLimit(hook.Id, 1)
+}
+
+func (rl *IncomingWebhookRateLimiter) getLimiter(hook *model.IncomingWebhook) (*throttled.GCRARateLimiter, error) {
+	quota := throttled.RateQuota{
+		MaxRate:  throttled.PerMin(hook.RateLimitPerMinute),
+		MaxBurst: hook.RateLimitMaxBurst(),
+	}
+
+	// The quota changes whenever the hook is updated, in which case a new limiter replaces the
+	// hook's previous one. Concurrent requests may both create it, which is harmless as the
+	// limiters keep their state in the shared store.
+	if v, ok := rl.limiters.Get(hook.Id); ok {
+		if l := v.(*incomingWebhookLimiter); l.quota == quota {
+			return l.limiter, nil
+		}
+	}
+
+	limiter, err := throttled.NewGCRARateLimiter(rl.store, quota)
+	if err != nil {
+		return nil, errors.Wrap(err, i18n.T("api.server.start_server.rate_limiting_rate_limiter"))
+	}
+	rl.limiters.Add(hook.Id, &incomingWebhookLimiter{quota: quota, limiter: limiter})
+
+	return limiter, nil
+}
+
 // Copied from https://github.com/throttled/throttled http.go
 func setRateLimitHeaders(w http.ResponseWriter, context throttled.RateLimitResult) {
 	if v := context.Limit; v >= 0 {
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/throttled/throttled/store/memstore"
 
 	"github.com/mattermost/mattermost/server/public/model"
 )
@@ -103,3 +104,128 @@ func TestGenerateKey_TrustedHeader(t *testing.T) {
 	key = rateLimiter.GenerateKey(req)
 	require.Equal(t, "10.10.10.5", key, "Wrong key on test without allowed trusted proxy header")
 }
+
+func TestIncomingWebhookRateLimiter(t *testing.T) {
+	rl, err := newIncomingWebhookMemoryRateLimiter()
+	require.NoError(t, err)
+
+	t.Run("hook without a rate limit", func(t *testing.T) {
+		hook := &model.IncomingWebhook{Id: model.NewId()}
+		for i := 0; i < 100; i++ {
+			limited, _, err := rl.RateLimit(hook)
+			require.NoError(t, err)
+			require.False(t, limited)
+		}
+	})
+
+	t.Run("hook with a rate limit", func(t *testing.T) {
+		hook := &model.IncomingWebhook{Id: model.NewId(), RateLimitPerMinute: 1, RateLimitBurst: 2}
+		for i := 0; i < 3; i++ {
+			limited, _, err := rl.RateLimit(hook)
+			require.NoError(t, err)
+			require.False(t, limited)
+		}
+
+		limited, result, err := rl.RateLimit(hook)
+		require.NoError(t, err)
+		require.True(t, limited)
+		require.Positive(t, result.RetryAfter)
+
+		otherHook := &model.IncomingWebhook{Id: model.NewId(), RateLimitPerMinute: 1, RateLimitBurst: 2}
+		limited, _, err = rl.RateLimit(otherHook)
+		require.NoError(t, err)
+		require.False(t, limited, "hooks should have separate quotas")
+	})
+
+	t.Run("hook with an updated rate limit", func(t *testing.T) {
+		hook := &model.IncomingWebhook{Id: model.NewId(), RateLimitPerMinute: 1, RateLimitBurst: 1}
+		for i := 0; i < 2; i++ {
+			limited, _, err := rl.RateLimit(hook)
+			require.NoError(t, err)
+			require.False(t, limited)
+		}
+
+		limited, _, err := rl.RateLimit(hook)
+		require.NoError(t, err)
+		require.True(t, limited)
+
+		hook.RateLimitPerMinute = 0
+		limited, _, err = rl.RateLimit(hook)
+		require.NoError(t, err)
+		require.False(t, limited)
+	})
+
+	t.Run("hook keeps a single limiter", func(t *testing.T) {
+		hook := &model.IncomingWebhook{Id: model.NewId(), RateLimitPerMinute: 1}
+		_, _, err := rl.RateLimit(hook)
+		require.NoError(t, err)
+		count := rl.limiters.Len()
+
+		hook.RateLimitPerMinute = 2
+		_, _, err = rl.RateLimit(hook)
+		require.NoError(t, err)
+		require.Equal(t, count, rl.limiters.Len(), "the limiter of the previous quota should be replaced")
+	})
+}
+
+func TestClassRateLimit(t *testing.T) {
+	settings := genRateLimitSettings(false, true, "")
+	settings.Classes = []*model.RateLimitClass{
+		{
+			Name:     model.NewPointer("login"),
+			Routes:   []string{"/api/v4/users/login"},
+			Methods:  []string{"post"},
+			PerSec:   model.NewPointer(1),
+			MaxBurst: model.NewPointer(1),
+		},
+		{
+			Name:     model.NewPointer("guests"),
+			Roles:    []string{model.SystemGuestRoleId},
+			PerSec:   model.NewPointer(1),
+			MaxBurst: model.NewPointer(0),
+		},
+	}
+
+	store, err := memstore.New(100)
+	require.NoError(t, err)
+	rateLimiter, err := NewRateLimiterWithStore(store, settings, nil, "/chat")
+	require.NoError(t, err)
+
+	newRequest := func(method, path, remoteAddr string) *http.Request {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		return req
+	}
+
+	t.Run("route and method", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			w := httptest.NewRecorder()
+			require.False(t, rateLimiter.ClassRateLimitByAddr(newRequest(http.MethodPost, "/chat/api/v4/users/login", "10.0.0.1:80"), w))
+		}
+
+		w := httptest.NewRecorder()
+		require.True(t, rateLimiter.ClassRateLimitByAddr(newRequest(http.MethodPost, "/chat/api/v4/users/login", "10.0.0.1:80"), w))
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		require.NotEmpty(t, w.Header().Get("Retry-After"))
+
+		require.False(t, rateLimiter.ClassRateLimitByAddr(newRequest(http.MethodPost, "/chat/api/v4/users/login", "10.0.0.2:80"), httptest.NewRecorder()), "addresses should have separate quotas")
+		require.False(t, rateLimiter.ClassRateLimitByAddr(newRequest(http.MethodGet, "/chat/api/v4/users/login", "10.0.0.1:80"), httptest.NewRecorder()), "other methods should not match")
+		require.False(t, rateLimiter.ClassRateLimitByAddr(newRequest(http.MethodPost, "/chat/api/v4/users/login_extra", "10.0.0.1:80"), httptest.NewRecorder()), "other routes should not match")
+		require.False(t, rateLimiter.ClassRateLimitByAddr(newRequest(http.MethodPost, "/api/v4/users/login", "10.0.0.1:80"), httptest.NewRecorder()), "routes should be relative to the subpath")
+		require.False(t, rateLimiter.ClassRateLimit(&model.Session{UserId: model.NewId()}, newRequest(http.MethodPost, "/chat/api/v4/users/login", "10.0.0.1:80"), httptest.NewRecorder()), "classes without roles should only apply by address")
+	})
+
+	t.Run("roles", func(t *testing.T) {
+		guest := &model.Session{UserId: model.NewId(), Roles: model.SystemGuestRoleId}
+		user := &model.Session{UserId: model.NewId(), Roles: model.SystemUserRoleId}
+
+		require.False(t, rateLimiter.ClassRateLimit(guest, newRequest(http.MethodGet, "/chat/api/v4/posts", "10.0.0.3:80"), httptest.NewRecorder()))
+		require.True(t, rateLimiter.ClassRateLimit(guest, newRequest(http.MethodGet, "/chat/api/v4/posts", "10.0.0.3:80"), httptest.NewRecorder()))
+
+		for i := 0; i < 3; i++ {
+			require.False(t, rateLimiter.ClassRateLimit(user, newRequest(http.MethodGet, "/chat/api/v4/posts", "10.0.0.3:80"), httptest.NewRecorder()))
+			require.False(t, rateLimiter.ClassRateLimit(nil, newRequest(http.MethodGet, "/chat/api/v4/posts", "10.0.0.3:80"), httptest.NewRecorder()))
+			require.False(t, rateLimiter.ClassRateLimitByAddr(newRequest(http.MethodGet, "/chat/api/v4/posts", "10.0.0.3:80"), httptest.NewRecorder()), "classes with roles should only apply by user")
+		}
+	})
+}
@@ -4,12 +4,16 @@
 package app
 
 import (
+	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
 )
 
 func (a *App) SaveScheduledPost(rctx request.CTX, scheduledPost *model.ScheduledPost, connectionId string) (*model.ScheduledPost, *model.AppError) {
@@ -113,6 +117,87 @@ func (a *App) DeleteScheduledPost(rctx request.CTX, userId, scheduledPostId, con
 	return scheduledPost, nil
 }
 
+// UpdateScheduledPostSeries pauses, resumes, skips the next occurrence of, or ends a recurring
+// scheduled post. Ending a series turns its pending occurrence into a regular scheduled post.
+// Skipping the last occurrence of a series deletes the scheduled post.
+func (a *App) UpdateScheduledPostSeries(rctx request.CTX, userId, scheduledPostId string, action model.ScheduledPostSeriesAction, connectionId string) (*model.ScheduledPost, *model.AppError) {
+	scheduledPost, err := a.Srv().Store().ScheduledPost().Get(scheduledPostId)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) || errors.Is(err, sql.ErrNoRows) {
+			return nil, model.NewAppError("app.UpdateScheduledPostSeries", "app.update_scheduled_post.existing_scheduled_post.not_exist", map[string]any{"user_id": userId, "scheduled_post_id": scheduledPostId}, "", http.StatusNotFound).Wrap(err)
+		}
+		return nil, model.NewAppError("app.UpdateScheduledPostSeries", "app.update_scheduled_post.get_scheduled_post.error", map[string]any{"user_id": userId, "scheduled_post_id": scheduledPostId}, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if scheduledPost.UserId != userId {
+		return nil, model.NewAppError("app.UpdateScheduledPostSeries", "app.update_scheduled_post.update_permission.error", map[string]any{"user_id": userId, "scheduled_post_id": scheduledPostId}, "", http.StatusForbidden)
+	}
+
+	if !scheduledPost.IsRecurring() {
+		return nil, model.NewAppError("app.UpdateScheduledPostSeries", "app.update_scheduled_post_series.not_recurring.app_error", map[string]any{"user_id": userId, "scheduled_post_id": scheduledPostId}, "", http.StatusBadRequest)
+	}
+
+	switch action {
+	case model.ScheduledPostSeriesPause:
+		scheduledPost.RecurrencePaused = true
+	case model.ScheduledPostSeriesResume:
+		scheduledPost.RecurrencePaused = false
+		scheduledPost.ErrorCode = ""
+		// Occurrences missed while the series was paused are not sent.
+		if now := model.GetMillis(); scheduledPost.ScheduledAt < now {
+			nextOccurrence, appErr := a.nextScheduledPostOccurrence(scheduledPost, now)
+			if appErr != nil {
+				return nil, appErr
+			}
+			if nextOccurrence == 0 {
+				return a.DeleteScheduledPost(rctx, userId, scheduledPostId, connectionId)
+			}
+			scheduledPost.ScheduledAt = nextOccurrence
+		}
+	case model.ScheduledPostSeriesSkip:
+		nextOccurrence, appErr := a.nextScheduledPostOccurrence(scheduledPost, max(scheduledPost.ScheduledAt, model.GetMillis()))
+		if appErr != nil {
+			return nil, appErr
+		}
+		if nextOccurrence == 0 {
+			return a.DeleteScheduledPost(rctx, userId, scheduledPostId, connectionId)
+		}
+		scheduledPost.ScheduledAt = nextOccurrence
+	case model.ScheduledPostSeriesEnd:
+		scheduledPost.Recurrence = ""
+		scheduledPost.RecurrencePaused = false
+		scheduledPost.RecurrenceEndAt = 0
+	default:
+		return nil, model.NewAppError("app.UpdateScheduledPostSeries", "app.update_scheduled_post_series.invalid_action.app_error", map[string]any{"action": action}, "", http.StatusBadRequest)
+	}
+
+	if err := a.Srv().Store().ScheduledPost().UpdatedScheduledPost(scheduledPost); err != nil {
+		return nil, model.NewAppError("app.UpdateScheduledPostSeries", "app.update_scheduled_post.update.error", map[string]any{"user_id": userId, "scheduled_post_id": scheduledPostId}, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	a.PublishScheduledPostEvent(rctx, model.WebsocketScheduledPostUpdated, scheduledPost, connectionId)
+
+	return scheduledPost, nil
+}
+
+// nextScheduledPostOccurrence computes the next occurrence of a recurring scheduled post after
+// the given time, in the timezone of the user who scheduled it. It returns 0 once the series
+// has ended.
+func (a *App) nextScheduledPostOccurrence(scheduledPost *model.ScheduledPost, after int64) (int64, *model.AppError) {
+	loc := time.UTC
+	if user, appErr := a.GetUser(scheduledPost.UserId); appErr == nil {
+		loc = user.GetTimezoneLocation()
+	}
+
+	nextOccurrence, err := scheduledPost.NextOccurrence(after, loc)
+	if err != nil {
+		return 0, model.NewAppError("app.nextScheduledPostOccurrence", "model.scheduled_post.is_valid.recurrence.app_error", map[string]any{"scheduled_post_id": scheduledPost.Id}, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	return nextOccurrence, nil
+}
+
 func (a *App) PublishScheduledPostEvent(rctx request.CTX, eventType model.WebsocketEventType, scheduledPost *model.ScheduledPost, connectionId string) {
 	if scheduledPost == nil {
 		rctx.Logger().Warn("publishScheduledPostEvent called with nil scheduledPost")
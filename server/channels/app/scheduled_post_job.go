@@ -121,11 +121,32 @@ func (a *App) processScheduledPostBatch(rctx request.CTX, scheduledPosts []*mode
 		scheduledPost, err := a.postScheduledPost(rctx, scheduledPosts[i])
 		if err != nil {
 			rctx.Logger().Debug("processScheduledPostBatch scheduled post processing failed", mlog.String("scheduled_post_id", scheduledPosts[i].Id), mlog.Err(err))
+
+			// A transient failure only skips the occurrence of a recurring scheduled post, the
+			// series is stopped when the post can't be sent anymore.
+			if scheduledPost.IsRecurring() && isRetryableScheduledPostError(scheduledPost.ErrorCode) {
+				rctx.Logger().Warn(
+					"App.processScheduledPostBatch: skipping the failed occurrence of a recurring scheduled post",
+					mlog.String("scheduled_post_id", scheduledPost.Id),
+					mlog.String("error_code", scheduledPost.ErrorCode),
+				)
+				errorCode := scheduledPost.ErrorCode
+				scheduledPost.ErrorCode = ""
+				if a.rescheduleRecurringScheduledPost(rctx, scheduledPost) {
+					continue
+				}
+				scheduledPost.ErrorCode = errorCode
+			}
+
 			failedScheduledPosts = append(failedScheduledPosts, scheduledPost)
 			continue
 		}
 
 		rctx.Logger().Trace("processScheduledPostBatch scheduled post processing successful", mlog.String("scheduled_post_id", scheduledPosts[i].Id))
+		if scheduledPost.IsRecurring() && scheduledPost.ErrorCode == "" && a.rescheduleRecurringScheduledPost(rctx, scheduledPost) {
+			continue
+		}
+
 		successfulScheduledPostIDs = append(successfulScheduledPostIDs, scheduledPost.Id)
 	}
 
@@ -229,12 +250,59 @@ func (a *App) postScheduledPost(rctx request.CTX, scheduledPost *model.Scheduled
 		return scheduledPost, appErr
 	}
 
-	// send the WS event to delete the just posted scheduledPost from list
-	a.PublishScheduledPostEvent(rctx, model.WebsocketScheduledPostDeleted, scheduledPost, "")
+	if !scheduledPost.IsRecurring() {
+		// send the WS event to delete the just posted scheduledPost from list
+		a.PublishScheduledPostEvent(rctx, model.WebsocketScheduledPostDeleted, scheduledPost, "")
+	}
 
 	return scheduledPost, nil
 }
 
+// isRetryableScheduledPostError tells whether a scheduled post which failed with the given error
+// code could be sent later, as opposed to errors such as a deleted channel or a lost permission.
+func isRetryableScheduledPostError(errorCode string) bool {
+	return errorCode == model.ScheduledPostErrorUnknownError || errorCode == model.ScheduledPostErrorUnableToSend
+}
+
+// rescheduleRecurringScheduledPost moves a just posted recurring scheduled post to its next
+// occurrence. It returns false when the series has ended and the scheduled post should be
+// deleted like a regular one.
+func (a *App) rescheduleRecurringScheduledPost(rctx request.CTX, scheduledPost *model.ScheduledPost) bool {
+	// Occurrences missed while the job wasn't running are not sent, the series resumes with the
+	// next one to come.
+	nextOccurrence, appErr := a.nextScheduledPostOccurrence(scheduledPost, max(scheduledPost.ScheduledAt, model.GetMillis()))
+	if appErr != nil {
+		rctx.Logger().Warn(
+			"App.rescheduleRecurringScheduledPost: failed to compute next occurrence, ending series",
+			mlog.String("scheduled_post_id", scheduledPost.Id),
+			mlog.String("recurrence", scheduledPost.Recurrence),
+			mlog.Err(appErr),
+		)
+	}
+
+	if nextOccurrence == 0 {
+		rctx.Logger().Debug("rescheduleRecurringScheduledPost series has ended", mlog.String("scheduled_post_id", scheduledPost.Id))
+		a.PublishScheduledPostEvent(rctx, model.WebsocketScheduledPostDeleted, scheduledPost, "")
+		return false
+	}
+
+	scheduledPost.ScheduledAt = nextOccurrence
+	if err := a.Srv().Store().ScheduledPost().UpdatedScheduledPost(scheduledPost); err != nil {
+		// The post was already sent, so the scheduled post must not be picked up again.
+		// Deleting it is safer than posting the same occurrence twice.
+		rctx.Logger().Error(
+			"App.rescheduleRecurringScheduledPost: failed to reschedule recurring scheduled post, ending series",
+			mlog.String("scheduled_post_id", scheduledPost.Id),
+			mlog.Err(err),
+		)
+		a.PublishScheduledPostEvent(rctx, model.WebsocketScheduledPostDeleted, scheduledPost, "")
+		return false
+	}
+
+	a.PublishScheduledPostEvent(rctx, model.WebsocketScheduledPostUpdated, scheduledPost, "")
+	return true
+}
+
 // canPostScheduledPost checks whether the scheduled post be created based on permissions and other checks.
 func (a *App) canPostScheduledPost(rctx request.CTX, scheduledPost *model.ScheduledPost, channel *model.Channel) (string, error) {
 	rctx.Logger().Trace("canPostScheduledPost called...", mlog.String("scheduled_post_id", scheduledPost.Id))
@@ -54,6 +54,134 @@ func TestProcessScheduledPosts(t *testing.T) {
 		assert.Len(t, scheduledPosts, 0)
 	})
 
+	t.Run("reschedules recurring scheduled posts", func(t *testing.T) {
+		th := Setup(t).InitBasic()
+		defer th.TearDown()
+
+		th.App.Srv().SetLicense(getLicWithSkuShortName(model.LicenseShortSkuProfessional))
+
+		scheduledAt := model.GetMillis() + 1000
+		recurringScheduledPost := &model.ScheduledPost{
+			Draft: model.Draft{
+				CreateAt:  model.GetMillis(),
+				UserId:    th.BasicUser.Id,
+				ChannelId: th.BasicChannel.Id,
+				Message:   "this is a recurring scheduled post",
+			},
+			ScheduledAt: scheduledAt,
+			Recurrence:  "FREQ=DAILY",
+		}
+		_, err := th.Server.Store().ScheduledPost().CreateScheduledPost(recurringScheduledPost)
+		assert.NoError(t, err)
+
+		pausedScheduledPost := &model.ScheduledPost{
+			Draft: model.Draft{
+				CreateAt:  model.GetMillis(),
+				UserId:    th.BasicUser.Id,
+				ChannelId: th.BasicChannel.Id,
+				Message:   "this is a paused scheduled post",
+			},
+			ScheduledAt:      scheduledAt,
+			Recurrence:       "FREQ=DAILY",
+			RecurrencePaused: true,
+		}
+		_, err = th.Server.Store().ScheduledPost().CreateScheduledPost(pausedScheduledPost)
+		assert.NoError(t, err)
+
+		time.Sleep(1 * time.Second)
+
+		th.App.ProcessScheduledPosts(th.Context)
+
+		rescheduled, err := th.App.Srv().Store().ScheduledPost().Get(recurringScheduledPost.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, scheduledAt/60000*60000+24*60*60*1000, rescheduled.ScheduledAt)
+		assert.Empty(t, rescheduled.ErrorCode)
+
+		paused, err := th.App.Srv().Store().ScheduledPost().Get(pausedScheduledPost.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, scheduledAt, paused.ScheduledAt)
+		assert.Empty(t, paused.ErrorCode)
+
+		posts, appErr := th.App.GetPostsPage(model.GetPostsOptions{ChannelId: th.BasicChannel.Id, Page: 0, PerPage: 10})
+		assert.Nil(t, appErr)
+		var sent int
+		for _, post := range posts.Posts {
+			if post.Message == recurringScheduledPost.Message {
+				sent++
+			}
+			assert.NotEqual(t, pausedScheduledPost.Message, post.Message)
+		}
+		assert.Equal(t, 1, sent)
+	})
+
+	t.Run("reschedules late recurring scheduled posts after now", func(t *testing.T) {
+		th := Setup(t).InitBasic()
+		defer th.TearDown()
+
+		th.App.Srv().SetLicense(getLicWithSkuShortName(model.LicenseShortSkuProfessional))
+
+		// The job didn't run for several occurrences.
+		scheduledAt := model.GetMillis() - (5 * 60 * 60 * 1000)
+		recurringScheduledPost := &model.ScheduledPost{
+			Draft: model.Draft{
+				CreateAt:  model.GetMillis(),
+				UserId:    th.BasicUser.Id,
+				ChannelId: th.BasicChannel.Id,
+				Message:   "this is a late recurring scheduled post",
+			},
+			ScheduledAt: scheduledAt,
+			Recurrence:  "FREQ=HOURLY",
+		}
+		_, err := th.Server.Store().ScheduledPost().CreateScheduledPost(recurringScheduledPost)
+		assert.NoError(t, err)
+
+		th.App.ProcessScheduledPosts(th.Context)
+
+		rescheduled, err := th.App.Srv().Store().ScheduledPost().Get(recurringScheduledPost.Id)
+		assert.NoError(t, err)
+		assert.Greater(t, rescheduled.ScheduledAt, model.GetMillis())
+		assert.LessOrEqual(t, rescheduled.ScheduledAt, model.GetMillis()+60*60*1000)
+	})
+
+	t.Run("stops recurring scheduled posts in archived channels", func(t *testing.T) {
+		th := Setup(t).InitBasic()
+		defer th.TearDown()
+
+		th.App.Srv().SetLicense(getLicWithSkuShortName(model.LicenseShortSkuProfessional))
+
+		appErr := th.App.DeleteChannel(th.Context, th.BasicChannel, th.BasicUser.Id)
+		assert.Nil(t, appErr)
+
+		scheduledAt := model.GetMillis() - (5 * 60 * 60 * 1000)
+		recurringScheduledPost := &model.ScheduledPost{
+			Draft: model.Draft{
+				CreateAt:  model.GetMillis(),
+				UserId:    th.BasicUser.Id,
+				ChannelId: th.BasicChannel.Id,
+				Message:   "this is a recurring scheduled post",
+			},
+			ScheduledAt: scheduledAt,
+			Recurrence:  "FREQ=DAILY",
+		}
+		_, err := th.Server.Store().ScheduledPost().CreateScheduledPost(recurringScheduledPost)
+		assert.NoError(t, err)
+
+		th.App.ProcessScheduledPosts(th.Context)
+
+		stopped, err := th.App.Srv().Store().ScheduledPost().Get(recurringScheduledPost.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, scheduledAt, stopped.ScheduledAt)
+		assert.Equal(t, model.ScheduledPostErrorCodeChannelArchived, stopped.ErrorCode)
+	})
+
+	t.Run("retryable errors", func(t *testing.T) {
+		assert.True(t, isRetryableScheduledPostError(model.ScheduledPostErrorUnknownError))
+		assert.True(t, isRetryableScheduledPostError(model.ScheduledPostErrorUnableToSend))
+		assert.False(t, isRetryableScheduledPostError(model.ScheduledPostErrorCodeChannelArchived))
+		assert.False(t, isRetryableScheduledPostError(model.ScheduledPostErrorCodeNoChannelPermission))
+		assert.False(t, isRetryableScheduledPostError(model.ScheduledPostErrorCodeUserDeleted))
+	})
+
 	t.Run("sets error code for archived channel", func(t *testing.T) {
 		th := Setup(t).InitBasic()
 		defer th.TearDown()
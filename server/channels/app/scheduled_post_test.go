@@ -755,6 +755,92 @@ func TestDeleteScheduledPost(t *testing.T) {
 	})
 }
 
+func TestUpdateScheduledPostSeries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	createRecurringScheduledPost := func(t *testing.T, recurrence string) *model.ScheduledPost {
+		t.Helper()
+		scheduledPost := &model.ScheduledPost{
+			Draft: model.Draft{
+				CreateAt:  model.GetMillis(),
+				UserId:    th.BasicUser.Id,
+				ChannelId: th.BasicChannel.Id,
+				Message:   "this is a recurring scheduled post",
+			},
+			ScheduledAt: model.GetMillis() + 100000, // 100 seconds in the future
+			Recurrence:  recurrence,
+		}
+		createdScheduledPost, appErr := th.App.SaveScheduledPost(th.Context, scheduledPost, "connection_id")
+		require.Nil(t, appErr)
+		return createdScheduledPost
+	}
+
+	t.Run("pause and resume", func(t *testing.T) {
+		scheduledPost := createRecurringScheduledPost(t, "FREQ=DAILY")
+
+		updatedScheduledPost, appErr := th.App.UpdateScheduledPostSeries(th.Context, th.BasicUser.Id, scheduledPost.Id, model.ScheduledPostSeriesPause, "connection_id")
+		require.Nil(t, appErr)
+		require.True(t, updatedScheduledPost.RecurrencePaused)
+
+		updatedScheduledPost, appErr = th.App.UpdateScheduledPostSeries(th.Context, th.BasicUser.Id, scheduledPost.Id, model.ScheduledPostSeriesResume, "connection_id")
+		require.Nil(t, appErr)
+		require.False(t, updatedScheduledPost.RecurrencePaused)
+		require.Equal(t, scheduledPost.ScheduledAt, updatedScheduledPost.ScheduledAt)
+	})
+
+	t.Run("skip moves to the next occurrence", func(t *testing.T) {
+		scheduledPost := createRecurringScheduledPost(t, "FREQ=WEEKLY")
+
+		updatedScheduledPost, appErr := th.App.UpdateScheduledPostSeries(th.Context, th.BasicUser.Id, scheduledPost.Id, model.ScheduledPostSeriesSkip, "connection_id")
+		require.Nil(t, appErr)
+		require.Equal(t, scheduledPost.ScheduledAt/60000*60000+7*24*60*60*1000, updatedScheduledPost.ScheduledAt)
+
+		fetchedScheduledPost, err := th.Server.Store().ScheduledPost().Get(scheduledPost.Id)
+		require.NoError(t, err)
+		require.Equal(t, updatedScheduledPost.ScheduledAt, fetchedScheduledPost.ScheduledAt)
+	})
+
+	t.Run("skipping the last occurrence deletes the scheduled post", func(t *testing.T) {
+		scheduledPost := createRecurringScheduledPost(t, "FREQ=DAILY")
+		scheduledPost.RecurrenceEndAt = scheduledPost.ScheduledAt + 1000
+		require.NoError(t, th.Server.Store().ScheduledPost().UpdatedScheduledPost(scheduledPost))
+
+		_, appErr := th.App.UpdateScheduledPostSeries(th.Context, th.BasicUser.Id, scheduledPost.Id, model.ScheduledPostSeriesSkip, "connection_id")
+		require.Nil(t, appErr)
+
+		_, err := th.Server.Store().ScheduledPost().Get(scheduledPost.Id)
+		require.Error(t, err)
+	})
+
+	t.Run("end turns the series into a regular scheduled post", func(t *testing.T) {
+		scheduledPost := createRecurringScheduledPost(t, "0 9 * * 1-5")
+
+		updatedScheduledPost, appErr := th.App.UpdateScheduledPostSeries(th.Context, th.BasicUser.Id, scheduledPost.Id, model.ScheduledPostSeriesEnd, "connection_id")
+		require.Nil(t, appErr)
+		require.False(t, updatedScheduledPost.IsRecurring())
+		require.Equal(t, scheduledPost.ScheduledAt, updatedScheduledPost.ScheduledAt)
+
+		_, appErr = th.App.UpdateScheduledPostSeries(th.Context, th.BasicUser.Id, scheduledPost.Id, model.ScheduledPostSeriesPause, "connection_id")
+		require.NotNil(t, appErr)
+		require.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+	})
+
+	t.Run("should not allow updating someone else's series", func(t *testing.T) {
+		scheduledPost := createRecurringScheduledPost(t, "FREQ=DAILY")
+
+		_, appErr := th.App.UpdateScheduledPostSeries(th.Context, th.BasicUser2.Id, scheduledPost.Id, model.ScheduledPostSeriesPause, "connection_id")
+		require.NotNil(t, appErr)
+		require.Equal(t, http.StatusForbidden, appErr.StatusCode)
+	})
+
+	t.Run("should not find a non existing scheduled post", func(t *testing.T) {
+		_, appErr := th.App.UpdateScheduledPostSeries(th.Context, th.BasicUser.Id, model.NewId(), model.ScheduledPostSeriesPause, "connection_id")
+		require.NotNil(t, appErr)
+		require.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+}
+
 func TestPublishScheduledPostEvent(t *testing.T) {
 	th := Setup(t).InitBasic()
 	defer th.TearDown()
@@ -25,6 +25,8 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/rs/cors"
+	"github.com/throttled/throttled"
+	"github.com/throttled/throttled/store/memstore"
 	"golang.org/x/crypto/acme/autocert"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -40,6 +42,7 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/audit"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/active_users"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/channel_bookmark_link_check"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/cleanup_desktop_tokens"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/delete_dms_preferences_migration"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/delete_empty_drafts_migration"
@@ -49,6 +52,8 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/export_process"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/export_users_to_csv"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/extract_content"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/file_deduplication"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/file_encryption_key_rotation"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/hosted_purchase_screening"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/import_delete"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/import_process"
@@ -57,7 +62,9 @@ import (
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/migrations"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/mobile_session_metadata"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/notify_admin"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/outgoing_webhook_retry"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/plugins"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs/post_escalation"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/post_persistent_notifications"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/product_notices"
 	"github.com/mattermost/mattermost/server/v8/channels/jobs/refresh_post_stats"
@@ -109,6 +116,10 @@ type Server struct {
 	ListenAddr  *net.TCPAddr
 	RateLimiter *RateLimiter
 
+	incomingWebhookRateLimiter *IncomingWebhookRateLimiter
+	// rateLimitStore holds the rate limit state in the external cache, if any.
+	rateLimitStore throttled.GCRAStore
+
 	localModeServer *http.Server
 
 	didFinishListen chan struct{}
@@ -119,6 +130,7 @@ type Server struct {
 	PushNotificationsHub   PushNotificationsHub
 	pushNotificationClient *http.Client // TODO: move this to it's own package
 	outgoingWebhookClient  *http.Client
+	webPushClient          *http.Client
 
 	runEssentialJobs bool
 	Jobs             *jobs.JobServer
@@ -304,6 +316,20 @@ func NewServer(options ...Option) (*Server, error) {
 
 	s.pushNotificationClient = s.httpService.MakeClient(true)
 	s.outgoingWebhookClient = s.httpService.MakeClient(false)
+	// Web push endpoints are provided by users, so only trust connections to external hosts.
+	s.webPushClient = s.httpService.MakeClient(false)
+
+	if s.rateLimitStore, err = newRateLimitStore(s.platform.CacheProvider(), &s.platform.Config().RateLimitSettings); err != nil {
+		return nil, errors.Wrap(err, "Unable to create rate limit store")
+	}
+
+	if s.rateLimitStore != nil {
+		if s.incomingWebhookRateLimiter, err = NewIncomingWebhookRateLimiter(s.rateLimitStore); err != nil {
+			return nil, errors.Wrap(err, "Unable to create incoming webhook rate limiter")
+		}
+	} else if s.incomingWebhookRateLimiter, err = newIncomingWebhookMemoryRateLimiter(); err != nil {
+		return nil, errors.Wrap(err, "Unable to create incoming webhook rate limiter")
+	}
 
 	if err2 := utils.TranslationsPreInit(); err2 != nil {
 		return nil, errors.Wrapf(err2, "unable to load Mattermost translation files")
@@ -440,6 +466,13 @@ func NewServer(options ...Option) (*Server, error) {
 		}
 	}
 
+	s.configureAuditDatabase(s.Audit, s.platform.Config())
+	s.platform.AddConfigListener(func(oldCfg, newCfg *model.Config) {
+		if *oldCfg.ExperimentalAuditSettings.DatabaseEnabled != *newCfg.ExperimentalAuditSettings.DatabaseEnabled {
+			s.configureAuditDatabase(s.Audit, newCfg)
+		}
+	})
+
 	s.platform.RemoveUnlicensedLogTargets(license)
 	s.platform.EnableLoggingMetrics()
 
@@ -930,7 +963,21 @@ func (s *Server) Start() error {
 	if *s.platform.Config().RateLimitSettings.Enable {
 		mlog.Info("RateLimiter is enabled")
 
-		rateLimiter, err2 := NewRateLimiter(&s.platform.Config().RateLimitSettings, s.platform.Config().ServiceSettings.TrustedProxyIPHeader)
+		subpath, err2 := utils.GetSubpathFromConfig(s.platform.Config())
+		if err2 != nil {
+			return errors.Wrap(err2, "failed to parse SiteURL subpath")
+		}
+
+		store := s.rateLimitStore
+		if store == nil {
+			memStore, err3 := memstore.New(*s.platform.Config().RateLimitSettings.MemoryStoreSize)
+			if err3 != nil {
+				return errors.Wrap(err3, i18n.T("api.server.start_server.rate_limiting_memory_store"))
+			}
+			store = memStore
+		}
+
+		rateLimiter, err2 := NewRateLimiterWithStore(store, &s.platform.Config().RateLimitSettings, s.platform.Config().ServiceSettings.TrustedProxyIPHeader, subpath)
 		if err2 != nil {
 			return err2
 		}
@@ -1655,6 +1702,36 @@ func (s *Server) initJobs() {
 		delete_dms_preferences_migration.MakeWorker(s.Jobs, s.Store(), New(ServerConnector(s.Channels()))),
 		nil)
 
+	s.Jobs.RegisterJobType(
+		model.JobTypeOutgoingWebhookRetry,
+		outgoing_webhook_retry.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		outgoing_webhook_retry.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeFileDeduplication,
+		file_deduplication.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		file_deduplication.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeFileEncryptionKeyRotation,
+		file_encryption_key_rotation.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		file_encryption_key_rotation.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypeChannelBookmarkLinkCheck,
+		channel_bookmark_link_check.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		channel_bookmark_link_check.MakeScheduler(s.Jobs),
+	)
+
+	s.Jobs.RegisterJobType(
+		model.JobTypePostEscalation,
+		post_escalation.MakeWorker(s.Jobs, New(ServerConnector(s.Channels()))),
+		post_escalation.MakeScheduler(s.Jobs, func() *model.License { return s.License() }),
+	)
+
 	s.platform.Jobs = s.Jobs
 }
 
@@ -181,6 +181,28 @@ func (a *App) NotifySharedChannelUserUpdate(user *model.User) {
 	a.sendUpdatedUserEvent(user)
 }
 
+// RemoveSharedChannelMember removes a user from a shared channel following a membership change
+// received from a remote. Unlike RemoveUserFromChannel no system message is posted, since the
+// message posted by the remote is synchronized along with the rest of the channel.
+func (a *App) RemoveSharedChannelMember(c request.CTX, userID string, channel *model.Channel) *model.AppError {
+	return a.removeUserFromChannel(c, userID, userID, channel)
+}
+
+// SearchRemoteUsers searches the user directories of the remotes a shared channel is shared with.
+func (a *App) SearchRemoteUsers(channelID string, term string) ([]*model.User, *model.AppError) {
+	scService := a.Srv().GetSharedChannelSyncService()
+	if scService == nil || !scService.Active() {
+		return nil, model.NewAppError("SearchRemoteUsers", "api.command_share.service_disabled",
+			nil, "", http.StatusBadRequest)
+	}
+
+	users, err := scService.SearchRemoteUsers(channelID, term)
+	if err != nil {
+		return nil, model.NewAppError("SearchRemoteUsers", "app.shared_channel.search_remote_users.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return users, nil
+}
+
 // onUserProfileChange is called when a user's profile has changed
 // (username, email, profile image, ...)
 func (a *App) onUserProfileChange(userID string) {
@@ -0,0 +1,268 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func (a *App) checkSharedDraftsEnabled(where string) *model.AppError {
+	if !*a.Config().ServiceSettings.AllowSyncedDrafts {
+		return model.NewAppError(where, "app.draft.feature_disabled", nil, "", http.StatusNotImplemented)
+	}
+	return nil
+}
+
+func (a *App) CreateSharedDraft(c request.CTX, draft *model.SharedDraft, connectionID string) (*model.SharedDraft, *model.AppError) {
+	if appErr := a.checkSharedDraftsEnabled("CreateSharedDraft"); appErr != nil {
+		return nil, appErr
+	}
+
+	channel, err := a.Srv().Store().Channel().Get(draft.ChannelId, true)
+	if err != nil {
+		return nil, model.NewAppError("CreateSharedDraft", "api.context.invalid_param.app_error", map[string]any{"Name": "shared_draft.channel_id"}, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if channel.DeleteAt != 0 {
+		return nil, model.NewAppError("CreateSharedDraft", "api.draft.create_draft.can_not_draft_to_deleted.error", nil, "", http.StatusBadRequest)
+	}
+
+	draft.UserIds = model.RemoveDuplicateStringsNonSort(append([]string{draft.CreatorId}, draft.UserIds...))
+	if appErr := a.checkSharedDraftMembers(c, draft.ChannelId, draft.UserIds); appErr != nil {
+		return nil, appErr
+	}
+
+	savedDraft, err := a.Srv().Store().SharedDraft().Save(draft)
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("CreateSharedDraft", "app.shared_draft.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.sendSharedDraftEvent(c, model.WebsocketEventSharedDraftUpdated, savedDraft, savedDraft.UserIds, connectionID)
+
+	return savedDraft, nil
+}
+
+func (a *App) GetSharedDraft(id string) (*model.SharedDraft, *model.AppError) {
+	if appErr := a.checkSharedDraftsEnabled("GetSharedDraft"); appErr != nil {
+		return nil, appErr
+	}
+
+	draft, err := a.Srv().Store().SharedDraft().Get(id)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("GetSharedDraft", "app.shared_draft.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return nil, model.NewAppError("GetSharedDraft", "app.shared_draft.get.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	return draft, nil
+}
+
+func (a *App) GetSharedDraftsForUser(userID string) ([]*model.SharedDraft, *model.AppError) {
+	if appErr := a.checkSharedDraftsEnabled("GetSharedDraftsForUser"); appErr != nil {
+		return nil, appErr
+	}
+
+	drafts, err := a.Srv().Store().SharedDraft().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetSharedDraftsForUser", "app.shared_draft.get_for_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return drafts, nil
+}
+
+// UpdateSharedDraft applies the edit of the user to the draft. The edit is rejected with
+// a conflict when someone else updated the draft since the version it's based on.
+func (a *App) UpdateSharedDraft(c request.CTX, draft *model.SharedDraft, patch *model.SharedDraftPatch, userID, connectionID string) (*model.SharedDraft, *model.AppError) {
+	if appErr := a.checkSharedDraftsEnabled("UpdateSharedDraft"); appErr != nil {
+		return nil, appErr
+	}
+
+	draft.Patch(patch, userID)
+
+	updatedDraft, err := a.Srv().Store().SharedDraft().Update(draft, patch.UpdateAt)
+	if err != nil {
+		var appErr *model.AppError
+		var nfErr *store.ErrNotFound
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("UpdateSharedDraft", "app.shared_draft.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("UpdateSharedDraft", "app.shared_draft.update.conflict.app_error", nil, "", http.StatusConflict).Wrap(err)
+		default:
+			return nil, model.NewAppError("UpdateSharedDraft", "app.shared_draft.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	a.sendSharedDraftEvent(c, model.WebsocketEventSharedDraftUpdated, updatedDraft, updatedDraft.UserIds, connectionID)
+
+	return updatedDraft, nil
+}
+
+func (a *App) AddSharedDraftMembers(c request.CTX, draft *model.SharedDraft, userIDs []string, connectionID string) (*model.SharedDraft, *model.AppError) {
+	if appErr := a.checkSharedDraftsEnabled("AddSharedDraftMembers"); appErr != nil {
+		return nil, appErr
+	}
+
+	newUserIDs := []string{}
+	for _, userID := range model.RemoveDuplicateStringsNonSort(userIDs) {
+		if !draft.HasMember(userID) {
+			newUserIDs = append(newUserIDs, userID)
+		}
+	}
+
+	if len(newUserIDs) == 0 {
+		return draft, nil
+	}
+
+	if len(draft.UserIds)+len(newUserIDs) > model.SharedDraftMaxMembers {
+		return nil, model.NewAppError("AddSharedDraftMembers", "model.shared_draft.is_valid.user_ids.app_error", map[string]any{"Max": model.SharedDraftMaxMembers}, "", http.StatusBadRequest)
+	}
+
+	if appErr := a.checkSharedDraftMembers(c, draft.ChannelId, newUserIDs); appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.Srv().Store().SharedDraft().SaveMembers(draft.Id, newUserIDs); err != nil {
+		return nil, model.NewAppError("AddSharedDraftMembers", "app.shared_draft.save_members.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	updatedDraft, appErr := a.GetSharedDraft(draft.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	a.sendSharedDraftEvent(c, model.WebsocketEventSharedDraftUpdated, updatedDraft, updatedDraft.UserIds, connectionID)
+
+	return updatedDraft, nil
+}
+
+func (a *App) RemoveSharedDraftMember(c request.CTX, draft *model.SharedDraft, userID, connectionID string) (*model.SharedDraft, *model.AppError) {
+	if appErr := a.checkSharedDraftsEnabled("RemoveSharedDraftMember"); appErr != nil {
+		return nil, appErr
+	}
+
+	if userID == draft.CreatorId {
+		return nil, model.NewAppError("RemoveSharedDraftMember", "app.shared_draft.remove_creator.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !draft.HasMember(userID) {
+		return draft, nil
+	}
+
+	if err := a.Srv().Store().SharedDraft().DeleteMember(draft.Id, userID); err != nil {
+		return nil, model.NewAppError("RemoveSharedDraftMember", "app.shared_draft.delete_member.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	updatedDraft, appErr := a.GetSharedDraft(draft.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	a.sendSharedDraftEvent(c, model.WebsocketEventSharedDraftUpdated, updatedDraft, updatedDraft.UserIds, connectionID)
+	a.sendSharedDraftEvent(c, model.WebsocketEventSharedDraftDeleted, updatedDraft, []string{userID}, connectionID)
+
+	return updatedDraft, nil
+}
+
+func (a *App) DeleteSharedDraft(c request.CTX, draft *model.SharedDraft, connectionID string) *model.AppError {
+	if appErr := a.checkSharedDraftsEnabled("DeleteSharedDraft"); appErr != nil {
+		return appErr
+	}
+
+	if err := a.Srv().Store().SharedDraft().Delete(draft.Id); err != nil {
+		return model.NewAppError("DeleteSharedDraft", "app.shared_draft.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	a.sendSharedDraftEvent(c, model.WebsocketEventSharedDraftDeleted, draft, draft.UserIds, connectionID)
+
+	return nil
+}
+
+// PublishSharedDraft posts the content of the draft to its channel as the given user. The
+// draft is deleted beforehand, so that it's published only once, and the publication is
+// rejected with a conflict when someone else updated the draft since updateAt.
+func (a *App) PublishSharedDraft(c request.CTX, draft *model.SharedDraft, updateAt int64, userID, sessionID, connectionID string) (*model.Post, *model.AppError) {
+	if appErr := a.checkSharedDraftsEnabled("PublishSharedDraft"); appErr != nil {
+		return nil, appErr
+	}
+
+	if err := a.Srv().Store().SharedDraft().DeleteIfUnchanged(draft.Id, updateAt); err != nil {
+		var nfErr *store.ErrNotFound
+		var cErr *store.ErrConflict
+		switch {
+		case errors.As(err, &nfErr):
+			return nil, model.NewAppError("PublishSharedDraft", "app.shared_draft.get.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		case errors.As(err, &cErr):
+			return nil, model.NewAppError("PublishSharedDraft", "app.shared_draft.update.conflict.app_error", nil, "", http.StatusConflict).Wrap(err)
+		default:
+			return nil, model.NewAppError("PublishSharedDraft", "app.shared_draft.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	post := draft.ToPost(userID)
+	post.SanitizeInput()
+
+	rp, appErr := a.CreatePostAsUser(c, a.PostWithProxyRemovedFromImageURLs(post), sessionID, true)
+	if appErr != nil {
+		// Give the draft back to its members, as a new version, so that it isn't lost.
+		if restoredDraft, err := a.Srv().Store().SharedDraft().Save(draft); err != nil {
+			c.Logger().Error("Failed to restore shared draft that couldn't be published", mlog.String("shared_draft_id", draft.Id), mlog.Err(err))
+		} else {
+			a.sendSharedDraftEvent(c, model.WebsocketEventSharedDraftUpdated, restoredDraft, restoredDraft.UserIds, "")
+		}
+		return nil, appErr
+	}
+
+	a.sendSharedDraftEvent(c, model.WebsocketEventSharedDraftDeleted, draft, draft.UserIds, connectionID)
+
+	return rp, nil
+}
+
+// checkSharedDraftMembers makes sure that all the users can read the channel the draft is for.
+func (a *App) checkSharedDraftMembers(c request.CTX, channelID string, userIDs []string) *model.AppError {
+	members, appErr := a.GetChannelMembersByIds(c, channelID, userIDs)
+	if appErr != nil {
+		return appErr
+	}
+
+	if len(members) != len(userIDs) {
+		return model.NewAppError("checkSharedDraftMembers", "app.shared_draft.invalid_members.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func (a *App) sendSharedDraftEvent(c request.CTX, event model.WebsocketEventType, draft *model.SharedDraft, userIDs []string, connectionID string) {
+	draftJSON, err := json.Marshal(draft)
+	if err != nil {
+		c.Logger().Warn("Failed to encode shared draft to JSON", mlog.Err(err))
+		return
+	}
+
+	for _, userID := range userIDs {
+		message := model.NewWebSocketEvent(event, "", draft.ChannelId, userID, nil, connectionID)
+		message.Add("shared_draft", string(draftJSON))
+		a.Publish(message)
+	}
+}
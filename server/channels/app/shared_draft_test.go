@@ -0,0 +1,122 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestSharedDraft(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.AllowSyncedDrafts = true
+	})
+
+	createDraft := func(t *testing.T) *model.SharedDraft {
+		draft, appErr := th.App.CreateSharedDraft(th.Context, &model.SharedDraft{
+			CreatorId: th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			Message:   "original",
+			UserIds:   []string{th.BasicUser2.Id},
+		}, "")
+		require.Nil(t, appErr)
+		return draft
+	}
+
+	t.Run("should create the draft with its creator as member", func(t *testing.T) {
+		draft := createDraft(t)
+		assert.Equal(t, []string{th.BasicUser.Id, th.BasicUser2.Id}, draft.UserIds)
+
+		drafts, appErr := th.App.GetSharedDraftsForUser(th.BasicUser2.Id)
+		require.Nil(t, appErr)
+		assert.Contains(t, drafts, draft)
+	})
+
+	t.Run("should reject members outside of the channel", func(t *testing.T) {
+		user := th.CreateUser()
+
+		_, appErr := th.App.CreateSharedDraft(th.Context, &model.SharedDraft{
+			CreatorId: th.BasicUser.Id,
+			ChannelId: th.BasicChannel.Id,
+			UserIds:   []string{user.Id},
+		}, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.shared_draft.invalid_members.app_error", appErr.Id)
+
+		_, appErr = th.App.AddSharedDraftMembers(th.Context, createDraft(t), []string{user.Id}, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.shared_draft.invalid_members.app_error", appErr.Id)
+	})
+
+	t.Run("should reject edits based on a stale version", func(t *testing.T) {
+		draft := createDraft(t)
+		lastUpdateAt := draft.UpdateAt
+
+		message := "edited"
+		edited, appErr := th.App.UpdateSharedDraft(th.Context, draft, &model.SharedDraftPatch{UpdateAt: lastUpdateAt, Message: &message}, th.BasicUser2.Id, "")
+		require.Nil(t, appErr)
+		assert.Equal(t, message, edited.Message)
+		assert.Equal(t, th.BasicUser2.Id, edited.LastEditedBy)
+
+		stale, appErr := th.App.GetSharedDraft(draft.Id)
+		require.Nil(t, appErr)
+		_, appErr = th.App.UpdateSharedDraft(th.Context, stale, &model.SharedDraftPatch{UpdateAt: lastUpdateAt, Message: &message}, th.BasicUser.Id, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusConflict, appErr.StatusCode)
+	})
+
+	t.Run("should not remove the creator", func(t *testing.T) {
+		draft := createDraft(t)
+
+		_, appErr := th.App.RemoveSharedDraftMember(th.Context, draft, th.BasicUser.Id, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusBadRequest, appErr.StatusCode)
+
+		draft, appErr = th.App.RemoveSharedDraftMember(th.Context, draft, th.BasicUser2.Id, "")
+		require.Nil(t, appErr)
+		assert.Equal(t, []string{th.BasicUser.Id}, draft.UserIds)
+	})
+
+	t.Run("should publish the draft and delete it", func(t *testing.T) {
+		draft := createDraft(t)
+
+		_, appErr := th.App.PublishSharedDraft(th.Context, draft, draft.UpdateAt-1, th.BasicUser2.Id, "", "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusConflict, appErr.StatusCode)
+
+		post, appErr := th.App.PublishSharedDraft(th.Context, draft, draft.UpdateAt, th.BasicUser2.Id, "", "")
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser2.Id, post.UserId)
+		assert.Equal(t, draft.Message, post.Message)
+
+		_, appErr = th.App.GetSharedDraft(draft.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+
+		_, appErr = th.App.PublishSharedDraft(th.Context, draft, draft.UpdateAt, th.BasicUser2.Id, "", "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotFound, appErr.StatusCode)
+	})
+
+	t.Run("should be disabled by configuration", func(t *testing.T) {
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.AllowSyncedDrafts = false
+		})
+		defer th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.AllowSyncedDrafts = true
+		})
+
+		_, appErr := th.App.GetSharedDraftsForUser(th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, http.StatusNotImplemented, appErr.StatusCode)
+	})
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slashcommands
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/i18n"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/app"
+)
+
+type RemindProvider struct {
+}
+
+const (
+	CmdRemind     = "remind"
+	CmdRemindList = "list"
+
+	// reminderDefaultHour is the time of day of reminders set for a day without a time.
+	reminderDefaultHour = 9
+)
+
+const reminderTimeOfDay = `(\d{1,2}(?::\d{2})?\s*(?:am|pm)?|noon|midnight)`
+const reminderWeekdayNames = `monday|tuesday|wednesday|thursday|friday|saturday|sunday`
+
+var (
+	reminderInRegexp       = regexp.MustCompile(`(?i)^(.+?)\s+in\s+(\d+)\s*(minutes?|mins?|m|hours?|hrs?|h|days?|d|weeks?|w)$`)
+	reminderEveryRegexp    = regexp.MustCompile(`(?i)^(.+?)\s+every\s+(day|weekday|week|` + reminderWeekdayNames + `)(?:\s+at\s+` + reminderTimeOfDay + `)?$`)
+	reminderTomorrowRegexp = regexp.MustCompile(`(?i)^(.+?)\s+(?:tomorrow(?:\s+at\s+` + reminderTimeOfDay + `)?|at\s+` + reminderTimeOfDay + `\s+tomorrow)$`)
+	reminderOnRegexp       = regexp.MustCompile(`(?i)^(.+?)\s+on\s+(` + reminderWeekdayNames + `)(?:\s+at\s+` + reminderTimeOfDay + `)?$`)
+	reminderAtRegexp       = regexp.MustCompile(`(?i)^(.+?)\s+at\s+` + reminderTimeOfDay + `(?:\s+today)?$`)
+	reminderClockRegexp    = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?\s*(am|pm)?$`)
+	reminderPrefixRegexp   = regexp.MustCompile(`(?i)^(?:me\b\s*)?(?:(?:to|about)\b\s*)?`)
+)
+
+var reminderWeekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+func init() {
+	app.RegisterCommandProvider(&RemindProvider{})
+}
+
+func (*RemindProvider) GetTrigger() string {
+	return CmdRemind
+}
+
+func (*RemindProvider) GetCommand(a *app.App, T i18n.TranslateFunc) *model.Command {
+	return &model.Command{
+		Trigger:          CmdRemind,
+		AutoComplete:     true,
+		AutoCompleteDesc: T("api.command_remind.desc"),
+		AutoCompleteHint: T("api.command_remind.hint"),
+		DisplayName:      T("api.command_remind.name"),
+	}
+}
+
+func (*RemindProvider) DoCommand(a *app.App, c request.CTX, args *model.CommandArgs, message string) *model.CommandResponse {
+	user, appErr := a.GetUser(args.UserId)
+	if appErr != nil {
+		return &model.CommandResponse{Text: args.T("api.command_remind.app_error"), ResponseType: model.CommandResponseTypeEphemeral}
+	}
+	loc := user.GetTimezoneLocation()
+
+	message = strings.TrimSpace(message)
+	if strings.EqualFold(message, CmdRemindList) {
+		return listReminders(a, args, loc)
+	}
+
+	reminder, ok := parseReminder(message, time.Now().In(loc))
+	if !ok {
+		return &model.CommandResponse{Text: args.T("api.command_remind.parse.app_error"), ResponseType: model.CommandResponseTypeEphemeral}
+	}
+	reminder.UserId = args.UserId
+
+	if _, appErr := a.CreateStandalonePostReminder(c, reminder); appErr != nil {
+		c.Logger().Debug(appErr.Error())
+		return &model.CommandResponse{Text: args.T("api.command_remind.app_error"), ResponseType: model.CommandResponseTypeEphemeral}
+	}
+
+	data := map[string]any{
+		"Message": reminder.Message,
+		"Time":    formatReminderTime(reminder.TargetTime, loc),
+	}
+	if reminder.Recurrence != "" {
+		data["Recurrence"] = args.T("api.command_remind.recurrence." + reminder.Recurrence)
+		return &model.CommandResponse{Text: args.T("api.command_remind.success_recurring", data), ResponseType: model.CommandResponseTypeEphemeral}
+	}
+
+	return &model.CommandResponse{Text: args.T("api.command_remind.success", data), ResponseType: model.CommandResponseTypeEphemeral}
+}
+
+func listReminders(a *app.App, args *model.CommandArgs, loc *time.Location) *model.CommandResponse {
+	reminders, appErr := a.GetPostRemindersForUser(args.UserId)
+	if appErr != nil {
+		return &model.CommandResponse{Text: args.T("api.command_remind.app_error"), ResponseType: model.CommandResponseTypeEphemeral}
+	}
+
+	if len(reminders) == 0 {
+		return &model.CommandResponse{Text: args.T("api.command_remind.list.empty"), ResponseType: model.CommandResponseTypeEphemeral}
+	}
+
+	lines := []string{args.T("api.command_remind.list.header")}
+	for _, reminder := range reminders {
+		about := reminder.Message
+		if !reminder.IsStandalone() {
+			about = args.SiteURL + "/pl/" + reminder.PostId
+		}
+		lines = append(lines, "- "+formatReminderTime(reminder.TargetTime, loc)+": "+about)
+	}
+
+	return &model.CommandResponse{Text: strings.Join(lines, "\n"), ResponseType: model.CommandResponseTypeEphemeral}
+}
+
+func formatReminderTime(targetTime int64, loc *time.Location) string {
+	return time.Unix(targetTime, 0).In(loc).Format("Mon Jan 2, 2006 at 3:04 PM MST")
+}
+
+// parseReminder reads reminders such as "me to call Bob in 2 hours" or "standup every weekday at 9:30am",
+// relative to now and in its location.
+func parseReminder(text string, now time.Time) (*model.PostReminder, bool) {
+	var message string
+	var target time.Time
+	recurrence := ""
+
+	if m := reminderInRegexp.FindStringSubmatch(text); m != nil {
+		amount, err := strconv.Atoi(m[2])
+		if err != nil || amount <= 0 {
+			return nil, false
+		}
+
+		unit := strings.ToLower(m[3])
+		switch {
+		case strings.HasPrefix(unit, "m"):
+			target = now.Add(time.Duration(amount) * time.Minute)
+		case strings.HasPrefix(unit, "h"):
+			target = now.Add(time.Duration(amount) * time.Hour)
+		case strings.HasPrefix(unit, "d"):
+			target = now.AddDate(0, 0, amount)
+		default:
+			target = now.AddDate(0, 0, 7*amount)
+		}
+		message = m[1]
+	} else if m := reminderEveryRegexp.FindStringSubmatch(text); m != nil {
+		hour, minute, ok := parseReminderTimeOfDay(m[3])
+		if !ok {
+			return nil, false
+		}
+
+		target = nextTimeOfDay(now, hour, minute)
+		switch period := strings.ToLower(m[2]); period {
+		case "day":
+			recurrence = model.PostReminderRecurrenceDaily
+		case "weekday":
+			recurrence = model.PostReminderRecurrenceWeekdays
+			for target.Weekday() == time.Saturday || target.Weekday() == time.Sunday {
+				target = target.AddDate(0, 0, 1)
+			}
+		case "week":
+			recurrence = model.PostReminderRecurrenceWeekly
+		default:
+			recurrence = model.PostReminderRecurrenceWeekly
+			target = nextWeekday(now, reminderWeekdays[period], hour, minute)
+		}
+		message = m[1]
+	} else if m := reminderTomorrowRegexp.FindStringSubmatch(text); m != nil {
+		hour, minute, ok := parseReminderTimeOfDay(m[2] + m[3])
+		if !ok {
+			return nil, false
+		}
+
+		target = atTimeOfDay(now.AddDate(0, 0, 1), hour, minute)
+		message = m[1]
+	} else if m := reminderOnRegexp.FindStringSubmatch(text); m != nil {
+		hour, minute, ok := parseReminderTimeOfDay(m[3])
+		if !ok {
+			return nil, false
+		}
+
+		target = nextWeekday(now, reminderWeekdays[strings.ToLower(m[2])], hour, minute)
+		message = m[1]
+	} else if m := reminderAtRegexp.FindStringSubmatch(text); m != nil {
+		hour, minute, ok := parseReminderTimeOfDay(m[2])
+		if !ok {
+			return nil, false
+		}
+
+		target = nextTimeOfDay(now, hour, minute)
+		message = m[1]
+	} else {
+		return nil, false
+	}
+
+	message = strings.TrimSpace(reminderPrefixRegexp.ReplaceAllString(strings.TrimSpace(message), ""))
+	if message == "" {
+		return nil, false
+	}
+
+	return &model.PostReminder{
+		Message:    message,
+		TargetTime: target.Unix(),
+		Recurrence: recurrence,
+	}, true
+}
+
+// parseReminderTimeOfDay reads times such as "9", "9:30", "9:30pm", "21:30" or "noon".
+// An empty time is the default time of day of reminders.
+func parseReminderTimeOfDay(text string) (int, int, bool) {
+	text = strings.ToLower(strings.TrimSpace(text))
+	switch text {
+	case "":
+		return reminderDefaultHour, 0, true
+	case "noon":
+		return 12, 0, true
+	case "midnight":
+		return 0, 0, true
+	}
+
+	m := reminderClockRegexp.FindStringSubmatch(text)
+	if m == nil {
+		return 0, 0, false
+	}
+
+	hour, _ := strconv.Atoi(m[1])
+	minute := 0
+	if m[2] != "" {
+		minute, _ = strconv.Atoi(m[2])
+	}
+	if minute > 59 {
+		return 0, 0, false
+	}
+
+	switch m[3] {
+	case "am", "pm":
+		if hour < 1 || hour > 12 {
+			return 0, 0, false
+		}
+		hour %= 12
+		if m[3] == "pm" {
+			hour += 12
+		}
+	default:
+		if hour > 23 {
+			return 0, 0, false
+		}
+	}
+
+	return hour, minute, true
+}
+
+func atTimeOfDay(day time.Time, hour, minute int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, day.Location())
+}
+
+// nextTimeOfDay returns the next time it's hour:minute, today or tomorrow.
+func nextTimeOfDay(now time.Time, hour, minute int) time.Time {
+	target := atTimeOfDay(now, hour, minute)
+	if !target.After(now) {
+		target = target.AddDate(0, 0, 1)
+	}
+	return target
+}
+
+// nextWeekday returns the next time it's hour:minute on the weekday, within a week.
+func nextWeekday(now time.Time, weekday time.Weekday, hour, minute int) time.Time {
+	target := atTimeOfDay(now, hour, minute).AddDate(0, 0, (int(weekday)-int(now.Weekday())+7)%7)
+	if !target.After(now) {
+		target = target.AddDate(0, 0, 7)
+	}
+	return target
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package slashcommands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestParseReminder(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	// A Wednesday afternoon.
+	now := time.Date(2024, time.May, 15, 14, 30, 0, 0, loc)
+	at := func(month time.Month, day, hour, minute int) int64 {
+		return time.Date(2024, month, day, hour, minute, 0, 0, loc).Unix()
+	}
+
+	for text, expected := range map[string]model.PostReminder{
+		"me to call Bob in 20 minutes":        {Message: "call Bob", TargetTime: now.Add(20 * time.Minute).Unix()},
+		"me to call Bob in 2h":                {Message: "call Bob", TargetTime: now.Add(2 * time.Hour).Unix()},
+		"about the release in 3 days":         {Message: "the release", TargetTime: at(time.May, 18, 14, 30)},
+		"to renew the domain in 1 week":       {Message: "renew the domain", TargetTime: at(time.May, 22, 14, 30)},
+		"me to stretch at 4pm":                {Message: "stretch", TargetTime: at(time.May, 15, 16, 0)},
+		"me to stretch at 9:15":               {Message: "stretch", TargetTime: at(time.May, 16, 9, 15)},
+		"me to stretch at noon tomorrow":      {Message: "stretch", TargetTime: at(time.May, 16, 12, 0)},
+		"water the plants tomorrow":           {Message: "water the plants", TargetTime: at(time.May, 16, 9, 0)},
+		"water the plants tomorrow at 7:45am": {Message: "water the plants", TargetTime: at(time.May, 16, 7, 45)},
+		"me to pay rent on Monday":            {Message: "pay rent", TargetTime: at(time.May, 20, 9, 0)},
+		"me to pay rent on wednesday at 3pm":  {Message: "pay rent", TargetTime: at(time.May, 15, 15, 0)},
+		"me to pay rent on wednesday at 2pm":  {Message: "pay rent", TargetTime: at(time.May, 22, 14, 0)},
+		"standup every weekday at 9:30am":     {Message: "standup", TargetTime: at(time.May, 16, 9, 30), Recurrence: model.PostReminderRecurrenceWeekdays},
+		"me to drink water every day at 5pm":  {Message: "drink water", TargetTime: at(time.May, 15, 17, 0), Recurrence: model.PostReminderRecurrenceDaily},
+		"timesheets every week":               {Message: "timesheets", TargetTime: at(time.May, 16, 9, 0), Recurrence: model.PostReminderRecurrenceWeekly},
+		"timesheets every Friday at midnight": {Message: "timesheets", TargetTime: at(time.May, 17, 0, 0), Recurrence: model.PostReminderRecurrenceWeekly},
+	} {
+		t.Run(text, func(t *testing.T) {
+			reminder, ok := parseReminder(text, now)
+			require.True(t, ok)
+			assert.Equal(t, expected.Message, reminder.Message)
+			assert.Equal(t, expected.TargetTime, reminder.TargetTime)
+			assert.Equal(t, expected.Recurrence, reminder.Recurrence)
+		})
+	}
+
+	t.Run("a weekday reminder set on a Friday evening starts on Monday", func(t *testing.T) {
+		friday := time.Date(2024, time.May, 17, 20, 0, 0, 0, loc)
+		reminder, ok := parseReminder("standup every weekday", friday)
+		require.True(t, ok)
+		assert.Equal(t, at(time.May, 20, 9, 0), reminder.TargetTime)
+	})
+
+	for _, text := range []string{
+		"",
+		"call Bob",
+		"in 20 minutes",
+		"me to in 20 minutes",
+		"call Bob in 0 minutes",
+		"call Bob at 25:00",
+		"call Bob at 13pm",
+		"call Bob at 9:75",
+		"call Bob on someday",
+	} {
+		t.Run("invalid "+text, func(t *testing.T) {
+			_, ok := parseReminder(text, now)
+			assert.False(t, ok)
+		})
+	}
+}
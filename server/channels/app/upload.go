@@ -50,6 +50,8 @@ func (a *App) genFileInfoFromReader(name string, file io.ReadSeeker, size int64)
 
 func (a *App) runPluginsHook(c request.CTX, info *model.FileInfo, file io.Reader) *model.AppError {
 	filePath := info.Path
+	// The plugins may hand back a different FileInfo than the caller's.
+	uploadedInfo := info
 	// using a pipe to avoid loading the whole file content in memory.
 	r, w := io.Pipe()
 	errChan := make(chan *model.AppError, 1)
@@ -109,6 +111,8 @@ func (a *App) runPluginsHook(c request.CTX, info *model.FileInfo, file io.Reader
 
 	if written > 0 {
 		info.Size = written
+		// The content was replaced, so its hash has to be computed again.
+		uploadedInfo.ContentHash = ""
 		if fileErr := a.MoveFile(tmpPath, info.Path); fileErr != nil {
 			return model.NewAppError("runPluginsHook", "app.upload.run_plugins_hook.move_fail",
 				nil, "", http.StatusInternalServerError).Wrap(fileErr)
@@ -306,6 +310,10 @@ func (a *App) UploadData(c request.CTX, us *model.UploadSession, rd io.Reader) (
 		}
 	}
 
+	if us.Type == model.UploadTypeAttachment {
+		a.deduplicateUploadedFile(c, info)
+	}
+
 	var storeErr error
 	if info, storeErr = a.Srv().Store().FileInfo().Save(c, info); storeErr != nil {
 		var appErr *model.AppError
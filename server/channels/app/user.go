@@ -786,7 +786,17 @@ func (a *App) DeactivateMfa(userID string) *model.AppError {
 		return appErr
 	}
 
-	if err := a.ch.srv.userService.DeactivateMfa(user); err != nil {
+	credentials, appErr := a.GetWebAuthnCredentials(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	// Security keys and passkeys keep multi-factor authentication on without the authenticator app.
+	if len(credentials) > 0 {
+		if err := a.Srv().Store().User().UpdateMfaSecret(userID, ""); err != nil {
+			return model.NewAppError("DeactivateMfa", "mfa.deactivate.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	} else if err := a.ch.srv.userService.DeactivateMfa(user); err != nil {
 		return model.NewAppError("DeactivateMfa", "mfa.deactivate.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
@@ -1403,6 +1413,12 @@ func (a *App) UpdateMfa(c request.CTX, activate bool, userID, token string) *mod
 		}
 	}
 
+	a.sendMfaChangeEmail(c, userID, activate)
+
+	return nil
+}
+
+func (a *App) sendMfaChangeEmail(c request.CTX, userID string, activate bool) {
 	a.Srv().Go(func() {
 		user, err := a.GetUser(userID)
 		if err != nil {
@@ -1414,8 +1430,6 @@ func (a *App) UpdateMfa(c request.CTX, activate bool, userID, token string) *mod
 			c.Logger().Error("Failed to send mfa change email", mlog.Err(err))
 		}
 	})
-
-	return nil
 }
 
 func (a *App) UpdatePasswordByUserIdSendEmail(c request.CTX, userID, newPassword, method string) *model.AppError {
@@ -1437,7 +1451,7 @@ func (a *App) UpdatePassword(rctx request.CTX, user *model.User, newPassword str
 		return model.NewAppError("UpdatePassword", "api.user.update_password.failed.app_error", nil, "", http.StatusInternalServerError)
 	}
 
-	hashedPassword, err := model.HashPassword(newPassword)
+	hashedPassword, err := a.ch.srv.userService.HashPassword(newPassword)
 	if err != nil {
 		// can't be password length (checked in IsPasswordValid)
 		return model.NewAppError("UpdatePassword", "api.user.update_password.password_hash.app_error", nil, "user_id="+user.Id, http.StatusInternalServerError).Wrap(err)
@@ -1832,6 +1846,10 @@ func (a *App) PermanentDeleteUser(rctx request.CTX, user *model.User) *model.App
 		return model.NewAppError("PermanentDeleteUser", "app.scheduled_post.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
 	}
 
+	if err := a.Srv().Store().WebAuthnCredential().PermanentDeleteByUser(user.Id); err != nil {
+		return model.NewAppError("PermanentDeleteUser", "app.webauthn.permanent_delete_by_user.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
 	if err := a.Srv().Store().Bot().PermanentDelete(user.Id); err != nil {
 		var invErr *store.ErrInvalidInput
 		switch {
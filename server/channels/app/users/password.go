@@ -4,12 +4,11 @@
 package users
 
 import (
-	"errors"
 	"strings"
 
-	"golang.org/x/crypto/bcrypt"
-
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/password"
 )
 
 func CheckUserPassword(user *model.User, password string) error {
@@ -20,12 +19,21 @@ func CheckUserPassword(user *model.User, password string) error {
 	return nil
 }
 
-func ComparePassword(hash string, password string) error {
-	if password == "" || hash == "" {
-		return errors.New("empty password or hash")
-	}
+// ComparePassword checks the password against a hash generated by any of the supported
+// hashing algorithms, regardless of the one currently configured.
+func ComparePassword(hash string, plaintext string) error {
+	return password.Compare(hash, plaintext)
+}
+
+// HashPassword hashes the password with the algorithm configured in the password settings.
+func (us *UserService) HashPassword(plaintext string) (string, error) {
+	return password.NewHasher(&us.config().PasswordSettings).Hash(plaintext)
+}
 
-	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+// PasswordNeedsRehash tells whether the hash wasn't generated with the algorithm and parameters
+// configured in the password settings, and should be replaced next time the password is known.
+func (us *UserService) PasswordNeedsRehash(hash string) bool {
+	return password.NewHasher(&us.config().PasswordSettings).NeedsRehash(hash)
 }
 
 func (us *UserService) isPasswordValid(password string) error {
@@ -33,19 +41,20 @@ func (us *UserService) isPasswordValid(password string) error {
 }
 
 // IsPasswordValidWithSettings is a utility functions that checks if the given password
-// conforms to the password settings. It returns the error id as error value.
-func IsPasswordValidWithSettings(password string, settings *model.PasswordSettings) error {
+// conforms to the password settings and, if a breached passwords file is configured, isn't listed
+// in it. It returns the error id as error value.
+func IsPasswordValidWithSettings(plaintext string, settings *model.PasswordSettings) error {
 	id := "model.user.is_valid.pwd"
 	isError := false
 	isMinMaxError := false
 
-	if len(password) < *settings.MinimumLength {
+	if len(plaintext) < *settings.MinimumLength {
 		isError = true
 		isMinMaxError = true
 		id = id + "_min_length"
 	}
 
-	if len(password) > model.PasswordMaximumLength {
+	if len(plaintext) > model.PasswordMaximumLength {
 		isError = true
 		isMinMaxError = true
 		id = id + "_max_length"
@@ -53,28 +62,28 @@ func IsPasswordValidWithSettings(password string, settings *model.PasswordSettin
 
 	if !isMinMaxError {
 		if *settings.Lowercase {
-			if !strings.ContainsAny(password, model.LowercaseLetters) {
+			if !strings.ContainsAny(plaintext, model.LowercaseLetters) {
 				isError = true
 				id = id + "_lowercase"
 			}
 		}
 
 		if *settings.Uppercase {
-			if !strings.ContainsAny(password, model.UppercaseLetters) {
+			if !strings.ContainsAny(plaintext, model.UppercaseLetters) {
 				isError = true
 				id = id + "_uppercase"
 			}
 		}
 
 		if *settings.Number {
-			if !strings.ContainsAny(password, model.NUMBERS) {
+			if !strings.ContainsAny(plaintext, model.NUMBERS) {
 				isError = true
 				id = id + "_number"
 			}
 		}
 
 		if *settings.Symbol {
-			if !strings.ContainsAny(password, model.SYMBOLS) {
+			if !strings.ContainsAny(plaintext, model.SYMBOLS) {
 				isError = true
 				id = id + "_symbol"
 			}
@@ -85,5 +94,15 @@ func IsPasswordValidWithSettings(password string, settings *model.PasswordSettin
 		return NewErrInvalidPassword(id + ".app_error")
 	}
 
+	if settings.BreachedPasswordsFile != nil && *settings.BreachedPasswordsFile != "" {
+		breached, err := password.IsBreached(*settings.BreachedPasswordsFile, plaintext)
+		if err != nil {
+			// A missing or unreadable list shouldn't prevent users from setting their password.
+			mlog.Warn("Failed to check the password against the breached passwords file", mlog.String("path", *settings.BreachedPasswordsFile), mlog.Err(err))
+		} else if breached {
+			return NewErrInvalidPassword("model.user.is_valid.pwd_breached.app_error")
+		}
+	}
+
 	return nil
 }
@@ -4,6 +4,10 @@
 package users
 
 import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -131,3 +135,22 @@ func TestIsPasswordValidWithSettings(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPasswordValidWithBreachedPasswords(t *testing.T) {
+	sum := sha1.Sum([]byte("Passw0rd!"))
+	path := filepath.Join(t.TempDir(), "pwned-passwords.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.ToUpper(hex.EncodeToString(sum[:]))+":42\n"), 0600))
+
+	settings := &model.PasswordSettings{BreachedPasswordsFile: model.NewPointer(path)}
+	settings.SetDefaults()
+
+	err := IsPasswordValidWithSettings("Passw0rd!", settings)
+	invErr, ok := err.(*ErrInvalidPassword)
+	require.True(t, ok)
+	assert.Equal(t, "model.user.is_valid.pwd_breached.app_error", invErr.Id())
+
+	assert.NoError(t, IsPasswordValidWithSettings("correct horse battery staple", settings))
+
+	*settings.BreachedPasswordsFile = filepath.Join(t.TempDir(), "missing.txt")
+	assert.NoError(t, IsPasswordValidWithSettings("Passw0rd!", settings), "an unreadable file shouldn't block passwords")
+}
@@ -64,6 +64,14 @@ func (us *UserService) createUser(rctx request.CTX, user *model.User) (*model.Us
 		return nil, err
 	}
 
+	if user.Password != "" {
+		hash, err := us.HashPassword(user.Password)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to hash password")
+		}
+		user.SetHashedPassword(hash)
+	}
+
 	ruser, err := us.store.Save(rctx, user)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,231 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/webpush"
+)
+
+// webPushTTL is how long push services keep a notification for a browser that is offline.
+const webPushTTL = 24 * time.Hour
+
+func (ch *Channels) WebPushVAPIDKey() *ecdsa.PrivateKey {
+	return ch.webPushVAPIDKey
+}
+
+func (a *App) canSendWebPushNotifications() bool {
+	if !*a.Config().EmailSettings.EnableWebPushNotifications {
+		a.NotificationsLog().Debug("Web push notifications are disabled - server config",
+			mlog.String("status", model.NotificationStatusNotSent),
+			mlog.String("reason", "web_push_disabled"),
+		)
+		return false
+	}
+
+	return a.ch.WebPushVAPIDKey() != nil
+}
+
+// GetWebPushPublicKey returns the server's VAPID public key, which browsers need to subscribe
+// to web push notifications.
+func (a *App) GetWebPushPublicKey() (*model.WebPushPublicKey, *model.AppError) {
+	if !a.canSendWebPushNotifications() {
+		return nil, model.NewAppError("GetWebPushPublicKey", "app.web_push.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	publicKey, err := webpush.PublicKey(a.ch.WebPushVAPIDKey())
+	if err != nil {
+		return nil, model.NewAppError("GetWebPushPublicKey", "app.web_push.public_key.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return &model.WebPushPublicKey{PublicKey: publicKey}, nil
+}
+
+// AttachWebPushSubscription attaches a browser's push subscription to its session, so that
+// push notifications for the session's user are sent to it.
+func (a *App) AttachWebPushSubscription(session *model.Session, subscription *model.WebPushSubscription) *model.AppError {
+	if !a.canSendWebPushNotifications() {
+		return model.NewAppError("AttachWebPushSubscription", "app.web_push.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	if appErr := subscription.IsValid(); appErr != nil {
+		return appErr
+	}
+
+	subscriptionJSON, err := json.Marshal(subscription)
+	if err != nil {
+		return model.NewAppError("AttachWebPushSubscription", "app.web_push.subscription.marshal.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if appErr := a.SetExtraSessionProps(session, map[string]string{
+		model.SessionPropWebPushSubscription: string(subscriptionJSON),
+	}); appErr != nil {
+		return appErr
+	}
+
+	a.ClearSessionCacheForUser(session.UserId)
+	return nil
+}
+
+// DetachWebPushSubscription removes the push subscription attached to the session, if any.
+func (a *App) DetachWebPushSubscription(session *model.Session) *model.AppError {
+	if appErr := a.SetExtraSessionProps(session, map[string]string{
+		model.SessionPropWebPushSubscription: "",
+	}); appErr != nil {
+		return appErr
+	}
+
+	a.ClearSessionCacheForUser(session.UserId)
+	return nil
+}
+
+// sendWebPushNotifications sends a message notification to the browsers subscribed to push
+// notifications in the user's sessions. Other notification types aren't sent, as browsers
+// require every push message to display a notification.
+func (a *App) sendWebPushNotifications(rctx request.CTX, msg *model.PushNotification, userID string, skipSessionID string) {
+	sessions, appErr := a.GetSessions(rctx, userID)
+	if appErr != nil {
+		a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypePush, model.NotificationReasonFetchError, model.PushNotifyWeb)
+		a.NotificationsLog().Error("Failed to get web push sessions",
+			mlog.String("type", model.NotificationTypePush),
+			mlog.String("status", model.NotificationStatusError),
+			mlog.String("reason", model.NotificationReasonFetchError),
+			mlog.String("user_id", userID),
+			mlog.Err(appErr),
+		)
+		return
+	}
+
+	webMessage := msg.DeepCopy()
+	webMessage.Platform = model.PushNotifyWeb
+	webMessage.ServerId = a.TelemetryId()
+	payload, err := webPushPayload(webMessage)
+	if err != nil {
+		a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypePush, model.NotificationReasonMarshalError, model.PushNotifyWeb)
+		a.NotificationsLog().Error("Failed to build web push payload",
+			mlog.String("type", model.NotificationTypePush),
+			mlog.String("status", model.NotificationStatusError),
+			mlog.String("reason", model.NotificationReasonMarshalError),
+			mlog.String("user_id", userID),
+			mlog.Err(err),
+		)
+		return
+	}
+
+	sender := webpush.NewSender(a.Srv().webPushClient, a.ch.WebPushVAPIDKey(), a.webPushSubject())
+	sentEndpoints := make(map[string]bool)
+	for _, session := range sessions {
+		subscriptionJSON := session.Props[model.SessionPropWebPushSubscription]
+		if subscriptionJSON == "" || session.IsExpired() || session.Id == skipSessionID {
+			continue
+		}
+
+		var subscription model.WebPushSubscription
+		if err := json.Unmarshal([]byte(subscriptionJSON), &subscription); err != nil {
+			continue
+		}
+
+		// The same browser might be subscribed from several sessions.
+		if sentEndpoints[subscription.Endpoint] {
+			continue
+		}
+		sentEndpoints[subscription.Endpoint] = true
+
+		err := sender.Send(rctx.Context(), &subscription, payload, webpush.Options{TTL: webPushTTL})
+		if err != nil {
+			reason := model.NotificationReasonWebPushSendError
+			if errors.Is(err, webpush.ErrSubscriptionGone) {
+				reason = model.NotificationReasonWebPushSubscriptionGone
+				if appErr := a.DetachWebPushSubscription(session); appErr != nil {
+					rctx.Logger().Warn("Failed to detach expired web push subscription", mlog.String("session_id", session.Id), mlog.Err(appErr))
+				}
+			}
+			a.CountNotificationReason(model.NotificationStatusError, model.NotificationTypePush, reason, model.PushNotifyWeb)
+			a.NotificationsLog().Error("Failed to send web push notification",
+				mlog.String("type", model.NotificationTypePush),
+				mlog.String("status", model.NotificationStatusNotSent),
+				mlog.String("reason", reason),
+				mlog.String("push_type", webMessage.Type),
+				mlog.String("user_id", session.UserId),
+				mlog.String("session_id", session.Id),
+				mlog.Err(err),
+			)
+			continue
+		}
+
+		a.NotificationsLog().Trace("Notification sent to web push service",
+			mlog.String("type", model.NotificationTypePush),
+			mlog.String("push_type", webMessage.Type),
+			mlog.String("user_id", session.UserId),
+			mlog.String("session_id", session.Id),
+			mlog.String("status", model.PushSendSuccess),
+		)
+
+		if a.Metrics() != nil {
+			a.Metrics().IncrementPostSentPush()
+		}
+		a.CountNotification(model.NotificationTypePush, model.PushNotifyWeb)
+	}
+}
+
+// webPushSubject returns the contact push services can use to reach the server's administrators.
+func (a *App) webPushSubject() string {
+	if siteURL := *a.Config().ServiceSettings.SiteURL; strings.HasPrefix(siteURL, "https://") {
+		return siteURL
+	}
+	if feedbackEmail := *a.Config().EmailSettings.FeedbackEmail; feedbackEmail != "" {
+		return "mailto:" + feedbackEmail
+	}
+	return ""
+}
+
+// webPushPayload serializes the notification, truncating its message if needed to fit in a
+// web push message.
+func webPushPayload(msg *model.PushNotification) ([]byte, error) {
+	payload, err := json.Marshal(msg)
+	if err != nil || len(payload) <= webpush.MaxPayloadSize {
+		return payload, err
+	}
+
+	// Escaping prevents computing how much of the message fits, so search for the longest
+	// prefix that does.
+	message := msg.Message
+	payload = nil
+	low, high := 0, len(message)-1
+	for low <= high {
+		mid := (low + high) / 2
+		cut := mid
+		for cut > 0 && !utf8.RuneStart(message[cut]) {
+			cut--
+		}
+
+		msg.Message = message[:cut]
+		truncated, err := json.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(truncated) <= webpush.MaxPayloadSize {
+			payload = truncated
+			low = mid + 1
+		} else {
+			high = mid - 1
+		}
+	}
+
+	if payload == nil {
+		return nil, errors.New("web push payload is too large")
+	}
+	return payload, nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/webpush"
+)
+
+func TestWebPushPayload(t *testing.T) {
+	t.Run("fits", func(t *testing.T) {
+		payload, err := webPushPayload(&model.PushNotification{Type: model.PushTypeMessage, Message: "hello"})
+		require.NoError(t, err)
+
+		var msg model.PushNotification
+		require.NoError(t, json.Unmarshal(payload, &msg))
+		assert.Equal(t, "hello", msg.Message)
+	})
+
+	t.Run("truncates long messages", func(t *testing.T) {
+		message := strings.Repeat("é", webpush.MaxPayloadSize)
+		payload, err := webPushPayload(&model.PushNotification{Type: model.PushTypeMessage, Message: message})
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(payload), webpush.MaxPayloadSize)
+
+		var msg model.PushNotification
+		require.NoError(t, json.Unmarshal(payload, &msg))
+		assert.NotEmpty(t, msg.Message)
+		assert.True(t, strings.HasPrefix(message, msg.Message))
+	})
+}
+
+func TestSendWebPushNotifications(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var requests atomic.Int32
+	status := atomic.Int32{}
+	status.Store(http.StatusCreated)
+	pushService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "vapid t="))
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer pushService.Close()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.EmailSettings.SendPushNotifications = false
+		*cfg.EmailSettings.EnableWebPushNotifications = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.1"
+	})
+
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	subscriptionJSON, err := json.Marshal(&model.WebPushSubscription{
+		Endpoint: pushService.URL + "/send/abc",
+		Keys: model.WebPushSubscriptionKeys{
+			P256dh: base64.RawURLEncoding.EncodeToString(uaPrivate.PublicKey().Bytes()),
+			Auth:   base64.RawURLEncoding.EncodeToString(make([]byte, 16)),
+		},
+	})
+	require.NoError(t, err)
+
+	session, appErr := th.App.CreateSession(th.Context, &model.Session{
+		UserId:    th.BasicUser.Id,
+		ExpiresAt: model.GetMillis() + 100000,
+		Props:     model.StringMap{model.SessionPropWebPushSubscription: string(subscriptionJSON)},
+	})
+	require.Nil(t, appErr)
+
+	msg := &model.PushNotification{
+		Type:      model.PushTypeMessage,
+		Version:   model.PushMessageV2,
+		ChannelId: th.BasicChannel.Id,
+		Message:   "hello",
+	}
+
+	t.Run("sends message notifications", func(t *testing.T) {
+		requests.Store(0)
+		appErr := th.App.sendPushNotificationToAllSessions(th.Context, msg, th.BasicUser.Id, "")
+		require.Nil(t, appErr)
+		assert.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("skips other notification types", func(t *testing.T) {
+		requests.Store(0)
+		appErr := th.App.sendPushNotificationToAllSessions(th.Context, &model.PushNotification{Type: model.PushTypeClear}, th.BasicUser.Id, "")
+		require.Nil(t, appErr)
+		assert.Equal(t, int32(0), requests.Load())
+	})
+
+	t.Run("skips the given session", func(t *testing.T) {
+		requests.Store(0)
+		appErr := th.App.sendPushNotificationToAllSessions(th.Context, msg, th.BasicUser.Id, session.Id)
+		require.Nil(t, appErr)
+		assert.Equal(t, int32(0), requests.Load())
+	})
+
+	t.Run("detaches expired subscriptions", func(t *testing.T) {
+		status.Store(http.StatusGone)
+		appErr := th.App.sendPushNotificationToAllSessions(th.Context, msg, th.BasicUser.Id, "")
+		require.Nil(t, appErr)
+
+		updated, err := th.Server.Store().Session().Get(th.Context, session.Id)
+		require.NoError(t, err)
+		assert.Empty(t, updated.Props[model.SessionPropWebPushSubscription])
+	})
+}
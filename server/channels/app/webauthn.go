@@ -0,0 +1,570 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/webauthn"
+)
+
+const (
+	TokenTypeWebAuthnChallenge = "webauthn_challenge"
+
+	webAuthnCeremonyRegistration   = "registration"
+	webAuthnCeremonyAuthentication = "authentication"
+)
+
+// webAuthnChallenge is the extra of the token a ceremony challenge is stored as. The user ID is
+// empty for the challenge of a passwordless login, where the user is only known from the assertion.
+type webAuthnChallenge struct {
+	UserId   string `json:"user_id"`
+	Ceremony string `json:"ceremony"`
+}
+
+func (a *App) webAuthn() (*webauthn.WebAuthn, *model.AppError) {
+	w, err := webauthn.New(*a.Config().ServiceSettings.SiteURL)
+	if err != nil {
+		return nil, model.NewAppError("webAuthn", "app.webauthn.site_url.app_error", nil, "", http.StatusNotImplemented).Wrap(err)
+	}
+
+	return w, nil
+}
+
+func (a *App) checkWebAuthnEnabled() *model.AppError {
+	if !*a.Config().ServiceSettings.EnableMultifactorAuthentication || !*a.Config().ServiceSettings.EnableWebAuthn {
+		return model.NewAppError("checkWebAuthnEnabled", "app.webauthn.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	return nil
+}
+
+func (a *App) createWebAuthnChallenge(userID, ceremony string) (string, *model.AppError) {
+	extra, err := json.Marshal(webAuthnChallenge{UserId: userID, Ceremony: ceremony})
+	if err != nil {
+		return "", model.NewAppError("createWebAuthnChallenge", "app.webauthn.challenge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	token := model.NewToken(TokenTypeWebAuthnChallenge, string(extra))
+	token.Token = webauthn.NewChallenge()
+	if err := a.Srv().Store().Token().Save(token); err != nil {
+		return "", model.NewAppError("createWebAuthnChallenge", "app.webauthn.challenge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return token.Token, nil
+}
+
+// consumeWebAuthnChallenge returns the challenge the client data answers, once, if it was issued
+// for the ceremony of the user and hasn't expired.
+func (a *App) consumeWebAuthnChallenge(clientDataJSON []byte, userID, ceremony string) (string, *model.AppError) {
+	invalidErr := model.NewAppError("consumeWebAuthnChallenge", "app.webauthn.invalid_challenge.app_error", nil, "", http.StatusUnauthorized)
+
+	challenge, err := webauthn.Challenge(clientDataJSON)
+	if err != nil || challenge == "" {
+		return "", invalidErr.Wrap(err)
+	}
+
+	token, err := a.Srv().Store().Token().GetByToken(challenge)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		if errors.As(err, &nfErr) {
+			return "", invalidErr.Wrap(err)
+		}
+		return "", model.NewAppError("consumeWebAuthnChallenge", "app.webauthn.challenge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	if token.Type != TokenTypeWebAuthnChallenge {
+		return "", invalidErr
+	}
+
+	if err := a.Srv().Store().Token().Delete(token.Token); err != nil {
+		return "", model.NewAppError("consumeWebAuthnChallenge", "app.webauthn.challenge.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	var extra webAuthnChallenge
+	if err := json.Unmarshal([]byte(token.Extra), &extra); err != nil {
+		return "", invalidErr.Wrap(err)
+	}
+
+	if model.GetMillis()-token.CreateAt > model.WebAuthnCeremonyTimeout || extra.Ceremony != ceremony || extra.UserId != userID {
+		return "", invalidErr
+	}
+
+	return challenge, nil
+}
+
+func webAuthnCredentialDescriptors(credentials []*model.WebAuthnCredential) []model.WebAuthnCredentialDescriptor {
+	descriptors := make([]model.WebAuthnCredentialDescriptor, 0, len(credentials))
+	for _, credential := range credentials {
+		descriptors = append(descriptors, model.WebAuthnCredentialDescriptor{
+			Type: model.WebAuthnCredentialTypePublicKey,
+			Id:   credential.CredentialId,
+		})
+	}
+
+	return descriptors
+}
+
+func decodeWebAuthnField(field string) ([]byte, *model.AppError) {
+	data, err := base64.RawURLEncoding.DecodeString(field)
+	if err != nil || len(data) == 0 {
+		return nil, model.NewAppError("decodeWebAuthnField", "app.webauthn.invalid_response.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	return data, nil
+}
+
+func (a *App) GetWebAuthnCredentials(userID string) ([]*model.WebAuthnCredential, *model.AppError) {
+	credentials, err := a.Srv().Store().WebAuthnCredential().GetForUser(userID)
+	if err != nil {
+		return nil, model.NewAppError("GetWebAuthnCredentials", "app.webauthn.get_credentials.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return credentials, nil
+}
+
+// GetWebAuthnRegistrationOptions starts the registration of a security key or passkey by the user.
+func (a *App) GetWebAuthnRegistrationOptions(userID string) (*model.WebAuthnCreationOptions, *model.AppError) {
+	if appErr := a.checkWebAuthnEnabled(); appErr != nil {
+		return nil, appErr
+	}
+
+	w, appErr := a.webAuthn()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if user.IsBot || (user.AuthService != "" && user.AuthService != model.UserAuthServiceLdap) {
+		return nil, model.NewAppError("GetWebAuthnRegistrationOptions", "api.user.activate_mfa.email_and_ldap_only.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	credentials, appErr := a.GetWebAuthnCredentials(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if len(credentials) >= model.WebAuthnMaxCredentialsPerUser {
+		return nil, model.NewAppError("GetWebAuthnRegistrationOptions", "app.webauthn.too_many_credentials.app_error", map[string]any{"Max": model.WebAuthnMaxCredentialsPerUser}, "", http.StatusBadRequest)
+	}
+
+	challenge, appErr := a.createWebAuthnChallenge(userID, webAuthnCeremonyRegistration)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	parameters := make([]model.WebAuthnCredentialParameter, 0, len(webauthn.SupportedAlgorithms))
+	for _, algorithm := range webauthn.SupportedAlgorithms {
+		parameters = append(parameters, model.WebAuthnCredentialParameter{
+			Type:      model.WebAuthnCredentialTypePublicKey,
+			Algorithm: algorithm,
+		})
+	}
+
+	return &model.WebAuthnCreationOptions{
+		Challenge: challenge,
+		RelyingParty: model.WebAuthnRelyingParty{
+			Id:   w.RPID(),
+			Name: *a.Config().TeamSettings.SiteName,
+		},
+		User: model.WebAuthnUserEntity{
+			Id:          base64.RawURLEncoding.EncodeToString([]byte(user.Id)),
+			Name:        user.Username,
+			DisplayName: user.GetDisplayName(model.ShowFullName),
+		},
+		Parameters:         parameters,
+		Timeout:            model.WebAuthnCeremonyTimeout,
+		ExcludeCredentials: webAuthnCredentialDescriptors(credentials),
+		AuthenticatorSelection: model.WebAuthnAuthenticatorSelection{
+			ResidentKey:      model.WebAuthnResidentKeyPreferred,
+			UserVerification: model.WebAuthnUserVerificationPreferred,
+		},
+		Attestation: model.WebAuthnAttestationNone,
+	}, nil
+}
+
+// RegisterWebAuthnCredential verifies and saves the credential created by the authenticator of
+// the user. Registering a first credential turns on multi-factor authentication for the user.
+func (a *App) RegisterWebAuthnCredential(rctx request.CTX, userID string, registration *model.WebAuthnRegistration) (*model.WebAuthnCredential, *model.AppError) {
+	if appErr := a.checkWebAuthnEnabled(); appErr != nil {
+		return nil, appErr
+	}
+
+	w, appErr := a.webAuthn()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	clientDataJSON, appErr := decodeWebAuthnField(registration.Attestation.Response.ClientDataJSON)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	attestationObject, appErr := decodeWebAuthnField(registration.Attestation.Response.AttestationObject)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	challenge, appErr := a.consumeWebAuthnChallenge(clientDataJSON, userID, webAuthnCeremonyRegistration)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	verified, err := w.VerifyRegistration(challenge, clientDataJSON, attestationObject, false)
+	if err != nil {
+		return nil, model.NewAppError("RegisterWebAuthnCredential", "app.webauthn.invalid_response.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	credentials, appErr := a.GetWebAuthnCredentials(userID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if len(credentials) >= model.WebAuthnMaxCredentialsPerUser {
+		return nil, model.NewAppError("RegisterWebAuthnCredential", "app.webauthn.too_many_credentials.app_error", map[string]any{"Max": model.WebAuthnMaxCredentialsPerUser}, "", http.StatusBadRequest)
+	}
+
+	credentialID := base64.RawURLEncoding.EncodeToString(verified.ID)
+	for _, credential := range credentials {
+		if credential.CredentialId == credentialID {
+			return nil, model.NewAppError("RegisterWebAuthnCredential", "app.webauthn.already_registered.app_error", nil, "", http.StatusBadRequest)
+		}
+	}
+
+	credential, err := a.Srv().Store().WebAuthnCredential().Save(&model.WebAuthnCredential{
+		UserId:       userID,
+		CredentialId: credentialID,
+		PublicKey:    verified.PublicKey,
+		SignCount:    int64(verified.SignCount),
+		Name:         registration.Name,
+	})
+	if err != nil {
+		var appErr *model.AppError
+		switch {
+		case errors.As(err, &appErr):
+			return nil, appErr
+		default:
+			return nil, model.NewAppError("RegisterWebAuthnCredential", "app.webauthn.save.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if !user.MfaActive {
+		if err := a.Srv().Store().User().UpdateMfaActive(userID, true); err != nil {
+			return nil, model.NewAppError("RegisterWebAuthnCredential", "mfa.activate.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		// Make sure old MFA status is not cached locally or in cluster nodes.
+		a.InvalidateCacheForUser(userID)
+		a.sendMfaChangeEmail(rctx, userID, true)
+	}
+
+	return credential, nil
+}
+
+// DeleteWebAuthnCredential removes a credential of the user. Removing the last credential of a user
+// without an authenticator app turns off multi-factor authentication for them.
+func (a *App) DeleteWebAuthnCredential(rctx request.CTX, userID, credentialID string) *model.AppError {
+	credential, err := a.Srv().Store().WebAuthnCredential().Get(credentialID)
+	if err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn.get_credential.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn.get_credentials.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if credential.UserId != userID {
+		return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn.get_credential.not_found.app_error", nil, "", http.StatusNotFound)
+	}
+
+	if err := a.Srv().Store().WebAuthnCredential().Delete(credentialID); err != nil {
+		var nfErr *store.ErrNotFound
+		switch {
+		case errors.As(err, &nfErr):
+			return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn.get_credential.not_found.app_error", nil, "", http.StatusNotFound).Wrap(err)
+		default:
+			return model.NewAppError("DeleteWebAuthnCredential", "app.webauthn.delete.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	user, appErr := a.GetUser(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if !user.MfaActive || user.MfaSecret != "" {
+		return nil
+	}
+
+	credentials, appErr := a.GetWebAuthnCredentials(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	if len(credentials) == 0 {
+		if err := a.Srv().Store().User().UpdateMfaActive(userID, false); err != nil {
+			return model.NewAppError("DeleteWebAuthnCredential", "mfa.deactivate.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+
+		// Make sure old MFA status is not cached locally or in cluster nodes.
+		a.InvalidateCacheForUser(userID)
+		a.sendMfaChangeEmail(rctx, userID, false)
+	}
+
+	return nil
+}
+
+// verifyWebAuthnAssertion verifies the assertion of one of the credentials of the user, and
+// records its use.
+func (a *App) verifyWebAuthnAssertion(rctx request.CTX, assertion *model.WebAuthnAssertion, userID string, requireUserVerification bool) *model.AppError {
+	invalidErr := model.NewAppError("verifyWebAuthnAssertion", "api.user.check_user_mfa.bad_code.app_error", nil, "", http.StatusUnauthorized)
+
+	w, appErr := a.webAuthn()
+	if appErr != nil {
+		return appErr
+	}
+
+	clientDataJSON, appErr := decodeWebAuthnField(assertion.Response.ClientDataJSON)
+	if appErr != nil {
+		return invalidErr.Wrap(appErr)
+	}
+
+	authData, appErr := decodeWebAuthnField(assertion.Response.AuthenticatorData)
+	if appErr != nil {
+		return invalidErr.Wrap(appErr)
+	}
+
+	signature, appErr := decodeWebAuthnField(assertion.Response.Signature)
+	if appErr != nil {
+		return invalidErr.Wrap(appErr)
+	}
+
+	ceremonyUserID := userID
+	if requireUserVerification {
+		// Passwordless logins are challenged before the user is known.
+		ceremonyUserID = ""
+	}
+
+	challenge, appErr := a.consumeWebAuthnChallenge(clientDataJSON, ceremonyUserID, webAuthnCeremonyAuthentication)
+	if appErr != nil {
+		return invalidErr.Wrap(appErr)
+	}
+
+	credentials, appErr := a.GetWebAuthnCredentials(userID)
+	if appErr != nil {
+		return appErr
+	}
+
+	var credential *model.WebAuthnCredential
+	for _, c := range credentials {
+		if c.CredentialId == assertion.Id {
+			credential = c
+			break
+		}
+	}
+	if credential == nil {
+		return invalidErr
+	}
+
+	credentialID, appErr := decodeWebAuthnField(credential.CredentialId)
+	if appErr != nil {
+		return appErr
+	}
+
+	signCount, err := w.VerifyAssertion(challenge, &webauthn.Credential{
+		ID:        credentialID,
+		PublicKey: credential.PublicKey,
+		SignCount: uint32(credential.SignCount),
+	}, clientDataJSON, authData, signature, requireUserVerification)
+	if err != nil {
+		if errors.Is(err, webauthn.ClonedAuthenticator) {
+			rctx.Logger().Warn("A WebAuthn credential was used with a signature counter that went backwards, it may have been cloned.", mlog.String("user_id", userID), mlog.String("credential_id", credential.Id))
+		}
+		return invalidErr.Wrap(err)
+	}
+
+	if err := a.Srv().Store().WebAuthnCredential().UpdateSignCount(credential.Id, int64(signCount), model.GetMillis()); err != nil {
+		var cErr *store.ErrConflict
+		if errors.As(err, &cErr) {
+			// A concurrent use of the credential advanced the counter first.
+			return invalidErr.Wrap(err)
+		}
+		return model.NewAppError("verifyWebAuthnAssertion", "app.webauthn.update.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return nil
+}
+
+// checkUserWebAuthn verifies the WebAuthn assertion a user gave as the MFA token of a login.
+func (a *App) checkUserWebAuthn(rctx request.CTX, user *model.User, token string) *model.AppError {
+	assertion, err := model.WebAuthnAssertionFromJSON(token)
+	if err != nil {
+		return model.NewAppError("checkUserWebAuthn", "api.user.check_user_mfa.bad_code.app_error", nil, "", http.StatusUnauthorized).Wrap(err)
+	}
+
+	return a.verifyWebAuthnAssertion(rctx, assertion, user.Id, false)
+}
+
+// IsWebAuthnLogin tells whether a successful login of the user was made with a WebAuthn second factor.
+func (a *App) IsWebAuthnLogin(user *model.User, mfaToken string) bool {
+	return user.MfaActive && *a.Config().ServiceSettings.EnableMultifactorAuthentication && model.IsWebAuthnAssertion(mfaToken)
+}
+
+// GetWebAuthnLoginOptions challenges a login with a WebAuthn credential. Given the login ID and
+// password of a user, it returns the credentials of the user to use as a second factor, once the
+// password has been verified. Without a login ID, it starts a passwordless login with a passkey.
+func (a *App) GetWebAuthnLoginOptions(rctx request.CTX, loginID, password string) (*model.WebAuthnRequestOptions, *model.AppError) {
+	if appErr := a.checkWebAuthnEnabled(); appErr != nil {
+		return nil, appErr
+	}
+
+	w, appErr := a.webAuthn()
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	options := &model.WebAuthnRequestOptions{
+		Timeout:          model.WebAuthnCeremonyTimeout,
+		RelyingPartyId:   w.RPID(),
+		AllowCredentials: []model.WebAuthnCredentialDescriptor{},
+		UserVerification: model.WebAuthnUserVerificationPreferred,
+	}
+
+	if loginID == "" {
+		if !*a.Config().ServiceSettings.EnablePasskeyLogin {
+			return nil, model.NewAppError("GetWebAuthnLoginOptions", "app.webauthn.passkey_login_disabled.app_error", nil, "", http.StatusNotImplemented)
+		}
+
+		challenge, appErr := a.createWebAuthnChallenge("", webAuthnCeremonyAuthentication)
+		if appErr != nil {
+			return nil, appErr
+		}
+
+		options.Challenge = challenge
+		options.UserVerification = model.WebAuthnUserVerificationRequired
+		return options, nil
+	}
+
+	if password == "" {
+		return nil, model.NewAppError("GetWebAuthnLoginOptions", "api.user.login.blank_pwd.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	user, appErr := a.GetUserForLogin(rctx, "", loginID)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	// The password is verified before revealing the credentials of the user, and the missing second
+	// factor is the only expected failure.
+	if _, appErr = a.authenticateUser(rctx, user, password, ""); appErr == nil {
+		return nil, model.NewAppError("GetWebAuthnLoginOptions", "app.webauthn.not_required.app_error", nil, "", http.StatusBadRequest)
+	}
+	if !isMissingMfaError(appErr) {
+		return nil, appErr
+	}
+
+	credentials, appErr := a.GetWebAuthnCredentials(user.Id)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	if len(credentials) == 0 {
+		return nil, model.NewAppError("GetWebAuthnLoginOptions", "app.webauthn.not_required.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	challenge, appErr := a.createWebAuthnChallenge(user.Id, webAuthnCeremonyAuthentication)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	options.Challenge = challenge
+	options.AllowCredentials = webAuthnCredentialDescriptors(credentials)
+	return options, nil
+}
+
+// isMissingMfaError tells whether a login failed only for the lack of a second factor.
+func isMissingMfaError(appErr *model.AppError) bool {
+	switch appErr.Id {
+	case "api.user.check_user_mfa.webauthn_required.app_error",
+		"mfa.validate_token.authenticate.app_error",
+		"api.user.check_user_mfa.bad_code.app_error":
+		return true
+	}
+	return false
+}
+
+// AuthenticateUserWithPasskey logs in the user whose passkey made the assertion, without a password.
+// The passkey must have verified the user, which makes it count for both factors.
+func (a *App) AuthenticateUserWithPasskey(rctx request.CTX, assertion *model.WebAuthnAssertion) (*model.User, *model.AppError) {
+	invalidErr := model.NewAppError("AuthenticateUserWithPasskey", "api.user.login_passkey.invalid.app_error", nil, "", http.StatusUnauthorized)
+
+	if appErr := a.checkWebAuthnEnabled(); appErr != nil {
+		return nil, appErr
+	}
+
+	if !*a.Config().ServiceSettings.EnablePasskeyLogin {
+		return nil, model.NewAppError("AuthenticateUserWithPasskey", "app.webauthn.passkey_login_disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	userHandle, err := base64.RawURLEncoding.DecodeString(assertion.Response.UserHandle)
+	if err != nil || !model.IsValidId(string(userHandle)) {
+		return nil, invalidErr.Wrap(err)
+	}
+
+	// Use locks to avoid concurrently checking AND updating the failed login attempts.
+	a.ch.loginAttemptsMut.Lock()
+	defer a.ch.loginAttemptsMut.Unlock()
+
+	user, appErr := a.GetUser(string(userHandle))
+	if appErr != nil {
+		return nil, invalidErr.Wrap(appErr)
+	}
+
+	if user.AuthService != "" && user.AuthService != model.UserAuthServiceLdap {
+		return nil, invalidErr
+	}
+
+	if appErr := a.CheckUserPreflightAuthenticationCriteria(rctx, user, ""); appErr != nil {
+		return nil, appErr
+	}
+
+	if appErr := a.verifyWebAuthnAssertion(rctx, assertion, user.Id, true); appErr != nil {
+		if err := a.Srv().Store().User().UpdateFailedPasswordAttempts(user.Id, user.FailedAttempts+1); err != nil {
+			return nil, model.NewAppError("AuthenticateUserWithPasskey", "app.user.update_failed_pwd_attempts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		a.InvalidateCacheForUser(user.Id)
+		return nil, invalidErr.Wrap(appErr)
+	}
+
+	if user.FailedAttempts > 0 {
+		if err := a.Srv().Store().User().UpdateFailedPasswordAttempts(user.Id, 0); err != nil {
+			return nil, model.NewAppError("AuthenticateUserWithPasskey", "app.user.update_failed_pwd_attempts.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		a.InvalidateCacheForUser(user.Id)
+	}
+
+	if appErr := a.CheckUserPostflightAuthenticationCriteria(rctx, user); appErr != nil {
+		return nil, appErr
+	}
+
+	return user, nil
+}
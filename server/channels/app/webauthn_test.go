@@ -0,0 +1,221 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/webauthn/webauthntest"
+)
+
+const webAuthnTestSiteURL = "http://localhost:8065"
+
+func registerTestWebAuthnCredential(t *testing.T, th *TestHelper, userID string, authenticator *webauthntest.Authenticator) *model.WebAuthnCredential {
+	t.Helper()
+
+	options, appErr := th.App.GetWebAuthnRegistrationOptions(userID)
+	require.Nil(t, appErr)
+
+	credential, appErr := th.App.RegisterWebAuthnCredential(th.Context, userID, &model.WebAuthnRegistration{
+		Name:        "Security key",
+		Attestation: authenticator.Register(webAuthnTestSiteURL, options),
+	})
+	require.Nil(t, appErr)
+	return credential
+}
+
+func TestWebAuthnCredentials(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.SiteURL = webAuthnTestSiteURL
+		*cfg.ServiceSettings.EnableMultifactorAuthentication = true
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		_, appErr := th.App.GetWebAuthnRegistrationOptions(th.BasicUser.Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.disabled.app_error", appErr.Id)
+	})
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableWebAuthn = true
+	})
+
+	authenticator := webauthntest.NewAuthenticator(false)
+	credential := registerTestWebAuthnCredential(t, th, th.BasicUser.Id, authenticator)
+	assert.Equal(t, authenticator.CredentialId(), credential.CredentialId)
+
+	user, appErr := th.App.GetUser(th.BasicUser.Id)
+	require.Nil(t, appErr)
+	assert.True(t, user.MfaActive, "registering a credential should turn on MFA")
+
+	t.Run("registration options exclude registered credentials", func(t *testing.T) {
+		options, appErr := th.App.GetWebAuthnRegistrationOptions(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		require.Len(t, options.ExcludeCredentials, 1)
+		assert.Equal(t, credential.CredentialId, options.ExcludeCredentials[0].Id)
+		assert.Equal(t, "localhost", options.RelyingParty.Id)
+	})
+
+	t.Run("a registration can't be replayed", func(t *testing.T) {
+		options, appErr := th.App.GetWebAuthnRegistrationOptions(th.BasicUser.Id)
+		require.Nil(t, appErr)
+
+		registration := &model.WebAuthnRegistration{
+			Name:        "Other key",
+			Attestation: webauthntest.NewAuthenticator(false).Register(webAuthnTestSiteURL, options),
+		}
+		_, appErr = th.App.RegisterWebAuthnCredential(th.Context, th.BasicUser.Id, registration)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.RegisterWebAuthnCredential(th.Context, th.BasicUser.Id, registration)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.invalid_challenge.app_error", appErr.Id)
+	})
+
+	t.Run("a registration for the challenge of another user is rejected", func(t *testing.T) {
+		options, appErr := th.App.GetWebAuthnRegistrationOptions(th.BasicUser2.Id)
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.RegisterWebAuthnCredential(th.Context, th.BasicUser.Id, &model.WebAuthnRegistration{
+			Name:        "Other key",
+			Attestation: webauthntest.NewAuthenticator(false).Register(webAuthnTestSiteURL, options),
+		})
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.invalid_challenge.app_error", appErr.Id)
+	})
+
+	t.Run("deleting the last credential turns off MFA", func(t *testing.T) {
+		credentials, appErr := th.App.GetWebAuthnCredentials(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		require.Len(t, credentials, 2)
+
+		appErr = th.App.DeleteWebAuthnCredential(th.Context, th.BasicUser2.Id, credentials[0].Id)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.get_credential.not_found.app_error", appErr.Id)
+
+		for _, c := range credentials {
+			require.Nil(t, th.App.DeleteWebAuthnCredential(th.Context, th.BasicUser.Id, c.Id))
+		}
+
+		user, appErr := th.App.GetUser(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.False(t, user.MfaActive)
+	})
+}
+
+func TestWebAuthnLogin(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.SiteURL = webAuthnTestSiteURL
+		*cfg.ServiceSettings.EnableMultifactorAuthentication = true
+		*cfg.ServiceSettings.EnableWebAuthn = true
+	})
+
+	password := "newpassword1"
+	require.Nil(t, th.App.UpdatePassword(th.Context, th.BasicUser, password))
+
+	authenticator := webauthntest.NewAuthenticator(true)
+	registerTestWebAuthnCredential(t, th, th.BasicUser.Id, authenticator)
+
+	t.Run("a security key is required", func(t *testing.T) {
+		_, appErr := th.App.AuthenticateUserForLogin(th.Context, "", th.BasicUser.Email, password, "", "", false)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.check_user_mfa.webauthn_required.app_error", appErr.Id)
+
+		_, appErr = th.App.AuthenticateUserForLogin(th.Context, "", th.BasicUser.Email, password, "123456", "", false)
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.check_user_mfa.webauthn_required.app_error", appErr.Id)
+	})
+
+	t.Run("login options require the password", func(t *testing.T) {
+		_, appErr := th.App.GetWebAuthnLoginOptions(th.Context, th.BasicUser.Email, "wrongpassword")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.check_user_password.invalid.app_error", appErr.Id)
+
+		_, appErr = th.App.GetWebAuthnLoginOptions(th.Context, th.BasicUser.Email, "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.login.blank_pwd.app_error", appErr.Id)
+
+		_, appErr = th.App.GetWebAuthnLoginOptions(th.Context, th.BasicUser2.Email, "Password1")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.not_required.app_error", appErr.Id)
+	})
+
+	t.Run("login with a security key", func(t *testing.T) {
+		options, appErr := th.App.GetWebAuthnLoginOptions(th.Context, th.BasicUser.Email, password)
+		require.Nil(t, appErr)
+		require.Len(t, options.AllowCredentials, 1)
+		assert.Equal(t, authenticator.CredentialId(), options.AllowCredentials[0].Id)
+
+		token := authenticator.AssertJSON(webAuthnTestSiteURL, options)
+		user, appErr := th.App.AuthenticateUserForLogin(th.Context, "", th.BasicUser.Email, password, token, "", false)
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser.Id, user.Id)
+		assert.True(t, th.App.IsWebAuthnLogin(user, token))
+
+		_, appErr = th.App.AuthenticateUserForLogin(th.Context, "", th.BasicUser.Email, password, token, "", false)
+		require.NotNil(t, appErr, "an assertion can't be replayed")
+		assert.Equal(t, "api.user.check_user_mfa.bad_code.app_error", appErr.Id)
+	})
+
+	t.Run("passkey login", func(t *testing.T) {
+		_, appErr := th.App.GetWebAuthnLoginOptions(th.Context, "", "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.passkey_login_disabled.app_error", appErr.Id)
+
+		th.App.UpdateConfig(func(cfg *model.Config) {
+			*cfg.ServiceSettings.EnablePasskeyLogin = true
+		})
+
+		options, appErr := th.App.GetWebAuthnLoginOptions(th.Context, "", "")
+		require.Nil(t, appErr)
+		assert.Empty(t, options.AllowCredentials)
+		assert.Equal(t, model.WebAuthnUserVerificationRequired, options.UserVerification)
+
+		user, appErr := th.App.AuthenticateUserWithPasskey(th.Context, authenticator.Assert(webAuthnTestSiteURL, options))
+		require.Nil(t, appErr)
+		assert.Equal(t, th.BasicUser.Id, user.Id)
+	})
+
+	t.Run("passkey login requires WebAuthn", func(t *testing.T) {
+		options, appErr := th.App.GetWebAuthnLoginOptions(th.Context, "", "")
+		require.Nil(t, appErr)
+
+		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableWebAuthn = false })
+		defer th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableWebAuthn = true })
+
+		_, appErr = th.App.GetWebAuthnLoginOptions(th.Context, "", "")
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.disabled.app_error", appErr.Id)
+
+		_, appErr = th.App.AuthenticateUserWithPasskey(th.Context, authenticator.Assert(webAuthnTestSiteURL, options))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "app.webauthn.disabled.app_error", appErr.Id)
+	})
+
+	t.Run("passkey login requires user verification", func(t *testing.T) {
+		unverified := webauthntest.NewAuthenticator(false)
+		registerTestWebAuthnCredential(t, th, th.BasicUser.Id, unverified)
+
+		options, appErr := th.App.GetWebAuthnLoginOptions(th.Context, "", "")
+		require.Nil(t, appErr)
+
+		_, appErr = th.App.AuthenticateUserWithPasskey(th.Context, unverified.Assert(webAuthnTestSiteURL, options))
+		require.NotNil(t, appErr)
+		assert.Equal(t, "api.user.login_passkey.invalid.app_error", appErr.Id)
+
+		user, appErr := th.App.GetUser(th.BasicUser.Id)
+		require.Nil(t, appErr)
+		assert.Positive(t, user.FailedAttempts)
+	})
+}
@@ -8,9 +8,13 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -28,6 +32,11 @@ const (
 	TriggerwordsStartsWith = 1
 
 	MaxIntegrationResponseSize = 1024 * 1024 // Posts can be <100KB at most, so this is likely more than enough
+
+	OutgoingWebhookRetryBaseBackoff  = 1 * time.Minute
+	OutgoingWebhookRetryMaxBackoff   = 1 * time.Hour
+	OutgoingWebhookRetryBatchSize    = 100
+	OutgoingWebhookDeliveryRetention = 7 * 24 * time.Hour
 )
 
 var linkWithTextRegex = regexp.MustCompile(`<([^\n<\|>]+)\|([^\|\n>]+)>`)
@@ -50,170 +59,350 @@ func (a *App) handleWebhookEvents(c request.CTX, post *model.Post, team *model.T
 		return nil
 	}
 
-	var firstWord, triggerWord string
+	var firstWord string
 
 	splitWords := strings.Fields(post.Message)
 	if len(splitWords) > 0 {
 		firstWord = splitWords[0]
 	}
 
-	relevantHooks := []*model.OutgoingWebhook{}
 	for _, hook := range hooks {
-		if hook.ChannelId == post.ChannelId || hook.ChannelId == "" {
-			if hook.ChannelId == post.ChannelId && len(hook.TriggerWords) == 0 {
-				relevantHooks = append(relevantHooks, hook)
-				triggerWord = ""
-			} else if hook.TriggerWhen == TriggerwordsExactMatch && hook.TriggerWordExactMatch(firstWord) {
-				relevantHooks = append(relevantHooks, hook)
-				triggerWord = hook.GetTriggerWord(firstWord, true)
-			} else if hook.TriggerWhen == TriggerwordsStartsWith && hook.TriggerWordStartsWith(firstWord) {
-				relevantHooks = append(relevantHooks, hook)
-				triggerWord = hook.GetTriggerWord(firstWord, false)
-			}
+		triggerWord, ok := outgoingWebhookTriggerWord(hook, post.ChannelId, firstWord)
+		if !ok {
+			continue
 		}
-	}
 
-	for _, hook := range relevantHooks {
-		payload := &model.OutgoingWebhookPayload{
-			Token:       hook.Token,
-			TeamId:      hook.TeamId,
-			TeamDomain:  team.Name,
-			ChannelId:   post.ChannelId,
-			ChannelName: channel.Name,
-			Timestamp:   post.CreateAt,
-			UserId:      post.UserId,
-			UserName:    user.Username,
-			PostId:      post.Id,
-			Text:        post.Message,
-			TriggerWord: triggerWord,
-			FileIds:     strings.Join(post.FileIds, ","),
-		}
-		a.TriggerWebhook(c, payload, hook, post, channel)
+		a.TriggerWebhook(c, newOutgoingWebhookPayload(hook, post, team, channel, user, triggerWord), hook, post, channel)
 	}
 
 	return nil
 }
 
-func (a *App) TriggerWebhook(c request.CTX, payload *model.OutgoingWebhookPayload, hook *model.OutgoingWebhook, post *model.Post, channel *model.Channel) {
-	var jsonBytes []byte
-	var err error
+// outgoingWebhookTriggerWord reports whether a post in channelID starting with firstWord triggers
+// the hook, along with the trigger word it matched.
+func outgoingWebhookTriggerWord(hook *model.OutgoingWebhook, channelID, firstWord string) (string, bool) {
+	if hook.ChannelId != channelID && hook.ChannelId != "" {
+		return "", false
+	}
+
+	if hook.ChannelId == channelID && len(hook.TriggerWords) == 0 {
+		return "", true
+	} else if hook.TriggerWhen == TriggerwordsExactMatch && hook.TriggerWordExactMatch(firstWord) {
+		return hook.GetTriggerWord(firstWord, true), true
+	} else if hook.TriggerWhen == TriggerwordsStartsWith && hook.TriggerWordStartsWith(firstWord) {
+		return hook.GetTriggerWord(firstWord, false), true
+	}
+	return "", false
+}
+
+func newOutgoingWebhookPayload(hook *model.OutgoingWebhook, post *model.Post, team *model.Team, channel *model.Channel, user *model.User, triggerWord string) *model.OutgoingWebhookPayload {
+	return &model.OutgoingWebhookPayload{
+		Token:       hook.Token,
+		TeamId:      hook.TeamId,
+		TeamDomain:  team.Name,
+		ChannelId:   post.ChannelId,
+		ChannelName: channel.Name,
+		Timestamp:   post.CreateAt,
+		UserId:      post.UserId,
+		UserName:    user.Username,
+		PostId:      post.Id,
+		Text:        post.Message,
+		TriggerWord: triggerWord,
+		FileIds:     strings.Join(post.FileIds, ","),
+	}
+}
+
+// encodeOutgoingWebhookPayload encodes the payload sent to the hook's callback URLs, along with
+// the copy of it kept in the delivery log, which leaves out the hook's token.
+func encodeOutgoingWebhookPayload(hook *model.OutgoingWebhook, payload *model.OutgoingWebhookPayload) (contentType string, body []byte, logged []byte, err error) {
+	redacted := *payload
+	redacted.Token = ""
 
-	contentType := "application/x-www-form-urlencoded"
 	if hook.ContentType == "application/json" {
-		contentType = "application/json"
-		jsonBytes, err = json.Marshal(payload)
-		if err != nil {
-			c.Logger().Warn("Failed to encode to JSON", mlog.Err(err))
-			return
+		if body, err = json.Marshal(payload); err != nil {
+			return "", nil, nil, err
 		}
+		if logged, err = json.Marshal(&redacted); err != nil {
+			return "", nil, nil, err
+		}
+		return "application/json", body, logged, nil
+	}
+
+	return "application/x-www-form-urlencoded", []byte(payload.ToFormValues()), []byte(redacted.ToFormValues()), nil
+}
+
+func (a *App) TriggerWebhook(c request.CTX, payload *model.OutgoingWebhookPayload, hook *model.OutgoingWebhook, post *model.Post, channel *model.Channel) {
+	contentType, body, logged, err := encodeOutgoingWebhookPayload(hook, payload)
+	if err != nil {
+		c.Logger().Warn("Failed to encode to JSON", mlog.Err(err))
+		return
 	}
 
 	var wg sync.WaitGroup
 
 	for i := range hook.CallbackURLs {
-		var body io.Reader
-		if hook.ContentType == "application/json" {
-			body = bytes.NewReader(jsonBytes)
-		} else {
-			body = strings.NewReader(payload.ToFormValues())
-		}
 		wg.Add(1)
 
-		// Get the callback URL by index to properly capture it for the go func
-		url := hook.CallbackURLs[i]
+		delivery := &model.OutgoingWebhookDelivery{
+			HookId:      hook.Id,
+			PostId:      post.Id,
+			CallbackURL: hook.CallbackURLs[i],
+			ContentType: contentType,
+			Payload:     string(logged),
+			Attempt:     1,
+			SignedBody:  string(body),
+		}
 
 		go func() {
 			defer wg.Done()
+			a.deliverOutgoingWebhook(c, hook, delivery, post, channel)
+		}()
+	}
+	wg.Wait()
+}
 
-			var accessToken *model.OutgoingOAuthConnectionToken
+// deliverOutgoingWebhook sends the delivery's body as a single attempt, records it in the delivery
+// log and, once delivered, posts the receiver's response, if any, to the channel.
+func (a *App) deliverOutgoingWebhook(c request.CTX, hook *model.OutgoingWebhook, delivery *model.OutgoingWebhookDelivery, post *model.Post, channel *model.Channel) {
+	var accessToken *model.OutgoingOAuthConnectionToken
 
-			// Retrieve an access token from a connection if one exists to use for the webhook request
-			if a.Config().ServiceSettings.EnableOutgoingOAuthConnections != nil && *a.Config().ServiceSettings.EnableOutgoingOAuthConnections && a.OutgoingOAuthConnections() != nil {
-				connection, err := a.OutgoingOAuthConnections().GetConnectionForAudience(c, url)
-				if err != nil {
-					c.Logger().Error("Failed to find an outgoing oauth connection for the webhook", mlog.Err(err))
-					return
-				}
-
-				if connection != nil {
-					accessToken, err = a.OutgoingOAuthConnections().RetrieveTokenForConnection(c, connection)
-					if err != nil {
-						c.Logger().Error("Failed to retrieve token for outgoing oauth connection", mlog.Err(err))
-						return
-					}
-				}
-			}
+	// Retrieve an access token from a connection if one exists to use for the webhook request
+	if a.Config().ServiceSettings.EnableOutgoingOAuthConnections != nil && *a.Config().ServiceSettings.EnableOutgoingOAuthConnections && a.OutgoingOAuthConnections() != nil {
+		connection, err := a.OutgoingOAuthConnections().GetConnectionForAudience(c, delivery.CallbackURL)
+		if err != nil {
+			c.Logger().Error("Failed to find an outgoing oauth connection for the webhook", mlog.Err(err))
+			a.saveOutgoingWebhookDelivery(c, delivery, err)
+			return
+		}
 
-			webhookResp, err := a.doOutgoingWebhookRequest(url, body, contentType, accessToken)
+		if connection != nil {
+			accessToken, err = a.OutgoingOAuthConnections().RetrieveTokenForConnection(c, connection)
 			if err != nil {
-				if errors.Is(err, context.DeadlineExceeded) {
-					c.Logger().Error("Outgoing Webhook POST timed out. Consider increasing ServiceSettings.OutgoingIntegrationRequestsTimeout.", mlog.Err(err))
-				} else {
-					c.Logger().Error("Outgoing Webhook POST failed", mlog.Err(err))
-				}
+				c.Logger().Error("Failed to retrieve token for outgoing oauth connection", mlog.Err(err))
+				a.saveOutgoingWebhookDelivery(c, delivery, err)
 				return
 			}
+		}
+	}
 
-			if webhookResp != nil && (webhookResp.Text != nil || len(webhookResp.Attachments) > 0) {
-				postRootId := ""
-				if webhookResp.ResponseType == model.OutgoingHookResponseTypeComment {
-					postRootId = post.Id
-				}
-				if len(webhookResp.Props) == 0 {
-					webhookResp.Props = make(model.StringInterface)
-				}
-				webhookResp.Props["webhook_display_name"] = hook.DisplayName
+	webhookResp, err := a.doOutgoingWebhookRequest(delivery, []byte(delivery.SignedBody), hook, accessToken)
+	deliveryErr := err
+	if delivery.StatusCode != 0 {
+		// the receiver answered: the attempt is judged by the status code alone, a 2xx counts as
+		// delivered even if the response isn't a valid hook response.
+		deliveryErr = nil
+	}
+	a.saveOutgoingWebhookDelivery(c, delivery, deliveryErr)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			c.Logger().Error("Outgoing Webhook POST timed out. Consider increasing ServiceSettings.OutgoingIntegrationRequestsTimeout.", mlog.Err(err))
+		} else {
+			c.Logger().Error("Outgoing Webhook POST failed", mlog.Err(err))
+		}
+		return
+	}
 
-				text := ""
-				if webhookResp.Text != nil {
-					text = a.ProcessSlackText(*webhookResp.Text)
-				}
-				webhookResp.Attachments = a.ProcessSlackAttachments(webhookResp.Attachments)
-				// attachments is in here for slack compatibility
-				if len(webhookResp.Attachments) > 0 {
-					webhookResp.Props["attachments"] = webhookResp.Attachments
-				}
-				if *a.Config().ServiceSettings.EnablePostUsernameOverride && hook.Username != "" && webhookResp.Username == "" {
-					webhookResp.Username = hook.Username
-				}
+	// Only the attempt that delivered the payload posts the response: no retry follows it, so
+	// the response is posted once per delivery, even if failed attempts got a response too.
+	if !delivery.IsSuccessful() {
+		return
+	}
 
-				if *a.Config().ServiceSettings.EnablePostIconOverride && hook.IconURL != "" && webhookResp.IconURL == "" {
-					webhookResp.IconURL = hook.IconURL
-				}
-				if _, err := a.CreateWebhookPost(c, hook.CreatorId, channel, text, webhookResp.Username, webhookResp.IconURL, "", webhookResp.Props, webhookResp.Type, postRootId, webhookResp.Priority); err != nil {
-					c.Logger().Error("Failed to create response post.", mlog.Err(err))
-				}
-			}
+	if webhookResp != nil && (webhookResp.Text != nil || len(webhookResp.Attachments) > 0) {
+		postRootId := ""
+		if webhookResp.ResponseType == model.OutgoingHookResponseTypeComment {
+			postRootId = post.Id
+		}
+		if len(webhookResp.Props) == 0 {
+			webhookResp.Props = make(model.StringInterface)
+		}
+		webhookResp.Props["webhook_display_name"] = hook.DisplayName
+
+		text := ""
+		if webhookResp.Text != nil {
+			text = a.ProcessSlackText(*webhookResp.Text)
+		}
+		webhookResp.Attachments = a.ProcessSlackAttachments(webhookResp.Attachments)
+		// attachments is in here for slack compatibility
+		if len(webhookResp.Attachments) > 0 {
+			webhookResp.Props["attachments"] = webhookResp.Attachments
+		}
+		if *a.Config().ServiceSettings.EnablePostUsernameOverride && hook.Username != "" && webhookResp.Username == "" {
+			webhookResp.Username = hook.Username
+		}
+
+		if *a.Config().ServiceSettings.EnablePostIconOverride && hook.IconURL != "" && webhookResp.IconURL == "" {
+			webhookResp.IconURL = hook.IconURL
+		}
+		if _, err := a.CreateWebhookPost(c, hook.CreatorId, channel, text, webhookResp.Username, webhookResp.IconURL, "", webhookResp.Props, webhookResp.Type, postRootId, webhookResp.Priority); err != nil {
+			c.Logger().Error("Failed to create response post.", mlog.Err(err))
+		}
+	}
+}
+
+// saveOutgoingWebhookDelivery stores the outcome of a delivery attempt, scheduling a retry
+// with exponential backoff if the attempt failed and retries remain.
+func (a *App) saveOutgoingWebhookDelivery(c request.CTX, delivery *model.OutgoingWebhookDelivery, deliveryErr error) {
+	if deliveryErr != nil && delivery.Error == "" {
+		delivery.Error = deliveryErr.Error()
+	}
+
+	delivery.Status = model.OutgoingWebhookDeliveryStatusSuccess
+	if !delivery.IsSuccessful() {
+		delivery.Status = model.OutgoingWebhookDeliveryStatusFailed
+		if delivery.Attempt <= *a.Config().ServiceSettings.OutgoingWebhookMaxRetries {
+			delivery.NextRetryAt = model.GetMillis() + outgoingWebhookRetryBackoff(delivery.Attempt).Milliseconds()
+		}
+	}
+
+	// The signed body holds the hook's token, so it's only kept for the pending retry.
+	if delivery.NextRetryAt == 0 {
+		delivery.SignedBody = ""
+		delivery.SignedAt = 0
+	}
+
+	if _, err := a.Srv().Store().Webhook().SaveOutgoingDelivery(delivery); err != nil {
+		c.Logger().Warn("Failed to save outgoing webhook delivery", mlog.String("hook_id", delivery.HookId), mlog.Err(err))
+	}
+}
+
+// outgoingWebhookRetryBackoff returns how long to wait before retrying a delivery
+// that failed on the given attempt.
+func outgoingWebhookRetryBackoff(attempt int) time.Duration {
+	backoff := OutgoingWebhookRetryBaseBackoff
+	for i := 1; i < attempt && backoff < OutgoingWebhookRetryMaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > OutgoingWebhookRetryMaxBackoff {
+		return OutgoingWebhookRetryMaxBackoff
+	}
+	return backoff
+}
+
+// RetryOutgoingWebhookDeliveries resends outgoing webhook deliveries whose retry is due
+// and prunes delivery log entries past their retention period.
+func (a *App) RetryOutgoingWebhookDeliveries(c request.CTX) error {
+	deliveries, err := a.Srv().Store().Webhook().GetOutgoingDeliveriesToRetry(model.GetMillis(), OutgoingWebhookRetryBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get outgoing webhook deliveries to retry: %w", err)
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		claimed, err := a.Srv().Store().Webhook().ClaimOutgoingDeliveryRetry(delivery.Id)
+		if err != nil {
+			return fmt.Errorf("failed to claim outgoing webhook delivery retry: %w", err)
+		}
+		if !claimed {
+			continue
+		}
+
+		hook, post, channel, err := a.getOutgoingWebhookDeliveryTarget(c, delivery)
+		if err != nil {
+			c.Logger().Debug("Dropping outgoing webhook delivery retry", mlog.String("delivery_id", delivery.Id), mlog.String("hook_id", delivery.HookId), mlog.Err(err))
+			continue
+		}
+
+		// the retry resends the exact body, signed at the same time, as the failed attempt.
+		next := delivery.NextAttempt()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.deliverOutgoingWebhook(c, hook, next, post, channel)
 		}()
 	}
 	wg.Wait()
+
+	if _, err := a.Srv().Store().Webhook().PermanentDeleteOutgoingDeliveriesBefore(model.GetMillis() - OutgoingWebhookDeliveryRetention.Milliseconds()); err != nil {
+		return fmt.Errorf("failed to delete old outgoing webhook deliveries: %w", err)
+	}
+
+	return nil
+}
+
+// getOutgoingWebhookDeliveryTarget loads the hook, post and channel a delivery is retried for,
+// failing if any of them no longer exist, the hook no longer posts to the delivery's URL or
+// the delivery has no body to send again.
+func (a *App) getOutgoingWebhookDeliveryTarget(c request.CTX, delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhook, *model.Post, *model.Channel, error) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, nil, nil, errors.New("outgoing webhooks are disabled")
+	}
+
+	if delivery.SignedBody == "" {
+		return nil, nil, nil, errors.New("delivery has no body to send again")
+	}
+
+	hook, err := a.Srv().Store().Webhook().GetOutgoing(delivery.HookId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !slices.Contains(hook.CallbackURLs, delivery.CallbackURL) {
+		return nil, nil, nil, errors.New("callback URL was removed from the webhook")
+	}
+
+	post, err := a.Srv().Store().Post().GetSingle(c, delivery.PostId, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	channel, err := a.Srv().Store().Channel().Get(post.ChannelId, true)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return hook, post, channel, nil
 }
 
-func (a *App) doOutgoingWebhookRequest(url string, body io.Reader, contentType string, accessToken *model.OutgoingOAuthConnectionToken) (*model.OutgoingWebhookResponse, error) {
+// doOutgoingWebhookRequest posts body to the delivery's callback URL, signing it with the hook's
+// secret at the delivery's SignedAt, or now if it was never sent, and records the status code,
+// latency and a snippet of the response on the delivery.
+func (a *App) doOutgoingWebhookRequest(delivery *model.OutgoingWebhookDelivery, body []byte, hook *model.OutgoingWebhook, accessToken *model.OutgoingOAuthConnectionToken) (*model.OutgoingWebhookResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(*a.Config().ServiceSettings.OutgoingIntegrationRequestsTimeout)*time.Second)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, "POST", url, body)
+	req, err := http.NewRequestWithContext(ctx, "POST", delivery.CallbackURL, bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Content-Type", delivery.ContentType)
 	req.Header.Set("Accept", "application/json")
 
+	if delivery.SignedAt == 0 {
+		delivery.SignedAt = time.Now().Unix()
+	}
+	timestamp := strconv.FormatInt(delivery.SignedAt, 10)
+	if signature := hook.Sign(timestamp, body); signature != "" {
+		req.Header.Set(model.OutgoingHookTimestampHeader, timestamp)
+		req.Header.Set(model.OutgoingHookSignatureHeader, signature)
+	}
+
 	if accessToken != nil {
 		req.Header.Add("Authorization", accessToken.AsHeaderValue())
 	}
 
+	start := time.Now()
 	resp, err := a.Srv().outgoingWebhookClient.Do(req)
 	if err != nil {
+		delivery.Latency = time.Since(start).Milliseconds()
 		return nil, err
 	}
 
 	defer resp.Body.Close()
 
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, MaxIntegrationResponseSize))
+	delivery.Latency = time.Since(start).Milliseconds()
+	delivery.StatusCode = resp.StatusCode
+	delivery.Response = string(respBody)
+	if err != nil {
+		return nil, err
+	}
+
 	var hookResp model.OutgoingWebhookResponse
-	if jsonErr := json.NewDecoder(io.LimitReader(resp.Body, MaxIntegrationResponseSize)).Decode(&hookResp); jsonErr != nil {
+	if jsonErr := json.NewDecoder(bytes.NewReader(respBody)).Decode(&hookResp); jsonErr != nil {
 		if jsonErr == io.EOF {
 			return nil, nil
 		}
@@ -432,6 +621,10 @@ func (a *App) UpdateIncomingWebhook(oldHook, updatedHook *model.IncomingWebhook)
 	updatedHook.TeamId = oldHook.TeamId
 	updatedHook.DeleteAt = oldHook.DeleteAt
 
+	if appErr := updatedHook.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
 	newWebhook, err := a.Srv().Store().Webhook().UpdateIncoming(updatedHook)
 	if err != nil {
 		return nil, model.NewAppError("UpdateIncomingWebhook", "app.webhooks.update_incoming.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
@@ -618,6 +811,7 @@ func (a *App) UpdateOutgoingWebhook(c request.CTX, oldHook, updatedHook *model.O
 	updatedHook.CreateAt = oldHook.CreateAt
 	updatedHook.DeleteAt = oldHook.DeleteAt
 	updatedHook.TeamId = oldHook.TeamId
+	updatedHook.Secret = oldHook.Secret
 	updatedHook.UpdateAt = model.GetMillis()
 
 	webhook, err := a.Srv().Store().Webhook().UpdateOutgoing(updatedHook)
@@ -721,18 +915,79 @@ func (a *App) RegenOutgoingWebhookToken(hook *model.OutgoingWebhook) (*model.Out
 	return webhook, nil
 }
 
-func (a *App) HandleIncomingWebhook(c request.CTX, hookID string, req *model.IncomingWebhookRequest) *model.AppError {
+func (a *App) RegenOutgoingWebhookSecret(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("RegenOutgoingWebhookSecret", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	hook.Secret = model.NewRandomString(model.OutgoingHookSecretLength)
+	hook.UpdateAt = model.GetMillis()
+
+	webhook, err := a.Srv().Store().Webhook().UpdateOutgoing(hook)
+	if err != nil {
+		return nil, model.NewAppError("RegenOutgoingWebhookSecret", "app.webhooks.update_outgoing.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return webhook, nil
+}
+
+func (a *App) GetOutgoingWebhookDeliveries(hookID string, page, perPage int) ([]*model.OutgoingWebhookDelivery, *model.AppError) {
+	if !*a.Config().ServiceSettings.EnableOutgoingWebhooks {
+		return nil, model.NewAppError("GetOutgoingWebhookDeliveries", "api.outgoing_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+	}
+
+	deliveries, err := a.Srv().Store().Webhook().GetOutgoingDeliveries(hookID, page*perPage, perPage)
+	if err != nil {
+		return nil, model.NewAppError("GetOutgoingWebhookDeliveries", "app.webhooks.get_outgoing_deliveries.app_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return deliveries, nil
+}
+
+// rateLimitIncomingWebhook returns an error if the hook has exceeded its own rate limit.
+func (a *App) rateLimitIncomingWebhook(c request.CTX, hook *model.IncomingWebhook) *model.AppError {
+	limited, result, err := a.Srv().incomingWebhookRateLimiter.RateLimit(hook)
+	if err != nil {
+		// Don't drop posts because the limiter is broken, same as the API rate limiter.
+		c.Logger().Error("Internal server error when rate limiting incoming webhook.", mlog.String("hook_id", hook.Id), mlog.Err(err))
+		return nil
+	}
+
+	if limited {
+		retryAfter := int(math.Ceil(result.RetryAfter.Seconds()))
+		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.rate_limited.app_error", map[string]any{"RetryAfter": retryAfter}, "hook_id="+hook.Id, http.StatusTooManyRequests)
+	}
+
+	return nil
+}
+
+// GetIncomingWebhookForRequest returns the hook a request to an incoming webhook posts with,
+// once the request is counted against the hook's rate limit.
+func (a *App) GetIncomingWebhookForRequest(c request.CTX, hookID string) (*model.IncomingWebhook, *model.AppError) {
 	if !*a.Config().ServiceSettings.EnableIncomingWebhooks {
-		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
+		return nil, model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.disabled.app_error", nil, "", http.StatusNotImplemented)
 	}
 
-	hchan := make(chan store.StoreResult[*model.IncomingWebhook], 1)
-	go func() {
-		webhook, err := a.Srv().Store().Webhook().GetIncoming(hookID, true)
-		hchan <- store.StoreResult[*model.IncomingWebhook]{Data: webhook, NErr: err}
-		close(hchan)
-	}()
+	hook, err := a.Srv().Store().Webhook().GetIncoming(hookID, true)
+	if err != nil {
+		return nil, model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.invalid.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	if appErr := a.rateLimitIncomingWebhook(c, hook); appErr != nil {
+		return nil, appErr
+	}
+
+	return hook, nil
+}
+
+// DecodeIncomingWebhookPayload decodes the body of a request to an incoming webhook, rendering it
+// through the hook's payload template if it has one.
+func (a *App) DecodeIncomingWebhookPayload(hook *model.IncomingWebhook, payload []byte) (*model.IncomingWebhookRequest, *model.AppError) {
+	return hook.TransformPayload(payload)
+}
 
+// HandleIncomingWebhook posts the request with the hook, as returned by GetIncomingWebhookForRequest.
+func (a *App) HandleIncomingWebhook(c request.CTX, hook *model.IncomingWebhook, req *model.IncomingWebhookRequest) *model.AppError {
 	if req == nil {
 		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.parse.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -745,13 +1000,6 @@ func (a *App) HandleIncomingWebhook(c request.CTX, hookID string, req *model.Inc
 	channelName := req.ChannelName
 	webhookType := req.Type
 
-	var hook *model.IncomingWebhook
-	result := <-hchan
-	if result.NErr != nil {
-		return model.NewAppError("HandleIncomingWebhook", "web.incoming_webhook.invalid.app_error", nil, "", http.StatusBadRequest).Wrap(result.NErr)
-	}
-	hook = result.Data
-
 	uchan := make(chan store.StoreResult[*model.User], 1)
 	go func() {
 		user, err := a.Srv().Store().User().Get(context.Background(), hook.UserId)
@@ -11,7 +11,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -927,7 +929,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		resp, err := th.App.doOutgoingWebhookRequest(&model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json"}, nil, &model.OutgoingWebhook{}, nil)
 		require.NoError(t, err)
 
 		require.NotNil(t, resp)
@@ -941,7 +943,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, err := th.App.doOutgoingWebhookRequest(&model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json"}, nil, &model.OutgoingWebhook{}, nil)
 		require.Error(t, err)
 		require.Equal(t, "api.unmarshal_error", err.(*model.AppError).Id)
 	})
@@ -952,7 +954,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, err := th.App.doOutgoingWebhookRequest(&model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json"}, nil, &model.OutgoingWebhook{}, nil)
 		require.Error(t, err)
 		require.Equal(t, "api.unmarshal_error", err.(*model.AppError).Id)
 	})
@@ -963,7 +965,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, err := th.App.doOutgoingWebhookRequest(&model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json"}, nil, &model.OutgoingWebhook{}, nil)
 		require.Error(t, err)
 		require.Equal(t, "api.unmarshal_error", err.(*model.AppError).Id)
 	})
@@ -982,7 +984,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 			cfg.ServiceSettings.OutgoingIntegrationRequestsTimeout = model.NewPointer(int64(1))
 		})
 
-		_, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		_, err := th.App.doOutgoingWebhookRequest(&model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json"}, nil, &model.OutgoingWebhook{}, nil)
 		require.Error(t, err)
 		require.IsType(t, &url.Error{}, err)
 	})
@@ -999,7 +1001,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 			cfg.ServiceSettings.OutgoingIntegrationRequestsTimeout = model.NewPointer(int64(2))
 		})
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		resp, err := th.App.doOutgoingWebhookRequest(&model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json"}, nil, &model.OutgoingWebhook{}, nil)
 		require.NoError(t, err)
 		require.NotNil(t, resp)
 		assert.NotNil(t, resp.Text)
@@ -1011,7 +1013,7 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", nil)
+		resp, err := th.App.doOutgoingWebhookRequest(&model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json"}, nil, &model.OutgoingWebhook{}, nil)
 		require.NoError(t, err)
 		require.Nil(t, resp)
 	})
@@ -1022,11 +1024,238 @@ func TestDoOutgoingWebhookRequest(t *testing.T) {
 		}))
 		defer server.Close()
 
-		resp, err := th.App.doOutgoingWebhookRequest(server.URL, strings.NewReader(""), "application/json", &model.OutgoingOAuthConnectionToken{
+		resp, err := th.App.doOutgoingWebhookRequest(&model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json"}, nil, &model.OutgoingWebhook{}, &model.OutgoingOAuthConnectionToken{
 			AccessToken: "test",
 			TokenType:   "Bearer",
 		})
 		require.NoError(t, err)
 		require.Equal(t, `Bearer test`, *resp.Text)
 	})
+
+	t.Run("with a secret", func(t *testing.T) {
+		hook := &model.OutgoingWebhook{Secret: "secret"}
+		payload := `{"text":"hello"}`
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, hook.Sign(r.Header.Get(model.OutgoingHookTimestampHeader), body), r.Header.Get(model.OutgoingHookSignatureHeader))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer server.Close()
+
+		delivery := &model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json", Payload: payload}
+		resp, err := th.App.doOutgoingWebhookRequest(delivery, []byte(payload), hook, nil)
+		require.NoError(t, err)
+		require.Nil(t, resp)
+		assert.Equal(t, http.StatusAccepted, delivery.StatusCode)
+		assert.True(t, delivery.IsSuccessful())
+	})
+
+	t.Run("records the response on the delivery", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			io.Copy(w, strings.NewReader(`{"text": "oops"}`))
+		}))
+		defer server.Close()
+
+		delivery := &model.OutgoingWebhookDelivery{CallbackURL: server.URL, ContentType: "application/json"}
+		_, err := th.App.doOutgoingWebhookRequest(delivery, nil, &model.OutgoingWebhook{}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, delivery.StatusCode)
+		assert.Equal(t, `{"text": "oops"}`, delivery.Response)
+		assert.False(t, delivery.IsSuccessful())
+	})
+}
+
+func TestOutgoingWebhookRetryBackoff(t *testing.T) {
+	assert.Equal(t, OutgoingWebhookRetryBaseBackoff, outgoingWebhookRetryBackoff(1))
+	assert.Equal(t, 2*OutgoingWebhookRetryBaseBackoff, outgoingWebhookRetryBackoff(2))
+	assert.Equal(t, 8*OutgoingWebhookRetryBaseBackoff, outgoingWebhookRetryBackoff(4))
+	assert.Equal(t, OutgoingWebhookRetryMaxBackoff, outgoingWebhookRetryBackoff(10))
+	assert.Equal(t, OutgoingWebhookRetryMaxBackoff, outgoingWebhookRetryBackoff(100))
+}
+
+func TestRetryOutgoingWebhookDeliveries(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	type request struct {
+		body      string
+		timestamp string
+	}
+	received := make(chan request, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		received <- request{body: string(body), timestamp: r.Header.Get(model.OutgoingHookTimestampHeader)}
+		io.Copy(w, strings.NewReader(`{"text": "reply"}`))
+	}))
+	defer server.Close()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOutgoingWebhooks = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.1"
+		*cfg.ServiceSettings.OutgoingWebhookMaxRetries = 3
+	})
+
+	hook, appErr := th.App.CreateOutgoingWebhook(&model.OutgoingWebhook{
+		ChannelId:    th.BasicChannel.Id,
+		TeamId:       th.BasicChannel.TeamId,
+		CreatorId:    th.BasicUser.Id,
+		CallbackURLs: []string{server.URL},
+		ContentType:  "application/json",
+		Secret:       model.NewId(),
+	})
+	require.Nil(t, appErr)
+
+	signedBody := `{"token":"` + hook.Token + `","post_id":"` + th.BasicPost.Id + `"}`
+	signedAt := time.Now().Add(-time.Hour).Unix()
+	failed, err := th.App.Srv().Store().Webhook().SaveOutgoingDelivery(&model.OutgoingWebhookDelivery{
+		HookId:      hook.Id,
+		PostId:      th.BasicPost.Id,
+		CallbackURL: server.URL,
+		ContentType: "application/json",
+		Payload:     "{}",
+		Status:      model.OutgoingWebhookDeliveryStatusFailed,
+		StatusCode:  http.StatusBadGateway,
+		NextRetryAt: model.GetMillis() - 1000,
+		SignedBody:  signedBody,
+		SignedAt:    signedAt,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, th.App.RetryOutgoingWebhookDeliveries(th.Context))
+
+	select {
+	case req := <-received:
+		// the exact body of the failed attempt is sent again, signed at the same time.
+		assert.Equal(t, signedBody, req.body)
+		assert.Equal(t, strconv.FormatInt(signedAt, 10), req.timestamp)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "retry was not delivered")
+	}
+
+	deliveries, appErr := th.App.GetOutgoingWebhookDeliveries(hook.Id, 0, 10)
+	require.Nil(t, appErr)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, 2, deliveries[0].Attempt)
+	assert.Equal(t, failed.Id, deliveries[0].DeliveryId)
+	assert.Equal(t, model.OutgoingWebhookDeliveryStatusSuccess, deliveries[0].Status)
+	assert.NotContains(t, deliveries[0].Payload, hook.Token)
+	assert.Equal(t, failed.Id, deliveries[1].Id)
+	assert.Zero(t, deliveries[1].NextRetryAt)
+}
+
+func TestOutgoingWebhookResponsePostedOnce(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the receiver replies to every attempt, but only the second one succeeds.
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		io.Copy(w, strings.NewReader(`{"text": "reply"}`))
+	}))
+	defer server.Close()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOutgoingWebhooks = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.1"
+		*cfg.ServiceSettings.OutgoingWebhookMaxRetries = 3
+	})
+
+	hook, appErr := th.App.CreateOutgoingWebhook(&model.OutgoingWebhook{
+		ChannelId:    th.BasicChannel.Id,
+		TeamId:       th.BasicChannel.TeamId,
+		CreatorId:    th.BasicUser.Id,
+		CallbackURLs: []string{server.URL},
+		ContentType:  "application/json",
+	})
+	require.Nil(t, appErr)
+
+	channel := th.CreateChannel(th.Context, th.BasicTeam)
+	post := th.CreatePost(channel)
+	payload := newOutgoingWebhookPayload(hook, post, th.BasicTeam, channel, th.BasicUser, "")
+	th.App.TriggerWebhook(th.Context, payload, hook, post, channel)
+
+	deliveries, appErr := th.App.GetOutgoingWebhookDeliveries(hook.Id, 0, 10)
+	require.Nil(t, appErr)
+	require.Len(t, deliveries, 1)
+	require.NotZero(t, deliveries[0].NextRetryAt)
+
+	_, err := th.App.Srv().Store().Webhook().ClaimOutgoingDeliveryRetry(deliveries[0].Id)
+	require.NoError(t, err)
+	th.App.deliverOutgoingWebhook(th.Context, hook, deliveries[0].NextAttempt(), post, channel)
+
+	posts, appErr := th.App.GetPosts(channel.Id, 0, 10)
+	require.Nil(t, appErr)
+	replies := 0
+	for _, p := range posts.Posts {
+		if p.Message == "reply" {
+			replies++
+		}
+	}
+	assert.Equal(t, 1, replies)
+}
+
+func TestOutgoingWebhookDeliveryLog(t *testing.T) {
+	th := Setup(t).InitBasic()
+	defer th.TearDown()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(w, strings.NewReader("ok"))
+	}))
+	defer server.Close()
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.ServiceSettings.EnableOutgoingWebhooks = true
+		*cfg.ServiceSettings.AllowedUntrustedInternalConnections = "127.0.0.1"
+	})
+
+	hook, appErr := th.App.CreateOutgoingWebhook(&model.OutgoingWebhook{
+		ChannelId:    th.BasicChannel.Id,
+		TeamId:       th.BasicChannel.TeamId,
+		CreatorId:    th.BasicUser.Id,
+		CallbackURLs: []string{server.URL},
+	})
+	require.Nil(t, appErr)
+
+	payload := newOutgoingWebhookPayload(hook, th.BasicPost, th.BasicTeam, th.BasicChannel, th.BasicUser, "")
+	payload.Text = strings.Repeat("a", model.OutgoingWebhookDeliveryPayloadMaxBytes)
+	th.App.TriggerWebhook(th.Context, payload, hook, th.BasicPost, th.BasicChannel)
+
+	deliveries, appErr := th.App.GetOutgoingWebhookDeliveries(hook.Id, 0, 10)
+	require.Nil(t, appErr)
+	require.Len(t, deliveries, 1)
+	// a 2xx answer counts as delivered even though the response isn't JSON.
+	assert.Equal(t, model.OutgoingWebhookDeliveryStatusSuccess, deliveries[0].Status)
+	assert.Empty(t, deliveries[0].Error)
+	assert.NotContains(t, deliveries[0].Payload, hook.Token)
+	assert.Len(t, deliveries[0].Payload, model.OutgoingWebhookDeliveryPayloadMaxBytes)
+}
+
+func TestEncodeOutgoingWebhookPayload(t *testing.T) {
+	payload := &model.OutgoingWebhookPayload{Token: "secret-token", PostId: "post-id", Text: "hello"}
+
+	t.Run("json", func(t *testing.T) {
+		contentType, body, logged, err := encodeOutgoingWebhookPayload(&model.OutgoingWebhook{ContentType: "application/json"}, payload)
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", contentType)
+		assert.Contains(t, string(body), "secret-token")
+		assert.NotContains(t, string(logged), "secret-token")
+		assert.Contains(t, string(logged), "post-id")
+	})
+
+	t.Run("form values", func(t *testing.T) {
+		contentType, body, logged, err := encodeOutgoingWebhookPayload(&model.OutgoingWebhook{}, payload)
+		require.NoError(t, err)
+		assert.Equal(t, "application/x-www-form-urlencoded", contentType)
+		assert.Contains(t, string(body), "token=secret-token")
+		assert.NotContains(t, string(logged), "secret-token")
+	})
+
+	assert.Equal(t, "secret-token", payload.Token, "the payload should not be modified")
 }
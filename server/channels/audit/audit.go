@@ -5,12 +5,14 @@ package audit
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
 
 type Audit struct {
-	logger *mlog.Logger
+	logger   *mlog.Logger
+	database *databaseTargetRef
 
 	// OnQueueFull is called on an attempt to add an audit record to a full queue.
 	// Return true to drop record, or false to block until there is room in queue.
@@ -21,6 +23,7 @@ type Audit struct {
 }
 
 func (a *Audit) Init(maxQueueSize int) {
+	a.database = &databaseTargetRef{}
 	a.logger, _ = mlog.NewLogger(
 		mlog.MaxQueueSize(maxQueueSize),
 		mlog.OnLoggerError(a.onLoggerError),
@@ -29,8 +32,45 @@ func (a *Audit) Init(maxQueueSize int) {
 	)
 }
 
+type databaseTargetRef struct {
+	mux    sync.RWMutex
+	target *DatabaseTarget
+}
+
+// SetDatabaseTarget sets the target that persists audit records to the database, shutting
+// down the previous one. A nil target disables database auditing.
+func (a *Audit) SetDatabaseTarget(target *DatabaseTarget) {
+	if a.database == nil {
+		a.database = &databaseTargetRef{}
+	}
+
+	a.database.mux.Lock()
+	prev := a.database.target
+	a.database.target = target
+	a.database.mux.Unlock()
+
+	if prev != nil {
+		prev.Shutdown()
+	}
+}
+
+// DatabaseTarget returns the database target, or nil when database auditing is disabled.
+func (a *Audit) DatabaseTarget() *DatabaseTarget {
+	if a.database == nil {
+		return nil
+	}
+
+	a.database.mux.RLock()
+	defer a.database.mux.RUnlock()
+	return a.database.target
+}
+
 // LogRecord emits an audit record with complete info.
 func (a *Audit) LogRecord(level mlog.Level, rec Record) {
+	if target := a.DatabaseTarget(); target != nil {
+		target.log(level, rec)
+	}
+
 	flds := []mlog.Field{
 		mlog.String(KeyEventName, rec.EventName),
 		mlog.String(KeyStatus, rec.Status),
@@ -50,6 +90,10 @@ func (a *Audit) Configure(cfg mlog.LoggerConfiguration) error {
 
 // Flush attempts to write all queued audit records to all targets.
 func (a *Audit) Flush() error {
+	if target := a.DatabaseTarget(); target != nil {
+		target.Flush()
+	}
+
 	err := a.logger.Flush()
 	if err != nil {
 		a.onLoggerError(err)
@@ -59,6 +103,8 @@ func (a *Audit) Flush() error {
 
 // Shutdown cleanly stops the audit engine after making best efforts to flush all targets.
 func (a *Audit) Shutdown() error {
+	a.SetDatabaseTarget(nil)
+
 	err := a.logger.Shutdown()
 	if err != nil {
 		a.onLoggerError(err)
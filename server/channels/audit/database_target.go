@@ -0,0 +1,143 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+// RecordStore persists audit records, chaining each record to the last one saved.
+type RecordStore interface {
+	SaveRecord(record *model.AuditRecord) (*model.AuditRecord, error)
+}
+
+// DatabaseTarget persists audit records through a RecordStore. Records are written by a
+// single goroutine in the order they were logged, so that the hash chain follows that order.
+type DatabaseTarget struct {
+	store        RecordStore
+	maxQueueSize int
+	queue        chan queuedRecord
+	done         chan struct{}
+
+	mux    sync.RWMutex
+	closed bool
+
+	// OnQueueFull is called on an attempt to add an audit record to a full queue.
+	// Return true to drop record, or false to block until there is room in queue.
+	OnQueueFull func(qname string, maxQueueSize int) bool
+
+	// OnError is called when an error occurs while writing an audit record.
+	OnError func(err error)
+}
+
+type queuedRecord struct {
+	record  *model.AuditRecord
+	flushed chan struct{}
+}
+
+// NewDatabaseTarget creates a database target and starts its writer.
+func NewDatabaseTarget(store RecordStore, maxQueueSize int) *DatabaseTarget {
+	t := &DatabaseTarget{
+		store:        store,
+		maxQueueSize: maxQueueSize,
+		queue:        make(chan queuedRecord, maxQueueSize),
+		done:         make(chan struct{}),
+	}
+	go t.run()
+	return t
+}
+
+func (t *DatabaseTarget) run() {
+	defer close(t.done)
+	for item := range t.queue {
+		if item.flushed != nil {
+			close(item.flushed)
+			continue
+		}
+		if _, err := t.store.SaveRecord(item.record); err != nil {
+			t.onError(fmt.Errorf("failed to save audit record %q: %w", item.record.EventName, err))
+		}
+	}
+}
+
+func (t *DatabaseTarget) log(level mlog.Level, rec Record) {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		t.onError(fmt.Errorf("failed to marshal audit record %q: %w", rec.EventName, err))
+		return
+	}
+
+	record := &model.AuditRecord{
+		CreateAt:  model.GetMillis(),
+		Level:     level.Name,
+		EventName: rec.EventName,
+		Status:    rec.Status,
+		UserId:    rec.Actor.UserId,
+		SessionId: rec.Actor.SessionId,
+		IpAddress: rec.Actor.IpAddress,
+		Data:      string(data),
+	}
+
+	t.mux.RLock()
+	defer t.mux.RUnlock()
+	if t.closed {
+		return
+	}
+
+	select {
+	case t.queue <- queuedRecord{record: record}:
+		return
+	default:
+	}
+
+	if t.OnQueueFull != nil && !t.OnQueueFull("database", t.maxQueueSize) {
+		t.queue <- queuedRecord{record: record}
+		return
+	}
+	if t.OnQueueFull == nil {
+		mlog.Error("Audit database queue full, dropping record.", mlog.Int("queueSize", t.maxQueueSize))
+	}
+}
+
+// Flush blocks until all records queued so far have been written.
+func (t *DatabaseTarget) Flush() {
+	flushed := make(chan struct{})
+
+	t.mux.RLock()
+	if t.closed {
+		t.mux.RUnlock()
+		return
+	}
+	t.queue <- queuedRecord{flushed: flushed}
+	t.mux.RUnlock()
+
+	<-flushed
+}
+
+// Shutdown writes the remaining queued records and stops the writer.
+func (t *DatabaseTarget) Shutdown() {
+	t.mux.Lock()
+	if t.closed {
+		t.mux.Unlock()
+		return
+	}
+	t.closed = true
+	close(t.queue)
+	t.mux.Unlock()
+
+	<-t.done
+}
+
+func (t *DatabaseTarget) onError(err error) {
+	if t.OnError != nil {
+		t.OnError(err)
+		return
+	}
+	mlog.Error("Auditing error", mlog.Err(err))
+}
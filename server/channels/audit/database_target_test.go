@@ -0,0 +1,144 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+)
+
+type testRecordStore struct {
+	mux     sync.Mutex
+	records []*model.AuditRecord
+	err     error
+}
+
+func (s *testRecordStore) SaveRecord(record *model.AuditRecord) (*model.AuditRecord, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	var prev *model.AuditRecord
+	if len(s.records) > 0 {
+		prev = s.records[len(s.records)-1]
+	}
+	record.PreSave(prev)
+	s.records = append(s.records, record)
+	return record, nil
+}
+
+func (s *testRecordStore) getRecords() []*model.AuditRecord {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.records
+}
+
+func TestDatabaseTarget(t *testing.T) {
+	t.Run("records are saved in order and chained", func(t *testing.T) {
+		store := &testRecordStore{}
+		target := NewDatabaseTarget(store, 10)
+		defer target.Shutdown()
+
+		for _, eventName := range []string{"login", "updateUser", "logout"} {
+			rec := Record{EventName: eventName, Status: Success}
+			rec.Actor.UserId = "user1"
+			rec.Actor.IpAddress = "127.0.0.1"
+			target.log(mlog.LvlAuditAPI, rec)
+		}
+		target.Flush()
+
+		records := store.getRecords()
+		require.Len(t, records, 3)
+		assert.Equal(t, "login", records[0].EventName)
+		assert.Equal(t, "updateUser", records[1].EventName)
+		assert.Equal(t, "logout", records[2].EventName)
+		assert.Equal(t, "user1", records[0].UserId)
+		assert.Equal(t, "127.0.0.1", records[0].IpAddress)
+		assert.Equal(t, mlog.LvlAuditAPI.Name, records[0].Level)
+
+		var data Record
+		require.NoError(t, json.Unmarshal([]byte(records[1].Data), &data))
+		assert.Equal(t, "updateUser", data.EventName)
+
+		var result model.AuditRecordVerification
+		assert.True(t, model.VerifyAuditRecordChain(nil, records, &result))
+	})
+
+	t.Run("shutdown writes queued records", func(t *testing.T) {
+		store := &testRecordStore{}
+		target := NewDatabaseTarget(store, 10)
+
+		target.log(mlog.LvlAuditAPI, Record{EventName: "login"})
+		target.Shutdown()
+
+		assert.Len(t, store.getRecords(), 1)
+
+		// Records logged after shutdown are dropped.
+		target.log(mlog.LvlAuditAPI, Record{EventName: "logout"})
+		target.Flush()
+		target.Shutdown()
+		assert.Len(t, store.getRecords(), 1)
+	})
+
+	t.Run("store errors are reported", func(t *testing.T) {
+		store := &testRecordStore{err: errors.New("database unavailable")}
+		target := NewDatabaseTarget(store, 10)
+		defer target.Shutdown()
+
+		var mux sync.Mutex
+		var errs []error
+		target.OnError = func(err error) {
+			mux.Lock()
+			defer mux.Unlock()
+			errs = append(errs, err)
+		}
+
+		target.log(mlog.LvlAuditAPI, Record{EventName: "login"})
+		target.Flush()
+
+		mux.Lock()
+		defer mux.Unlock()
+		require.Len(t, errs, 1)
+		assert.ErrorContains(t, errs[0], "database unavailable")
+	})
+}
+
+func TestAudit_SetDatabaseTarget(t *testing.T) {
+	store := &testRecordStore{}
+
+	audit := &Audit{}
+	audit.Init(10)
+	defer audit.Shutdown()
+
+	audit.LogRecord(mlog.LvlAuditAPI, Record{EventName: "beforeTarget"})
+	require.Nil(t, audit.DatabaseTarget())
+
+	audit.SetDatabaseTarget(NewDatabaseTarget(store, 10))
+	require.NotNil(t, audit.DatabaseTarget())
+
+	audit.LogRecord(mlog.LvlAuditAPI, Record{EventName: "withTarget"})
+	require.NoError(t, audit.Flush())
+
+	records := store.getRecords()
+	require.Len(t, records, 1)
+	assert.Equal(t, "withTarget", records[0].EventName)
+
+	audit.SetDatabaseTarget(nil)
+	require.Nil(t, audit.DatabaseTarget())
+
+	audit.LogRecord(mlog.LvlAuditAPI, Record{EventName: "afterTarget"})
+	require.NoError(t, audit.Flush())
+	assert.Len(t, store.getRecords(), 1)
+}
@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS OutgoingWebhookDeliveries;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OutgoingWebhooks'
+        AND table_schema = DATABASE()
+        AND column_name = 'Secret'
+    ) > 0,
+    'ALTER TABLE OutgoingWebhooks DROP COLUMN Secret;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
@@ -0,0 +1,35 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OutgoingWebhooks'
+        AND table_schema = DATABASE()
+        AND column_name = 'Secret'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE OutgoingWebhooks ADD Secret varchar(128) DEFAULT "";'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+CREATE TABLE IF NOT EXISTS OutgoingWebhookDeliveries (
+    Id varchar(26) NOT NULL,
+    HookId varchar(26) NOT NULL,
+    PostId varchar(26) DEFAULT '',
+    CallbackURL text NOT NULL,
+    ContentType varchar(128) DEFAULT '',
+    Payload text,
+    Attempt int DEFAULT 1,
+    Status varchar(32) NOT NULL,
+    StatusCode int DEFAULT 0,
+    Latency bigint(20) DEFAULT 0,
+    Response text,
+    Error text,
+    NextRetryAt bigint(20) DEFAULT 0,
+    CreateAt bigint(20) NOT NULL,
+    PRIMARY KEY (Id),
+    KEY idx_outgoingwebhookdeliveries_hookid_createat (HookId, CreateAt),
+    KEY idx_outgoingwebhookdeliveries_nextretryat (NextRetryAt),
+    KEY idx_outgoingwebhookdeliveries_createat (CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'IncomingWebhooks'
+        AND table_schema = DATABASE()
+        AND column_name = 'PayloadTemplate'
+    ) > 0,
+    'ALTER TABLE IncomingWebhooks DROP COLUMN PayloadTemplate;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'IncomingWebhooks'
+        AND table_schema = DATABASE()
+        AND column_name = 'RateLimitBurst'
+    ) > 0,
+    'ALTER TABLE IncomingWebhooks DROP COLUMN RateLimitBurst;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'IncomingWebhooks'
+        AND table_schema = DATABASE()
+        AND column_name = 'RateLimitPerMinute'
+    ) > 0,
+    'ALTER TABLE IncomingWebhooks DROP COLUMN RateLimitPerMinute;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
@@ -0,0 +1,16 @@
+DROP TABLE IF EXISTS FileBlobs;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'FileInfo'
+        AND table_schema = DATABASE()
+        AND column_name = 'ContentHash'
+    ) > 0,
+    'ALTER TABLE FileInfo DROP COLUMN ContentHash;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
@@ -0,0 +1,25 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'FileInfo'
+        AND table_schema = DATABASE()
+        AND column_name = 'ContentHash'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE FileInfo ADD ContentHash varchar(64) DEFAULT "";'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+CREATE TABLE IF NOT EXISTS FileBlobs (
+    Hash varchar(64) NOT NULL,
+    Path text NOT NULL,
+    Size bigint(20) DEFAULT 0,
+    RefCount bigint(20) DEFAULT 0,
+    CreateAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    PRIMARY KEY (Hash),
+    KEY idx_fileblobs_refcount_updateat (RefCount, UpdateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ScheduledPosts'
+        AND table_schema = DATABASE()
+        AND column_name = 'RecurrenceEndAt'
+    ) > 0,
+    'ALTER TABLE ScheduledPosts DROP COLUMN RecurrenceEndAt;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ScheduledPosts'
+        AND table_schema = DATABASE()
+        AND column_name = 'RecurrencePaused'
+    ) > 0,
+    'ALTER TABLE ScheduledPosts DROP COLUMN RecurrencePaused;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ScheduledPosts'
+        AND table_schema = DATABASE()
+        AND column_name = 'Recurrence'
+    ) > 0,
+    'ALTER TABLE ScheduledPosts DROP COLUMN Recurrence;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
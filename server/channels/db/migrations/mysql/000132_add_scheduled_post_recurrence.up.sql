@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ScheduledPosts'
+        AND table_schema = DATABASE()
+        AND column_name = 'Recurrence'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE ScheduledPosts ADD Recurrence varchar(256) DEFAULT '''';'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ScheduledPosts'
+        AND table_schema = DATABASE()
+        AND column_name = 'RecurrencePaused'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE ScheduledPosts ADD RecurrencePaused tinyint(1) DEFAULT 0;'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ScheduledPosts'
+        AND table_schema = DATABASE()
+        AND column_name = 'RecurrenceEndAt'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE ScheduledPosts ADD RecurrenceEndAt bigint(20) DEFAULT 0;'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
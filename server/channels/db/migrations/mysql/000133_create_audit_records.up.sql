@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS AuditRecords (
+    Sequence bigint(20) NOT NULL,
+    Id varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    Level varchar(64) DEFAULT '',
+    EventName varchar(256) DEFAULT '',
+    Status varchar(32) DEFAULT '',
+    UserId varchar(128) DEFAULT '',
+    SessionId varchar(26) DEFAULT '',
+    IpAddress varchar(64) DEFAULT '',
+    Data mediumtext,
+    PrevHash varchar(64) DEFAULT '',
+    Hash varchar(64) NOT NULL,
+    PRIMARY KEY (Sequence),
+    KEY idx_auditrecords_createat (CreateAt),
+    KEY idx_auditrecords_userid_createat (UserId, CreateAt),
+    KEY idx_auditrecords_eventname_createat (EventName, CreateAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'ChannelBookmarks'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_channelbookmarks_linkcheckedat'
+    ) > 0,
+    'DROP INDEX idx_channelbookmarks_linkcheckedat ON ChannelBookmarks;',
+    'SELECT 1'
+));
+
+PREPARE removeIndexIfExists FROM @preparedStatement;
+EXECUTE removeIndexIfExists;
+DEALLOCATE PREPARE removeIndexIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ChannelBookmarks'
+        AND table_schema = DATABASE()
+        AND column_name = 'LinkCheckedAt'
+    ) > 0,
+    'ALTER TABLE ChannelBookmarks DROP COLUMN LinkCheckedAt;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ChannelBookmarks'
+        AND table_schema = DATABASE()
+        AND column_name = 'LinkStatus'
+    ) > 0,
+    'ALTER TABLE ChannelBookmarks DROP COLUMN LinkStatus;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
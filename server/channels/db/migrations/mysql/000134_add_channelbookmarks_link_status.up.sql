@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ChannelBookmarks'
+        AND table_schema = DATABASE()
+        AND column_name = 'LinkStatus'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE ChannelBookmarks ADD LinkStatus varchar(16) DEFAULT '''';'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'ChannelBookmarks'
+        AND table_schema = DATABASE()
+        AND column_name = 'LinkCheckedAt'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE ChannelBookmarks ADD LinkCheckedAt bigint(20) DEFAULT 0;'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'ChannelBookmarks'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_channelbookmarks_linkcheckedat'
+    ) > 0,
+    'SELECT 1',
+    'CREATE INDEX idx_channelbookmarks_linkcheckedat ON ChannelBookmarks (LinkCheckedAt);'
+));
+
+PREPARE createIndexIfNotExists FROM @preparedStatement;
+EXECUTE createIndexIfNotExists;
+DEALLOCATE PREPARE createIndexIfNotExists;
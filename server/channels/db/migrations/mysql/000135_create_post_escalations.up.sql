@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS PostEscalations (
+    PostId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    Levels text,
+    FallbackGroupId varchar(26) NOT NULL,
+    FallbackDelayMinutes int NOT NULL,
+    NotifiedLevels int DEFAULT 0,
+    LastNotifiedAt bigint(20) DEFAULT 0,
+    NextEscalationAt bigint(20) DEFAULT 0,
+    Status varchar(16) NOT NULL,
+    PRIMARY KEY (PostId),
+    KEY idx_postescalations_status_nextescalationat (Status, NextEscalationAt)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS SharedDraftMembers;
+DROP TABLE IF EXISTS SharedDrafts;
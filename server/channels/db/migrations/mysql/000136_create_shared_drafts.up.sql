@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS SharedDrafts (
+    Id varchar(26) NOT NULL,
+    CreatorId varchar(26) NOT NULL,
+    ChannelId varchar(26) NOT NULL,
+    RootId varchar(26) DEFAULT '',
+    CreateAt bigint(20) NOT NULL,
+    UpdateAt bigint(20) NOT NULL,
+    LastEditedBy varchar(26) NOT NULL,
+    Message text,
+    Props text,
+    PRIMARY KEY (Id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS SharedDraftMembers (
+    SharedDraftId varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    PRIMARY KEY (SharedDraftId, UserId),
+    KEY idx_shareddraftmembers_userid (UserId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
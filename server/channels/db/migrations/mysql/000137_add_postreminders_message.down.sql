@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'PostReminders'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_postreminders_userid'
+    ) > 0,
+    'DROP INDEX idx_postreminders_userid ON PostReminders;',
+    'SELECT 1'
+));
+
+PREPARE removeIndexIfExists FROM @preparedStatement;
+EXECUTE removeIndexIfExists;
+DEALLOCATE PREPARE removeIndexIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'PostReminders'
+        AND table_schema = DATABASE()
+        AND column_name = 'Recurrence'
+    ) > 0,
+    'ALTER TABLE PostReminders DROP COLUMN Recurrence;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'PostReminders'
+        AND table_schema = DATABASE()
+        AND column_name = 'Message'
+    ) > 0,
+    'ALTER TABLE PostReminders DROP COLUMN Message;',
+    'SELECT 1'
+));
+
+PREPARE alterIfExists FROM @preparedStatement;
+EXECUTE alterIfExists;
+DEALLOCATE PREPARE alterIfExists;
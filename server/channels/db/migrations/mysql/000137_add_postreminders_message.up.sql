@@ -0,0 +1,44 @@
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'PostReminders'
+        AND table_schema = DATABASE()
+        AND column_name = 'Message'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE PostReminders ADD Message varchar(4000) DEFAULT '''';'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'PostReminders'
+        AND table_schema = DATABASE()
+        AND column_name = 'Recurrence'
+    ) > 0,
+    'SELECT 1',
+    'ALTER TABLE PostReminders ADD Recurrence varchar(32) DEFAULT '''';'
+));
+
+PREPARE alterIfNotExists FROM @preparedStatement;
+EXECUTE alterIfNotExists;
+DEALLOCATE PREPARE alterIfNotExists;
+
+SET @preparedStatement = (SELECT IF(
+    (
+        SELECT COUNT(*) FROM INFORMATION_SCHEMA.STATISTICS
+        WHERE table_name = 'PostReminders'
+        AND table_schema = DATABASE()
+        AND index_name = 'idx_postreminders_userid'
+    ) > 0,
+    'SELECT 1',
+    'CREATE INDEX idx_postreminders_userid ON PostReminders(UserId);'
+));
+
+PREPARE createIndexIfNotExists FROM @preparedStatement;
+EXECUTE createIndexIfNotExists;
+DEALLOCATE PREPARE createIndexIfNotExists;
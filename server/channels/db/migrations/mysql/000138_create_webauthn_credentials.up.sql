@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS WebAuthnCredentials (
+    Id varchar(26) NOT NULL,
+    UserId varchar(26) NOT NULL,
+    CredentialId varchar(1400) NOT NULL,
+    PublicKey blob NOT NULL,
+    SignCount bigint(20) NOT NULL DEFAULT 0,
+    Name varchar(64) NOT NULL,
+    CreateAt bigint(20) NOT NULL,
+    LastUsedAt bigint(20) NOT NULL DEFAULT 0,
+    PRIMARY KEY (Id),
+    KEY idx_webauthncredentials_userid (UserId)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OutgoingWebhookDeliveries'
+        AND table_schema = DATABASE()
+        AND column_name = 'DeliveryId'
+    ),
+    'ALTER TABLE OutgoingWebhookDeliveries DROP COLUMN DeliveryId, DROP COLUMN SignedBody, DROP COLUMN SignedAt;',
+    'SELECT 1;'
+));
+
+PREPARE removeColumnIfExists FROM @preparedStatement;
+EXECUTE removeColumnIfExists;
+DEALLOCATE PREPARE removeColumnIfExists;
@@ -0,0 +1,14 @@
+SET @preparedStatement = (SELECT IF(
+    NOT EXISTS(
+        SELECT 1 FROM INFORMATION_SCHEMA.COLUMNS
+        WHERE table_name = 'OutgoingWebhookDeliveries'
+        AND table_schema = DATABASE()
+        AND column_name = 'DeliveryId'
+    ),
+    'ALTER TABLE OutgoingWebhookDeliveries ADD COLUMN DeliveryId varchar(26) DEFAULT "", ADD COLUMN SignedBody mediumtext, ADD COLUMN SignedAt bigint(20) DEFAULT 0;',
+    'SELECT 1;'
+));
+
+PREPARE addColumnIfNotExists FROM @preparedStatement;
+EXECUTE addColumnIfNotExists;
+DEALLOCATE PREPARE addColumnIfNotExists;
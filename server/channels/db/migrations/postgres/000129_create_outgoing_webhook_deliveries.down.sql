@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_outgoingwebhookdeliveries_hookid_createat;
+DROP INDEX IF EXISTS idx_outgoingwebhookdeliveries_nextretryat;
+DROP INDEX IF EXISTS idx_outgoingwebhookdeliveries_createat;
+
+DROP TABLE IF EXISTS outgoingwebhookdeliveries;
+
+ALTER TABLE outgoingwebhooks DROP COLUMN IF EXISTS secret;
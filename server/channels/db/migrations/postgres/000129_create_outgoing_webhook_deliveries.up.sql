@@ -0,0 +1,22 @@
+ALTER TABLE outgoingwebhooks ADD COLUMN IF NOT EXISTS secret varchar(128) DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS outgoingwebhookdeliveries (
+    id varchar(26) PRIMARY KEY,
+    hookid varchar(26) NOT NULL,
+    postid varchar(26) DEFAULT '',
+    callbackurl text NOT NULL,
+    contenttype varchar(128) DEFAULT '',
+    payload text,
+    attempt integer DEFAULT 1,
+    status varchar(32) NOT NULL,
+    statuscode integer DEFAULT 0,
+    latency bigint DEFAULT 0,
+    response text,
+    error text,
+    nextretryat bigint DEFAULT 0,
+    createat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_outgoingwebhookdeliveries_hookid_createat ON outgoingwebhookdeliveries (hookid, createat);
+CREATE INDEX IF NOT EXISTS idx_outgoingwebhookdeliveries_nextretryat ON outgoingwebhookdeliveries (nextretryat);
+CREATE INDEX IF NOT EXISTS idx_outgoingwebhookdeliveries_createat ON outgoingwebhookdeliveries (createat);
@@ -0,0 +1,3 @@
+ALTER TABLE incomingwebhooks DROP COLUMN IF EXISTS payloadtemplate;
+ALTER TABLE incomingwebhooks DROP COLUMN IF EXISTS ratelimitburst;
+ALTER TABLE incomingwebhooks DROP COLUMN IF EXISTS ratelimitperminute;
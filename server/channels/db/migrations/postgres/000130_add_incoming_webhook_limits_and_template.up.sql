@@ -0,0 +1,3 @@
+ALTER TABLE incomingwebhooks ADD COLUMN IF NOT EXISTS ratelimitperminute integer DEFAULT 0;
+ALTER TABLE incomingwebhooks ADD COLUMN IF NOT EXISTS ratelimitburst integer DEFAULT 0;
+ALTER TABLE incomingwebhooks ADD COLUMN IF NOT EXISTS payloadtemplate text DEFAULT '';
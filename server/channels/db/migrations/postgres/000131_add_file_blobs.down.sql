@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_fileblobs_refcount_updateat;
+
+DROP TABLE IF EXISTS fileblobs;
+
+ALTER TABLE fileinfo DROP COLUMN IF EXISTS contenthash;
@@ -0,0 +1,12 @@
+ALTER TABLE fileinfo ADD COLUMN IF NOT EXISTS contenthash varchar(64) DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS fileblobs (
+    hash varchar(64) PRIMARY KEY,
+    path text NOT NULL,
+    size bigint DEFAULT 0,
+    refcount bigint DEFAULT 0,
+    createat bigint NOT NULL,
+    updateat bigint NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_fileblobs_refcount_updateat ON fileblobs (refcount, updateat);
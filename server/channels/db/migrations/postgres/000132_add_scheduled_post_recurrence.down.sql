@@ -0,0 +1,3 @@
+ALTER TABLE scheduledposts DROP COLUMN IF EXISTS recurrenceendat;
+ALTER TABLE scheduledposts DROP COLUMN IF EXISTS recurrencepaused;
+ALTER TABLE scheduledposts DROP COLUMN IF EXISTS recurrence;
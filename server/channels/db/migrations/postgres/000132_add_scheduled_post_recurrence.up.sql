@@ -0,0 +1,3 @@
+ALTER TABLE scheduledposts ADD COLUMN IF NOT EXISTS recurrence VARCHAR(256) DEFAULT '';
+ALTER TABLE scheduledposts ADD COLUMN IF NOT EXISTS recurrencepaused boolean DEFAULT false;
+ALTER TABLE scheduledposts ADD COLUMN IF NOT EXISTS recurrenceendat bigint DEFAULT 0;
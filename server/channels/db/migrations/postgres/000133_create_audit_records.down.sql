@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_auditrecords_createat;
+DROP INDEX IF EXISTS idx_auditrecords_userid_createat;
+DROP INDEX IF EXISTS idx_auditrecords_eventname_createat;
+
+DROP TABLE IF EXISTS auditrecords;
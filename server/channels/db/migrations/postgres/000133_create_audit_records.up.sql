@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS auditrecords (
+    sequence bigint PRIMARY KEY,
+    id varchar(26) NOT NULL,
+    createat bigint NOT NULL,
+    level varchar(64) DEFAULT '',
+    eventname varchar(256) DEFAULT '',
+    status varchar(32) DEFAULT '',
+    userid varchar(128) DEFAULT '',
+    sessionid varchar(26) DEFAULT '',
+    ipaddress varchar(64) DEFAULT '',
+    data text,
+    prevhash varchar(64) DEFAULT '',
+    hash varchar(64) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_auditrecords_createat ON auditrecords (createat);
+CREATE INDEX IF NOT EXISTS idx_auditrecords_userid_createat ON auditrecords (userid, createat);
+CREATE INDEX IF NOT EXISTS idx_auditrecords_eventname_createat ON auditrecords (eventname, createat);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_channelbookmarks_linkcheckedat;
+
+ALTER TABLE channelbookmarks DROP COLUMN IF EXISTS linkcheckedat;
+ALTER TABLE channelbookmarks DROP COLUMN IF EXISTS linkstatus;
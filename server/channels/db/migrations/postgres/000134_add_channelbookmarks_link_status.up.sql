@@ -0,0 +1,4 @@
+ALTER TABLE channelbookmarks ADD COLUMN IF NOT EXISTS linkstatus varchar(16) DEFAULT '';
+ALTER TABLE channelbookmarks ADD COLUMN IF NOT EXISTS linkcheckedat bigint DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_channelbookmarks_linkcheckedat ON channelbookmarks (linkcheckedat);
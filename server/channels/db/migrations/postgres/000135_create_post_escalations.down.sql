@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_postescalations_status_nextescalationat;
+
+DROP TABLE IF EXISTS postescalations;
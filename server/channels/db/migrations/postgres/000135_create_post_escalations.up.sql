@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS postescalations (
+    postid varchar(26) PRIMARY KEY,
+    channelid varchar(26) NOT NULL,
+    creatorid varchar(26) NOT NULL,
+    createat bigint NOT NULL,
+    updateat bigint NOT NULL,
+    levels text,
+    fallbackgroupid varchar(26) NOT NULL,
+    fallbackdelayminutes integer NOT NULL,
+    notifiedlevels integer DEFAULT 0,
+    lastnotifiedat bigint DEFAULT 0,
+    nextescalationat bigint DEFAULT 0,
+    status varchar(16) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_postescalations_status_nextescalationat ON postescalations (status, nextescalationat);
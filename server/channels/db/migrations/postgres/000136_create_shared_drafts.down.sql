@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_shareddraftmembers_userid;
+
+DROP TABLE IF EXISTS shareddraftmembers;
+DROP TABLE IF EXISTS shareddrafts;
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS shareddrafts (
+    id varchar(26) PRIMARY KEY,
+    creatorid varchar(26) NOT NULL,
+    channelid varchar(26) NOT NULL,
+    rootid varchar(26) DEFAULT '',
+    createat bigint NOT NULL,
+    updateat bigint NOT NULL,
+    lasteditedby varchar(26) NOT NULL,
+    message varchar(65535),
+    props varchar(8000)
+);
+
+CREATE TABLE IF NOT EXISTS shareddraftmembers (
+    shareddraftid varchar(26) NOT NULL,
+    userid varchar(26) NOT NULL,
+    createat bigint NOT NULL,
+    PRIMARY KEY (shareddraftid, userid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_shareddraftmembers_userid ON shareddraftmembers (userid);
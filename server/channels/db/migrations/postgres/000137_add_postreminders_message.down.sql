@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_postreminders_userid;
+
+ALTER TABLE postreminders DROP COLUMN IF EXISTS recurrence;
+ALTER TABLE postreminders DROP COLUMN IF EXISTS message;
@@ -0,0 +1,4 @@
+ALTER TABLE postreminders ADD COLUMN IF NOT EXISTS message VARCHAR(4000) DEFAULT '';
+ALTER TABLE postreminders ADD COLUMN IF NOT EXISTS recurrence VARCHAR(32) DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_postreminders_userid ON postreminders(userid);
@@ -0,0 +1,5 @@
+ALTER TABLE postacknowledgements DROP COLUMN IF EXISTS remoteid;
+ALTER TABLE postacknowledgements DROP COLUMN IF EXISTS updateat;
+ALTER TABLE channelbookmarks DROP COLUMN IF EXISTS remoteid;
+ALTER TABLE sharedchannelremotes DROP COLUMN IF EXISTS lastbookmarkupdateat;
+ALTER TABLE remoteclusters DROP COLUMN IF EXISTS capabilities;
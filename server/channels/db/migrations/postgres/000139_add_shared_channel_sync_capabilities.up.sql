@@ -0,0 +1,5 @@
+ALTER TABLE remoteclusters ADD COLUMN IF NOT EXISTS capabilities integer NOT NULL DEFAULT 0;
+ALTER TABLE sharedchannelremotes ADD COLUMN IF NOT EXISTS lastbookmarkupdateat bigint DEFAULT 0;
+ALTER TABLE channelbookmarks ADD COLUMN IF NOT EXISTS remoteid varchar(26);
+ALTER TABLE postacknowledgements ADD COLUMN IF NOT EXISTS updateat bigint NOT NULL DEFAULT 0;
+ALTER TABLE postacknowledgements ADD COLUMN IF NOT EXISTS remoteid varchar(26);
@@ -0,0 +1,3 @@
+ALTER TABLE outgoingwebhookdeliveries DROP COLUMN IF EXISTS signedat;
+ALTER TABLE outgoingwebhookdeliveries DROP COLUMN IF EXISTS signedbody;
+ALTER TABLE outgoingwebhookdeliveries DROP COLUMN IF EXISTS deliveryid;
@@ -0,0 +1,3 @@
+ALTER TABLE outgoingwebhookdeliveries ADD COLUMN IF NOT EXISTS deliveryid varchar(26) DEFAULT '';
+ALTER TABLE outgoingwebhookdeliveries ADD COLUMN IF NOT EXISTS signedbody text;
+ALTER TABLE outgoingwebhookdeliveries ADD COLUMN IF NOT EXISTS signedat bigint DEFAULT 0;
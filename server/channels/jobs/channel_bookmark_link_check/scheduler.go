@@ -0,0 +1,20 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_bookmark_link_check
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+const schedFreq = 6 * time.Hour
+
+func MakeScheduler(jobServer *jobs.JobServer) *jobs.PeriodicScheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		return cfg.FeatureFlags.ChannelBookmarks && *cfg.ServiceSettings.EnableChannelBookmarkLinkChecks
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypeChannelBookmarkLinkCheck, schedFreq, isEnabled)
+}
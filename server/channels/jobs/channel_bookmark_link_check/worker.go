@@ -0,0 +1,30 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package channel_bookmark_link_check
+
+import (
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+const jobName = "ChannelBookmarkLinkCheck"
+
+type AppIface interface {
+	CheckChannelBookmarkLinks(rctx request.CTX) error
+}
+
+func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
+	isEnabled := func(cfg *model.Config) bool {
+		return cfg.FeatureFlags.ChannelBookmarks && *cfg.ServiceSettings.EnableChannelBookmarkLinkChecks
+	}
+	execute := func(logger mlog.LoggerIFace, job *model.Job) error {
+		defer jobServer.HandleJobPanic(logger, job)
+
+		return app.CheckChannelBookmarkLinks(request.EmptyContext(logger))
+	}
+	worker := jobs.NewSimpleWorker(jobName, jobServer, execute, isEnabled)
+	return worker
+}
@@ -53,6 +53,16 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface) *jobs.SimpleWorker {
 			opts.IncludeRolesAndSchemes = true
 		}
 
+		includeIntegrations, ok := job.Data["include_integrations"]
+		if ok && includeIntegrations == "true" {
+			opts.IncludeIntegrations = true
+		}
+
+		includeIntegrationSecrets, ok := job.Data["include_integration_secrets"]
+		if ok && includeIntegrationSecrets == "true" {
+			opts.IncludeIntegrationSecrets = true
+		}
+
 		outPath := *app.Config().ExportSettings.Directory
 		exportFilename := job.Id + "_export.zip"
 
@@ -49,6 +49,9 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface, store store.Store) *job
 			toTS *= 1000
 		}
 
+		// Images are processed when their text can be recognized.
+		ocrEnabled := *jobServer.Config().FileSettings.EnableOCR
+
 		var nFiles int
 		var nErrs int
 		for {
@@ -65,7 +68,7 @@ func MakeWorker(jobServer *jobs.JobServer, app AppIface, store store.Store) *job
 				break
 			}
 			for _, fileInfo := range fileInfos {
-				if !ignoredFiles[fileInfo.Extension] {
+				if !ignoredFiles[fileInfo.Extension] || (ocrEnabled && fileInfo.IsImage()) {
 					logger.Debug("Extracting file", mlog.String("filename", fileInfo.Name), mlog.String("filepath", fileInfo.Path))
 
 					err = app.ExtractContentFromFileInfo(request.EmptyContext(logger), fileInfo)
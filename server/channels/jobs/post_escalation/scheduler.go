@@ -0,0 +1,22 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package post_escalation
+
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/jobs"
+)
+
+const schedFreq = time.Minute
+
+func MakeScheduler(jobServer *jobs.JobServer, licenseFunc func() *model.License) *jobs.PeriodicScheduler {
+	isEnabled := func(cfg *model.Config) bool {
+		l := licenseFunc()
+		return l != nil && (l.SkuShortName == model.LicenseShortSkuProfessional || l.SkuShortName == model.LicenseShortSkuEnterprise) &&
+			*cfg.ServiceSettings.PostPriority && *cfg.ServiceSettings.AllowPostEscalations
+	}
+	return jobs.NewPeriodicScheduler(jobServer, model.JobTypePostEscalation, schedFreq, isEnabled)
+}
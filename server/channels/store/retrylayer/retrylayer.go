@@ -46,6 +46,7 @@ type RetryLayer struct {
 	PluginStore                     store.PluginStore
 	PostStore                       store.PostStore
 	PostAcknowledgementStore        store.PostAcknowledgementStore
+	PostEscalationStore             store.PostEscalationStore
 	PostPersistentNotificationStore store.PostPersistentNotificationStore
 	PostPriorityStore               store.PostPriorityStore
 	PreferenceStore                 store.PreferenceStore
@@ -58,6 +59,7 @@ type RetryLayer struct {
 	SchemeStore                     store.SchemeStore
 	SessionStore                    store.SessionStore
 	SharedChannelStore              store.SharedChannelStore
+	SharedDraftStore                store.SharedDraftStore
 	StatusStore                     store.StatusStore
 	SystemStore                     store.SystemStore
 	TeamStore                       store.TeamStore
@@ -68,6 +70,7 @@ type RetryLayer struct {
 	UserStore                       store.UserStore
 	UserAccessTokenStore            store.UserAccessTokenStore
 	UserTermsOfServiceStore         store.UserTermsOfServiceStore
+	WebAuthnCredentialStore         store.WebAuthnCredentialStore
 	WebhookStore                    store.WebhookStore
 }
 
@@ -163,6 +166,10 @@ func (s *RetryLayer) PostAcknowledgement() store.PostAcknowledgementStore {
 	return s.PostAcknowledgementStore
 }
 
+func (s *RetryLayer) PostEscalation() store.PostEscalationStore {
+	return s.PostEscalationStore
+}
+
 func (s *RetryLayer) PostPersistentNotification() store.PostPersistentNotificationStore {
 	return s.PostPersistentNotificationStore
 }
@@ -211,6 +218,10 @@ func (s *RetryLayer) SharedChannel() store.SharedChannelStore {
 	return s.SharedChannelStore
 }
 
+func (s *RetryLayer) SharedDraft() store.SharedDraftStore {
+	return s.SharedDraftStore
+}
+
 func (s *RetryLayer) Status() store.StatusStore {
 	return s.StatusStore
 }
@@ -251,6 +262,10 @@ func (s *RetryLayer) UserTermsOfService() store.UserTermsOfServiceStore {
 	return s.UserTermsOfServiceStore
 }
 
+func (s *RetryLayer) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return s.WebAuthnCredentialStore
+}
+
 func (s *RetryLayer) Webhook() store.WebhookStore {
 	return s.WebhookStore
 }
@@ -370,6 +385,11 @@ type RetryLayerPostAcknowledgementStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerPostEscalationStore struct {
+	store.PostEscalationStore
+	Root *RetryLayer
+}
+
 type RetryLayerPostPersistentNotificationStore struct {
 	store.PostPersistentNotificationStore
 	Root *RetryLayer
@@ -430,6 +450,11 @@ type RetryLayerSharedChannelStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerSharedDraftStore struct {
+	store.SharedDraftStore
+	Root *RetryLayer
+}
+
 type RetryLayerStatusStore struct {
 	store.StatusStore
 	Root *RetryLayer
@@ -480,6 +505,11 @@ type RetryLayerUserTermsOfServiceStore struct {
 	Root *RetryLayer
 }
 
+type RetryLayerWebAuthnCredentialStore struct {
+	store.WebAuthnCredentialStore
+	Root *RetryLayer
+}
+
 type RetryLayerWebhookStore struct {
 	store.WebhookStore
 	Root *RetryLayer
@@ -522,6 +552,27 @@ func (s *RetryLayerAuditStore) Get(userID string, offset int, limit int) (model.
 
 }
 
+func (s *RetryLayerAuditStore) GetRecordsAfter(sequence int64, limit int) ([]*model.AuditRecord, error) {
+
+	tries := 0
+	for {
+		result, err := s.AuditStore.GetRecordsAfter(sequence, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerAuditStore) PermanentDeleteByUser(userID string) error {
 
 	tries := 0
@@ -564,6 +615,48 @@ func (s *RetryLayerAuditStore) Save(audit *model.Audit) error {
 
 }
 
+func (s *RetryLayerAuditStore) SaveRecord(record *model.AuditRecord) (*model.AuditRecord, error) {
+
+	tries := 0
+	for {
+		result, err := s.AuditStore.SaveRecord(record)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerAuditStore) SearchRecords(opts model.AuditRecordSearchOpts) ([]*model.AuditRecord, error) {
+
+	tries := 0
+	for {
+		result, err := s.AuditStore.SearchRecords(opts)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
 
 	tries := 0
@@ -3165,6 +3258,27 @@ func (s *RetryLayerChannelBookmarkStore) GetBookmarksForChannelSince(channelID s
 
 }
 
+func (s *RetryLayerChannelBookmarkStore) GetLinkBookmarksToCheck(checkedBefore int64, limit int) ([]*model.ChannelBookmarkWithFileInfo, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelBookmarkStore.GetLinkBookmarksToCheck(checkedBefore, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerChannelBookmarkStore) Save(bookmark *model.ChannelBookmark, increaseSortOrder bool) (*model.ChannelBookmarkWithFileInfo, error) {
 
 	tries := 0
@@ -3207,6 +3321,48 @@ func (s *RetryLayerChannelBookmarkStore) Update(bookmark *model.ChannelBookmark)
 
 }
 
+func (s *RetryLayerChannelBookmarkStore) UpdateLinkCheck(bookmark *model.ChannelBookmark, updateAt int64) error {
+
+	tries := 0
+	for {
+		err := s.ChannelBookmarkStore.UpdateLinkCheck(bookmark, updateAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerChannelBookmarkStore) UpdateLinkCheckedAt(bookmarkIDs []string, checkedAt int64) error {
+
+	tries := 0
+	for {
+		err := s.ChannelBookmarkStore.UpdateLinkCheckedAt(bookmarkIDs, checkedAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerChannelBookmarkStore) UpdateSortOrder(bookmarkID string, channelID string, newIndex int64) ([]*model.ChannelBookmarkWithFileInfo, error) {
 
 	tries := 0
@@ -3228,6 +3384,27 @@ func (s *RetryLayerChannelBookmarkStore) UpdateSortOrder(bookmarkID string, chan
 
 }
 
+func (s *RetryLayerChannelBookmarkStore) UpsertForSync(bookmark *model.ChannelBookmark) error {
+
+	tries := 0
+	for {
+		err := s.ChannelBookmarkStore.UpsertForSync(bookmark)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
 
 	tries := 0
@@ -3270,6 +3447,27 @@ func (s *RetryLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string
 
 }
 
+func (s *RetryLayerChannelMemberHistoryStore) GetMembershipChangesSince(channelID string, since int64, limit int) ([]*model.ChannelMemberHistoryResult, error) {
+
+	tries := 0
+	for {
+		result, err := s.ChannelMemberHistoryStore.GetMembershipChangesSince(channelID, since, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
 
 	tries := 0
@@ -4521,6 +4719,27 @@ func (s *RetryLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, err
 
 }
 
+func (s *RetryLayerFileInfoStore) GetOrphanedBlobs(updatedBefore int64, limit int) ([]*model.FileBlob, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.GetOrphanedBlobs(updatedBefore, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDeleted bool) (int64, error) {
 
 	tries := 0
@@ -4584,6 +4803,27 @@ func (s *RetryLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *mod
 
 }
 
+func (s *RetryLayerFileInfoStore) GetWithoutContentHash(afterID string, limit int) ([]*model.FileInfo, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.GetWithoutContentHash(afterID, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
 
 	s.FileInfoStore.InvalidateFileInfosForPostCache(postID, deleted)
@@ -4632,6 +4872,27 @@ func (s *RetryLayerFileInfoStore) PermanentDeleteBatch(ctx request.CTX, endTime
 
 }
 
+func (s *RetryLayerFileInfoStore) PermanentDeleteBlob(contentHash string, updatedBefore int64) (bool, error) {
+
+	tries := 0
+	for {
+		result, err := s.FileInfoStore.PermanentDeleteBlob(contentHash, updatedBefore)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerFileInfoStore) PermanentDeleteByUser(ctx request.CTX, userID string) (int64, error) {
 
 	tries := 0
@@ -4737,11 +4998,11 @@ func (s *RetryLayerFileInfoStore) SetContent(ctx request.CTX, fileID string, con
 
 }
 
-func (s *RetryLayerFileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
+func (s *RetryLayerFileInfoStore) SetContentHash(ctx request.CTX, path string, contentHash string, blobPath string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.FileInfoStore.Upsert(rctx, info)
+		result, err := s.FileInfoStore.SetContentHash(ctx, path, contentHash, blobPath)
 		if err == nil {
 			return result, nil
 		}
@@ -4758,11 +5019,11 @@ func (s *RetryLayerFileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo)
 
 }
 
-func (s *RetryLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
+func (s *RetryLayerFileInfoStore) TouchBlob(contentHash string) (bool, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
+		result, err := s.FileInfoStore.TouchBlob(contentHash)
 		if err == nil {
 			return result, nil
 		}
@@ -4779,11 +5040,11 @@ func (s *RetryLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, s
 
 }
 
-func (s *RetryLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
+func (s *RetryLayerFileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
+		result, err := s.FileInfoStore.Upsert(rctx, info)
 		if err == nil {
 			return result, nil
 		}
@@ -4800,11 +5061,11 @@ func (s *RetryLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string,
 
 }
 
-func (s *RetryLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
+func (s *RetryLayerGroupStore) AdminRoleGroupsForSyncableMember(userID string, syncableID string, syncableType model.GroupSyncableType) ([]string, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
+		result, err := s.GroupStore.AdminRoleGroupsForSyncableMember(userID, syncableID, syncableType)
 		if err == nil {
 			return result, nil
 		}
@@ -4821,11 +5082,11 @@ func (s *RetryLayerGroupStore) ChannelMembersToAdd(since int64, channelID *strin
 
 }
 
-func (s *RetryLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
+func (s *RetryLayerGroupStore) ChannelMembersMinusGroupMembers(channelID string, groupIDs []string, page int, perPage int) ([]*model.UserWithGroups, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.ChannelMembersToRemove(channelID)
+		result, err := s.GroupStore.ChannelMembersMinusGroupMembers(channelID, groupIDs, page, perPage)
 		if err == nil {
 			return result, nil
 		}
@@ -4842,11 +5103,11 @@ func (s *RetryLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*mod
 
 }
 
-func (s *RetryLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
+func (s *RetryLayerGroupStore) ChannelMembersToAdd(since int64, channelID *string, includeRemovedMembers bool) ([]*model.UserChannelIDPair, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
+		result, err := s.GroupStore.ChannelMembersToAdd(since, channelID, includeRemovedMembers)
 		if err == nil {
 			return result, nil
 		}
@@ -4863,11 +5124,11 @@ func (s *RetryLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID st
 
 }
 
-func (s *RetryLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *RetryLayerGroupStore) ChannelMembersToRemove(channelID *string) ([]*model.ChannelMember, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountGroupsByChannel(channelID, opts)
+		result, err := s.GroupStore.ChannelMembersToRemove(channelID)
 		if err == nil {
 			return result, nil
 		}
@@ -4884,11 +5145,11 @@ func (s *RetryLayerGroupStore) CountGroupsByChannel(channelID string, opts model
 
 }
 
-func (s *RetryLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
+func (s *RetryLayerGroupStore) CountChannelMembersMinusGroupMembers(channelID string, groupIDs []string) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountGroupsByTeam(teamID, opts)
+		result, err := s.GroupStore.CountChannelMembersMinusGroupMembers(channelID, groupIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -4905,11 +5166,53 @@ func (s *RetryLayerGroupStore) CountGroupsByTeam(teamID string, opts model.Group
 
 }
 
-func (s *RetryLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
+func (s *RetryLayerGroupStore) CountGroupsByChannel(channelID string, opts model.GroupSearchOpts) (int64, error) {
 
 	tries := 0
 	for {
-		result, err := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
+		result, err := s.GroupStore.CountGroupsByChannel(channelID, opts)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerGroupStore) CountGroupsByTeam(teamID string, opts model.GroupSearchOpts) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.GroupStore.CountGroupsByTeam(teamID, opts)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerGroupStore) CountTeamMembersMinusGroupMembers(teamID string, groupIDs []string) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.GroupStore.CountTeamMembersMinusGroupMembers(teamID, groupIDs)
 		if err == nil {
 			return result, nil
 		}
@@ -6669,6 +6972,27 @@ func (s *RetryLayerOAuthStore) GetPreviousAccessData(userID string, clientID str
 
 }
 
+func (s *RetryLayerOAuthStore) ImportApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+
+	tries := 0
+	for {
+		result, err := s.OAuthStore.ImportApp(app)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
 
 	tries := 0
@@ -7284,6 +7608,27 @@ func (s *RetryLayerPostStore) Delete(rctx request.CTX, postID string, timestamp
 
 }
 
+func (s *RetryLayerPostStore) DeletePostReminder(postID string, userID string) error {
+
+	tries := 0
+	for {
+		err := s.PostStore.DeletePostReminder(postID, userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
 
 	tries := 0
@@ -7569,6 +7914,27 @@ func (s *RetryLayerPostStore) GetPostIdBeforeTime(channelID string, timestamp in
 
 }
 
+func (s *RetryLayerPostStore) GetPostReminder(postID string, userID string) (*model.PostReminder, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostStore.GetPostReminder(postID, userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPostStore) GetPostReminderMetadata(postID string) (*store.PostReminderMetadata, error) {
 
 	tries := 0
@@ -7611,6 +7977,27 @@ func (s *RetryLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder
 
 }
 
+func (s *RetryLayerPostStore) GetPostRemindersForUser(userID string) ([]*model.PostReminder, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostStore.GetPostRemindersForUser(userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 
 	tries := 0
@@ -8226,6 +8613,27 @@ func (s *RetryLayerPostAcknowledgementStore) GetForPosts(postIds []string) ([]*m
 
 }
 
+func (s *RetryLayerPostAcknowledgementStore) GetForPostsSince(postIDs []string, since int64, excludeRemoteID string, inclDeleted bool) ([]*model.PostAcknowledgement, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostAcknowledgementStore.GetForPostsSince(postIDs, since, excludeRemoteID, inclDeleted)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPostAcknowledgementStore) Save(postID string, userID string, acknowledgedAt int64) (*model.PostAcknowledgement, error) {
 
 	tries := 0
@@ -8247,6 +8655,132 @@ func (s *RetryLayerPostAcknowledgementStore) Save(postID string, userID string,
 
 }
 
+func (s *RetryLayerPostAcknowledgementStore) SaveForSync(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostAcknowledgementStore.SaveForSync(acknowledgement)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostEscalationStore) Get(postID string) (*model.PostEscalation, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostEscalationStore.Get(postID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostEscalationStore) GetDue(now int64, limit int) ([]*model.PostEscalation, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostEscalationStore.GetDue(now, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostEscalationStore) GetForPosts(postIDs []string) ([]*model.PostEscalation, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostEscalationStore.GetForPosts(postIDs)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostEscalationStore) Save(escalation *model.PostEscalation) (*model.PostEscalation, error) {
+
+	tries := 0
+	for {
+		result, err := s.PostEscalationStore.Save(escalation)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerPostEscalationStore) Update(escalation *model.PostEscalation) error {
+
+	tries := 0
+	for {
+		err := s.PostEscalationStore.Update(escalation)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerPostPersistentNotificationStore) Delete(postIds []string) error {
 
 	tries := 0
@@ -9129,6 +9663,27 @@ func (s *RetryLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.Rem
 
 }
 
+func (s *RetryLayerRemoteClusterStore) SetCapabilities(remoteClusterID string, capabilities model.Bitmask) error {
+
+	tries := 0
+	for {
+		err := s.RemoteClusterStore.SetCapabilities(remoteClusterID, capabilities)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerRemoteClusterStore) SetLastPingAt(remoteClusterID string) error {
 
 	tries := 0
@@ -11025,6 +11580,27 @@ func (s *RetryLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelR
 
 }
 
+func (s *RetryLayerSharedChannelStore) UpdateRemoteBookmarkCursor(id string, lastBookmarkUpdateAt int64) error {
+
+	tries := 0
+	for {
+		err := s.SharedChannelStore.UpdateRemoteBookmarkCursor(id, lastBookmarkUpdateAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error {
 
 	tries := 0
@@ -11034,23 +11610,212 @@ func (s *RetryLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor mode
 			return nil
 		}
 		if !isRepeatableError(err) {
-			return err
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSharedChannelStore) UpdateRemoteMembershipCursor(id string, lastMembersSyncAt int64) error {
+
+	tries := 0
+	for {
+		err := s.SharedChannelStore.UpdateRemoteMembershipCursor(id, lastMembersSyncAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
+
+	tries := 0
+	for {
+		err := s.SharedChannelStore.UpdateUserLastSyncAt(userID, channelID, remoteID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChannelAttachment) (string, error) {
+
+	tries := 0
+	for {
+		result, err := s.SharedChannelStore.UpsertAttachment(remote)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSharedDraftStore) Delete(id string) error {
+
+	tries := 0
+	for {
+		err := s.SharedDraftStore.Delete(id)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSharedDraftStore) DeleteIfUnchanged(id string, lastUpdateAt int64) error {
+
+	tries := 0
+	for {
+		err := s.SharedDraftStore.DeleteIfUnchanged(id, lastUpdateAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSharedDraftStore) DeleteMember(id string, userID string) error {
+
+	tries := 0
+	for {
+		err := s.SharedDraftStore.DeleteMember(id, userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSharedDraftStore) Get(id string) (*model.SharedDraft, error) {
+
+	tries := 0
+	for {
+		result, err := s.SharedDraftStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSharedDraftStore) GetForUser(userID string) ([]*model.SharedDraft, error) {
+
+	tries := 0
+	for {
+		result, err := s.SharedDraftStore.GetForUser(userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerSharedDraftStore) Save(draft *model.SharedDraft) (*model.SharedDraft, error) {
+
+	tries := 0
+	for {
+		result, err := s.SharedDraftStore.Save(draft)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
 		}
 		tries++
 		if tries >= 3 {
 			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
-			return err
+			return result, err
 		}
 		timepkg.Sleep(100 * timepkg.Millisecond)
 	}
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
+func (s *RetryLayerSharedDraftStore) SaveMembers(id string, userIDs []string) error {
 
 	tries := 0
 	for {
-		err := s.SharedChannelStore.UpdateUserLastSyncAt(userID, channelID, remoteID)
+		err := s.SharedDraftStore.SaveMembers(id, userIDs)
 		if err == nil {
 			return nil
 		}
@@ -11067,11 +11832,11 @@ func (s *RetryLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, chann
 
 }
 
-func (s *RetryLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChannelAttachment) (string, error) {
+func (s *RetryLayerSharedDraftStore) Update(draft *model.SharedDraft, lastUpdateAt int64) (*model.SharedDraft, error) {
 
 	tries := 0
 	for {
-		result, err := s.SharedChannelStore.UpsertAttachment(remote)
+		result, err := s.SharedDraftStore.Update(draft, lastUpdateAt)
 		if err == nil {
 			return result, nil
 		}
@@ -14865,6 +15630,27 @@ func (s *RetryLayerUserStore) UpdatePassword(userID string, newPassword string)
 
 }
 
+func (s *RetryLayerUserStore) UpdatePasswordHash(userID string, oldHash string, newHash string) error {
+
+	tries := 0
+	for {
+		err := s.UserStore.UpdatePasswordHash(userID, oldHash, newHash)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerUserStore) UpdateUpdateAt(userID string) (int64, error) {
 
 	tries := 0
@@ -15180,6 +15966,132 @@ func (s *RetryLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserT
 
 }
 
+func (s *RetryLayerWebAuthnCredentialStore) Delete(id string) error {
+
+	tries := 0
+	for {
+		err := s.WebAuthnCredentialStore.Delete(id)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerWebAuthnCredentialStore) Get(id string) (*model.WebAuthnCredential, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebAuthnCredentialStore.Get(id)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerWebAuthnCredentialStore) GetForUser(userID string) ([]*model.WebAuthnCredential, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebAuthnCredentialStore.GetForUser(userID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerWebAuthnCredentialStore) PermanentDeleteByUser(userID string) error {
+
+	tries := 0
+	for {
+		err := s.WebAuthnCredentialStore.PermanentDeleteByUser(userID)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerWebAuthnCredentialStore) Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebAuthnCredentialStore.Save(credential)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerWebAuthnCredentialStore) UpdateSignCount(id string, signCount int64, lastUsedAt int64) error {
+
+	tries := 0
+	for {
+		err := s.WebAuthnCredentialStore.UpdateSignCount(id, signCount, lastUsedAt)
+		if err == nil {
+			return nil
+		}
+		if !isRepeatableError(err) {
+			return err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerWebhookStore) AnalyticsIncomingCount(teamID string, userID string) (int64, error) {
 
 	tries := 0
@@ -15222,6 +16134,27 @@ func (s *RetryLayerWebhookStore) AnalyticsOutgoingCount(teamID string) (int64, e
 
 }
 
+func (s *RetryLayerWebhookStore) ClaimOutgoingDeliveryRetry(deliveryID string) (bool, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebhookStore.ClaimOutgoingDeliveryRetry(deliveryID)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerWebhookStore) ClearCaches() {
 
 	s.WebhookStore.ClearCaches()
@@ -15501,6 +16434,48 @@ func (s *RetryLayerWebhookStore) GetOutgoingByTeamByUser(teamID string, userID s
 
 }
 
+func (s *RetryLayerWebhookStore) GetOutgoingDeliveries(hookID string, offset int, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebhookStore.GetOutgoingDeliveries(hookID, offset, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
+func (s *RetryLayerWebhookStore) GetOutgoingDeliveriesToRetry(before int64, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebhookStore.GetOutgoingDeliveriesToRetry(before, limit)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerWebhookStore) GetOutgoingList(offset int, limit int) ([]*model.OutgoingWebhook, error) {
 
 	tries := 0
@@ -15543,6 +16518,27 @@ func (s *RetryLayerWebhookStore) GetOutgoingListByUser(userID string, offset int
 
 }
 
+func (s *RetryLayerWebhookStore) ImportIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebhookStore.ImportIncoming(webhook)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerWebhookStore) InvalidateWebhookCache(webhook string) {
 
 	s.WebhookStore.InvalidateWebhookCache(webhook)
@@ -15633,6 +16629,27 @@ func (s *RetryLayerWebhookStore) PermanentDeleteOutgoingByUser(userID string) er
 
 }
 
+func (s *RetryLayerWebhookStore) PermanentDeleteOutgoingDeliveriesBefore(createAt int64) (int64, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebhookStore.PermanentDeleteOutgoingDeliveriesBefore(createAt)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 
 	tries := 0
@@ -15675,6 +16692,27 @@ func (s *RetryLayerWebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*
 
 }
 
+func (s *RetryLayerWebhookStore) SaveOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error) {
+
+	tries := 0
+	for {
+		result, err := s.WebhookStore.SaveOutgoingDelivery(delivery)
+		if err == nil {
+			return result, nil
+		}
+		if !isRepeatableError(err) {
+			return result, err
+		}
+		tries++
+		if tries >= 3 {
+			err = errors.Wrap(err, "giving up after 3 consecutive repeatable transaction failures")
+			return result, err
+		}
+		timepkg.Sleep(100 * timepkg.Millisecond)
+	}
+
+}
+
 func (s *RetryLayerWebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 
 	tries := 0
@@ -15781,6 +16819,7 @@ func New(childStore store.Store) *RetryLayer {
 	newStore.PluginStore = &RetryLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
 	newStore.PostStore = &RetryLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
 	newStore.PostAcknowledgementStore = &RetryLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
+	newStore.PostEscalationStore = &RetryLayerPostEscalationStore{PostEscalationStore: childStore.PostEscalation(), Root: &newStore}
 	newStore.PostPersistentNotificationStore = &RetryLayerPostPersistentNotificationStore{PostPersistentNotificationStore: childStore.PostPersistentNotification(), Root: &newStore}
 	newStore.PostPriorityStore = &RetryLayerPostPriorityStore{PostPriorityStore: childStore.PostPriority(), Root: &newStore}
 	newStore.PreferenceStore = &RetryLayerPreferenceStore{PreferenceStore: childStore.Preference(), Root: &newStore}
@@ -15793,6 +16832,7 @@ func New(childStore store.Store) *RetryLayer {
 	newStore.SchemeStore = &RetryLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
 	newStore.SessionStore = &RetryLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
 	newStore.SharedChannelStore = &RetryLayerSharedChannelStore{SharedChannelStore: childStore.SharedChannel(), Root: &newStore}
+	newStore.SharedDraftStore = &RetryLayerSharedDraftStore{SharedDraftStore: childStore.SharedDraft(), Root: &newStore}
 	newStore.StatusStore = &RetryLayerStatusStore{StatusStore: childStore.Status(), Root: &newStore}
 	newStore.SystemStore = &RetryLayerSystemStore{SystemStore: childStore.System(), Root: &newStore}
 	newStore.TeamStore = &RetryLayerTeamStore{TeamStore: childStore.Team(), Root: &newStore}
@@ -15803,6 +16843,7 @@ func New(childStore store.Store) *RetryLayer {
 	newStore.UserStore = &RetryLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &RetryLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
 	newStore.UserTermsOfServiceStore = &RetryLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
+	newStore.WebAuthnCredentialStore = &RetryLayerWebAuthnCredentialStore{WebAuthnCredentialStore: childStore.WebAuthnCredential(), Root: &newStore}
 	newStore.WebhookStore = &RetryLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
 	return &newStore
 }
@@ -4,6 +4,8 @@
 package sqlstore
 
 import (
+	"database/sql"
+
 	sq "github.com/mattermost/squirrel"
 	"github.com/pkg/errors"
 
@@ -66,3 +68,108 @@ func (s SqlAuditStore) PermanentDeleteByUser(userId string) error {
 	}
 	return nil
 }
+
+// auditRecordSaveAttempts bounds the retries when another node appends to the audit record
+// chain between reading the last record and inserting the new one.
+const auditRecordSaveAttempts = 10
+
+var auditRecordColumns = []string{
+	"Sequence",
+	"Id",
+	"CreateAt",
+	"Level",
+	"EventName",
+	"Status",
+	"UserId",
+	"SessionId",
+	"IpAddress",
+	"Data",
+	"PrevHash",
+	"Hash",
+}
+
+func (s SqlAuditStore) SaveRecord(record *model.AuditRecord) (*model.AuditRecord, error) {
+	for range auditRecordSaveAttempts {
+		var prev *model.AuditRecord
+		var last model.AuditRecord
+		query := s.getQueryBuilder().
+			Select(auditRecordColumns...).
+			From("AuditRecords").
+			OrderBy("Sequence DESC").
+			Limit(1)
+		if err := s.GetMasterX().GetBuilder(&last, query); err == nil {
+			prev = &last
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.Wrap(err, "failed to get last AuditRecord")
+		}
+
+		record.PreSave(prev)
+
+		insert := s.getQueryBuilder().
+			Insert("AuditRecords").
+			Columns(auditRecordColumns...).
+			Values(record.Sequence, record.Id, record.CreateAt, record.Level, record.EventName, record.Status,
+				record.UserId, record.SessionId, record.IpAddress, record.Data, record.PrevHash, record.Hash)
+		if _, err := s.GetMasterX().ExecBuilder(insert); err != nil {
+			// The sequence was taken by a concurrent writer, chain to its record instead.
+			if IsUniqueConstraintError(err, []string{"PRIMARY", "auditrecords_pkey"}) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to save AuditRecord with eventName=%s", record.EventName)
+		}
+
+		return record, nil
+	}
+
+	return nil, errors.Errorf("failed to save AuditRecord with eventName=%s: too many concurrent writers", record.EventName)
+}
+
+func (s SqlAuditStore) SearchRecords(opts model.AuditRecordSearchOpts) ([]*model.AuditRecord, error) {
+	if opts.PerPage > model.AuditRecordSearchMaxLimit {
+		return nil, store.NewErrOutOfBounds(opts.PerPage)
+	}
+
+	query := s.getQueryBuilder().
+		Select(auditRecordColumns...).
+		From("AuditRecords").
+		OrderBy("Sequence DESC").
+		Limit(uint64(opts.PerPage)).
+		Offset(uint64(opts.Page * opts.PerPage))
+
+	if opts.UserId != "" {
+		query = query.Where(sq.Eq{"UserId": opts.UserId})
+	}
+	if opts.EventName != "" {
+		query = query.Where(sq.Eq{"EventName": opts.EventName})
+	}
+	if opts.Status != "" {
+		query = query.Where(sq.Eq{"Status": opts.Status})
+	}
+	if opts.StartTime > 0 {
+		query = query.Where(sq.GtOrEq{"CreateAt": opts.StartTime})
+	}
+	if opts.EndTime > 0 {
+		query = query.Where(sq.LtOrEq{"CreateAt": opts.EndTime})
+	}
+
+	records := []*model.AuditRecord{}
+	if err := s.GetReplicaX().SelectBuilder(&records, query); err != nil {
+		return nil, errors.Wrap(err, "failed to search AuditRecords")
+	}
+	return records, nil
+}
+
+func (s SqlAuditStore) GetRecordsAfter(sequence int64, limit int) ([]*model.AuditRecord, error) {
+	query := s.getQueryBuilder().
+		Select(auditRecordColumns...).
+		From("AuditRecords").
+		Where(sq.Gt{"Sequence": sequence}).
+		OrderBy("Sequence ASC").
+		Limit(uint64(limit))
+
+	records := []*model.AuditRecord{}
+	if err := s.GetMasterX().SelectBuilder(&records, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get AuditRecords after sequence=%d", sequence)
+	}
+	return records, nil
+}
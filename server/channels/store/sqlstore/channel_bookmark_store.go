@@ -39,6 +39,9 @@ func bookmarkWithFileInfoSliceColumns() []string {
 		"cb.Emoji",
 		"cb.Type",
 		"COALESCE(cb.OriginalId, '') as OriginalId",
+		"COALESCE(cb.LinkStatus, '') as LinkStatus",
+		"COALESCE(cb.LinkCheckedAt, 0) as LinkCheckedAt",
+		"cb.RemoteId",
 		"COALESCE(fi.Id, '') as FileId",
 		"COALESCE(fi.Name, '') as FileName",
 		"COALESCE(fi.Extension, '') as Extension",
@@ -161,8 +164,8 @@ func (s *SqlChannelBookmarkStore) Save(bookmark *model.ChannelBookmark, increase
 
 	sql, args, sqlErr := s.getQueryBuilder().
 		Insert("ChannelBookmarks").
-		Columns("Id", "CreateAt", "UpdateAt", "DeleteAt", "ChannelId", "OwnerId", "FileInfoId", "DisplayName", "SortOrder", "LinkUrl", "ImageUrl", "Emoji", "Type").
-		Values(bookmark.Id, bookmark.CreateAt, bookmark.UpdateAt, bookmark.DeleteAt, bookmark.ChannelId, bookmark.OwnerId, bookmark.FileId, bookmark.DisplayName, bookmark.SortOrder, bookmark.LinkUrl, bookmark.ImageUrl, bookmark.Emoji, bookmark.Type).
+		Columns("Id", "CreateAt", "UpdateAt", "DeleteAt", "ChannelId", "OwnerId", "FileInfoId", "DisplayName", "SortOrder", "LinkUrl", "ImageUrl", "Emoji", "Type", "LinkStatus", "LinkCheckedAt", "RemoteId").
+		Values(bookmark.Id, bookmark.CreateAt, bookmark.UpdateAt, bookmark.DeleteAt, bookmark.ChannelId, bookmark.OwnerId, bookmark.FileId, bookmark.DisplayName, bookmark.SortOrder, bookmark.LinkUrl, bookmark.ImageUrl, bookmark.Emoji, bookmark.Type, bookmark.LinkStatus, bookmark.LinkCheckedAt, bookmark.RemoteId).
 		ToSql()
 
 	if sqlErr != nil {
@@ -206,6 +209,9 @@ func (s *SqlChannelBookmarkStore) Update(bookmark *model.ChannelBookmark) error
 		Set("ImageUrl", bookmark.ImageUrl).
 		Set("Emoji", bookmark.Emoji).
 		Set("FileInfoId", bookmark.FileId).
+		Set("LinkStatus", bookmark.LinkStatus).
+		Set("LinkCheckedAt", bookmark.LinkCheckedAt).
+		Set("RemoteId", bookmark.RemoteId).
 		Set("UpdateAt", bookmark.UpdateAt).
 		Where(sq.Eq{
 			"Id":       bookmark.Id,
@@ -276,6 +282,7 @@ func (s *SqlChannelBookmarkStore) UpdateSortOrder(bookmarkId, channelId string,
 	}
 	query = query.Set("SortOrder", caseStmt)
 	query = query.Set("UpdateAt", now)
+	query = query.Set("RemoteId", nil)
 	query = query.Where(sq.Eq{"Id": ids})
 	queryStr, args, queryErr := query.ToSql()
 	if queryErr != nil {
@@ -301,6 +308,7 @@ func (s *SqlChannelBookmarkStore) Delete(bookmarkId string, deleteFile bool) err
 		Update("ChannelBookmarks").
 		Set("DeleteAt", now).
 		Set("UpdateAt", now).
+		Set("RemoteId", nil).
 		Where(sq.Eq{"Id": bookmarkId}).
 		ToSql()
 	if err != nil {
@@ -378,3 +386,140 @@ func (s *SqlChannelBookmarkStore) GetBookmarksForChannelSince(channelId string,
 
 	return bookmarks, nil
 }
+
+func (s *SqlChannelBookmarkStore) GetLinkBookmarksToCheck(checkedBefore int64, limit int) ([]*model.ChannelBookmarkWithFileInfo, error) {
+	query := s.getQueryBuilder().
+		Select(bookmarkWithFileInfoSliceColumns()...).
+		From("ChannelBookmarks cb").
+		LeftJoin("FileInfo fi ON cb.FileInfoId = fi.Id").
+		Where(sq.And{
+			sq.Eq{"cb.Type": model.ChannelBookmarkLink},
+			sq.Eq{"cb.DeleteAt": 0},
+			sq.Lt{"cb.LinkCheckedAt": checkedBefore},
+		}).
+		OrderBy("cb.LinkCheckedAt ASC", "cb.Id ASC").
+		Limit(uint64(limit))
+
+	bookmarkRows := []model.ChannelBookmarkAndFileInfo{}
+	if err := s.GetReplicaX().SelectBuilder(&bookmarkRows, query); err != nil {
+		return nil, errors.Wrap(err, "failed to find bookmarks to check")
+	}
+
+	bookmarks := make([]*model.ChannelBookmarkWithFileInfo, 0, len(bookmarkRows))
+	for _, bookmark := range bookmarkRows {
+		bookmarks = append(bookmarks, bookmark.ToChannelBookmarkWithFileInfo())
+	}
+
+	return bookmarks, nil
+}
+
+func (s *SqlChannelBookmarkStore) UpdateLinkCheckedAt(bookmarkIDs []string, checkedAt int64) error {
+	if len(bookmarkIDs) == 0 {
+		return nil
+	}
+
+	// UpdateAt isn't changed, as nothing clients display has changed.
+	query := s.getQueryBuilder().
+		Update("ChannelBookmarks").
+		Set("LinkCheckedAt", checkedAt).
+		Where(sq.Eq{"Id": bookmarkIDs})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrap(err, "failed to update bookmarks link check time")
+	}
+
+	return nil
+}
+
+func (s *SqlChannelBookmarkStore) UpdateLinkCheck(bookmark *model.ChannelBookmark, updateAt int64) error {
+	// Only the fields set by the link check are written, so that a concurrent edit of the
+	// bookmark, which changes UpdateAt, is never overwritten.
+	query := s.getQueryBuilder().
+		Update("ChannelBookmarks").
+		Set("DisplayName", bookmark.DisplayName).
+		Set("ImageUrl", bookmark.ImageUrl).
+		Set("LinkStatus", bookmark.LinkStatus).
+		Set("LinkCheckedAt", bookmark.LinkCheckedAt).
+		Set("UpdateAt", bookmark.UpdateAt).
+		Where(sq.Eq{
+			"Id":       bookmark.Id,
+			"DeleteAt": 0,
+			"UpdateAt": updateAt,
+		})
+
+	res, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update link check of channel bookmark with id=%s", bookmark.Id)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get affected rows after updating bookmark with id=%s", bookmark.Id)
+	}
+	if rowsAffected == 0 {
+		return store.NewErrConflict("ChannelBookmark", nil, "id="+bookmark.Id)
+	}
+	return nil
+}
+
+func (s *SqlChannelBookmarkStore) UpsertForSync(bookmark *model.ChannelBookmark) (err error) {
+	if appErr := bookmark.IsValid(); appErr != nil {
+		return appErr
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	// The most recent change wins: an existing bookmark is only overwritten by a newer one.
+	updateQuery := s.getQueryBuilder().
+		Update("ChannelBookmarks").
+		Set("DeleteAt", bookmark.DeleteAt).
+		Set("OwnerId", bookmark.OwnerId).
+		Set("DisplayName", bookmark.DisplayName).
+		Set("SortOrder", bookmark.SortOrder).
+		Set("LinkUrl", bookmark.LinkUrl).
+		Set("ImageUrl", bookmark.ImageUrl).
+		Set("Emoji", bookmark.Emoji).
+		Set("RemoteId", bookmark.RemoteId).
+		Set("UpdateAt", bookmark.UpdateAt).
+		Where(sq.And{
+			sq.Eq{"Id": bookmark.Id},
+			sq.Eq{"ChannelId": bookmark.ChannelId},
+			sq.Lt{"UpdateAt": bookmark.UpdateAt},
+		})
+
+	result, err := transaction.ExecBuilder(updateQuery)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update channel bookmark with id=%s", bookmark.Id)
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to determine rows affected")
+	}
+
+	if count == 0 {
+		var existing int64
+		existingQuery := s.getQueryBuilder().
+			Select("COUNT(*)").
+			From("ChannelBookmarks").
+			Where(sq.Eq{"Id": bookmark.Id})
+		if err = transaction.GetBuilder(&existing, existingQuery); err != nil {
+			return errors.Wrapf(err, "failed to get channel bookmark with id=%s", bookmark.Id)
+		}
+		if existing > 0 {
+			return store.NewErrConflict("ChannelBookmark", nil, "id="+bookmark.Id)
+		}
+
+		insertQuery := s.getQueryBuilder().
+			Insert("ChannelBookmarks").
+			Columns("Id", "CreateAt", "UpdateAt", "DeleteAt", "ChannelId", "OwnerId", "FileInfoId", "DisplayName", "SortOrder", "LinkUrl", "ImageUrl", "Emoji", "Type", "OriginalId", "RemoteId").
+			Values(bookmark.Id, bookmark.CreateAt, bookmark.UpdateAt, bookmark.DeleteAt, bookmark.ChannelId, bookmark.OwnerId, bookmark.FileId, bookmark.DisplayName, bookmark.SortOrder, bookmark.LinkUrl, bookmark.ImageUrl, bookmark.Emoji, bookmark.Type, bookmark.OriginalId, bookmark.RemoteId)
+		if _, err = transaction.ExecBuilder(insertQuery); err != nil {
+			return errors.Wrapf(err, "failed to save channel bookmark with id=%s", bookmark.Id)
+		}
+	}
+
+	return transaction.Commit()
+}
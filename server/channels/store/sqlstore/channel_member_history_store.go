@@ -267,3 +267,24 @@ func (s SqlChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since
 
 	return channelIds, nil
 }
+
+// GetMembershipChangesSince returns the channel membership periods that started or ended after the given time,
+// ordered by the time of their latest change: the leave time if the user left, otherwise the join time.
+func (s SqlChannelMemberHistoryStore) GetMembershipChangesSince(channelID string, since int64, limit int) ([]*model.ChannelMemberHistoryResult, error) {
+	query, args, err := s.getQueryBuilder().
+		Select("ChannelId", "UserId", "JoinTime", "LeaveTime").
+		From("ChannelMemberHistory").
+		Where(sq.Eq{"ChannelId": channelID}).
+		Where(sq.Expr("COALESCE(LeaveTime, JoinTime) > ?", since)).
+		OrderBy("COALESCE(LeaveTime, JoinTime) ASC", "UserId ASC").
+		Limit(uint64(limit)).ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "channel_member_history_to_sql")
+	}
+
+	histories := []*model.ChannelMemberHistoryResult{}
+	if err := s.GetReplicaX().Select(&histories, query, args...); err != nil {
+		return nil, errors.Wrapf(err, "GetMembershipChangesSince channelId=%s since=%d", channelID, since)
+	}
+	return histories, nil
+}
@@ -42,6 +42,7 @@ type fileInfoWithChannelID struct {
 	Content         string
 	RemoteId        *string
 	Archived        bool
+	ContentHash     string
 }
 
 func (fi fileInfoWithChannelID) ToModel() *model.FileInfo {
@@ -66,6 +67,7 @@ func (fi fileInfoWithChannelID) ToModel() *model.FileInfo {
 		MiniPreview:     fi.MiniPreview,
 		Content:         fi.Content,
 		RemoteId:        fi.RemoteId,
+		ContentHash:     fi.ContentHash,
 	}
 }
 
@@ -106,32 +108,133 @@ func newSqlFileInfoStore(sqlStore *SqlStore, metrics einterfaces.MetricsInterfac
 		"Coalesce(FileInfo.Content, '') AS Content",
 		"Coalesce(FileInfo.RemoteId, '') AS RemoteId",
 		"FileInfo.Archived",
+		"COALESCE(FileInfo.ContentHash, '') AS ContentHash",
 	}
 
 	return s
 }
 
-func (fs SqlFileInfoStore) Save(rctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
+func (fs SqlFileInfoStore) Save(rctx request.CTX, info *model.FileInfo) (_ *model.FileInfo, err error) {
 	info.PreSave()
-	if err := info.IsValid(); err != nil {
-		return nil, err
+	if appErr := info.IsValid(); appErr != nil {
+		return nil, appErr
 	}
 
 	query := `
 		INSERT INTO FileInfo
 		(Id, CreatorId, PostId, ChannelId, CreateAt, UpdateAt, DeleteAt, Path, ThumbnailPath, PreviewPath,
-			Name, Extension, Size, MimeType, Width, Height, HasPreviewImage, MiniPreview, Content, RemoteId)
+			Name, Extension, Size, MimeType, Width, Height, HasPreviewImage, MiniPreview, Content, RemoteId, ContentHash)
 		VALUES
 		(:Id, :CreatorId, :PostId, :ChannelId, :CreateAt, :UpdateAt, :DeleteAt, :Path, :ThumbnailPath, :PreviewPath,
-			:Name, :Extension, :Size, :MimeType, :Width, :Height, :HasPreviewImage, :MiniPreview, :Content, :RemoteId)
+			:Name, :Extension, :Size, :MimeType, :Width, :Height, :HasPreviewImage, :MiniPreview, :Content, :RemoteId, :ContentHash)
 	`
 
-	if _, err := fs.GetMasterX().NamedExec(query, info); err != nil {
+	transaction, err := fs.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if _, err = transaction.NamedExec(query, info); err != nil {
 		return nil, errors.Wrap(err, "failed to save FileInfo")
 	}
+
+	if info.ContentHash != "" {
+		if err = fs.acquireBlobT(transaction, info.ContentHash, info.Path, info.Size, 1); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
 	return info, nil
 }
 
+// acquireBlobT adds refs references to the blob with the given content hash, creating it if needed.
+func (fs SqlFileInfoStore) acquireBlobT(transaction *sqlxTxWrapper, contentHash, path string, size, refs int64) error {
+	now := model.GetMillis()
+	query := fs.getQueryBuilder().
+		Insert("FileBlobs").
+		Columns("Hash", "Path", "Size", "RefCount", "CreateAt", "UpdateAt").
+		Values(contentHash, path, size, refs, now, now)
+
+	if fs.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE RefCount = RefCount + ?, UpdateAt = ?", refs, now))
+	} else {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (hash) DO UPDATE SET RefCount = FileBlobs.RefCount + ?, UpdateAt = ?", refs, now))
+	}
+
+	if _, err := transaction.ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to acquire FileBlob with hash=%s", contentHash)
+	}
+
+	return nil
+}
+
+// releaseBlobsT removes the references held on blobs by the FileInfos matching the given
+// condition. It must be called before those FileInfos are deleted.
+func (fs SqlFileInfoStore) releaseBlobsT(transaction *sqlxTxWrapper, where sq.Sqlizer) error {
+	var refs []struct {
+		ContentHash string
+		Count       int64
+	}
+	query := fs.getQueryBuilder().
+		Select("ContentHash", "COUNT(*) AS Count").
+		From("FileInfo").
+		Where(where).
+		Where(sq.NotEq{"ContentHash": ""}).
+		GroupBy("ContentHash")
+	if err := transaction.SelectBuilder(&refs, query); err != nil {
+		return errors.Wrap(err, "failed to get FileInfo content hashes")
+	}
+
+	now := model.GetMillis()
+	for _, ref := range refs {
+		update := fs.getQueryBuilder().
+			Update("FileBlobs").
+			Set("RefCount", sq.Expr("RefCount - ?", ref.Count)).
+			Set("UpdateAt", now).
+			Where(sq.Eq{"Hash": ref.ContentHash})
+		if _, err := transaction.ExecBuilder(update); err != nil {
+			return errors.Wrapf(err, "failed to release FileBlob with hash=%s", ref.ContentHash)
+		}
+	}
+
+	return nil
+}
+
+// permanentDeleteWhere deletes the FileInfos matching the given condition, releasing the blobs
+// they reference.
+func (fs SqlFileInfoStore) permanentDeleteWhere(where sq.Sqlizer) (_ int64, err error) {
+	transaction, err := fs.GetMasterX().Beginx()
+	if err != nil {
+		return 0, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if err = fs.releaseBlobsT(transaction, where); err != nil {
+		return 0, err
+	}
+
+	sqlResult, err := transaction.ExecBuilder(fs.getQueryBuilder().Delete("FileInfo").Where(where))
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to retrieve rows affected")
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return 0, errors.Wrap(err, "commit_transaction")
+	}
+
+	return rowsAffected, nil
+}
+
 func (fs SqlFileInfoStore) GetByIds(ids []string) ([]*model.FileInfo, error) {
 	query := fs.getQueryBuilder().
 		Select(fs.queryFields...).
@@ -456,56 +559,173 @@ func (fs SqlFileInfoStore) DeleteForPost(rctx request.CTX, postId string) (strin
 }
 
 func (fs SqlFileInfoStore) PermanentDeleteForPost(rctx request.CTX, postID string) error {
-	if _, err := fs.GetMasterX().Exec(`DELETE FROM FileInfo WHERE PostId = ?`, postID); err != nil {
+	if _, err := fs.permanentDeleteWhere(sq.Eq{"PostId": postID}); err != nil {
 		return errors.Wrapf(err, "failed to delete FileInfo with PostId=%s", postID)
 	}
 	return nil
 }
 
 func (fs SqlFileInfoStore) PermanentDelete(rctx request.CTX, fileId string) error {
-	if _, err := fs.GetMasterX().Exec(`DELETE FROM FileInfo WHERE Id = ?`, fileId); err != nil {
+	if _, err := fs.permanentDeleteWhere(sq.Eq{"Id": fileId}); err != nil {
 		return errors.Wrapf(err, "failed to delete FileInfo with id=%s", fileId)
 	}
 	return nil
 }
 
 func (fs SqlFileInfoStore) PermanentDeleteBatch(rctx request.CTX, endTime int64, limit int64) (int64, error) {
-	var query string
-	if fs.DriverName() == "postgres" {
-		query = "DELETE from FileInfo WHERE Id = any (array (SELECT Id FROM FileInfo WHERE CreateAt < ? AND CreatorId != ? LIMIT ?))"
-	} else {
-		query = "DELETE from FileInfo WHERE CreateAt < ? AND CreatorId != ? LIMIT ?"
+	// The ids are fetched first so that the blobs released are those of the deleted FileInfos.
+	var ids []string
+	query := fs.getQueryBuilder().
+		Select("Id").
+		From("FileInfo").
+		Where(sq.Lt{"CreateAt": endTime}).
+		Where(sq.NotEq{"CreatorId": model.BookmarkFileOwner}).
+		Limit(uint64(limit))
+	if err := fs.GetMasterX().SelectBuilder(&ids, query); err != nil {
+		return 0, errors.Wrap(err, "failed to get FileInfos to delete in batch")
 	}
 
-	sqlResult, err := fs.GetMasterX().Exec(query, endTime, model.BookmarkFileOwner, limit)
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	rowsAffected, err := fs.permanentDeleteWhere(sq.Eq{"Id": ids})
 	if err != nil {
 		return 0, errors.Wrap(err, "failed to delete FileInfos in batch")
 	}
 
-	rowsAffected, err := sqlResult.RowsAffected()
+	return rowsAffected, nil
+}
+
+func (fs SqlFileInfoStore) PermanentDeleteByUser(rctx request.CTX, userId string) (int64, error) {
+	rowsAffected, err := fs.permanentDeleteWhere(sq.Eq{"CreatorId": userId})
 	if err != nil {
-		return 0, errors.Wrapf(err, "unable to retrieve rows affected")
+		return 0, errors.Wrapf(err, "failed to delete FileInfo with creatorId=%s", userId)
 	}
 
 	return rowsAffected, nil
 }
 
-func (fs SqlFileInfoStore) PermanentDeleteByUser(rctx request.CTX, userId string) (int64, error) {
-	query := "DELETE from FileInfo WHERE CreatorId = ?"
+func (fs SqlFileInfoStore) SetContentHash(rctx request.CTX, path, contentHash, blobPath string) (_ int64, err error) {
+	transaction, err := fs.GetMasterX().Beginx()
+	if err != nil {
+		return 0, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
 
-	sqlResult, err := fs.GetMasterX().Exec(query, userId)
+	// Copied FileInfos share the same path, so all of them are moved to the blob at once.
+	var sizes []int64
+	query := fs.getQueryBuilder().
+		Select("Size").
+		From("FileInfo").
+		Where(sq.Eq{"Path": path, "ContentHash": ""})
+	if err = transaction.SelectBuilder(&sizes, query); err != nil {
+		return 0, errors.Wrapf(err, "failed to get FileInfos with path=%s", path)
+	}
+
+	if len(sizes) == 0 {
+		return 0, nil
+	}
+
+	update := fs.getQueryBuilder().
+		Update("FileInfo").
+		Set("ContentHash", contentHash).
+		Set("Path", blobPath).
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.Eq{"Path": path, "ContentHash": ""})
+	sqlResult, err := transaction.ExecBuilder(update)
 	if err != nil {
-		return 0, errors.Wrapf(err, "failed to delete FileInfo with creatorId=%s", userId)
+		return 0, errors.Wrapf(err, "failed to update FileInfos with path=%s", path)
 	}
 
 	rowsAffected, err := sqlResult.RowsAffected()
 	if err != nil {
-		return 0, errors.Wrapf(err, "unable to retrieve rows affected")
+		return 0, errors.Wrap(err, "unable to retrieve rows affected")
+	}
+
+	if err = fs.acquireBlobT(transaction, contentHash, blobPath, sizes[0], rowsAffected); err != nil {
+		return 0, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return 0, errors.Wrap(err, "commit_transaction")
 	}
 
 	return rowsAffected, nil
 }
 
+func (fs SqlFileInfoStore) GetWithoutContentHash(afterID string, limit int) ([]*model.FileInfo, error) {
+	infos := []*model.FileInfo{}
+	query := fs.getQueryBuilder().
+		Select(fs.queryFields...).
+		From("FileInfo").
+		Where(sq.Gt{"Id": afterID}).
+		Where(sq.Eq{"ContentHash": ""}).
+		Where(sq.NotEq{"Path": ""}).
+		OrderBy("Id").
+		Limit(uint64(limit))
+	if err := fs.GetReplicaX().SelectBuilder(&infos, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get FileInfos without content hash")
+	}
+
+	return infos, nil
+}
+
+func (fs SqlFileInfoStore) GetOrphanedBlobs(updatedBefore int64, limit int) ([]*model.FileBlob, error) {
+	blobs := []*model.FileBlob{}
+	query := fs.getQueryBuilder().
+		Select("Hash", "Path", "Size", "RefCount", "CreateAt", "UpdateAt").
+		From("FileBlobs").
+		Where(sq.LtOrEq{"RefCount": 0}).
+		Where(sq.Lt{"UpdateAt": updatedBefore}).
+		OrderBy("UpdateAt").
+		Limit(uint64(limit))
+	if err := fs.GetMasterX().SelectBuilder(&blobs, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get orphaned FileBlobs")
+	}
+
+	return blobs, nil
+}
+
+func (fs SqlFileInfoStore) TouchBlob(contentHash string) (bool, error) {
+	query := fs.getQueryBuilder().
+		Update("FileBlobs").
+		Set("UpdateAt", model.GetMillis()).
+		Where(sq.Eq{"Hash": contentHash})
+	sqlResult, err := fs.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to touch FileBlob with hash=%s", contentHash)
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to retrieve rows affected")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (fs SqlFileInfoStore) PermanentDeleteBlob(contentHash string, updatedBefore int64) (bool, error) {
+	// The conditions GetOrphanedBlobs selected the blob on are checked again, as the blob may have
+	// been referenced or touched by an upload since.
+	query := fs.getQueryBuilder().
+		Delete("FileBlobs").
+		Where(sq.Eq{"Hash": contentHash}).
+		Where(sq.LtOrEq{"RefCount": 0}).
+		Where(sq.Lt{"UpdateAt": updatedBefore})
+	sqlResult, err := fs.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to delete FileBlob with hash=%s", contentHash)
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to retrieve rows affected")
+	}
+
+	return rowsAffected > 0, nil
+}
+
 func (fs SqlFileInfoStore) Search(rctx request.CTX, paramsList []*model.SearchParams, userId, teamId string, page, perPage int) (*model.FileInfoList, error) {
 	// Since we don't support paging for DB search, we just return nothing for later pages
 	if page > 0 {
@@ -26,6 +26,16 @@ func (as SqlOAuthStore) SaveApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 		return nil, store.NewErrInvalidInput("OAuthApp", "Id", app.Id)
 	}
 
+	return as.insertApp(app)
+}
+
+// ImportApp saves an OAuth app keeping its id when set, so that clients configured with the
+// existing client id keep working.
+func (as SqlOAuthStore) ImportApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+	return as.insertApp(app)
+}
+
+func (as SqlOAuthStore) insertApp(app *model.OAuthApp) (*model.OAuthApp, error) {
 	app.PreSave()
 	if err := app.IsValid(); err != nil {
 		return nil, err
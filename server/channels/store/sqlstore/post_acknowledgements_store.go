@@ -21,9 +21,13 @@ func newSqlPostAcknowledgementStore(sqlStore *SqlStore) store.PostAcknowledgemen
 	return &SqlPostAcknowledgementStore{sqlStore}
 }
 
+func postAcknowledgementColumns() []string {
+	return []string{"PostId", "UserId", "AcknowledgedAt", "UpdateAt", "RemoteId"}
+}
+
 func (s *SqlPostAcknowledgementStore) Get(postID, userID string) (*model.PostAcknowledgement, error) {
 	query := s.getQueryBuilder().
-		Select("PostId", "UserId", "AcknowledgedAt").
+		Select(postAcknowledgementColumns()...).
 		From("PostAcknowledgements").
 		Where(sq.And{
 			sq.Eq{"PostId": postID},
@@ -53,6 +57,7 @@ func (s *SqlPostAcknowledgementStore) Save(postID, userID string, acknowledgedAt
 		UserId:         userID,
 		PostId:         postID,
 		AcknowledgedAt: acknowledgedAt,
+		UpdateAt:       model.GetMillis(),
 	}
 
 	if err := acknowledgement.IsValid(); err != nil {
@@ -67,13 +72,13 @@ func (s *SqlPostAcknowledgementStore) Save(postID, userID string, acknowledgedAt
 
 	query := s.getQueryBuilder().
 		Insert("PostAcknowledgements").
-		Columns("PostId", "UserId", "AcknowledgedAt").
-		Values(acknowledgement.PostId, acknowledgement.UserId, acknowledgement.AcknowledgedAt)
+		Columns("PostId", "UserId", "AcknowledgedAt", "UpdateAt").
+		Values(acknowledgement.PostId, acknowledgement.UserId, acknowledgement.AcknowledgedAt, acknowledgement.UpdateAt)
 
 	if s.DriverName() == model.DatabaseDriverMysql {
-		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE AcknowledgedAt = ?", acknowledgement.AcknowledgedAt))
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE AcknowledgedAt = ?, UpdateAt = ?, RemoteId = NULL", acknowledgement.AcknowledgedAt, acknowledgement.UpdateAt))
 	} else {
-		query = query.SuffixExpr(sq.Expr("ON CONFLICT (postid, userid) DO UPDATE SET AcknowledgedAt = ?", acknowledgement.AcknowledgedAt))
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (postid, userid) DO UPDATE SET AcknowledgedAt = ?, UpdateAt = ?, RemoteId = NULL", acknowledgement.AcknowledgedAt, acknowledgement.UpdateAt))
 	}
 
 	_, err = transaction.ExecBuilder(query)
@@ -104,6 +109,8 @@ func (s *SqlPostAcknowledgementStore) Delete(acknowledgement *model.PostAcknowle
 	query := s.getQueryBuilder().
 		Update("PostAcknowledgements").
 		Set("AcknowledgedAt", 0).
+		Set("UpdateAt", model.GetMillis()).
+		Set("RemoteId", nil).
 		Where(sq.And{
 			sq.Eq{"PostId": acknowledgement.PostId},
 			sq.Eq{"UserId": acknowledgement.UserId},
@@ -131,7 +138,7 @@ func (s *SqlPostAcknowledgementStore) GetForPost(postID string) ([]*model.PostAc
 	var acknowledgements []*model.PostAcknowledgement
 
 	query := s.getQueryBuilder().
-		Select("PostId", "UserId", "AcknowledgedAt").
+		Select(postAcknowledgementColumns()...).
 		From("PostAcknowledgements").
 		Where(sq.And{
 			sq.NotEq{"AcknowledgedAt": 0},
@@ -157,7 +164,7 @@ func (s *SqlPostAcknowledgementStore) GetForPosts(postIds []string) ([]*model.Po
 		}
 
 		query := s.getQueryBuilder().
-			Select("PostId", "UserId", "AcknowledgedAt").
+			Select(postAcknowledgementColumns()...).
 			From("PostAcknowledgements").
 			Where(sq.And{
 				sq.Eq{"PostId": postIds[i:j]},
@@ -176,6 +183,93 @@ func (s *SqlPostAcknowledgementStore) GetForPosts(postIds []string) ([]*model.Po
 	return acknowledgements, nil
 }
 
+func (s *SqlPostAcknowledgementStore) GetForPostsSince(postIDs []string, since int64, excludeRemoteID string, inclDeleted bool) ([]*model.PostAcknowledgement, error) {
+	var acknowledgements []*model.PostAcknowledgement
+
+	perPage := 200
+	for i := 0; i < len(postIDs); i += perPage {
+		j := min(i+perPage, len(postIDs))
+
+		query := s.getQueryBuilder().
+			Select(postAcknowledgementColumns()...).
+			From("PostAcknowledgements").
+			Where(sq.And{
+				sq.Eq{"PostId": postIDs[i:j]},
+				sq.GtOrEq{"UpdateAt": since},
+			})
+
+		if excludeRemoteID != "" {
+			query = query.Where(sq.NotEq{"COALESCE(RemoteId, '')": excludeRemoteID})
+		}
+
+		if !inclDeleted {
+			query = query.Where(sq.NotEq{"AcknowledgedAt": 0})
+		}
+
+		var acknowledgementsBatch []*model.PostAcknowledgement
+		if err := s.GetReplicaX().SelectBuilder(&acknowledgementsBatch, query); err != nil {
+			return nil, errors.Wrapf(err, "failed to get PostAcknowledgements since %d", since)
+		}
+
+		acknowledgements = append(acknowledgements, acknowledgementsBatch...)
+	}
+
+	return acknowledgements, nil
+}
+
+func (s *SqlPostAcknowledgementStore) SaveForSync(acknowledgement *model.PostAcknowledgement) (_ *model.PostAcknowledgement, err error) {
+	if appErr := acknowledgement.IsValid(); appErr != nil {
+		return nil, appErr
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	query := s.getQueryBuilder().
+		Insert("PostAcknowledgements").
+		Columns("PostId", "UserId", "AcknowledgedAt", "UpdateAt", "RemoteId").
+		Values(acknowledgement.PostId, acknowledgement.UserId, acknowledgement.AcknowledgedAt, acknowledgement.UpdateAt, acknowledgement.RemoteId)
+
+	// The most recent change wins: an existing row is only overwritten by a newer one.
+	if s.DriverName() == model.DatabaseDriverMysql {
+		// MySQL evaluates the assignments in order, so UpdateAt must be assigned last.
+		query = query.Suffix(`ON DUPLICATE KEY UPDATE
+			AcknowledgedAt = IF(UpdateAt < VALUES(UpdateAt), VALUES(AcknowledgedAt), AcknowledgedAt),
+			RemoteId = IF(UpdateAt < VALUES(UpdateAt), VALUES(RemoteId), RemoteId),
+			UpdateAt = GREATEST(UpdateAt, VALUES(UpdateAt))`)
+	} else {
+		query = query.Suffix(`ON CONFLICT (postid, userid) DO UPDATE SET
+			AcknowledgedAt = EXCLUDED.AcknowledgedAt, UpdateAt = EXCLUDED.UpdateAt, RemoteId = EXCLUDED.RemoteId
+			WHERE PostAcknowledgements.UpdateAt < EXCLUDED.UpdateAt`)
+	}
+
+	result, err := transaction.ExecBuilder(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save PostAcknowledgement")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to determine rows affected")
+	}
+	if count == 0 {
+		return nil, store.NewErrConflict("PostAcknowledgement", nil, "postId="+acknowledgement.PostId+", userId="+acknowledgement.UserId)
+	}
+
+	if err = updatePost(transaction, acknowledgement.PostId); err != nil {
+		return nil, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return acknowledgement, nil
+}
+
 func updatePost(transaction *sqlxTxWrapper, postId string) error {
 	_, err := transaction.Exec(
 		`UPDATE
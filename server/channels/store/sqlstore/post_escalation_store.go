@@ -0,0 +1,171 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlPostEscalationStore struct {
+	*SqlStore
+
+	postEscalationQuery sq.SelectBuilder
+}
+
+func newSqlPostEscalationStore(sqlStore *SqlStore) store.PostEscalationStore {
+	s := &SqlPostEscalationStore{SqlStore: sqlStore}
+
+	s.postEscalationQuery = s.getQueryBuilder().
+		Select(
+			"PostId",
+			"ChannelId",
+			"CreatorId",
+			"CreateAt",
+			"UpdateAt",
+			"Levels",
+			"FallbackGroupId",
+			"FallbackDelayMinutes",
+			"NotifiedLevels",
+			"LastNotifiedAt",
+			"NextEscalationAt",
+			"Status",
+		).
+		From("PostEscalations")
+
+	return s
+}
+
+// Save creates the escalation of the post, replacing any previous one.
+func (s *SqlPostEscalationStore) Save(escalation *model.PostEscalation) (*model.PostEscalation, error) {
+	if err := escalation.IsValid(); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	query := s.getQueryBuilder().
+		Insert("PostEscalations").
+		Columns("PostId", "ChannelId", "CreatorId", "CreateAt", "UpdateAt", "Levels", "FallbackGroupId", "FallbackDelayMinutes", "NotifiedLevels", "LastNotifiedAt", "NextEscalationAt", "Status").
+		Values(escalation.PostId, escalation.ChannelId, escalation.CreatorId, escalation.CreateAt, escalation.UpdateAt, escalation.Levels, escalation.FallbackGroupId, escalation.FallbackDelayMinutes, escalation.NotifiedLevels, escalation.LastNotifiedAt, escalation.NextEscalationAt, escalation.Status)
+
+	updateSet := "CreatorId = ?, CreateAt = ?, UpdateAt = ?, Levels = ?, FallbackGroupId = ?, FallbackDelayMinutes = ?, NotifiedLevels = ?, LastNotifiedAt = ?, NextEscalationAt = ?, Status = ?"
+	updateArgs := []any{escalation.CreatorId, escalation.CreateAt, escalation.UpdateAt, escalation.Levels, escalation.FallbackGroupId, escalation.FallbackDelayMinutes, escalation.NotifiedLevels, escalation.LastNotifiedAt, escalation.NextEscalationAt, escalation.Status}
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE "+updateSet, updateArgs...))
+	} else {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (postid) DO UPDATE SET "+updateSet, updateArgs...))
+	}
+
+	if _, err = transaction.ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save escalation for post=%s", escalation.PostId)
+	}
+
+	if err = updatePost(transaction, escalation.PostId); err != nil {
+		return nil, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return escalation, nil
+}
+
+// Update saves the progress of the escalation.
+func (s *SqlPostEscalationStore) Update(escalation *model.PostEscalation) error {
+	if err := escalation.IsValid(); err != nil {
+		return err
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	query := s.getQueryBuilder().
+		Update("PostEscalations").
+		Set("UpdateAt", escalation.UpdateAt).
+		Set("NotifiedLevels", escalation.NotifiedLevels).
+		Set("LastNotifiedAt", escalation.LastNotifiedAt).
+		Set("NextEscalationAt", escalation.NextEscalationAt).
+		Set("Status", escalation.Status).
+		Where(sq.Eq{"PostId": escalation.PostId})
+
+	result, err := transaction.ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update escalation for post=%s", escalation.PostId)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return store.NewErrNotFound("PostEscalation", escalation.PostId)
+	}
+
+	if err = updatePost(transaction, escalation.PostId); err != nil {
+		return err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlPostEscalationStore) Get(postID string) (*model.PostEscalation, error) {
+	query := s.postEscalationQuery.Where(sq.Eq{"PostId": postID})
+
+	var escalation model.PostEscalation
+	if err := s.GetReplicaX().GetBuilder(&escalation, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PostEscalation", postID)
+		}
+		return nil, errors.Wrapf(err, "failed to get escalation for post=%s", postID)
+	}
+
+	return &escalation, nil
+}
+
+func (s *SqlPostEscalationStore) GetForPosts(postIDs []string) ([]*model.PostEscalation, error) {
+	escalations := []*model.PostEscalation{}
+	if len(postIDs) == 0 {
+		return escalations, nil
+	}
+
+	query := s.postEscalationQuery.Where(sq.Eq{"PostId": postIDs})
+
+	if err := s.GetReplicaX().SelectBuilder(&escalations, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get escalations for posts")
+	}
+
+	return escalations, nil
+}
+
+// GetDue returns the active escalations whose next level should have been notified by now.
+func (s *SqlPostEscalationStore) GetDue(now int64, limit int) ([]*model.PostEscalation, error) {
+	query := s.postEscalationQuery.
+		Where(sq.And{
+			sq.Eq{"Status": model.PostEscalationStatusActive},
+			sq.LtOrEq{"NextEscalationAt": now},
+		}).
+		OrderBy("NextEscalationAt", "PostId").
+		Limit(uint64(limit))
+
+	escalations := []*model.PostEscalation{}
+	// The master is used so that escalations advanced by the previous batch aren't returned again.
+	if err := s.GetMasterX().SelectBuilder(&escalations, query); err != nil {
+		return nil, errors.Wrap(err, "failed to get due escalations")
+	}
+
+	return escalations, nil
+}
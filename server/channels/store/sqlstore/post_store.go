@@ -3212,25 +3212,28 @@ func (s *SqlPostStore) SetPostReminder(reminder *model.PostReminder) error {
 	}
 	defer finalizeTransactionX(transaction, &err)
 
-	sql := `SELECT EXISTS (SELECT 1 FROM Posts	WHERE Id=?)`
-	var exist bool
-	err = transaction.Get(&exist, sql, reminder.PostId)
-	if err != nil {
-		return errors.Wrap(err, "failed to check for post")
-	}
-	if !exist {
-		return store.NewErrNotFound("Post", reminder.PostId)
+	// Standalone reminders aren't about a post.
+	if !reminder.IsStandalone() {
+		sql := `SELECT EXISTS (SELECT 1 FROM Posts	WHERE Id=?)`
+		var exist bool
+		err = transaction.Get(&exist, sql, reminder.PostId)
+		if err != nil {
+			return errors.Wrap(err, "failed to check for post")
+		}
+		if !exist {
+			return store.NewErrNotFound("Post", reminder.PostId)
+		}
 	}
 
 	query := s.getQueryBuilder().
 		Insert("PostReminders").
-		Columns("PostId", "UserId", "TargetTime").
-		Values(reminder.PostId, reminder.UserId, reminder.TargetTime)
+		Columns("PostId", "UserId", "TargetTime", "Message", "Recurrence").
+		Values(reminder.PostId, reminder.UserId, reminder.TargetTime, reminder.Message, reminder.Recurrence)
 
 	if s.DriverName() == model.DatabaseDriverMysql {
-		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE TargetTime = ?", reminder.TargetTime))
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE TargetTime = ?, Message = ?, Recurrence = ?", reminder.TargetTime, reminder.Message, reminder.Recurrence))
 	} else {
-		query = query.SuffixExpr(sq.Expr("ON CONFLICT (postid, userid) DO UPDATE SET TargetTime = ?", reminder.TargetTime))
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (postid, userid) DO UPDATE SET TargetTime = ?, Message = ?, Recurrence = ?", reminder.TargetTime, reminder.Message, reminder.Recurrence))
 	}
 
 	sql, args, err := query.ToSql()
@@ -3255,7 +3258,7 @@ func (s *SqlPostStore) GetPostReminders(now int64) (_ []*model.PostReminder, err
 	}
 	defer finalizeTransactionX(transaction, &err)
 
-	err = transaction.Select(&reminders, `SELECT PostId, UserId
+	err = transaction.Select(&reminders, `SELECT PostId, UserId, TargetTime, Message, Recurrence
 		FROM PostReminders
 		WHERE TargetTime < ?`, now)
 	if err != nil && err != sql.ErrNoRows {
@@ -3282,6 +3285,60 @@ func (s *SqlPostStore) GetPostReminders(now int64) (_ []*model.PostReminder, err
 	return reminders, nil
 }
 
+// GetPostRemindersForUser returns the pending reminders of the user, soonest first.
+func (s *SqlPostStore) GetPostRemindersForUser(userID string) ([]*model.PostReminder, error) {
+	query := s.getQueryBuilder().
+		Select("PostId", "UserId", "TargetTime", "Message", "Recurrence").
+		From("PostReminders").
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("TargetTime", "PostId")
+
+	reminders := []*model.PostReminder{}
+	if err := s.GetReplicaX().SelectBuilder(&reminders, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get post reminders for userId=%s", userID)
+	}
+
+	return reminders, nil
+}
+
+func (s *SqlPostStore) GetPostReminder(postID, userID string) (*model.PostReminder, error) {
+	query := s.getQueryBuilder().
+		Select("PostId", "UserId", "TargetTime", "Message", "Recurrence").
+		From("PostReminders").
+		Where(sq.Eq{"PostId": postID, "UserId": userID})
+
+	var reminder model.PostReminder
+	if err := s.GetMasterX().GetBuilder(&reminder, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("PostReminder", postID)
+		}
+		return nil, errors.Wrapf(err, "failed to get post reminder with postId=%s", postID)
+	}
+
+	return &reminder, nil
+}
+
+func (s *SqlPostStore) DeletePostReminder(postID, userID string) error {
+	query := s.getQueryBuilder().
+		Delete("PostReminders").
+		Where(sq.Eq{"PostId": postID, "UserId": userID})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete post reminder with postId=%s", postID)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if rows == 0 {
+		return store.NewErrNotFound("PostReminder", postID)
+	}
+
+	return nil
+}
+
 func (s *SqlPostStore) GetPostReminderMetadata(postID string) (*store.PostReminderMetadata, error) {
 	meta := &store.PostReminderMetadata{}
 	err := s.GetReplicaX().Get(meta, `SELECT c.id as ChannelID,
@@ -44,6 +44,7 @@ func remoteClusterFields(prefix string) []string {
 		prefix + "CreatorId",
 		prefix + "PluginID",
 		prefix + "Options",
+		prefix + "Capabilities",
 	}
 }
 
@@ -68,10 +69,10 @@ func (s sqlRemoteClusterStore) Save(remoteCluster *model.RemoteCluster) (*model.
 
 	query := `INSERT INTO RemoteClusters
 				(RemoteId, RemoteTeamId, Name, DisplayName, SiteURL, DefaultTeamId, CreateAt,
-                DeleteAt, LastPingAt, Token, RemoteToken, Topics, CreatorId, PluginID, Options)
+                DeleteAt, LastPingAt, Token, RemoteToken, Topics, CreatorId, PluginID, Options, Capabilities)
 				VALUES
 				(:RemoteId, :RemoteTeamId, :Name, :DisplayName, :SiteURL, :DefaultTeamId, :CreateAt,
-				:DeleteAt, :LastPingAt, :Token, :RemoteToken, :Topics, :CreatorId, :PluginID, :Options)`
+				:DeleteAt, :LastPingAt, :Token, :RemoteToken, :Topics, :CreatorId, :PluginID, :Options, :Capabilities)`
 
 	if _, err := s.GetMasterX().NamedExec(query, remoteCluster); err != nil {
 		return nil, errors.Wrap(err, "failed to save RemoteCluster")
@@ -310,3 +311,15 @@ func (s sqlRemoteClusterStore) SetLastPingAt(remoteClusterId string) error {
 	}
 	return nil
 }
+
+func (s sqlRemoteClusterStore) SetCapabilities(remoteClusterId string, capabilities model.Bitmask) error {
+	query := s.getQueryBuilder().
+		Update("RemoteClusters").
+		Set("Capabilities", capabilities).
+		Where(sq.Eq{"RemoteId": remoteClusterId})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrap(err, "failed to update RemoteCluster capabilities")
+	}
+	return nil
+}
@@ -45,6 +45,9 @@ func (s *SqlScheduledPostStore) columns(prefix string) []string {
 		prefix + "ScheduledAt",
 		prefix + "ProcessedAt",
 		prefix + "ErrorCode",
+		prefix + "Recurrence",
+		prefix + "RecurrencePaused",
+		prefix + "RecurrenceEndAt",
 	}
 }
 
@@ -63,6 +66,9 @@ func (s *SqlScheduledPostStore) scheduledPostToSlice(scheduledPost *model.Schedu
 		scheduledPost.ScheduledAt,
 		scheduledPost.ProcessedAt,
 		scheduledPost.ErrorCode,
+		scheduledPost.Recurrence,
+		scheduledPost.RecurrencePaused,
+		scheduledPost.RecurrenceEndAt,
 	}
 }
 
@@ -139,7 +145,7 @@ func (s *SqlScheduledPostStore) GetPendingScheduledPosts(beforeTime, afterTime i
 	query := s.getQueryBuilder().
 		Select(s.columns("")...).
 		From("ScheduledPosts").
-		Where(sq.Eq{"ErrorCode": ""}).
+		Where(sq.Eq{"ErrorCode": "", "RecurrencePaused": false}).
 		OrderBy("ScheduledAt DESC", "Id").
 		Limit(perPage)
 
@@ -233,14 +239,17 @@ func (s *SqlScheduledPostStore) UpdatedScheduledPost(scheduledPost *model.Schedu
 func (s *SqlScheduledPostStore) toUpdateMap(scheduledPost *model.ScheduledPost) map[string]interface{} {
 	now := model.GetMillis()
 	return map[string]interface{}{
-		"UpdateAt":    now,
-		"Message":     scheduledPost.Message,
-		"Props":       model.StringInterfaceToJSON(scheduledPost.GetProps()),
-		"FileIds":     model.ArrayToJSON(scheduledPost.FileIds),
-		"Priority":    model.StringInterfaceToJSON(scheduledPost.Priority),
-		"ScheduledAt": scheduledPost.ScheduledAt,
-		"ProcessedAt": now,
-		"ErrorCode":   scheduledPost.ErrorCode,
+		"UpdateAt":         now,
+		"Message":          scheduledPost.Message,
+		"Props":            model.StringInterfaceToJSON(scheduledPost.GetProps()),
+		"FileIds":          model.ArrayToJSON(scheduledPost.FileIds),
+		"Priority":         model.StringInterfaceToJSON(scheduledPost.Priority),
+		"ScheduledAt":      scheduledPost.ScheduledAt,
+		"ProcessedAt":      now,
+		"ErrorCode":        scheduledPost.ErrorCode,
+		"Recurrence":       scheduledPost.Recurrence,
+		"RecurrencePaused": scheduledPost.RecurrencePaused,
+		"RecurrenceEndAt":  scheduledPost.RecurrenceEndAt,
 	}
 }
 
@@ -269,7 +278,7 @@ func (s *SqlScheduledPostStore) UpdateOldScheduledPosts(beforeTime int64) error
 		Set("ErrorCode", model.ScheduledPostErrorUnableToSend).
 		Set("ProcessedAt", model.GetMillis()).
 		Where(sq.And{
-			sq.Eq{"ErrorCode": ""},
+			sq.Eq{"ErrorCode": "", "RecurrencePaused": false},
 			sq.Lt{"ScheduledAt": beforeTime},
 		})
 
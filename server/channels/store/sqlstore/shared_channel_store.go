@@ -343,9 +343,11 @@ func (s SqlSharedChannelStore) SaveRemote(remote *model.SharedChannelRemote) (*m
 
 	query, args, err := s.getQueryBuilder().Insert("SharedChannelRemotes").
 		Columns("Id", "ChannelId", "CreatorId", "CreateAt", "UpdateAt", "DeleteAt", "IsInviteAccepted", "IsInviteConfirmed", "RemoteId",
-			"LastPostCreateAt", "LastPostCreateId", "LastPostUpdateAt", "LastPostId").
+			"LastPostCreateAt", "LastPostCreateId", "LastPostUpdateAt", "LastPostId", "LastBookmarkUpdateAt",
+			"LastMembersSyncAt").
 		Values(remote.Id, remote.ChannelId, remote.CreatorId, remote.CreateAt, remote.UpdateAt, remote.DeleteAt, remote.IsInviteAccepted, remote.IsInviteConfirmed,
-			remote.RemoteId, remote.LastPostCreateAt, remote.LastPostCreateID, remote.LastPostUpdateAt, remote.LastPostUpdateID).
+			remote.RemoteId, remote.LastPostCreateAt, remote.LastPostCreateID, remote.LastPostUpdateAt, remote.LastPostUpdateID, remote.LastBookmarkUpdateAt,
+			remote.LastMembersSyncAt).
 		ToSql()
 	if err != nil {
 		return nil, errors.Wrapf(err, "savesharedchannelremote_tosql")
@@ -375,6 +377,8 @@ func (s SqlSharedChannelStore) UpdateRemote(remote *model.SharedChannelRemote) (
 		Set("LastPostCreateId", remote.LastPostCreateID).
 		Set("LastPostUpdateAt", remote.LastPostUpdateAt).
 		Set("LastPostId", remote.LastPostUpdateID).
+		Set("LastBookmarkUpdateAt", remote.LastBookmarkUpdateAt).
+		Set("LastMembersSyncAt", remote.LastMembersSyncAt).
 		Where(sq.And{
 			sq.Eq{"Id": remote.Id},
 			sq.Eq{"ChannelId": remote.ChannelId},
@@ -417,6 +421,8 @@ func sharedChannelRemoteFields(prefix string) []string {
 		"COALESCE(" + prefix + "LastPostCreateID,'') AS LastPostCreateID",
 		prefix + "LastPostUpdateAt",
 		"COALESCE(" + prefix + "LastPostId,'') AS LastPostUpdateID",
+		"COALESCE(" + prefix + "LastBookmarkUpdateAt,0) AS LastBookmarkUpdateAt",
+		"COALESCE(" + prefix + "LastMembersSyncAt,0) AS LastMembersSyncAt",
 	}
 }
 
@@ -626,6 +632,50 @@ func (s SqlSharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPos
 	return nil
 }
 
+// UpdateRemoteBookmarkCursor updates the bookmark cursor for the specified SharedChannelRemote.
+func (s SqlSharedChannelStore) UpdateRemoteBookmarkCursor(id string, lastBookmarkUpdateAt int64) error {
+	query := s.getQueryBuilder().
+		Update("SharedChannelRemotes").
+		Set("LastBookmarkUpdateAt", lastBookmarkUpdateAt).
+		Where(sq.Eq{"Id": id})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrap(err, "failed to update bookmark cursor for SharedChannelRemote")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to determine rows affected")
+	}
+	if count == 0 {
+		return fmt.Errorf("id not found: %s", id)
+	}
+	return nil
+}
+
+// UpdateRemoteMembershipCursor updates the time of the last channel membership change sent to the remote.
+func (s SqlSharedChannelStore) UpdateRemoteMembershipCursor(id string, lastMembersSyncAt int64) error {
+	query := s.getQueryBuilder().
+		Update("SharedChannelRemotes").
+		Set("LastMembersSyncAt", lastMembersSyncAt).
+		Where(sq.Eq{"Id": id})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrap(err, "failed to update membership cursor for SharedChannelRemote")
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to determine rows affected")
+	}
+	if count == 0 {
+		return fmt.Errorf("id not found: %s", id)
+	}
+	return nil
+}
+
 // DeleteRemote deletes a single shared channel remote.
 // Returns true if remote found and deleted, false if not found.
 func (s SqlSharedChannelStore) DeleteRemote(id string) (bool, error) {
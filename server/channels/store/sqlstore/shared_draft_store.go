@@ -0,0 +1,301 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlSharedDraftStore struct {
+	*SqlStore
+
+	sharedDraftQuery sq.SelectBuilder
+}
+
+func newSqlSharedDraftStore(sqlStore *SqlStore) store.SharedDraftStore {
+	s := &SqlSharedDraftStore{SqlStore: sqlStore}
+
+	s.sharedDraftQuery = s.getQueryBuilder().
+		Select(
+			"SharedDrafts.Id",
+			"SharedDrafts.CreatorId",
+			"SharedDrafts.ChannelId",
+			"SharedDrafts.RootId",
+			"SharedDrafts.CreateAt",
+			"SharedDrafts.UpdateAt",
+			"SharedDrafts.LastEditedBy",
+			"SharedDrafts.Message",
+			"SharedDrafts.Props",
+		).
+		From("SharedDrafts")
+
+	return s
+}
+
+// Save creates the shared draft along with its members.
+func (s *SqlSharedDraftStore) Save(draft *model.SharedDraft) (*model.SharedDraft, error) {
+	draft.PreSave()
+	if err := draft.IsValid(model.PostMessageMaxRunesV2); err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return nil, errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	query := s.getQueryBuilder().
+		Insert("SharedDrafts").
+		Columns("Id", "CreatorId", "ChannelId", "RootId", "CreateAt", "UpdateAt", "LastEditedBy", "Message", "Props").
+		Values(draft.Id, draft.CreatorId, draft.ChannelId, draft.RootId, draft.CreateAt, draft.UpdateAt, draft.LastEditedBy, draft.Message, model.StringInterfaceToJSON(draft.Props))
+
+	if _, err = transaction.ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save shared draft with id=%s", draft.Id)
+	}
+
+	if err = s.saveMembers(transaction, draft.Id, draft.UserIds, draft.CreateAt); err != nil {
+		return nil, err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return nil, errors.Wrap(err, "commit_transaction")
+	}
+
+	return draft, nil
+}
+
+// Update saves the content of the draft, as long as it wasn't updated since lastUpdateAt.
+func (s *SqlSharedDraftStore) Update(draft *model.SharedDraft, lastUpdateAt int64) (*model.SharedDraft, error) {
+	draft.UpdateAt = model.GetMillis()
+	if draft.UpdateAt <= lastUpdateAt {
+		// Keeps every version distinct, even when edited twice in the same millisecond.
+		draft.UpdateAt = lastUpdateAt + 1
+	}
+	if err := draft.IsValid(model.PostMessageMaxRunesV2); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Update("SharedDrafts").
+		Set("UpdateAt", draft.UpdateAt).
+		Set("LastEditedBy", draft.LastEditedBy).
+		Set("Message", draft.Message).
+		Set("Props", model.StringInterfaceToJSON(draft.Props)).
+		Where(sq.Eq{"Id": draft.Id, "UpdateAt": lastUpdateAt})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update shared draft with id=%s", draft.Id)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get affected rows")
+	}
+	if rows == 0 {
+		if _, err := s.Get(draft.Id); err != nil {
+			return nil, err
+		}
+		return nil, store.NewErrConflict("SharedDraft", nil, "id="+draft.Id)
+	}
+
+	return draft, nil
+}
+
+func (s *SqlSharedDraftStore) Get(id string) (*model.SharedDraft, error) {
+	query := s.sharedDraftQuery.Where(sq.Eq{"SharedDrafts.Id": id})
+
+	var draft model.SharedDraft
+	if err := s.GetMasterX().GetBuilder(&draft, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("SharedDraft", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get shared draft with id=%s", id)
+	}
+
+	if err := s.populateMembers([]*model.SharedDraft{&draft}); err != nil {
+		return nil, err
+	}
+
+	return &draft, nil
+}
+
+// GetForUser returns the shared drafts the user is a member of, most recently updated first.
+func (s *SqlSharedDraftStore) GetForUser(userID string) ([]*model.SharedDraft, error) {
+	query := s.sharedDraftQuery.
+		Join("SharedDraftMembers ON SharedDraftMembers.SharedDraftId = SharedDrafts.Id").
+		Where(sq.Eq{"SharedDraftMembers.UserId": userID}).
+		OrderBy("SharedDrafts.UpdateAt DESC", "SharedDrafts.Id")
+
+	drafts := []*model.SharedDraft{}
+	if err := s.GetReplicaX().SelectBuilder(&drafts, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get shared drafts for user=%s", userID)
+	}
+
+	if err := s.populateMembers(drafts); err != nil {
+		return nil, err
+	}
+
+	return drafts, nil
+}
+
+func (s *SqlSharedDraftStore) Delete(id string) error {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if _, err = transaction.ExecBuilder(s.getQueryBuilder().Delete("SharedDraftMembers").Where(sq.Eq{"SharedDraftId": id})); err != nil {
+		return errors.Wrapf(err, "failed to delete members of shared draft with id=%s", id)
+	}
+
+	if _, err = transaction.ExecBuilder(s.getQueryBuilder().Delete("SharedDrafts").Where(sq.Eq{"Id": id})); err != nil {
+		return errors.Wrapf(err, "failed to delete shared draft with id=%s", id)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+// DeleteIfUnchanged deletes the draft along with its members, as long as it wasn't updated
+// since lastUpdateAt.
+func (s *SqlSharedDraftStore) DeleteIfUnchanged(id string, lastUpdateAt int64) (err error) {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	result, err := transaction.ExecBuilder(s.getQueryBuilder().Delete("SharedDrafts").Where(sq.Eq{"Id": id, "UpdateAt": lastUpdateAt}))
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete shared draft with id=%s", id)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if rows == 0 {
+		var count int64
+		if err = transaction.GetBuilder(&count, s.getQueryBuilder().Select("COUNT(*)").From("SharedDrafts").Where(sq.Eq{"Id": id})); err != nil {
+			return errors.Wrapf(err, "failed to get shared draft with id=%s", id)
+		}
+		if count == 0 {
+			return store.NewErrNotFound("SharedDraft", id)
+		}
+		return store.NewErrConflict("SharedDraft", nil, "id="+id)
+	}
+
+	if _, err = transaction.ExecBuilder(s.getQueryBuilder().Delete("SharedDraftMembers").Where(sq.Eq{"SharedDraftId": id})); err != nil {
+		return errors.Wrapf(err, "failed to delete members of shared draft with id=%s", id)
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+// SaveMembers adds the users to the members of the draft, ignoring the existing members.
+func (s *SqlSharedDraftStore) SaveMembers(id string, userIDs []string) error {
+	transaction, err := s.GetMasterX().Beginx()
+	if err != nil {
+		return errors.Wrap(err, "begin_transaction")
+	}
+	defer finalizeTransactionX(transaction, &err)
+
+	if err = s.saveMembers(transaction, id, userIDs, model.GetMillis()); err != nil {
+		return err
+	}
+
+	if err = transaction.Commit(); err != nil {
+		return errors.Wrap(err, "commit_transaction")
+	}
+
+	return nil
+}
+
+func (s *SqlSharedDraftStore) DeleteMember(id, userID string) error {
+	query := s.getQueryBuilder().
+		Delete("SharedDraftMembers").
+		Where(sq.Eq{"SharedDraftId": id, "UserId": userID})
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to delete member of shared draft with id=%s", id)
+	}
+
+	return nil
+}
+
+func (s *SqlSharedDraftStore) saveMembers(transaction *sqlxTxWrapper, id string, userIDs []string, createAt int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	query := s.getQueryBuilder().
+		Insert("SharedDraftMembers").
+		Columns("SharedDraftId", "UserId", "CreateAt")
+	for _, userID := range userIDs {
+		query = query.Values(id, userID, createAt)
+	}
+
+	if s.DriverName() == model.DatabaseDriverMysql {
+		query = query.SuffixExpr(sq.Expr("ON DUPLICATE KEY UPDATE UserId=UserId"))
+	} else {
+		query = query.SuffixExpr(sq.Expr("ON CONFLICT (SharedDraftId, UserId) DO NOTHING"))
+	}
+
+	if _, err := transaction.ExecBuilder(query); err != nil {
+		return errors.Wrapf(err, "failed to save members of shared draft with id=%s", id)
+	}
+
+	return nil
+}
+
+func (s *SqlSharedDraftStore) populateMembers(drafts []*model.SharedDraft) error {
+	if len(drafts) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(drafts))
+	draftsByID := make(map[string]*model.SharedDraft, len(drafts))
+	for _, draft := range drafts {
+		draft.UserIds = []string{}
+		ids = append(ids, draft.Id)
+		draftsByID[draft.Id] = draft
+	}
+
+	query := s.getQueryBuilder().
+		Select("SharedDraftId", "UserId").
+		From("SharedDraftMembers").
+		Where(sq.Eq{"SharedDraftId": ids}).
+		OrderBy("CreateAt", "UserId")
+
+	members := []struct {
+		SharedDraftId string
+		UserId        string
+	}{}
+	if err := s.GetMasterX().SelectBuilder(&members, query); err != nil {
+		return errors.Wrap(err, "failed to get shared draft members")
+	}
+
+	for _, member := range members {
+		draft := draftsByID[member.SharedDraftId]
+		draft.UserIds = append(draft.UserIds, member.UserId)
+	}
+
+	return nil
+}
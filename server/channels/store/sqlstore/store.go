@@ -110,6 +110,9 @@ type SqlStoreStores struct {
 	postPriority               store.PostPriorityStore
 	postAcknowledgement        store.PostAcknowledgementStore
 	postPersistentNotification store.PostPersistentNotificationStore
+	postEscalation             store.PostEscalationStore
+	sharedDraft                store.SharedDraftStore
+	webAuthnCredential         store.WebAuthnCredentialStore
 	desktopTokens              store.DesktopTokensStore
 	channelBookmarks           store.ChannelBookmarkStore
 	scheduledPost              store.ScheduledPostStore
@@ -236,6 +239,9 @@ func New(settings model.SqlSettings, logger mlog.LoggerIFace, metrics einterface
 	store.stores.postPriority = newSqlPostPriorityStore(store)
 	store.stores.postAcknowledgement = newSqlPostAcknowledgementStore(store)
 	store.stores.postPersistentNotification = newSqlPostPersistentNotificationStore(store)
+	store.stores.postEscalation = newSqlPostEscalationStore(store)
+	store.stores.sharedDraft = newSqlSharedDraftStore(store)
+	store.stores.webAuthnCredential = newSqlWebAuthnCredentialStore(store)
 	store.stores.desktopTokens = newSqlDesktopTokensStore(store, metrics)
 	store.stores.channelBookmarks = newSqlChannelBookmarkStore(store)
 	store.stores.scheduledPost = newScheduledPostStore(store)
@@ -1034,6 +1040,18 @@ func (ss *SqlStore) PostPersistentNotification() store.PostPersistentNotificatio
 	return ss.stores.postPersistentNotification
 }
 
+func (ss *SqlStore) PostEscalation() store.PostEscalationStore {
+	return ss.stores.postEscalation
+}
+
+func (ss *SqlStore) SharedDraft() store.SharedDraftStore {
+	return ss.stores.sharedDraft
+}
+
+func (ss *SqlStore) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return ss.stores.webAuthnCredential
+}
+
 func (ss *SqlStore) DesktopTokens() store.DesktopTokensStore {
 	return ss.stores.desktopTokens
 }
@@ -312,6 +312,23 @@ func (us SqlUserStore) UpdatePassword(userId, hashedPassword string) error {
 	return nil
 }
 
+func (us SqlUserStore) UpdatePasswordHash(userId, oldHash, newHash string) error {
+	result, err := us.GetMasterX().Exec("UPDATE Users SET Password = ? WHERE Id = ? AND Password = ?", newHash, userId, oldHash)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update User with userId=%s", userId)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected")
+	}
+	if rows == 0 {
+		return store.NewErrConflict("User", errors.New("password changed"), "id="+userId)
+	}
+
+	return nil
+}
+
 func (us SqlUserStore) UpdateFailedPasswordAttempts(userId string, attempts int) error {
 	if _, err := us.GetMasterX().Exec("UPDATE Users SET FailedAttempts = ? WHERE Id = ?", attempts, userId); err != nil {
 		return errors.Wrapf(err, "failed to update User with userId=%s", userId)
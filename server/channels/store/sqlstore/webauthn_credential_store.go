@@ -0,0 +1,143 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sqlstore
+
+import (
+	"database/sql"
+
+	sq "github.com/mattermost/squirrel"
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+type SqlWebAuthnCredentialStore struct {
+	*SqlStore
+
+	credentialQuery sq.SelectBuilder
+}
+
+func newSqlWebAuthnCredentialStore(sqlStore *SqlStore) store.WebAuthnCredentialStore {
+	s := &SqlWebAuthnCredentialStore{SqlStore: sqlStore}
+
+	s.credentialQuery = s.getQueryBuilder().
+		Select(
+			"Id",
+			"UserId",
+			"CredentialId",
+			"PublicKey",
+			"SignCount",
+			"Name",
+			"CreateAt",
+			"LastUsedAt",
+		).
+		From("WebAuthnCredentials")
+
+	return s
+}
+
+func (s *SqlWebAuthnCredentialStore) Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error) {
+	credential.PreSave()
+	if err := credential.IsValid(); err != nil {
+		return nil, err
+	}
+
+	query := s.getQueryBuilder().
+		Insert("WebAuthnCredentials").
+		Columns("Id", "UserId", "CredentialId", "PublicKey", "SignCount", "Name", "CreateAt", "LastUsedAt").
+		Values(credential.Id, credential.UserId, credential.CredentialId, credential.PublicKey, credential.SignCount, credential.Name, credential.CreateAt, credential.LastUsedAt)
+
+	if _, err := s.GetMasterX().ExecBuilder(query); err != nil {
+		return nil, errors.Wrapf(err, "failed to save webauthn credential with id=%s", credential.Id)
+	}
+
+	return credential, nil
+}
+
+func (s *SqlWebAuthnCredentialStore) Get(id string) (*model.WebAuthnCredential, error) {
+	query := s.credentialQuery.Where(sq.Eq{"Id": id})
+
+	var credential model.WebAuthnCredential
+	if err := s.GetMasterX().GetBuilder(&credential, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, store.NewErrNotFound("WebAuthnCredential", id)
+		}
+		return nil, errors.Wrapf(err, "failed to get webauthn credential with id=%s", id)
+	}
+
+	return &credential, nil
+}
+
+// GetForUser returns the credentials of the user, oldest first.
+func (s *SqlWebAuthnCredentialStore) GetForUser(userID string) ([]*model.WebAuthnCredential, error) {
+	query := s.credentialQuery.
+		Where(sq.Eq{"UserId": userID}).
+		OrderBy("CreateAt", "Id")
+
+	credentials := []*model.WebAuthnCredential{}
+	// Read from the master so that a credential can be used right after its registration.
+	if err := s.GetMasterX().SelectBuilder(&credentials, query); err != nil {
+		return nil, errors.Wrapf(err, "failed to get webauthn credentials for user=%s", userID)
+	}
+
+	return credentials, nil
+}
+
+// UpdateSignCount records a use of the credential, as long as its signature counter moved forward
+// or the authenticator doesn't implement one. Otherwise, the same assertion was used twice, or
+// the authenticator was cloned.
+func (s *SqlWebAuthnCredentialStore) UpdateSignCount(id string, signCount, lastUsedAt int64) error {
+	query := s.getQueryBuilder().
+		Update("WebAuthnCredentials").
+		Set("SignCount", signCount).
+		Set("LastUsedAt", lastUsedAt).
+		Where(sq.And{
+			sq.Eq{"Id": id},
+			sq.Or{sq.Lt{"SignCount": signCount}, sq.Eq{"SignCount": 0}},
+		})
+
+	result, err := s.GetMasterX().ExecBuilder(query)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update webauthn credential with id=%s", id)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if rows == 0 {
+		if _, err := s.Get(id); err != nil {
+			return err
+		}
+		return store.NewErrConflict("WebAuthnCredential", nil, "id="+id)
+	}
+
+	return nil
+}
+
+func (s *SqlWebAuthnCredentialStore) Delete(id string) error {
+	result, err := s.GetMasterX().ExecBuilder(s.getQueryBuilder().Delete("WebAuthnCredentials").Where(sq.Eq{"Id": id}))
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete webauthn credential with id=%s", id)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get affected rows")
+	}
+	if rows == 0 {
+		return store.NewErrNotFound("WebAuthnCredential", id)
+	}
+
+	return nil
+}
+
+func (s *SqlWebAuthnCredentialStore) PermanentDeleteByUser(userID string) error {
+	if _, err := s.GetMasterX().ExecBuilder(s.getQueryBuilder().Delete("WebAuthnCredentials").Where(sq.Eq{"UserId": userID})); err != nil {
+		return errors.Wrapf(err, "failed to delete webauthn credentials for user=%s", userID)
+	}
+
+	return nil
+}
@@ -37,15 +37,27 @@ func (s SqlWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.In
 		return nil, store.NewErrInvalidInput("IncomingWebhook", "id", webhook.Id)
 	}
 
+	return s.insertIncoming(webhook)
+}
+
+// ImportIncoming saves an incoming webhook keeping its id when set, since the id is the secret
+// part of the webhook URL that existing integrations are configured with.
+func (s SqlWebhookStore) ImportIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
+	return s.insertIncoming(webhook)
+}
+
+func (s SqlWebhookStore) insertIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	webhook.PreSave()
 	if err := webhook.IsValid(); err != nil {
 		return nil, err
 	}
 
 	if _, err := s.GetMasterX().NamedExec(`INSERT INTO IncomingWebhooks
-		(Id, CreateAt, UpdateAt, DeleteAt, UserId, ChannelId, TeamId, DisplayName, Description, Username, IconURL, ChannelLocked)
+		(Id, CreateAt, UpdateAt, DeleteAt, UserId, ChannelId, TeamId, DisplayName, Description, Username, IconURL, ChannelLocked,
+		RateLimitPerMinute, RateLimitBurst, PayloadTemplate)
 		VALUES
-		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :UserId, :ChannelId, :TeamId, :DisplayName, :Description, :Username, :IconURL, :ChannelLocked)`, webhook); err != nil {
+		(:Id, :CreateAt, :UpdateAt, :DeleteAt, :UserId, :ChannelId, :TeamId, :DisplayName, :Description, :Username, :IconURL, :ChannelLocked,
+		:RateLimitPerMinute, :RateLimitBurst, :PayloadTemplate)`, webhook); err != nil {
 		return nil, errors.Wrapf(err, "failed to save IncomingWebhook with id=%s", webhook.Id)
 	}
 
@@ -57,7 +69,8 @@ func (s SqlWebhookStore) UpdateIncoming(hook *model.IncomingWebhook) (*model.Inc
 
 	_, err := s.GetMasterX().NamedExec(`UPDATE IncomingWebhooks SET
 			CreateAt=:CreateAt, UpdateAt=:UpdateAt, DeleteAt=:DeleteAt, ChannelId=:ChannelId, TeamId=:TeamId, DisplayName=:DisplayName,
-			Description=:Description, Username=:Username, IconURL=:IconURL, ChannelLocked=:ChannelLocked
+			Description=:Description, Username=:Username, IconURL=:IconURL, ChannelLocked=:ChannelLocked,
+			RateLimitPerMinute=:RateLimitPerMinute, RateLimitBurst=:RateLimitBurst, PayloadTemplate=:PayloadTemplate
 			WHERE Id=:Id`, hook)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to update IncomingWebhook with id=%s", hook.Id)
@@ -186,10 +199,10 @@ func (s SqlWebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*model.Ou
 
 	if _, err := s.GetMasterX().NamedExec(`INSERT INTO OutgoingWebhooks
 			(Id, Token, CreateAt, UpdateAt, DeleteAt, CreatorId, ChannelId, TeamId, TriggerWords, TriggerWhen,
-			CallbackURLs, DisplayName, Description, ContentType, Username, IconURL)
+			CallbackURLs, DisplayName, Description, ContentType, Username, IconURL, Secret)
 			VALUES
 			(:Id, :Token, :CreateAt, :UpdateAt, :DeleteAt, :CreatorId, :ChannelId, :TeamId, :TriggerWords, :TriggerWhen,
-			:CallbackURLs, :DisplayName, :Description, :ContentType, :Username, :IconURL)`, webhook); err != nil {
+			:CallbackURLs, :DisplayName, :Description, :ContentType, :Username, :IconURL, :Secret)`, webhook); err != nil {
 		return nil, errors.Wrapf(err, "failed to save OutgoingWebhook with id=%s", webhook.Id)
 	}
 
@@ -344,7 +357,7 @@ func (s SqlWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.Out
 			CreateAt = :CreateAt, UpdateAt = :UpdateAt, DeleteAt = :DeleteAt, Token = :Token, CreatorId = :CreatorId,
 			ChannelId = :ChannelId, TeamId = :TeamId, TriggerWords = :TriggerWords, TriggerWhen = :TriggerWhen,
 			CallbackURLs = :CallbackURLs, DisplayName = :DisplayName, Description = :Description,
-			ContentType = :ContentType, Username = :Username, IconURL = :IconURL WHERE Id = :Id`, hook)
+			ContentType = :ContentType, Username = :Username, IconURL = :IconURL, Secret = :Secret WHERE Id = :Id`, hook)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to update OutgoingWebhook with id=%s", hook.Id)
 	}
@@ -352,6 +365,106 @@ func (s SqlWebhookStore) UpdateOutgoing(hook *model.OutgoingWebhook) (*model.Out
 	return hook, nil
 }
 
+func (s SqlWebhookStore) SaveOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error) {
+	if delivery.Id != "" {
+		return nil, store.NewErrInvalidInput("OutgoingWebhookDelivery", "id", delivery.Id)
+	}
+
+	delivery.PreSave()
+	if err := delivery.IsValid(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetMasterX().NamedExec(`INSERT INTO OutgoingWebhookDeliveries
+			(Id, DeliveryId, HookId, PostId, CallbackURL, ContentType, Payload, Attempt, Status, StatusCode, Latency,
+			Response, Error, NextRetryAt, CreateAt, SignedBody, SignedAt)
+			VALUES
+			(:Id, :DeliveryId, :HookId, :PostId, :CallbackURL, :ContentType, :Payload, :Attempt, :Status, :StatusCode, :Latency,
+			:Response, :Error, :NextRetryAt, :CreateAt, :SignedBody, :SignedAt)`, delivery); err != nil {
+		return nil, errors.Wrapf(err, "failed to save OutgoingWebhookDelivery with id=%s", delivery.Id)
+	}
+
+	return delivery, nil
+}
+
+func (s SqlWebhookStore) GetOutgoingDeliveries(hookID string, offset, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	deliveries := []*model.OutgoingWebhookDelivery{}
+
+	query := s.getQueryBuilder().
+		Select("*").
+		From("OutgoingWebhookDeliveries").
+		Where(sq.Eq{"HookId": hookID}).
+		OrderBy("CreateAt DESC", "Id DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "outgoing_webhook_delivery_tosql")
+	}
+
+	if err := s.GetReplicaX().Select(&deliveries, queryString, args...); err != nil {
+		return nil, errors.Wrapf(err, "failed to find OutgoingWebhookDeliveries with hookId=%s", hookID)
+	}
+
+	return deliveries, nil
+}
+
+func (s SqlWebhookStore) GetOutgoingDeliveriesToRetry(before int64, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	deliveries := []*model.OutgoingWebhookDelivery{}
+
+	query := s.getQueryBuilder().
+		Select("*").
+		From("OutgoingWebhookDeliveries").
+		Where(sq.And{
+			sq.Gt{"NextRetryAt": 0},
+			sq.LtOrEq{"NextRetryAt": before},
+		}).
+		OrderBy("NextRetryAt ASC").
+		Limit(uint64(limit))
+
+	queryString, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "outgoing_webhook_delivery_tosql")
+	}
+
+	if err := s.GetMasterX().Select(&deliveries, queryString, args...); err != nil {
+		return nil, errors.Wrap(err, "failed to find OutgoingWebhookDeliveries to retry")
+	}
+
+	return deliveries, nil
+}
+
+// ClaimOutgoingDeliveryRetry clears the pending retry of a delivery, returning
+// false if the retry was already claimed by someone else.
+func (s SqlWebhookStore) ClaimOutgoingDeliveryRetry(deliveryID string) (bool, error) {
+	result, err := s.GetMasterX().Exec("UPDATE OutgoingWebhookDeliveries SET NextRetryAt = 0 WHERE Id = ? AND NextRetryAt > 0", deliveryID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to claim OutgoingWebhookDelivery retry with id=%s", deliveryID)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get rows affected")
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (s SqlWebhookStore) PermanentDeleteOutgoingDeliveriesBefore(createAt int64) (int64, error) {
+	result, err := s.GetMasterX().Exec("DELETE FROM OutgoingWebhookDeliveries WHERE CreateAt < ? AND NextRetryAt = 0", createAt)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete OutgoingWebhookDeliveries")
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get rows affected")
+	}
+
+	return rowsAffected, nil
+}
+
 func (s SqlWebhookStore) AnalyticsIncomingCount(teamID string, userID string) (int64, error) {
 	queryBuilder :=
 		s.getQueryBuilder().
@@ -89,6 +89,9 @@ type Store interface {
 	PostPriority() PostPriorityStore
 	PostAcknowledgement() PostAcknowledgementStore
 	PostPersistentNotification() PostPersistentNotificationStore
+	PostEscalation() PostEscalationStore
+	SharedDraft() SharedDraftStore
+	WebAuthnCredential() WebAuthnCredentialStore
 	DesktopTokens() DesktopTokensStore
 	ChannelBookmark() ChannelBookmarkStore
 	ScheduledPost() ScheduledPostStore
@@ -323,6 +326,7 @@ type ChannelMemberHistoryStore interface {
 	DeleteOrphanedRows(limit int) (deleted int64, err error)
 	PermanentDeleteBatch(endTime int64, limit int64) (int64, error)
 	GetChannelsLeftSince(userID string, since int64) ([]string, error)
+	GetMembershipChangesSince(channelID string, since int64, limit int) ([]*model.ChannelMemberHistoryResult, error)
 }
 type ThreadStore interface {
 	GetThreadFollowers(threadID string, fetchOnlyActive bool) ([]string, error)
@@ -406,6 +410,9 @@ type PostStore interface {
 	SetPostReminder(reminder *model.PostReminder) error
 	GetPostReminders(now int64) ([]*model.PostReminder, error)
 	GetPostReminderMetadata(postID string) (*PostReminderMetadata, error)
+	GetPostRemindersForUser(userID string) ([]*model.PostReminder, error)
+	GetPostReminder(postID, userID string) (*model.PostReminder, error)
+	DeletePostReminder(postID, userID string) error
 	// GetNthRecentPostTime returns the CreateAt time of the nth most recent post.
 	GetNthRecentPostTime(n int64) (int64, error)
 }
@@ -417,6 +424,9 @@ type UserStore interface {
 	UpdateLastPictureUpdate(userID string) error
 	ResetLastPictureUpdate(userID string) error
 	UpdatePassword(userID, newPassword string) error
+	// UpdatePasswordHash replaces the hash of the password with one of the same password, as long as
+	// it wasn't changed in the meantime. Unlike UpdatePassword, it doesn't count as a password change.
+	UpdatePasswordHash(userID, oldHash, newHash string) error
 	UpdateUpdateAt(userID string) (int64, error)
 	UpdateAuthData(userID string, service string, authData *string, email string, resetMfa bool) (string, error)
 	UpdateLastLogin(userID string, lastLogin int64) error
@@ -531,6 +541,11 @@ type AuditStore interface {
 	Save(audit *model.Audit) error
 	Get(userID string, offset int, limit int) (model.Audits, error)
 	PermanentDeleteByUser(userID string) error
+	// SaveRecord appends an audit record to the hash chained audit records.
+	SaveRecord(record *model.AuditRecord) (*model.AuditRecord, error)
+	SearchRecords(opts model.AuditRecordSearchOpts) ([]*model.AuditRecord, error)
+	// GetRecordsAfter returns up to limit audit records following the given sequence number, in order.
+	GetRecordsAfter(sequence int64, limit int) ([]*model.AuditRecord, error)
 }
 
 type ClusterDiscoveryStore interface {
@@ -551,6 +566,7 @@ type RemoteClusterStore interface {
 	GetAll(offset, limit int, filter model.RemoteClusterQueryFilter) ([]*model.RemoteCluster, error)
 	UpdateTopics(remoteClusterID string, topics string) (*model.RemoteCluster, error)
 	SetLastPingAt(remoteClusterID string) error
+	SetCapabilities(remoteClusterID string, capabilities model.Bitmask) error
 }
 
 type ComplianceStore interface {
@@ -564,6 +580,7 @@ type ComplianceStore interface {
 
 type OAuthStore interface {
 	SaveApp(app *model.OAuthApp) (*model.OAuthApp, error)
+	ImportApp(app *model.OAuthApp) (*model.OAuthApp, error)
 	UpdateApp(app *model.OAuthApp) (*model.OAuthApp, error)
 	GetApp(id string) (*model.OAuthApp, error)
 	GetAppByUser(userID string, offset, limit int) ([]*model.OAuthApp, error)
@@ -606,6 +623,7 @@ type SystemStore interface {
 
 type WebhookStore interface {
 	SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error)
+	ImportIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error)
 	GetIncoming(id string, allowFromCache bool) (*model.IncomingWebhook, error)
 	GetIncomingList(offset, limit int) ([]*model.IncomingWebhook, error)
 	GetIncomingListByUser(userID string, offset, limit int) ([]*model.IncomingWebhook, error)
@@ -630,6 +648,12 @@ type WebhookStore interface {
 	PermanentDeleteOutgoingByUser(userID string) error
 	UpdateOutgoing(hook *model.OutgoingWebhook) (*model.OutgoingWebhook, error)
 
+	SaveOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error)
+	GetOutgoingDeliveries(hookID string, offset, limit int) ([]*model.OutgoingWebhookDelivery, error)
+	GetOutgoingDeliveriesToRetry(before int64, limit int) ([]*model.OutgoingWebhookDelivery, error)
+	ClaimOutgoingDeliveryRetry(deliveryID string) (bool, error)
+	PermanentDeleteOutgoingDeliveriesBefore(createAt int64) (int64, error)
+
 	AnalyticsIncomingCount(teamID string, userID string) (int64, error)
 	AnalyticsOutgoingCount(teamID string) (int64, error)
 	InvalidateWebhookCache(webhook string)
@@ -731,6 +755,18 @@ type FileInfoStore interface {
 	PermanentDeleteBatch(ctx request.CTX, endTime int64, limit int64) (int64, error)
 	PermanentDeleteByUser(ctx request.CTX, userID string) (int64, error)
 	SetContent(ctx request.CTX, fileID, content string) error
+	// SetContentHash points the FileInfos stored at path without a content hash at the blob
+	// stored at blobPath, adding a reference to that blob for each of them.
+	SetContentHash(ctx request.CTX, path, contentHash, blobPath string) (int64, error)
+	GetWithoutContentHash(afterID string, limit int) ([]*model.FileInfo, error)
+	// GetOrphanedBlobs returns the blobs no longer referenced by any FileInfo since before updatedBefore.
+	GetOrphanedBlobs(updatedBefore int64, limit int) ([]*model.FileBlob, error)
+	// TouchBlob marks the blob with the given hash as used now, keeping it from being deleted as
+	// orphaned for a while, and returns whether the blob is known.
+	TouchBlob(contentHash string) (bool, error)
+	// PermanentDeleteBlob deletes the blob with the given hash if it is still unreferenced and
+	// wasn't touched since updatedBefore, returning whether it was deleted.
+	PermanentDeleteBlob(contentHash string, updatedBefore int64) (bool, error)
 	Search(ctx request.CTX, paramsList []*model.SearchParams, userID, teamID string, page, perPage int) (*model.FileInfoList, error)
 	CountAll() (int64, error)
 	GetFilesBatchForIndexing(startTime int64, startFileID string, includeDeleted bool, limit int) ([]*model.FileForIndexing, error)
@@ -998,6 +1034,8 @@ type SharedChannelStore interface {
 	GetRemoteByIds(channelID string, remoteID string) (*model.SharedChannelRemote, error)
 	GetRemotes(offset, limit int, opts model.SharedChannelRemoteFilterOpts) ([]*model.SharedChannelRemote, error)
 	UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error
+	UpdateRemoteBookmarkCursor(id string, lastBookmarkUpdateAt int64) error
+	UpdateRemoteMembershipCursor(id string, lastMembersSyncAt int64) error
 	DeleteRemote(remoteID string) (bool, error)
 	GetRemotesStatus(channelID string) ([]*model.SharedChannelRemoteStatus, error)
 
@@ -1029,12 +1067,47 @@ type DraftStore interface {
 	DeleteOrphanDraftsByCreateAtAndUserId(createAt int64, userID string) error
 }
 
+type SharedDraftStore interface {
+	Save(draft *model.SharedDraft) (*model.SharedDraft, error)
+	Update(draft *model.SharedDraft, lastUpdateAt int64) (*model.SharedDraft, error)
+	Get(id string) (*model.SharedDraft, error)
+	GetForUser(userID string) ([]*model.SharedDraft, error)
+	Delete(id string) error
+	// DeleteIfUnchanged deletes the draft, returning an ErrConflict when it was updated since lastUpdateAt.
+	DeleteIfUnchanged(id string, lastUpdateAt int64) error
+	SaveMembers(id string, userIDs []string) error
+	DeleteMember(id, userID string) error
+}
+
+type WebAuthnCredentialStore interface {
+	Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error)
+	Get(id string) (*model.WebAuthnCredential, error)
+	GetForUser(userID string) ([]*model.WebAuthnCredential, error)
+	UpdateSignCount(id string, signCount, lastUsedAt int64) error
+	Delete(id string) error
+	PermanentDeleteByUser(userID string) error
+}
+
 type PostAcknowledgementStore interface {
 	Get(postID, userID string) (*model.PostAcknowledgement, error)
 	GetForPost(postID string) ([]*model.PostAcknowledgement, error)
 	GetForPosts(postIds []string) ([]*model.PostAcknowledgement, error)
 	Save(postID, userID string, acknowledgedAt int64) (*model.PostAcknowledgement, error)
 	Delete(acknowledgement *model.PostAcknowledgement) error
+	// GetForPostsSince returns the acknowledgements of the given posts changed since the given time,
+	// excluding the ones last changed by excludeRemoteID.
+	GetForPostsSince(postIDs []string, since int64, excludeRemoteID string, inclDeleted bool) ([]*model.PostAcknowledgement, error)
+	// SaveForSync saves or removes an acknowledgement received from a remote. It returns an ErrConflict
+	// when the acknowledgement was changed more recently than acknowledgement.UpdateAt.
+	SaveForSync(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error)
+}
+
+type PostEscalationStore interface {
+	Save(escalation *model.PostEscalation) (*model.PostEscalation, error)
+	Update(escalation *model.PostEscalation) error
+	Get(postID string) (*model.PostEscalation, error)
+	GetForPosts(postIDs []string) ([]*model.PostEscalation, error)
+	GetDue(now int64, limit int) ([]*model.PostEscalation, error)
 }
 
 type PostPersistentNotificationStore interface {
@@ -1054,6 +1127,16 @@ type ChannelBookmarkStore interface {
 	UpdateSortOrder(bookmarkID, channelID string, newIndex int64) ([]*model.ChannelBookmarkWithFileInfo, error)
 	Delete(bookmarkID string, deleteFile bool) error
 	GetBookmarksForChannelSince(channelID string, since int64) ([]*model.ChannelBookmarkWithFileInfo, error)
+	// GetLinkBookmarksToCheck returns up to limit link bookmarks last checked before the given
+	// time, least recently checked first.
+	GetLinkBookmarksToCheck(checkedBefore int64, limit int) ([]*model.ChannelBookmarkWithFileInfo, error)
+	UpdateLinkCheckedAt(bookmarkIDs []string, checkedAt int64) error
+	// UpdateLinkCheck saves the link status and preview of a checked bookmark. It returns an
+	// ErrConflict when the bookmark was changed or deleted since updateAt.
+	UpdateLinkCheck(bookmark *model.ChannelBookmark, updateAt int64) error
+	// UpsertForSync saves a bookmark received from a remote, keeping its timestamps. It returns an
+	// ErrConflict when the bookmark was changed more recently than bookmark.UpdateAt.
+	UpsertForSync(bookmark *model.ChannelBookmark) error
 }
 
 type ScheduledPostStore interface {
@@ -4,6 +4,7 @@
 package storetest
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 
 func TestAuditStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("", func(t *testing.T) { testAuditStore(t, rctx, ss) })
+	t.Run("Records", func(t *testing.T) { testAuditStoreRecords(t, rctx, ss) })
 }
 
 func testAuditStore(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -50,3 +52,87 @@ func testAuditStore(t *testing.T, rctx request.CTX, ss store.Store) {
 
 	require.NoError(t, ss.Audit().PermanentDeleteByUser(audit.UserId))
 }
+
+func testAuditStoreRecords(t *testing.T, rctx request.CTX, ss store.Store) {
+	userId := model.NewId()
+	otherUserId := model.NewId()
+	eventName := "testAuditStoreRecords_" + model.NewId()
+
+	saved := make([]*model.AuditRecord, 0, 4)
+	for i, record := range []*model.AuditRecord{
+		{UserId: userId, EventName: eventName, Status: "success", CreateAt: 1000},
+		{UserId: userId, EventName: eventName, Status: "fail", CreateAt: 2000},
+		{UserId: otherUserId, EventName: eventName, Status: "success", CreateAt: 3000},
+		{UserId: userId, EventName: eventName, Status: "success", CreateAt: 4000},
+	} {
+		record.Data = fmt.Sprintf(`{"index":%d}`, i)
+		rec, err := ss.Audit().SaveRecord(record)
+		require.NoError(t, err)
+		saved = append(saved, rec)
+	}
+
+	t.Run("save chains records", func(t *testing.T) {
+		for i := 1; i < len(saved); i++ {
+			assert.Greater(t, saved[i].Sequence, saved[i-1].Sequence)
+		}
+
+		records, err := ss.Audit().GetRecordsAfter(saved[0].Sequence-1, 100)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, len(records), len(saved))
+		assert.Equal(t, saved[0].Id, records[0].Id)
+
+		var prev *model.AuditRecord
+		if saved[0].Sequence > 1 {
+			prevRecords, err := ss.Audit().GetRecordsAfter(saved[0].Sequence-2, 1)
+			require.NoError(t, err)
+			require.Len(t, prevRecords, 1)
+			prev = prevRecords[0]
+		}
+		var result model.AuditRecordVerification
+		assert.True(t, model.VerifyAuditRecordChain(prev, records, &result), result.Reason)
+	})
+
+	t.Run("get records after respects limit", func(t *testing.T) {
+		records, err := ss.Audit().GetRecordsAfter(saved[0].Sequence, 2)
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, saved[1].Sequence, records[0].Sequence)
+		assert.Equal(t, saved[2].Sequence, records[1].Sequence)
+	})
+
+	t.Run("search by user", func(t *testing.T) {
+		records, err := ss.Audit().SearchRecords(model.AuditRecordSearchOpts{UserId: userId, PerPage: 100})
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		assert.Equal(t, saved[3].Id, records[0].Id, "most recent record first")
+		assert.Equal(t, saved[0].Id, records[2].Id)
+	})
+
+	t.Run("search by event name and status", func(t *testing.T) {
+		records, err := ss.Audit().SearchRecords(model.AuditRecordSearchOpts{EventName: eventName, Status: "fail", PerPage: 100})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, saved[1].Id, records[0].Id)
+	})
+
+	t.Run("search by time range", func(t *testing.T) {
+		records, err := ss.Audit().SearchRecords(model.AuditRecordSearchOpts{EventName: eventName, StartTime: 2000, EndTime: 3000, PerPage: 100})
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, saved[2].Id, records[0].Id)
+		assert.Equal(t, saved[1].Id, records[1].Id)
+	})
+
+	t.Run("search paging", func(t *testing.T) {
+		records, err := ss.Audit().SearchRecords(model.AuditRecordSearchOpts{EventName: eventName, Page: 1, PerPage: 3})
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, saved[0].Id, records[0].Id)
+	})
+
+	t.Run("search limit out of bounds", func(t *testing.T) {
+		_, err := ss.Audit().SearchRecords(model.AuditRecordSearchOpts{PerPage: model.AuditRecordSearchMaxLimit + 1})
+		var oobErr *store.ErrOutOfBounds
+		require.ErrorAs(t, err, &oobErr)
+	})
+}
@@ -30,6 +30,8 @@ func TestChannelBookmarkStore(t *testing.T, rctx request.CTX, ss store.Store, s
 	t.Run("UpdateSortOrderChannelBookmark", func(t *testing.T) { testUpdateSortOrderChannelBookmark(t, rctx, ss) })
 	t.Run("DeleteChannelBookmark", func(t *testing.T) { testDeleteChannelBookmark(t, rctx, ss) })
 	t.Run("GetChannelBookmark", func(t *testing.T) { testGetChannelBookmark(t, rctx, ss) })
+	t.Run("ChannelBookmarkLinkChecks", func(t *testing.T) { testChannelBookmarkLinkChecks(t, rctx, ss) })
+	t.Run("UpsertForSyncChannelBookmark", func(t *testing.T) { testUpsertForSyncChannelBookmark(t, rctx, ss) })
 }
 
 func testSaveChannelBookmark(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -486,3 +488,155 @@ func testGetChannelBookmark(t *testing.T, rctx request.CTX, ss store.Store) {
 		assert.NotNil(t, bookmarkResp)
 	})
 }
+
+func testChannelBookmarkLinkChecks(t *testing.T, rctx request.CTX, ss store.Store) {
+	channelID := model.NewId()
+	userID := model.NewId()
+
+	newBookmark := func(checkedAt int64) *model.ChannelBookmark {
+		bookmark, err := ss.ChannelBookmark().Save(&model.ChannelBookmark{
+			ChannelId:     channelID,
+			OwnerId:       userID,
+			DisplayName:   "Link bookmark test",
+			LinkUrl:       "https://mattermost.com",
+			Type:          model.ChannelBookmarkLink,
+			LinkStatus:    model.ChannelBookmarkLinkStatusOk,
+			LinkCheckedAt: checkedAt,
+		}, true)
+		require.NoError(t, err)
+		return bookmark.ChannelBookmark
+	}
+
+	due := newBookmark(1)
+	notDue := newBookmark(model.GetMillis())
+	deleted := newBookmark(1)
+	require.NoError(t, ss.ChannelBookmark().Delete(deleted.Id, false))
+
+	t.Run("get link bookmarks to check", func(t *testing.T) {
+		bookmarks, err := ss.ChannelBookmark().GetLinkBookmarksToCheck(2, 1000)
+		require.NoError(t, err)
+
+		b := find_bookmark(bookmarks, due.Id)
+		require.NotNil(t, b)
+		assert.Equal(t, model.ChannelBookmarkLinkStatusOk, b.LinkStatus)
+		assert.Equal(t, int64(1), b.LinkCheckedAt)
+		assert.Nil(t, find_bookmark(bookmarks, notDue.Id))
+		assert.Nil(t, find_bookmark(bookmarks, deleted.Id))
+
+		for i := 1; i < len(bookmarks); i++ {
+			assert.LessOrEqual(t, bookmarks[i-1].LinkCheckedAt, bookmarks[i].LinkCheckedAt)
+		}
+	})
+
+	t.Run("update link checked at", func(t *testing.T) {
+		checkedAt := model.GetMillis()
+		err := ss.ChannelBookmark().UpdateLinkCheckedAt([]string{due.Id}, checkedAt)
+		require.NoError(t, err)
+
+		b, err := ss.ChannelBookmark().Get(due.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, checkedAt, b.LinkCheckedAt)
+		assert.Equal(t, due.UpdateAt, b.UpdateAt)
+
+		require.NoError(t, ss.ChannelBookmark().UpdateLinkCheckedAt(nil, checkedAt))
+	})
+
+	t.Run("update link check", func(t *testing.T) {
+		bookmark := newBookmark(1)
+		bookmark.LinkStatus = model.ChannelBookmarkLinkStatusBroken
+		bookmark.LinkCheckedAt = model.GetMillis()
+		bookmark.ImageUrl = "https://mattermost.com/logo.png"
+		updateAt := bookmark.UpdateAt
+		bookmark.UpdateAt = bookmark.LinkCheckedAt
+		require.NoError(t, ss.ChannelBookmark().UpdateLinkCheck(bookmark, updateAt))
+
+		b, err := ss.ChannelBookmark().Get(bookmark.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, model.ChannelBookmarkLinkStatusBroken, b.LinkStatus)
+		assert.Equal(t, bookmark.LinkCheckedAt, b.LinkCheckedAt)
+		assert.Equal(t, "https://mattermost.com/logo.png", b.ImageUrl)
+		assert.Equal(t, bookmark.UpdateAt, b.UpdateAt)
+
+		// The bookmark was changed since, so the outdated check isn't saved.
+		stale := b.ChannelBookmark.Clone()
+		stale.LinkStatus = model.ChannelBookmarkLinkStatusOk
+		stale.UpdateAt = model.GetMillis() + 1
+		err = ss.ChannelBookmark().UpdateLinkCheck(stale, updateAt)
+		var cErr *store.ErrConflict
+		require.ErrorAs(t, err, &cErr)
+
+		b, err = ss.ChannelBookmark().Get(bookmark.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, model.ChannelBookmarkLinkStatusBroken, b.LinkStatus)
+	})
+
+	t.Run("update link status", func(t *testing.T) {
+		bookmark := notDue.Clone()
+		bookmark.LinkStatus = model.ChannelBookmarkLinkStatusBroken
+		require.NoError(t, ss.ChannelBookmark().Update(bookmark))
+
+		b, err := ss.ChannelBookmark().Get(notDue.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, model.ChannelBookmarkLinkStatusBroken, b.LinkStatus)
+	})
+}
+
+func testUpsertForSyncChannelBookmark(t *testing.T, rctx request.CTX, ss store.Store) {
+	remoteID := model.NewId()
+	now := model.GetMillis()
+	bookmark := &model.ChannelBookmark{
+		Id:          model.NewId(),
+		CreateAt:    now - 1000,
+		UpdateAt:    now - 1000,
+		ChannelId:   model.NewId(),
+		OwnerId:     model.NewId(),
+		DisplayName: "Remote bookmark",
+		LinkUrl:     "https://mattermost.com",
+		Type:        model.ChannelBookmarkLink,
+		SortOrder:   3,
+		RemoteId:    model.NewPointer(remoteID),
+	}
+
+	t.Run("inserts a new bookmark keeping its timestamps", func(t *testing.T) {
+		require.NoError(t, ss.ChannelBookmark().UpsertForSync(bookmark))
+
+		b, err := ss.ChannelBookmark().Get(bookmark.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, bookmark.UpdateAt, b.UpdateAt)
+		assert.Equal(t, bookmark.SortOrder, b.SortOrder)
+		assert.Equal(t, remoteID, b.GetRemoteID())
+	})
+
+	t.Run("updates with a newer change", func(t *testing.T) {
+		updated := bookmark.Clone()
+		updated.DisplayName = "Renamed"
+		updated.UpdateAt = now
+		require.NoError(t, ss.ChannelBookmark().UpsertForSync(updated))
+
+		b, err := ss.ChannelBookmark().Get(bookmark.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", b.DisplayName)
+		assert.Equal(t, now, b.UpdateAt)
+	})
+
+	t.Run("ignores an older change", func(t *testing.T) {
+		stale := bookmark.Clone()
+		stale.DisplayName = "Stale"
+		stale.UpdateAt = now - 500
+		err := ss.ChannelBookmark().UpsertForSync(stale)
+		var cErr *store.ErrConflict
+		require.ErrorAs(t, err, &cErr)
+
+		b, err := ss.ChannelBookmark().Get(bookmark.Id, false)
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", b.DisplayName)
+	})
+
+	t.Run("local changes clear the remote id", func(t *testing.T) {
+		require.NoError(t, ss.ChannelBookmark().Delete(bookmark.Id, false))
+
+		b, err := ss.ChannelBookmark().Get(bookmark.Id, true)
+		require.NoError(t, err)
+		assert.Empty(t, b.GetRemoteID())
+	})
+}
@@ -24,6 +24,7 @@ func TestChannelMemberHistoryStore(t *testing.T, rctx request.CTX, ss store.Stor
 	t.Run("TestPermanentDeleteBatch", func(t *testing.T) { testPermanentDeleteBatch(t, rctx, ss) })
 	t.Run("TestPermanentDeleteBatchForRetentionPolicies", func(t *testing.T) { testPermanentDeleteBatchForRetentionPolicies(t, rctx, ss) })
 	t.Run("TestGetChannelsLeftSince", func(t *testing.T) { testGetChannelsLeftSince(t, rctx, ss) })
+	t.Run("TestGetMembershipChangesSince", func(t *testing.T) { testGetMembershipChangesSince(t, rctx, ss) })
 }
 
 func testLogJoinEvent(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -445,3 +446,48 @@ func testGetChannelsLeftSince(t *testing.T, rctx request.CTX, ss store.Store) {
 	require.NoError(t, err)
 	assert.Equal(t, []string{channel.Id}, ids)
 }
+
+func testGetMembershipChangesSince(t *testing.T, rctx request.CTX, ss store.Store) {
+	channelID := model.NewId()
+	user1 := model.NewId()
+	user2 := model.NewId()
+	user3 := model.NewId()
+
+	require.NoError(t, ss.ChannelMemberHistory().LogJoinEvent(user1, channelID, 100))
+	require.NoError(t, ss.ChannelMemberHistory().LogJoinEvent(user2, channelID, 200))
+	require.NoError(t, ss.ChannelMemberHistory().LogLeaveEvent(user1, channelID, 300))
+	require.NoError(t, ss.ChannelMemberHistory().LogJoinEvent(user3, channelID, 400))
+	// another channel
+	require.NoError(t, ss.ChannelMemberHistory().LogJoinEvent(user1, model.NewId(), 500))
+
+	t.Run("all changes, ordered by latest change", func(t *testing.T) {
+		changes, err := ss.ChannelMemberHistory().GetMembershipChangesSince(channelID, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, changes, 3)
+		assert.Equal(t, user2, changes[0].UserId)
+		assert.Nil(t, changes[0].LeaveTime)
+		assert.Equal(t, user1, changes[1].UserId)
+		require.NotNil(t, changes[1].LeaveTime)
+		assert.Equal(t, int64(300), *changes[1].LeaveTime)
+		assert.Equal(t, user3, changes[2].UserId)
+	})
+
+	t.Run("changes since", func(t *testing.T) {
+		changes, err := ss.ChannelMemberHistory().GetMembershipChangesSince(channelID, 200, 10)
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, user1, changes[0].UserId)
+		assert.Equal(t, user3, changes[1].UserId)
+
+		changes, err = ss.ChannelMemberHistory().GetMembershipChangesSince(channelID, 400, 10)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		changes, err := ss.ChannelMemberHistory().GetMembershipChangesSince(channelID, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, user2, changes[0].UserId)
+	})
+}
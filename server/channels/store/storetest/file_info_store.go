@@ -21,6 +21,7 @@ import (
 func TestFileInfoStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Cleanup(func() {
 		s.GetMasterX().Exec("TRUNCATE FileInfo")
+		s.GetMasterX().Exec("TRUNCATE FileBlobs")
 	})
 	t.Run("FileInfoSaveGet", func(t *testing.T) { testFileInfoSaveGet(t, rctx, ss) })
 	t.Run("FileInfoSaveGetByPath", func(t *testing.T) { testFileInfoSaveGetByPath(t, rctx, ss) })
@@ -38,6 +39,8 @@ func TestFileInfoStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStor
 	t.Run("GetStorageUsage", func(t *testing.T) { testFileInfoGetStorageUsage(t, rctx, ss) })
 	t.Run("GetUptoNSizeFileTime", func(t *testing.T) { testGetUptoNSizeFileTime(t, rctx, ss, s) })
 	t.Run("FileInfoPermanentDeleteForPost", func(t *testing.T) { testPermanentDeleteForPost(t, rctx, ss) })
+	t.Run("FileInfoBlobReferences", func(t *testing.T) { testFileInfoBlobReferences(t, rctx, ss) })
+	t.Run("FileInfoSetContentHash", func(t *testing.T) { testFileInfoSetContentHash(t, rctx, ss) })
 }
 
 func testFileInfoSaveGet(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -967,3 +970,125 @@ func testPermanentDeleteForPost(t *testing.T, rctx request.CTX, ss store.Store)
 	require.NoError(t, err)
 	assert.Len(t, postInfos, 0)
 }
+
+func testFileInfoBlobReferences(t *testing.T, rctx request.CTX, ss store.Store) {
+	contentHash := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	blobPath := model.FileBlobPath(contentHash)
+
+	var infos []*model.FileInfo
+	for i := 0; i < 2; i++ {
+		info, err := ss.FileInfo().Save(rctx, &model.FileInfo{
+			CreatorId:   model.NewId(),
+			PostId:      model.NewId(),
+			Path:        blobPath,
+			Size:        5,
+			ContentHash: contentHash,
+		})
+		require.NoError(t, err)
+		infos = append(infos, info)
+	}
+
+	info, err := ss.FileInfo().Get(infos[0].Id)
+	require.NoError(t, err)
+	assert.Equal(t, contentHash, info.ContentHash)
+
+	isOrphaned := func() bool {
+		blobs, err := ss.FileInfo().GetOrphanedBlobs(model.GetMillis()+1, 100)
+		require.NoError(t, err)
+		for _, blob := range blobs {
+			if blob.Hash == contentHash {
+				assert.Equal(t, blobPath, blob.Path)
+				assert.EqualValues(t, 5, blob.Size)
+				return true
+			}
+		}
+		return false
+	}
+
+	deleted, err := ss.FileInfo().PermanentDeleteBlob(contentHash, model.GetMillis()+1)
+	require.NoError(t, err)
+	require.False(t, deleted, "referenced blobs shouldn't be deleted")
+
+	require.NoError(t, ss.FileInfo().PermanentDelete(rctx, infos[0].Id))
+	require.False(t, isOrphaned())
+
+	require.NoError(t, ss.FileInfo().PermanentDeleteForPost(rctx, infos[1].PostId))
+	require.True(t, isOrphaned())
+
+	touchedBefore := model.GetMillis()
+	time.Sleep(2 * time.Millisecond)
+	touched, err := ss.FileInfo().TouchBlob(contentHash)
+	require.NoError(t, err)
+	require.True(t, touched)
+	deleted, err = ss.FileInfo().PermanentDeleteBlob(contentHash, touchedBefore)
+	require.NoError(t, err)
+	require.False(t, deleted, "touched blobs shouldn't be deleted")
+
+	deleted, err = ss.FileInfo().PermanentDeleteBlob(contentHash, model.GetMillis()+1)
+	require.NoError(t, err)
+	require.True(t, deleted)
+	require.False(t, isOrphaned())
+
+	touched, err = ss.FileInfo().TouchBlob(contentHash)
+	require.NoError(t, err)
+	require.False(t, touched, "deleted blobs shouldn't be touched")
+}
+
+func testFileInfoSetContentHash(t *testing.T, rctx request.CTX, ss store.Store) {
+	contentHash := "486ea46224d1bb4fb680f34f7c9ad96a8f24ec88be73ea8e5a6c65260e9cb8a7"
+	blobPath := model.FileBlobPath(contentHash)
+	path := "set_content_hash/" + model.NewId() + "/file.txt"
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		info, err := ss.FileInfo().Save(rctx, &model.FileInfo{
+			CreatorId: model.NewId(),
+			Path:      path,
+			Size:      5,
+		})
+		require.NoError(t, err)
+		ids = append(ids, info.Id)
+	}
+	defer func() {
+		for _, id := range ids {
+			ss.FileInfo().PermanentDelete(rctx, id)
+		}
+		ss.FileInfo().PermanentDeleteBlob(contentHash, model.GetMillis()+1)
+	}()
+
+	infos, err := ss.FileInfo().GetWithoutContentHash("", 1000)
+	require.NoError(t, err)
+	var found int
+	for _, info := range infos {
+		if info.Path == path {
+			found++
+		}
+	}
+	require.Equal(t, 2, found)
+
+	updated, err := ss.FileInfo().SetContentHash(rctx, path, contentHash, blobPath)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, updated)
+
+	for _, id := range ids {
+		info, err := ss.FileInfo().Get(id)
+		require.NoError(t, err)
+		assert.Equal(t, contentHash, info.ContentHash)
+		assert.Equal(t, blobPath, info.Path)
+	}
+
+	infos, err = ss.FileInfo().GetWithoutContentHash("", 1000)
+	require.NoError(t, err)
+	for _, info := range infos {
+		assert.NotEqual(t, path, info.Path)
+	}
+
+	updated, err = ss.FileInfo().SetContentHash(rctx, path, contentHash, blobPath)
+	require.NoError(t, err)
+	require.Zero(t, updated)
+
+	require.NoError(t, ss.FileInfo().PermanentDelete(rctx, ids[0]))
+	deleted, err := ss.FileInfo().PermanentDeleteBlob(contentHash, model.GetMillis()+1)
+	require.NoError(t, err)
+	require.False(t, deleted, "the blob is still referenced by the second FileInfo")
+}
@@ -44,6 +44,36 @@ func (_m *AuditStore) Get(userID string, offset int, limit int) (model.Audits, e
 	return r0, r1
 }
 
+// GetRecordsAfter provides a mock function with given fields: sequence, limit
+func (_m *AuditStore) GetRecordsAfter(sequence int64, limit int) ([]*model.AuditRecord, error) {
+	ret := _m.Called(sequence, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRecordsAfter")
+	}
+
+	var r0 []*model.AuditRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int) ([]*model.AuditRecord, error)); ok {
+		return rf(sequence, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.AuditRecord); ok {
+		r0 = rf(sequence, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(sequence, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PermanentDeleteByUser provides a mock function with given fields: userID
 func (_m *AuditStore) PermanentDeleteByUser(userID string) error {
 	ret := _m.Called(userID)
@@ -80,6 +110,66 @@ func (_m *AuditStore) Save(audit *model.Audit) error {
 	return r0
 }
 
+// SaveRecord provides a mock function with given fields: record
+func (_m *AuditStore) SaveRecord(record *model.AuditRecord) (*model.AuditRecord, error) {
+	ret := _m.Called(record)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveRecord")
+	}
+
+	var r0 *model.AuditRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.AuditRecord) (*model.AuditRecord, error)); ok {
+		return rf(record)
+	}
+	if rf, ok := ret.Get(0).(func(*model.AuditRecord) *model.AuditRecord); ok {
+		r0 = rf(record)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.AuditRecord) error); ok {
+		r1 = rf(record)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchRecords provides a mock function with given fields: opts
+func (_m *AuditStore) SearchRecords(opts model.AuditRecordSearchOpts) ([]*model.AuditRecord, error) {
+	ret := _m.Called(opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchRecords")
+	}
+
+	var r0 []*model.AuditRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.AuditRecordSearchOpts) ([]*model.AuditRecord, error)); ok {
+		return rf(opts)
+	}
+	if rf, ok := ret.Get(0).(func(model.AuditRecordSearchOpts) []*model.AuditRecord); ok {
+		r0 = rf(opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AuditRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.AuditRecordSearchOpts) error); ok {
+		r1 = rf(opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewAuditStore creates a new instance of AuditStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuditStore(t interface {
@@ -110,6 +110,36 @@ func (_m *ChannelBookmarkStore) GetBookmarksForChannelSince(channelID string, si
 	return r0, r1
 }
 
+// GetLinkBookmarksToCheck provides a mock function with given fields: checkedBefore, limit
+func (_m *ChannelBookmarkStore) GetLinkBookmarksToCheck(checkedBefore int64, limit int) ([]*model.ChannelBookmarkWithFileInfo, error) {
+	ret := _m.Called(checkedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinkBookmarksToCheck")
+	}
+
+	var r0 []*model.ChannelBookmarkWithFileInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int) ([]*model.ChannelBookmarkWithFileInfo, error)); ok {
+		return rf(checkedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.ChannelBookmarkWithFileInfo); ok {
+		r0 = rf(checkedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ChannelBookmarkWithFileInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(checkedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: bookmark, increaseSortOrder
 func (_m *ChannelBookmarkStore) Save(bookmark *model.ChannelBookmark, increaseSortOrder bool) (*model.ChannelBookmarkWithFileInfo, error) {
 	ret := _m.Called(bookmark, increaseSortOrder)
@@ -158,6 +188,42 @@ func (_m *ChannelBookmarkStore) Update(bookmark *model.ChannelBookmark) error {
 	return r0
 }
 
+// UpdateLinkCheck provides a mock function with given fields: bookmark, updateAt
+func (_m *ChannelBookmarkStore) UpdateLinkCheck(bookmark *model.ChannelBookmark, updateAt int64) error {
+	ret := _m.Called(bookmark, updateAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLinkCheck")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.ChannelBookmark, int64) error); ok {
+		r0 = rf(bookmark, updateAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateLinkCheckedAt provides a mock function with given fields: bookmarkIDs, checkedAt
+func (_m *ChannelBookmarkStore) UpdateLinkCheckedAt(bookmarkIDs []string, checkedAt int64) error {
+	ret := _m.Called(bookmarkIDs, checkedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLinkCheckedAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string, int64) error); ok {
+		r0 = rf(bookmarkIDs, checkedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateSortOrder provides a mock function with given fields: bookmarkID, channelID, newIndex
 func (_m *ChannelBookmarkStore) UpdateSortOrder(bookmarkID string, channelID string, newIndex int64) ([]*model.ChannelBookmarkWithFileInfo, error) {
 	ret := _m.Called(bookmarkID, channelID, newIndex)
@@ -188,6 +254,24 @@ func (_m *ChannelBookmarkStore) UpdateSortOrder(bookmarkID string, channelID str
 	return r0, r1
 }
 
+// UpsertForSync provides a mock function with given fields: bookmark
+func (_m *ChannelBookmarkStore) UpsertForSync(bookmark *model.ChannelBookmark) error {
+	ret := _m.Called(bookmark)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertForSync")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.ChannelBookmark) error); ok {
+		r0 = rf(bookmark)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewChannelBookmarkStore creates a new instance of ChannelBookmarkStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewChannelBookmarkStore(t interface {
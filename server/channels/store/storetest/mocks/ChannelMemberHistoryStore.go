@@ -72,6 +72,36 @@ func (_m *ChannelMemberHistoryStore) GetChannelsLeftSince(userID string, since i
 	return r0, r1
 }
 
+// GetMembershipChangesSince provides a mock function with given fields: channelID, since, limit
+func (_m *ChannelMemberHistoryStore) GetMembershipChangesSince(channelID string, since int64, limit int) ([]*model.ChannelMemberHistoryResult, error) {
+	ret := _m.Called(channelID, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMembershipChangesSince")
+	}
+
+	var r0 []*model.ChannelMemberHistoryResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int) ([]*model.ChannelMemberHistoryResult, error)); ok {
+		return rf(channelID, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int) []*model.ChannelMemberHistoryResult); ok {
+		r0 = rf(channelID, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ChannelMemberHistoryResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int) error); ok {
+		r1 = rf(channelID, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUsersInChannelDuring provides a mock function with given fields: startTime, endTime, channelID
 func (_m *ChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
 	ret := _m.Called(startTime, endTime, channelID)
@@ -304,6 +304,36 @@ func (_m *FileInfoStore) GetFromMaster(id string) (*model.FileInfo, error) {
 	return r0, r1
 }
 
+// GetOrphanedBlobs provides a mock function with given fields: updatedBefore, limit
+func (_m *FileInfoStore) GetOrphanedBlobs(updatedBefore int64, limit int) ([]*model.FileBlob, error) {
+	ret := _m.Called(updatedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrphanedBlobs")
+	}
+
+	var r0 []*model.FileBlob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int) ([]*model.FileBlob, error)); ok {
+		return rf(updatedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.FileBlob); ok {
+		r0 = rf(updatedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FileBlob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(updatedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStorageUsage provides a mock function with given fields: allowFromCache, includeDeleted
 func (_m *FileInfoStore) GetStorageUsage(allowFromCache bool, includeDeleted bool) (int64, error) {
 	ret := _m.Called(allowFromCache, includeDeleted)
@@ -390,6 +420,36 @@ func (_m *FileInfoStore) GetWithOptions(page int, perPage int, opt *model.GetFil
 	return r0, r1
 }
 
+// GetWithoutContentHash provides a mock function with given fields: afterID, limit
+func (_m *FileInfoStore) GetWithoutContentHash(afterID string, limit int) ([]*model.FileInfo, error) {
+	ret := _m.Called(afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithoutContentHash")
+	}
+
+	var r0 []*model.FileInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]*model.FileInfo, error)); ok {
+		return rf(afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []*model.FileInfo); ok {
+		r0 = rf(afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FileInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InvalidateFileInfosForPostCache provides a mock function with given fields: postID, deleted
 func (_m *FileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
 	_m.Called(postID, deleted)
@@ -441,6 +501,36 @@ func (_m *FileInfoStore) PermanentDeleteBatch(ctx request.CTX, endTime int64, li
 	return r0, r1
 }
 
+// PermanentDeleteBlob provides a mock function with given fields: contentHash, updatedBefore
+func (_m *FileInfoStore) PermanentDeleteBlob(contentHash string, updatedBefore int64) (bool, error) {
+	ret := _m.Called(contentHash, updatedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermanentDeleteBlob")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64) (bool, error)); ok {
+		return rf(contentHash, updatedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64) bool); ok {
+		r0 = rf(contentHash, updatedBefore)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64) error); ok {
+		r1 = rf(contentHash, updatedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PermanentDeleteByUser provides a mock function with given fields: ctx, userID
 func (_m *FileInfoStore) PermanentDeleteByUser(ctx request.CTX, userID string) (int64, error) {
 	ret := _m.Called(ctx, userID)
@@ -565,6 +655,64 @@ func (_m *FileInfoStore) SetContent(ctx request.CTX, fileID string, content stri
 	return r0
 }
 
+// SetContentHash provides a mock function with given fields: ctx, path, contentHash, blobPath
+func (_m *FileInfoStore) SetContentHash(ctx request.CTX, path string, contentHash string, blobPath string) (int64, error) {
+	ret := _m.Called(ctx, path, contentHash, blobPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetContentHash")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(request.CTX, string, string, string) (int64, error)); ok {
+		return rf(ctx, path, contentHash, blobPath)
+	}
+	if rf, ok := ret.Get(0).(func(request.CTX, string, string, string) int64); ok {
+		r0 = rf(ctx, path, contentHash, blobPath)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(request.CTX, string, string, string) error); ok {
+		r1 = rf(ctx, path, contentHash, blobPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TouchBlob provides a mock function with given fields: contentHash
+func (_m *FileInfoStore) TouchBlob(contentHash string) (bool, error) {
+	ret := _m.Called(contentHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TouchBlob")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(contentHash)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(contentHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(contentHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Upsert provides a mock function with given fields: rctx, info
 func (_m *FileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
 	ret := _m.Called(rctx, info)
@@ -302,6 +302,36 @@ func (_m *OAuthStore) GetPreviousAccessData(userID string, clientID string) (*mo
 	return r0, r1
 }
 
+// ImportApp provides a mock function with given fields: app
+func (_m *OAuthStore) ImportApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+	ret := _m.Called(app)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportApp")
+	}
+
+	var r0 *model.OAuthApp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OAuthApp) (*model.OAuthApp, error)); ok {
+		return rf(app)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OAuthApp) *model.OAuthApp); ok {
+		r0 = rf(app)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OAuthApp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OAuthApp) error); ok {
+		r1 = rf(app)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PermanentDeleteAuthDataByUser provides a mock function with given fields: userID
 func (_m *OAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
 	ret := _m.Called(userID)
@@ -122,6 +122,36 @@ func (_m *PostAcknowledgementStore) GetForPosts(postIds []string) ([]*model.Post
 	return r0, r1
 }
 
+// GetForPostsSince provides a mock function with given fields: postIDs, since, excludeRemoteID, inclDeleted
+func (_m *PostAcknowledgementStore) GetForPostsSince(postIDs []string, since int64, excludeRemoteID string, inclDeleted bool) ([]*model.PostAcknowledgement, error) {
+	ret := _m.Called(postIDs, since, excludeRemoteID, inclDeleted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetForPostsSince")
+	}
+
+	var r0 []*model.PostAcknowledgement
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, int64, string, bool) ([]*model.PostAcknowledgement, error)); ok {
+		return rf(postIDs, since, excludeRemoteID, inclDeleted)
+	}
+	if rf, ok := ret.Get(0).(func([]string, int64, string, bool) []*model.PostAcknowledgement); ok {
+		r0 = rf(postIDs, since, excludeRemoteID, inclDeleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PostAcknowledgement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, int64, string, bool) error); ok {
+		r1 = rf(postIDs, since, excludeRemoteID, inclDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Save provides a mock function with given fields: postID, userID, acknowledgedAt
 func (_m *PostAcknowledgementStore) Save(postID string, userID string, acknowledgedAt int64) (*model.PostAcknowledgement, error) {
 	ret := _m.Called(postID, userID, acknowledgedAt)
@@ -152,6 +182,36 @@ func (_m *PostAcknowledgementStore) Save(postID string, userID string, acknowled
 	return r0, r1
 }
 
+// SaveForSync provides a mock function with given fields: acknowledgement
+func (_m *PostAcknowledgementStore) SaveForSync(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
+	ret := _m.Called(acknowledgement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveForSync")
+	}
+
+	var r0 *model.PostAcknowledgement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.PostAcknowledgement) (*model.PostAcknowledgement, error)); ok {
+		return rf(acknowledgement)
+	}
+	if rf, ok := ret.Get(0).(func(*model.PostAcknowledgement) *model.PostAcknowledgement); ok {
+		r0 = rf(acknowledgement)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostAcknowledgement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.PostAcknowledgement) error); ok {
+		r1 = rf(acknowledgement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewPostAcknowledgementStore creates a new instance of PostAcknowledgementStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPostAcknowledgementStore(t interface {
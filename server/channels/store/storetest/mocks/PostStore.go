@@ -131,6 +131,24 @@ func (_m *PostStore) Delete(rctx request.CTX, postID string, timestamp int64, de
 	return r0
 }
 
+// DeletePostReminder provides a mock function with given fields: postID, userID
+func (_m *PostStore) DeletePostReminder(postID string, userID string) error {
+	ret := _m.Called(postID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePostReminder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(postID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, id, opts, userID, sanitizeOptions
 func (_m *PostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	ret := _m.Called(ctx, id, opts, userID, sanitizeOptions)
@@ -549,6 +567,36 @@ func (_m *PostStore) GetPostIdBeforeTime(channelID string, timestamp int64, coll
 	return r0, r1
 }
 
+// GetPostReminder provides a mock function with given fields: postID, userID
+func (_m *PostStore) GetPostReminder(postID string, userID string) (*model.PostReminder, error) {
+	ret := _m.Called(postID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostReminder")
+	}
+
+	var r0 *model.PostReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.PostReminder, error)); ok {
+		return rf(postID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.PostReminder); ok {
+		r0 = rf(postID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PostReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(postID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPostReminderMetadata provides a mock function with given fields: postID
 func (_m *PostStore) GetPostReminderMetadata(postID string) (*store.PostReminderMetadata, error) {
 	ret := _m.Called(postID)
@@ -609,6 +657,36 @@ func (_m *PostStore) GetPostReminders(now int64) ([]*model.PostReminder, error)
 	return r0, r1
 }
 
+// GetPostRemindersForUser provides a mock function with given fields: userID
+func (_m *PostStore) GetPostRemindersForUser(userID string) ([]*model.PostReminder, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPostRemindersForUser")
+	}
+
+	var r0 []*model.PostReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.PostReminder, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.PostReminder); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PostReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPosts provides a mock function with given fields: options, allowFromCache, sanitizeOptions
 func (_m *PostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	ret := _m.Called(options, allowFromCache, sanitizeOptions)
@@ -162,6 +162,24 @@ func (_m *RemoteClusterStore) Save(rc *model.RemoteCluster) (*model.RemoteCluste
 	return r0, r1
 }
 
+// SetCapabilities provides a mock function with given fields: remoteClusterID, capabilities
+func (_m *RemoteClusterStore) SetCapabilities(remoteClusterID string, capabilities model.Bitmask) error {
+	ret := _m.Called(remoteClusterID, capabilities)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetCapabilities")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, model.Bitmask) error); ok {
+		r0 = rf(remoteClusterID, capabilities)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetLastPingAt provides a mock function with given fields: remoteClusterID
 func (_m *RemoteClusterStore) SetLastPingAt(remoteClusterID string) error {
 	ret := _m.Called(remoteClusterID)
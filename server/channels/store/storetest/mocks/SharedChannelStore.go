@@ -682,6 +682,24 @@ func (_m *SharedChannelStore) UpdateRemote(remote *model.SharedChannelRemote) (*
 	return r0, r1
 }
 
+// UpdateRemoteBookmarkCursor provides a mock function with given fields: id, lastBookmarkUpdateAt
+func (_m *SharedChannelStore) UpdateRemoteBookmarkCursor(id string, lastBookmarkUpdateAt int64) error {
+	ret := _m.Called(id, lastBookmarkUpdateAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRemoteBookmarkCursor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, lastBookmarkUpdateAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateRemoteCursor provides a mock function with given fields: id, cursor
 func (_m *SharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error {
 	ret := _m.Called(id, cursor)
@@ -700,6 +718,24 @@ func (_m *SharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPost
 	return r0
 }
 
+// UpdateRemoteMembershipCursor provides a mock function with given fields: id, lastMembersSyncAt
+func (_m *SharedChannelStore) UpdateRemoteMembershipCursor(id string, lastMembersSyncAt int64) error {
+	ret := _m.Called(id, lastMembersSyncAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRemoteMembershipCursor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int64) error); ok {
+		r0 = rf(id, lastMembersSyncAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateUserLastSyncAt provides a mock function with given fields: userID, channelID, remoteID
 func (_m *SharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
 	ret := _m.Called(userID, channelID, remoteID)
@@ -718,6 +718,26 @@ func (_m *Store) PostAcknowledgement() store.PostAcknowledgementStore {
 	return r0
 }
 
+// PostEscalation provides a mock function with given fields:
+func (_m *Store) PostEscalation() store.PostEscalationStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PostEscalation")
+	}
+
+	var r0 store.PostEscalationStore
+	if rf, ok := ret.Get(0).(func() store.PostEscalationStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.PostEscalationStore)
+		}
+	}
+
+	return r0
+}
+
 // PostPersistentNotification provides a mock function with given fields:
 func (_m *Store) PostPersistentNotification() store.PostPersistentNotificationStore {
 	ret := _m.Called()
@@ -1004,6 +1024,26 @@ func (_m *Store) SharedChannel() store.SharedChannelStore {
 	return r0
 }
 
+// SharedDraft provides a mock function with given fields:
+func (_m *Store) SharedDraft() store.SharedDraftStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SharedDraft")
+	}
+
+	var r0 store.SharedDraftStore
+	if rf, ok := ret.Get(0).(func() store.SharedDraftStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.SharedDraftStore)
+		}
+	}
+
+	return r0
+}
+
 // Status provides a mock function with given fields:
 func (_m *Store) Status() store.StatusStore {
 	ret := _m.Called()
@@ -1263,6 +1303,26 @@ func (_m *Store) UserTermsOfService() store.UserTermsOfServiceStore {
 	return r0
 }
 
+// WebAuthnCredential provides a mock function with given fields:
+func (_m *Store) WebAuthnCredential() store.WebAuthnCredentialStore {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WebAuthnCredential")
+	}
+
+	var r0 store.WebAuthnCredentialStore
+	if rf, ok := ret.Get(0).(func() store.WebAuthnCredentialStore); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.WebAuthnCredentialStore)
+		}
+	}
+
+	return r0
+}
+
 // Webhook provides a mock function with given fields:
 func (_m *Store) Webhook() store.WebhookStore {
 	ret := _m.Called()
@@ -2116,6 +2116,24 @@ func (_m *UserStore) UpdatePassword(userID string, newPassword string) error {
 	return r0
 }
 
+// UpdatePasswordHash provides a mock function with given fields: userID, oldHash, newHash
+func (_m *UserStore) UpdatePasswordHash(userID string, oldHash string, newHash string) error {
+	ret := _m.Called(userID, oldHash, newHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePasswordHash")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(userID, oldHash, newHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateUpdateAt provides a mock function with given fields: userID
 func (_m *UserStore) UpdateUpdateAt(userID string) (int64, error) {
 	ret := _m.Called(userID)
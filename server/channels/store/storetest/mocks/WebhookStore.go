@@ -70,6 +70,34 @@ func (_m *WebhookStore) AnalyticsOutgoingCount(teamID string) (int64, error) {
 	return r0, r1
 }
 
+// ClaimOutgoingDeliveryRetry provides a mock function with given fields: deliveryID
+func (_m *WebhookStore) ClaimOutgoingDeliveryRetry(deliveryID string) (bool, error) {
+	ret := _m.Called(deliveryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimOutgoingDeliveryRetry")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(deliveryID)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(deliveryID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(deliveryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ClearCaches provides a mock function with given fields:
 func (_m *WebhookStore) ClearCaches() {
 	_m.Called()
@@ -441,6 +469,66 @@ func (_m *WebhookStore) GetOutgoingByTeamByUser(teamID string, userID string, of
 	return r0, r1
 }
 
+// GetOutgoingDeliveries provides a mock function with given fields: hookID, offset, limit
+func (_m *WebhookStore) GetOutgoingDeliveries(hookID string, offset int, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	ret := _m.Called(hookID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutgoingDeliveries")
+	}
+
+	var r0 []*model.OutgoingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.OutgoingWebhookDelivery, error)); ok {
+		return rf(hookID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.OutgoingWebhookDelivery); ok {
+		r0 = rf(hookID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OutgoingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(hookID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutgoingDeliveriesToRetry provides a mock function with given fields: before, limit
+func (_m *WebhookStore) GetOutgoingDeliveriesToRetry(before int64, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	ret := _m.Called(before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOutgoingDeliveriesToRetry")
+	}
+
+	var r0 []*model.OutgoingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64, int) ([]*model.OutgoingWebhookDelivery, error)); ok {
+		return rf(before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int64, int) []*model.OutgoingWebhookDelivery); ok {
+		r0 = rf(before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OutgoingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int64, int) error); ok {
+		r1 = rf(before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOutgoingList provides a mock function with given fields: offset, limit
 func (_m *WebhookStore) GetOutgoingList(offset int, limit int) ([]*model.OutgoingWebhook, error) {
 	ret := _m.Called(offset, limit)
@@ -501,6 +589,36 @@ func (_m *WebhookStore) GetOutgoingListByUser(userID string, offset int, limit i
 	return r0, r1
 }
 
+// ImportIncoming provides a mock function with given fields: webhook
+func (_m *WebhookStore) ImportIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
+	ret := _m.Called(webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportIncoming")
+	}
+
+	var r0 *model.IncomingWebhook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.IncomingWebhook) (*model.IncomingWebhook, error)); ok {
+		return rf(webhook)
+	}
+	if rf, ok := ret.Get(0).(func(*model.IncomingWebhook) *model.IncomingWebhook); ok {
+		r0 = rf(webhook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IncomingWebhook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.IncomingWebhook) error); ok {
+		r1 = rf(webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InvalidateWebhookCache provides a mock function with given fields: webhook
 func (_m *WebhookStore) InvalidateWebhookCache(webhook string) {
 	_m.Called(webhook)
@@ -578,6 +696,34 @@ func (_m *WebhookStore) PermanentDeleteOutgoingByUser(userID string) error {
 	return r0
 }
 
+// PermanentDeleteOutgoingDeliveriesBefore provides a mock function with given fields: createAt
+func (_m *WebhookStore) PermanentDeleteOutgoingDeliveriesBefore(createAt int64) (int64, error) {
+	ret := _m.Called(createAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PermanentDeleteOutgoingDeliveriesBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int64) (int64, error)); ok {
+		return rf(createAt)
+	}
+	if rf, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = rf(createAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(int64) error); ok {
+		r1 = rf(createAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SaveIncoming provides a mock function with given fields: webhook
 func (_m *WebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	ret := _m.Called(webhook)
@@ -638,6 +784,36 @@ func (_m *WebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*model.Out
 	return r0, r1
 }
 
+// SaveOutgoingDelivery provides a mock function with given fields: delivery
+func (_m *WebhookStore) SaveOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error) {
+	ret := _m.Called(delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveOutgoingDelivery")
+	}
+
+	var r0 *model.OutgoingWebhookDelivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error)); ok {
+		return rf(delivery)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OutgoingWebhookDelivery) *model.OutgoingWebhookDelivery); ok {
+		r0 = rf(delivery)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OutgoingWebhookDelivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OutgoingWebhookDelivery) error); ok {
+		r1 = rf(delivery)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateIncoming provides a mock function with given fields: webhook
 func (_m *WebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	ret := _m.Called(webhook)
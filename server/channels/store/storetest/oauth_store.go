@@ -16,6 +16,7 @@ import (
 
 func TestOAuthStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("SaveApp", func(t *testing.T) { testOAuthStoreSaveApp(t, rctx, ss) })
+	t.Run("ImportApp", func(t *testing.T) { testOAuthStoreImportApp(t, rctx, ss) })
 	t.Run("GetApp", func(t *testing.T) { testOAuthStoreGetApp(t, rctx, ss) })
 	t.Run("UpdateApp", func(t *testing.T) { testOAuthStoreUpdateApp(t, rctx, ss) })
 	t.Run("SaveAccessData", func(t *testing.T) { testOAuthStoreSaveAccessData(t, rctx, ss) })
@@ -60,6 +61,27 @@ func testOAuthStoreSaveApp(t *testing.T, rctx request.CTX, ss store.Store) {
 	require.NoError(t, err)
 }
 
+func testOAuthStoreImportApp(t *testing.T, rctx request.CTX, ss store.Store) {
+	a1 := model.OAuthApp{}
+	a1.Id = model.NewId()
+	a1.ClientSecret = model.NewId()
+	a1.CreatorId = model.NewId()
+	a1.Name = "TestApp" + model.NewId()
+	a1.CallbackUrls = []string{"https://nowhere.com"}
+	a1.Homepage = "https://nowhere.com"
+
+	_, err := ss.OAuth().ImportApp(&a1)
+	require.NoError(t, err)
+
+	app, err := ss.OAuth().GetApp(a1.Id)
+	require.NoError(t, err)
+	require.Equal(t, a1.Id, app.Id, "the client id should be preserved")
+	require.Equal(t, a1.ClientSecret, app.ClientSecret, "the client secret should be preserved")
+
+	_, err = ss.OAuth().ImportApp(&a1)
+	require.Error(t, err, "Should have failed, the app id is already taken")
+}
+
 func testOAuthStoreGetApp(t *testing.T, rctx request.CTX, ss store.Store) {
 	a1 := model.OAuthApp{}
 	a1.CreatorId = model.NewId()
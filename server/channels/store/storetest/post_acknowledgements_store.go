@@ -17,6 +17,7 @@ func TestPostAcknowledgementsStore(t *testing.T, rctx request.CTX, ss store.Stor
 	t.Run("Save", func(t *testing.T) { testPostAcknowledgementsStoreSave(t, rctx, ss) })
 	t.Run("GetForPost", func(t *testing.T) { testPostAcknowledgementsStoreGetForPost(t, rctx, ss) })
 	t.Run("GetForPosts", func(t *testing.T) { testPostAcknowledgementsStoreGetForPosts(t, rctx, ss) })
+	t.Run("SaveForSync", func(t *testing.T) { testPostAcknowledgementsStoreSaveForSync(t, rctx, ss) })
 }
 
 func testPostAcknowledgementsStoreSave(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -191,3 +192,78 @@ func testPostAcknowledgementsStoreGetForPosts(t *testing.T, rctx request.CTX, ss
 		require.Empty(t, acknowledgements)
 	})
 }
+
+func testPostAcknowledgementsStoreSaveForSync(t *testing.T, rctx request.CTX, ss store.Store) {
+	remoteID := model.NewId()
+	userID1 := model.NewId()
+	userID2 := model.NewId()
+
+	post, err := ss.Post().Save(rctx, &model.Post{
+		ChannelId: model.NewId(),
+		UserId:    model.NewId(),
+		Message:   NewTestID(),
+	})
+	require.NoError(t, err)
+
+	localAck, err := ss.PostAcknowledgement().Save(post.Id, userID1, 0)
+	require.NoError(t, err)
+
+	now := model.GetMillis()
+	remoteAck := &model.PostAcknowledgement{
+		PostId:         post.Id,
+		UserId:         userID2,
+		AcknowledgedAt: now - 1000,
+		UpdateAt:       now - 1000,
+		RemoteId:       model.NewPointer(remoteID),
+	}
+
+	t.Run("saves a remote acknowledgement", func(t *testing.T) {
+		_, err := ss.PostAcknowledgement().SaveForSync(remoteAck)
+		require.NoError(t, err)
+
+		ack, err := ss.PostAcknowledgement().Get(post.Id, userID2)
+		require.NoError(t, err)
+		require.Equal(t, remoteAck, ack)
+	})
+
+	t.Run("ignores an older change", func(t *testing.T) {
+		stale := *remoteAck
+		stale.AcknowledgedAt = 0
+		stale.UpdateAt = now - 2000
+		_, err := ss.PostAcknowledgement().SaveForSync(&stale)
+		var cErr *store.ErrConflict
+		require.ErrorAs(t, err, &cErr)
+
+		_, err = ss.PostAcknowledgement().Get(post.Id, userID2)
+		require.NoError(t, err)
+	})
+
+	t.Run("gets acknowledgements since, excluding the remote", func(t *testing.T) {
+		acks, err := ss.PostAcknowledgement().GetForPostsSince([]string{post.Id}, 0, "", false)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []*model.PostAcknowledgement{localAck, remoteAck}, acks)
+
+		acks, err = ss.PostAcknowledgement().GetForPostsSince([]string{post.Id}, 0, remoteID, false)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []*model.PostAcknowledgement{localAck}, acks)
+
+		acks, err = ss.PostAcknowledgement().GetForPostsSince([]string{post.Id}, localAck.UpdateAt+1, "", false)
+		require.NoError(t, err)
+		require.Empty(t, acks)
+	})
+
+	t.Run("removes an acknowledgement with a newer change", func(t *testing.T) {
+		removed := *remoteAck
+		removed.AcknowledgedAt = 0
+		removed.UpdateAt = now + 1000
+		_, err := ss.PostAcknowledgement().SaveForSync(&removed)
+		require.NoError(t, err)
+
+		_, err = ss.PostAcknowledgement().Get(post.Id, userID2)
+		require.Error(t, err)
+
+		acks, err := ss.PostAcknowledgement().GetForPostsSince([]string{post.Id}, removed.UpdateAt, "", true)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []*model.PostAcknowledgement{&removed}, acks)
+	})
+}
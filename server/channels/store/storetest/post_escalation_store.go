@@ -0,0 +1,167 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestPostEscalationStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("Save", func(t *testing.T) { testPostEscalationStoreSave(t, rctx, ss) })
+	t.Run("Update", func(t *testing.T) { testPostEscalationStoreUpdate(t, rctx, ss) })
+	t.Run("GetForPosts", func(t *testing.T) { testPostEscalationStoreGetForPosts(t, rctx, ss) })
+	t.Run("GetDue", func(t *testing.T) { testPostEscalationStoreGetDue(t, rctx, ss) })
+}
+
+func makePostEscalation(t *testing.T, rctx request.CTX, ss store.Store) *model.PostEscalation {
+	post, err := ss.Post().Save(rctx, &model.Post{
+		ChannelId: model.NewId(),
+		UserId:    model.NewId(),
+		Message:   NewTestID(),
+	})
+	require.NoError(t, err)
+
+	escalation := &model.PostEscalation{
+		PostId:    post.Id,
+		ChannelId: post.ChannelId,
+		CreatorId: post.UserId,
+		Levels: model.PostEscalationLevels{
+			{DelayMinutes: 5, UserIds: []string{model.NewId()}},
+		},
+		FallbackGroupId:      model.NewId(),
+		FallbackDelayMinutes: 10,
+	}
+	escalation.PreSave()
+	return escalation
+}
+
+func testPostEscalationStoreSave(t *testing.T, rctx request.CTX, ss store.Store) {
+	escalation := makePostEscalation(t, rctx, ss)
+
+	t.Run("invalid escalation", func(t *testing.T) {
+		invalid := *escalation
+		invalid.FallbackGroupId = ""
+		_, err := ss.PostEscalation().Save(&invalid)
+		require.Error(t, err)
+	})
+
+	t.Run("save and get", func(t *testing.T) {
+		_, err := ss.PostEscalation().Save(escalation)
+		require.NoError(t, err)
+
+		saved, err := ss.PostEscalation().Get(escalation.PostId)
+		require.NoError(t, err)
+		assert.Equal(t, escalation, saved)
+	})
+
+	t.Run("saving again replaces the escalation", func(t *testing.T) {
+		replaced := *escalation
+		replaced.Levels = model.PostEscalationLevels{
+			{DelayMinutes: 1, GroupIds: []string{model.NewId()}},
+			{DelayMinutes: 2, UserIds: []string{model.NewId()}},
+		}
+		replaced.PreSave()
+		_, err := ss.PostEscalation().Save(&replaced)
+		require.NoError(t, err)
+
+		saved, err := ss.PostEscalation().Get(escalation.PostId)
+		require.NoError(t, err)
+		assert.Equal(t, &replaced, saved)
+	})
+
+	t.Run("saving updates the update at of the post", func(t *testing.T) {
+		post, err := ss.Post().GetSingle(rctx, escalation.PostId, false)
+		require.NoError(t, err)
+
+		_, err = ss.PostEscalation().Save(escalation)
+		require.NoError(t, err)
+
+		updated, err := ss.Post().GetSingle(rctx, escalation.PostId, false)
+		require.NoError(t, err)
+		assert.Greater(t, updated.UpdateAt, post.UpdateAt)
+	})
+
+	t.Run("get missing escalation", func(t *testing.T) {
+		_, err := ss.PostEscalation().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.ErrorAs(t, err, &nfErr)
+	})
+}
+
+func testPostEscalationStoreUpdate(t *testing.T, rctx request.CTX, ss store.Store) {
+	escalation := makePostEscalation(t, rctx, ss)
+	_, err := ss.PostEscalation().Save(escalation)
+	require.NoError(t, err)
+
+	escalation.Advance(escalation.CreateAt + 1000)
+	require.NoError(t, ss.PostEscalation().Update(escalation))
+
+	updated, err := ss.PostEscalation().Get(escalation.PostId)
+	require.NoError(t, err)
+	assert.Equal(t, escalation, updated)
+
+	missing := makePostEscalation(t, rctx, ss)
+	err = ss.PostEscalation().Update(missing)
+	var nfErr *store.ErrNotFound
+	require.ErrorAs(t, err, &nfErr)
+}
+
+func testPostEscalationStoreGetForPosts(t *testing.T, rctx request.CTX, ss store.Store) {
+	escalation1 := makePostEscalation(t, rctx, ss)
+	_, err := ss.PostEscalation().Save(escalation1)
+	require.NoError(t, err)
+
+	escalation2 := makePostEscalation(t, rctx, ss)
+	_, err = ss.PostEscalation().Save(escalation2)
+	require.NoError(t, err)
+
+	escalations, err := ss.PostEscalation().GetForPosts([]string{escalation1.PostId, escalation2.PostId, model.NewId()})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []*model.PostEscalation{escalation1, escalation2}, escalations)
+
+	escalations, err = ss.PostEscalation().GetForPosts([]string{})
+	require.NoError(t, err)
+	assert.Empty(t, escalations)
+}
+
+func testPostEscalationStoreGetDue(t *testing.T, rctx request.CTX, ss store.Store) {
+	now := model.GetMillis()
+
+	due := makePostEscalation(t, rctx, ss)
+	due.CreateAt = now - 10*60*1000
+	due.PreSave()
+	_, err := ss.PostEscalation().Save(due)
+	require.NoError(t, err)
+
+	notDue := makePostEscalation(t, rctx, ss)
+	notDue.CreateAt = now
+	notDue.PreSave()
+	_, err = ss.PostEscalation().Save(notDue)
+	require.NoError(t, err)
+
+	canceled := makePostEscalation(t, rctx, ss)
+	canceled.CreateAt = now - 10*60*1000
+	canceled.PreSave()
+	canceled.Stop(model.PostEscalationStatusCanceled)
+	_, err = ss.PostEscalation().Save(canceled)
+	require.NoError(t, err)
+
+	escalations, err := ss.PostEscalation().GetDue(now, 100)
+	require.NoError(t, err)
+
+	postIDs := make([]string, 0, len(escalations))
+	for _, escalation := range escalations {
+		postIDs = append(postIDs, escalation.PostId)
+	}
+	assert.Contains(t, postIDs, due.PostId)
+	assert.NotContains(t, postIDs, notDue.PostId)
+	assert.NotContains(t, postIDs, canceled.PostId)
+}
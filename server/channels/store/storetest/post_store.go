@@ -63,6 +63,8 @@ func TestPostStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Run("SetPostReminder", func(t *testing.T) { testSetPostReminder(t, rctx, ss, s) })
 	t.Run("GetPostReminders", func(t *testing.T) { testGetPostReminders(t, rctx, ss, s) })
 	t.Run("GetPostReminderMetadata", func(t *testing.T) { testGetPostReminderMetadata(t, rctx, ss, s) })
+	t.Run("GetPostRemindersForUser", func(t *testing.T) { testGetPostRemindersForUser(t, rctx, ss) })
+	t.Run("DeletePostReminder", func(t *testing.T) { testDeletePostReminder(t, ss) })
 	t.Run("GetNthRecentPostTime", func(t *testing.T) { testGetNthRecentPostTime(t, rctx, ss) })
 	t.Run("GetEditHistoryForPost", func(t *testing.T) { testGetEditHistoryForPost(t, rctx, ss) })
 }
@@ -5036,6 +5038,72 @@ func testGetPostReminders(t *testing.T, rctx request.CTX, ss store.Store, s SqlS
 	require.Len(t, reminders, 0)
 }
 
+func testGetPostRemindersForUser(t *testing.T, rctx request.CTX, ss store.Store) {
+	userID := NewTestID()
+
+	post, err := ss.Post().Save(rctx, &model.Post{
+		UserId:    NewTestID(),
+		ChannelId: NewTestID(),
+		Message:   "hi there",
+	})
+	require.NoError(t, err)
+
+	postReminder := &model.PostReminder{
+		TargetTime: 200,
+		PostId:     post.Id,
+		UserId:     userID,
+	}
+	require.NoError(t, ss.Post().SetPostReminder(postReminder))
+
+	// Standalone reminders don't need a post.
+	standaloneReminder := &model.PostReminder{
+		TargetTime: 100,
+		PostId:     model.NewId(),
+		UserId:     userID,
+		Message:    "water the plants",
+		Recurrence: model.PostReminderRecurrenceWeekly,
+	}
+	require.NoError(t, ss.Post().SetPostReminder(standaloneReminder))
+
+	require.NoError(t, ss.Post().SetPostReminder(&model.PostReminder{
+		TargetTime: 100,
+		PostId:     post.Id,
+		UserId:     NewTestID(),
+	}))
+
+	reminders, err := ss.Post().GetPostRemindersForUser(userID)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.PostReminder{standaloneReminder, postReminder}, reminders)
+
+	reminder, err := ss.Post().GetPostReminder(standaloneReminder.PostId, userID)
+	require.NoError(t, err)
+	assert.Equal(t, standaloneReminder, reminder)
+
+	_, err = ss.Post().GetPostReminder(standaloneReminder.PostId, NewTestID())
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
+func testDeletePostReminder(t *testing.T, ss store.Store) {
+	reminder := &model.PostReminder{
+		TargetTime: 100,
+		PostId:     model.NewId(),
+		UserId:     NewTestID(),
+		Message:    "standalone",
+	}
+	require.NoError(t, ss.Post().SetPostReminder(reminder))
+
+	require.NoError(t, ss.Post().DeletePostReminder(reminder.PostId, reminder.UserId))
+
+	reminders, err := ss.Post().GetPostRemindersForUser(reminder.UserId)
+	require.NoError(t, err)
+	assert.Empty(t, reminders)
+
+	err = ss.Post().DeletePostReminder(reminder.PostId, reminder.UserId)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+}
+
 func testGetPostReminderMetadata(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	team := &model.Team{
 		Name:        "teamname",
@@ -25,6 +25,7 @@ func TestRemoteClusterStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("RemoteClusterGetAll", func(t *testing.T) { testRemoteClusterGetAll(t, rctx, ss) })
 	t.Run("RemoteClusterGetByTopic", func(t *testing.T) { testRemoteClusterGetByTopic(t, rctx, ss) })
 	t.Run("RemoteClusterUpdateTopics", func(t *testing.T) { testRemoteClusterUpdateTopics(t, rctx, ss) })
+	t.Run("RemoteClusterSetCapabilities", func(t *testing.T) { testRemoteClusterSetCapabilities(t, rctx, ss) })
 }
 
 func makeSiteURL() string {
@@ -760,3 +761,23 @@ func testRemoteClusterUpdateTopics(t *testing.T, _ request.CTX, ss store.Store)
 		require.Equal(t, tt.expected, rcUpdated.Topics)
 	}
 }
+
+func testRemoteClusterSetCapabilities(t *testing.T, _ request.CTX, ss store.Store) {
+	rc := &model.RemoteCluster{
+		Name:      "capable",
+		SiteURL:   "capable.com",
+		CreatorId: model.NewId(),
+	}
+
+	rcSaved, err := ss.RemoteCluster().Save(rc)
+	require.NoError(t, err)
+	require.Zero(t, rcSaved.Capabilities)
+
+	err = ss.RemoteCluster().SetCapabilities(rcSaved.RemoteId, model.RemoteCapabilities)
+	require.NoError(t, err)
+
+	rcGet, err := ss.RemoteCluster().Get(rcSaved.RemoteId, false)
+	require.NoError(t, err)
+	require.True(t, rcGet.HasCapability(model.RemoteCapabilityBookmarks))
+	require.True(t, rcGet.HasCapability(model.RemoteCapabilityAcknowledgements))
+}
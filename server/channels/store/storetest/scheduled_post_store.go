@@ -170,6 +170,46 @@ func testGetScheduledPosts(t *testing.T, rctx request.CTX, ss store.Store, s Sql
 		assert.NoError(t, err)
 		assert.Equal(t, 0, len(scheduledPosts))
 	})
+
+	t.Run("should skip paused recurring scheduled posts", func(t *testing.T) {
+		jan2100 := time.Date(2100, time.January, 1, 1, 0, 0, 0, time.UTC)
+		recurringScheduledPost := &model.ScheduledPost{
+			Draft: model.Draft{
+				CreateAt:  model.GetMillis(),
+				UserId:    model.NewId(),
+				ChannelId: model.NewId(),
+				Message:   "this is a recurring scheduled post",
+			},
+			ScheduledAt:     model.GetMillisForTime(jan2100),
+			Recurrence:      "FREQ=DAILY",
+			RecurrenceEndAt: model.GetMillisForTime(jan2100.AddDate(0, 1, 0)),
+		}
+
+		createdScheduledPost, err := ss.ScheduledPost().CreateScheduledPost(recurringScheduledPost)
+		assert.NoError(t, err)
+		defer func() {
+			_ = ss.ScheduledPost().PermanentlyDeleteScheduledPosts([]string{createdScheduledPost.Id})
+		}()
+
+		fetchedScheduledPost, err := ss.ScheduledPost().Get(createdScheduledPost.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, "FREQ=DAILY", fetchedScheduledPost.Recurrence)
+		assert.Equal(t, recurringScheduledPost.RecurrenceEndAt, fetchedScheduledPost.RecurrenceEndAt)
+		assert.False(t, fetchedScheduledPost.RecurrencePaused)
+
+		beforeTime := model.GetMillisForTime(jan2100.Add(time.Hour))
+		afterTime := model.GetMillisForTime(jan2100.Add(-time.Hour))
+		scheduledPosts, err := ss.ScheduledPost().GetPendingScheduledPosts(beforeTime, afterTime, "", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(scheduledPosts))
+
+		createdScheduledPost.RecurrencePaused = true
+		assert.NoError(t, ss.ScheduledPost().UpdatedScheduledPost(createdScheduledPost))
+
+		scheduledPosts, err = ss.ScheduledPost().GetPendingScheduledPosts(beforeTime, afterTime, "", 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, len(scheduledPosts))
+	})
 }
 
 func testPermanentlyDeleteScheduledPosts(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
@@ -930,6 +930,36 @@ func testUpdateSharedChannelRemoteCursor(t *testing.T, rctx request.CTX, ss stor
 		err := ss.SharedChannel().UpdateRemoteCursor(remoteSaved.Id, emptyCursor)
 		require.Error(t, err, "update with empty cursor should error", err)
 	})
+
+	t.Run("Update bookmark cursor for remote", func(t *testing.T) {
+		err := ss.SharedChannel().UpdateRemoteBookmarkCursor(remoteSaved.Id, futureUpdateAt)
+		require.NoError(t, err, "update bookmark cursor should not error", err)
+
+		r, err := ss.SharedChannel().GetRemote(remoteSaved.Id)
+		require.NoError(t, err)
+		require.Equal(t, futureUpdateAt, r.LastBookmarkUpdateAt)
+		require.Equal(t, futureUpdateAt, r.LastPostUpdateAt, "post cursor should be unchanged")
+	})
+
+	t.Run("Update bookmark cursor for non-existent shared channel remote", func(t *testing.T) {
+		err := ss.SharedChannel().UpdateRemoteBookmarkCursor(model.NewId(), futureUpdateAt)
+		require.Error(t, err, "update non-existent remote should error", err)
+	})
+
+	t.Run("Update membership cursor for remote", func(t *testing.T) {
+		err := ss.SharedChannel().UpdateRemoteMembershipCursor(remoteSaved.Id, futureCreateAt)
+		require.NoError(t, err, "update membership cursor should not error", err)
+
+		r, err := ss.SharedChannel().GetRemote(remoteSaved.Id)
+		require.NoError(t, err)
+		require.Equal(t, futureCreateAt, r.LastMembersSyncAt)
+		require.Equal(t, futureUpdateAt, r.LastBookmarkUpdateAt, "bookmark cursor should be unchanged")
+	})
+
+	t.Run("Update membership cursor for non-existent shared channel remote", func(t *testing.T) {
+		err := ss.SharedChannel().UpdateRemoteMembershipCursor(model.NewId(), futureCreateAt)
+		require.Error(t, err, "update non-existent remote should error", err)
+	})
 }
 
 func testDeleteSharedChannelRemote(t *testing.T, rctx request.CTX, ss store.Store) {
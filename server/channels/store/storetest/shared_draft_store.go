@@ -0,0 +1,197 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestSharedDraftStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("Save", func(t *testing.T) { testSharedDraftStoreSave(t, ss) })
+	t.Run("Update", func(t *testing.T) { testSharedDraftStoreUpdate(t, ss) })
+	t.Run("GetForUser", func(t *testing.T) { testSharedDraftStoreGetForUser(t, ss) })
+	t.Run("Members", func(t *testing.T) { testSharedDraftStoreMembers(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testSharedDraftStoreDelete(t, ss) })
+	t.Run("DeleteIfUnchanged", func(t *testing.T) { testSharedDraftStoreDeleteIfUnchanged(t, ss) })
+}
+
+func makeSharedDraft(t *testing.T, ss store.Store, userIDs ...string) *model.SharedDraft {
+	draft, err := ss.SharedDraft().Save(&model.SharedDraft{
+		CreatorId: model.NewId(),
+		ChannelId: model.NewId(),
+		Message:   NewTestID(),
+		UserIds:   userIDs,
+	})
+	require.NoError(t, err)
+	return draft
+}
+
+func testSharedDraftStoreSave(t *testing.T, ss store.Store) {
+	t.Run("invalid draft", func(t *testing.T) {
+		_, err := ss.SharedDraft().Save(&model.SharedDraft{
+			CreatorId: model.NewId(),
+			ChannelId: "invalid",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("save and get", func(t *testing.T) {
+		userID := model.NewId()
+		draft := makeSharedDraft(t, ss, userID)
+		assert.Equal(t, []string{draft.CreatorId, userID}, draft.UserIds)
+
+		saved, err := ss.SharedDraft().Get(draft.Id)
+		require.NoError(t, err)
+		assert.Equal(t, draft, saved)
+	})
+
+	t.Run("get missing draft", func(t *testing.T) {
+		_, err := ss.SharedDraft().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testSharedDraftStoreUpdate(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	draft := makeSharedDraft(t, ss, userID)
+
+	t.Run("update from the latest version", func(t *testing.T) {
+		lastUpdateAt := draft.UpdateAt
+		edited := *draft
+		edited.Message = "edited"
+		edited.LastEditedBy = userID
+
+		updated, err := ss.SharedDraft().Update(&edited, lastUpdateAt)
+		require.NoError(t, err)
+		assert.Greater(t, updated.UpdateAt, lastUpdateAt)
+
+		saved, err := ss.SharedDraft().Get(draft.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "edited", saved.Message)
+		assert.Equal(t, userID, saved.LastEditedBy)
+		assert.Equal(t, updated.UpdateAt, saved.UpdateAt)
+	})
+
+	t.Run("update from a stale version", func(t *testing.T) {
+		stale := *draft
+		stale.Message = "stale"
+
+		_, err := ss.SharedDraft().Update(&stale, draft.UpdateAt)
+		var cErr *store.ErrConflict
+		require.True(t, errors.As(err, &cErr))
+
+		saved, err := ss.SharedDraft().Get(draft.Id)
+		require.NoError(t, err)
+		assert.Equal(t, "edited", saved.Message)
+	})
+
+	t.Run("update a missing draft", func(t *testing.T) {
+		missing := *draft
+		missing.Id = model.NewId()
+
+		_, err := ss.SharedDraft().Update(&missing, draft.UpdateAt)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testSharedDraftStoreGetForUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	draft1 := makeSharedDraft(t, ss, userID)
+	draft2 := makeSharedDraft(t, ss, userID)
+	makeSharedDraft(t, ss)
+
+	edited := *draft1
+	edited.Message = "edited"
+	_, err := ss.SharedDraft().Update(&edited, draft1.UpdateAt)
+	require.NoError(t, err)
+
+	drafts, err := ss.SharedDraft().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, drafts, 2)
+	assert.Equal(t, draft1.Id, drafts[0].Id)
+	assert.Equal(t, draft2.Id, drafts[1].Id)
+	assert.Equal(t, []string{draft1.CreatorId, userID}, drafts[0].UserIds)
+
+	drafts, err = ss.SharedDraft().GetForUser(model.NewId())
+	require.NoError(t, err)
+	assert.Empty(t, drafts)
+}
+
+func testSharedDraftStoreMembers(t *testing.T, ss store.Store) {
+	userID1 := model.NewId()
+	userID2 := model.NewId()
+	draft := makeSharedDraft(t, ss, userID1)
+
+	err := ss.SharedDraft().SaveMembers(draft.Id, []string{userID1, userID2})
+	require.NoError(t, err, "existing members should be ignored")
+
+	saved, err := ss.SharedDraft().Get(draft.Id)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{draft.CreatorId, userID1, userID2}, saved.UserIds)
+
+	err = ss.SharedDraft().DeleteMember(draft.Id, userID1)
+	require.NoError(t, err)
+
+	saved, err = ss.SharedDraft().Get(draft.Id)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{draft.CreatorId, userID2}, saved.UserIds)
+}
+
+func testSharedDraftStoreDelete(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	draft := makeSharedDraft(t, ss, userID)
+
+	err := ss.SharedDraft().Delete(draft.Id)
+	require.NoError(t, err)
+
+	_, err = ss.SharedDraft().Get(draft.Id)
+	var nfErr *store.ErrNotFound
+	require.True(t, errors.As(err, &nfErr))
+
+	drafts, err := ss.SharedDraft().GetForUser(userID)
+	require.NoError(t, err)
+	assert.Empty(t, drafts)
+}
+
+func testSharedDraftStoreDeleteIfUnchanged(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	draft := makeSharedDraft(t, ss, userID)
+
+	t.Run("delete from a stale version", func(t *testing.T) {
+		err := ss.SharedDraft().DeleteIfUnchanged(draft.Id, draft.UpdateAt-1)
+		var cErr *store.ErrConflict
+		require.True(t, errors.As(err, &cErr))
+
+		_, err = ss.SharedDraft().Get(draft.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("delete from the latest version", func(t *testing.T) {
+		require.NoError(t, ss.SharedDraft().DeleteIfUnchanged(draft.Id, draft.UpdateAt))
+
+		_, err := ss.SharedDraft().Get(draft.Id)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+
+		drafts, err := ss.SharedDraft().GetForUser(userID)
+		require.NoError(t, err)
+		assert.Empty(t, drafts)
+	})
+
+	t.Run("delete a missing draft", func(t *testing.T) {
+		err := ss.SharedDraft().DeleteIfUnchanged(draft.Id, draft.UpdateAt)
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
@@ -63,6 +63,9 @@ type Store struct {
 	PostPriorityStore               mocks.PostPriorityStore
 	PostAcknowledgementStore        mocks.PostAcknowledgementStore
 	PostPersistentNotificationStore mocks.PostPersistentNotificationStore
+	PostEscalationStore             mocks.PostEscalationStore
+	SharedDraftStore                mocks.SharedDraftStore
+	WebAuthnCredentialStore         mocks.WebAuthnCredentialStore
 	DesktopTokensStore              mocks.DesktopTokensStore
 	ChannelBookmarkStore            mocks.ChannelBookmarkStore
 	ScheduledPostStore              mocks.ScheduledPostStore
@@ -125,6 +128,15 @@ func (s *Store) PostAcknowledgement() store.PostAcknowledgementStore {
 func (s *Store) PostPersistentNotification() store.PostPersistentNotificationStore {
 	return &s.PostPersistentNotificationStore
 }
+func (s *Store) PostEscalation() store.PostEscalationStore {
+	return &s.PostEscalationStore
+}
+func (s *Store) SharedDraft() store.SharedDraftStore {
+	return &s.SharedDraftStore
+}
+func (s *Store) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return &s.WebAuthnCredentialStore
+}
 func (s *Store) MarkSystemRanUnitTests()             { /* do nothing */ }
 func (s *Store) Close()                              { /* do nothing */ }
 func (s *Store) LockToMaster()                       { /* do nothing */ }
@@ -188,6 +200,9 @@ func (s *Store) AssertExpectations(t mock.TestingT) bool {
 		&s.PostPriorityStore,
 		&s.PostAcknowledgementStore,
 		&s.PostPersistentNotificationStore,
+		&s.PostEscalationStore,
+		&s.SharedDraftStore,
+		&s.WebAuthnCredentialStore,
 		&s.DesktopTokensStore,
 		&s.ChannelBookmarkStore,
 		&s.ScheduledPostStore,
@@ -73,6 +73,7 @@ func TestUserStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
 	t.Run("GetByUsername", func(t *testing.T) { testUserStoreGetByUsername(t, rctx, ss) })
 	t.Run("GetForLogin", func(t *testing.T) { testUserStoreGetForLogin(t, rctx, ss) })
 	t.Run("UpdatePassword", func(t *testing.T) { testUserStoreUpdatePassword(t, rctx, ss) })
+	t.Run("UpdatePasswordHash", func(t *testing.T) { testUserStoreUpdatePasswordHash(t, rctx, ss) })
 	t.Run("Delete", func(t *testing.T) { testUserStoreDelete(t, rctx, ss) })
 	t.Run("UpdateAuthData", func(t *testing.T) { testUserStoreUpdateAuthData(t, rctx, ss) })
 	t.Run("ResetAuthDataToEmailForUsers", func(t *testing.T) { testUserStoreResetAuthDataToEmailForUsers(t, rctx, ss) })
@@ -2312,6 +2313,33 @@ func testUserStoreUpdatePassword(t *testing.T, rctx request.CTX, ss store.Store)
 	require.Equal(t, user.Password, hashedPassword, "Password was not updated correctly")
 }
 
+func testUserStoreUpdatePasswordHash(t *testing.T, rctx request.CTX, ss store.Store) {
+	u1 := &model.User{Email: MakeEmail(), Password: "Passw0rd!"}
+	_, err := ss.User().Save(rctx, u1)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ss.User().PermanentDelete(rctx, u1.Id)) }()
+
+	user, err := ss.User().Get(context.Background(), u1.Id)
+	require.NoError(t, err)
+	oldHash := user.Password
+
+	newHash := "$argon2id$v=19$m=19456,t=2,p=1$c29tZXNhbHRzb21lc2FsdA$Q+9pUz0pUBbIt3l2mMrfA1JoEUkPxIgGDaXGvNIWsiA"
+	require.NoError(t, ss.User().UpdatePasswordHash(u1.Id, oldHash, newHash))
+
+	user, err = ss.User().Get(context.Background(), u1.Id)
+	require.NoError(t, err)
+	assert.Equal(t, newHash, user.Password)
+	assert.Equal(t, u1.LastPasswordUpdate, user.LastPasswordUpdate, "rehashing isn't a password change")
+
+	err = ss.User().UpdatePasswordHash(u1.Id, oldHash, "$2a$10$changed")
+	var cErr *store.ErrConflict
+	require.ErrorAs(t, err, &cErr, "the hash shouldn't be replaced if the password changed")
+
+	user, err = ss.User().Get(context.Background(), u1.Id)
+	require.NoError(t, err)
+	assert.Equal(t, newHash, user.Password)
+}
+
 func testUserStoreDelete(t *testing.T, rctx request.CTX, ss store.Store) {
 	u1 := &model.User{}
 	u1.Email = MakeEmail()
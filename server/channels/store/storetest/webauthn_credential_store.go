@@ -0,0 +1,140 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package storetest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+)
+
+func TestWebAuthnCredentialStore(t *testing.T, rctx request.CTX, ss store.Store, s SqlStore) {
+	t.Run("Save", func(t *testing.T) { testWebAuthnCredentialStoreSave(t, ss) })
+	t.Run("GetForUser", func(t *testing.T) { testWebAuthnCredentialStoreGetForUser(t, ss) })
+	t.Run("UpdateSignCount", func(t *testing.T) { testWebAuthnCredentialStoreUpdateSignCount(t, ss) })
+	t.Run("Delete", func(t *testing.T) { testWebAuthnCredentialStoreDelete(t, ss) })
+}
+
+func makeWebAuthnCredential(t *testing.T, ss store.Store, userID string) *model.WebAuthnCredential {
+	credential, err := ss.WebAuthnCredential().Save(&model.WebAuthnCredential{
+		UserId:       userID,
+		CredentialId: NewTestID(),
+		PublicKey:    []byte{0xa5, 0x01, 0x02, 0x03, 0x26},
+		Name:         "Security key",
+	})
+	require.NoError(t, err)
+	return credential
+}
+
+func testWebAuthnCredentialStoreSave(t *testing.T, ss store.Store) {
+	t.Run("invalid credential", func(t *testing.T) {
+		_, err := ss.WebAuthnCredential().Save(&model.WebAuthnCredential{
+			UserId:       model.NewId(),
+			CredentialId: NewTestID(),
+			Name:         "Security key",
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("save and get", func(t *testing.T) {
+		credential := makeWebAuthnCredential(t, ss, model.NewId())
+
+		saved, err := ss.WebAuthnCredential().Get(credential.Id)
+		require.NoError(t, err)
+		assert.Equal(t, credential, saved)
+	})
+
+	t.Run("get missing credential", func(t *testing.T) {
+		_, err := ss.WebAuthnCredential().Get(model.NewId())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testWebAuthnCredentialStoreGetForUser(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+
+	credentials, err := ss.WebAuthnCredential().GetForUser(userID)
+	require.NoError(t, err)
+	assert.Empty(t, credentials)
+
+	first := makeWebAuthnCredential(t, ss, userID)
+	second := makeWebAuthnCredential(t, ss, userID)
+	makeWebAuthnCredential(t, ss, model.NewId())
+
+	credentials, err = ss.WebAuthnCredential().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, credentials, 2)
+	assert.ElementsMatch(t, []string{first.Id, second.Id}, []string{credentials[0].Id, credentials[1].Id})
+}
+
+func testWebAuthnCredentialStoreUpdateSignCount(t *testing.T, ss store.Store) {
+	t.Run("without a signature counter", func(t *testing.T) {
+		credential := makeWebAuthnCredential(t, ss, model.NewId())
+
+		for i := 0; i < 2; i++ {
+			lastUsedAt := model.GetMillis()
+			require.NoError(t, ss.WebAuthnCredential().UpdateSignCount(credential.Id, 0, lastUsedAt))
+
+			saved, err := ss.WebAuthnCredential().Get(credential.Id)
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), saved.SignCount)
+			assert.Equal(t, lastUsedAt, saved.LastUsedAt)
+		}
+	})
+
+	t.Run("the signature counter only moves forward", func(t *testing.T) {
+		credential := makeWebAuthnCredential(t, ss, model.NewId())
+
+		require.NoError(t, ss.WebAuthnCredential().UpdateSignCount(credential.Id, 5, model.GetMillis()))
+		require.NoError(t, ss.WebAuthnCredential().UpdateSignCount(credential.Id, 6, model.GetMillis()))
+
+		var cErr *store.ErrConflict
+		err := ss.WebAuthnCredential().UpdateSignCount(credential.Id, 6, model.GetMillis())
+		require.True(t, errors.As(err, &cErr))
+		err = ss.WebAuthnCredential().UpdateSignCount(credential.Id, 0, model.GetMillis())
+		require.True(t, errors.As(err, &cErr))
+
+		saved, err := ss.WebAuthnCredential().Get(credential.Id)
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), saved.SignCount)
+	})
+
+	t.Run("missing credential", func(t *testing.T) {
+		err := ss.WebAuthnCredential().UpdateSignCount(model.NewId(), 1, model.GetMillis())
+		var nfErr *store.ErrNotFound
+		require.True(t, errors.As(err, &nfErr))
+	})
+}
+
+func testWebAuthnCredentialStoreDelete(t *testing.T, ss store.Store) {
+	userID := model.NewId()
+	credential := makeWebAuthnCredential(t, ss, userID)
+	makeWebAuthnCredential(t, ss, userID)
+	other := makeWebAuthnCredential(t, ss, model.NewId())
+
+	require.NoError(t, ss.WebAuthnCredential().Delete(credential.Id))
+
+	var nfErr *store.ErrNotFound
+	err := ss.WebAuthnCredential().Delete(credential.Id)
+	require.True(t, errors.As(err, &nfErr))
+
+	credentials, err := ss.WebAuthnCredential().GetForUser(userID)
+	require.NoError(t, err)
+	require.Len(t, credentials, 1)
+
+	require.NoError(t, ss.WebAuthnCredential().PermanentDeleteByUser(userID))
+	credentials, err = ss.WebAuthnCredential().GetForUser(userID)
+	require.NoError(t, err)
+	assert.Empty(t, credentials)
+
+	_, err = ss.WebAuthnCredential().Get(other.Id)
+	require.NoError(t, err)
+}
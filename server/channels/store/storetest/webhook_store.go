@@ -17,6 +17,7 @@ import (
 
 func TestWebhookStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("SaveIncoming", func(t *testing.T) { testWebhookStoreSaveIncoming(t, rctx, ss) })
+	t.Run("ImportIncoming", func(t *testing.T) { testWebhookStoreImportIncoming(t, rctx, ss) })
 	t.Run("UpdateIncoming", func(t *testing.T) { testWebhookStoreUpdateIncoming(t, rctx, ss) })
 	t.Run("GetIncoming", func(t *testing.T) { testWebhookStoreGetIncoming(t, rctx, ss) })
 	t.Run("GetIncomingList", func(t *testing.T) { testWebhookStoreGetIncomingList(t, rctx, ss) })
@@ -39,6 +40,10 @@ func TestWebhookStore(t *testing.T, rctx request.CTX, ss store.Store) {
 	t.Run("DeleteOutgoingByChannel", func(t *testing.T) { testWebhookStoreDeleteOutgoingByChannel(t, rctx, ss) })
 	t.Run("DeleteOutgoingByUser", func(t *testing.T) { testWebhookStoreDeleteOutgoingByUser(t, rctx, ss) })
 	t.Run("UpdateOutgoing", func(t *testing.T) { testWebhookStoreUpdateOutgoing(t, rctx, ss) })
+	t.Run("SaveOutgoingDelivery", func(t *testing.T) { testWebhookStoreSaveOutgoingDelivery(t, rctx, ss) })
+	t.Run("GetOutgoingDeliveries", func(t *testing.T) { testWebhookStoreGetOutgoingDeliveries(t, rctx, ss) })
+	t.Run("OutgoingDeliveryRetries", func(t *testing.T) { testWebhookStoreOutgoingDeliveryRetries(t, rctx, ss) })
+	t.Run("PermanentDeleteOutgoingDeliveriesBefore", func(t *testing.T) { testWebhookStorePermanentDeleteOutgoingDeliveriesBefore(t, rctx, ss) })
 	t.Run("CountIncoming", func(t *testing.T) { testWebhookStoreCountIncoming(t, rctx, ss) })
 	t.Run("CountOutgoing", func(t *testing.T) { testWebhookStoreCountOutgoing(t, rctx, ss) })
 }
@@ -53,6 +58,21 @@ func testWebhookStoreSaveIncoming(t *testing.T, rctx request.CTX, ss store.Store
 	require.Error(t, err, "shouldn't be able to update from save")
 }
 
+func testWebhookStoreImportIncoming(t *testing.T, rctx request.CTX, ss store.Store) {
+	o1 := buildIncomingWebhook()
+	o1.Id = model.NewId()
+
+	_, err := ss.Webhook().ImportIncoming(o1)
+	require.NoError(t, err, "couldn't import item")
+
+	webhook, err := ss.Webhook().GetIncoming(o1.Id, false)
+	require.NoError(t, err)
+	require.Equal(t, o1.Id, webhook.Id, "the id should be preserved")
+
+	_, err = ss.Webhook().ImportIncoming(o1)
+	require.Error(t, err, "shouldn't be able to import a duplicated id")
+}
+
 func testWebhookStoreUpdateIncoming(t *testing.T, rctx request.CTX, ss store.Store) {
 	var err error
 
@@ -63,6 +83,9 @@ func testWebhookStoreUpdateIncoming(t *testing.T, rctx request.CTX, ss store.Sto
 	previousUpdatedAt := o1.UpdateAt
 
 	o1.DisplayName = "TestHook"
+	o1.RateLimitPerMinute = 30
+	o1.RateLimitBurst = 5
+	o1.PayloadTemplate = "{{ .text }}"
 	time.Sleep(10 * time.Millisecond)
 
 	webhook, err := ss.Webhook().UpdateIncoming(o1)
@@ -71,6 +94,12 @@ func testWebhookStoreUpdateIncoming(t *testing.T, rctx request.CTX, ss store.Sto
 	require.NotEqual(t, webhook.UpdateAt, previousUpdatedAt, "should have updated the UpdatedAt of the hook")
 
 	require.Equal(t, "TestHook", webhook.DisplayName, "display name is not updated")
+
+	webhook, err = ss.Webhook().GetIncoming(o1.Id, false)
+	require.NoError(t, err)
+	require.Equal(t, 30, webhook.RateLimitPerMinute)
+	require.Equal(t, 5, webhook.RateLimitBurst)
+	require.Equal(t, "{{ .text }}", webhook.PayloadTemplate)
 }
 
 func testWebhookStoreGetIncoming(t *testing.T, rctx request.CTX, ss store.Store) {
@@ -564,9 +593,169 @@ func testWebhookStoreUpdateOutgoing(t *testing.T, rctx request.CTX, ss store.Sto
 
 	o1.Token = model.NewId()
 	o1.Username = "another-test-user-name"
+	o1.Secret = model.NewRandomString(model.OutgoingHookSecretLength)
 
 	_, err := ss.Webhook().UpdateOutgoing(o1)
 	require.NoError(t, err)
+
+	updated, err := ss.Webhook().GetOutgoing(o1.Id)
+	require.NoError(t, err)
+	require.Equal(t, o1.Secret, updated.Secret)
+}
+
+func buildOutgoingWebhookDelivery(hookID string) *model.OutgoingWebhookDelivery {
+	return &model.OutgoingWebhookDelivery{
+		HookId:      hookID,
+		PostId:      model.NewId(),
+		CallbackURL: "http://nowhere.com/",
+		ContentType: "application/json",
+		Payload:     `{"text":"hello"}`,
+		Status:      model.OutgoingWebhookDeliveryStatusSuccess,
+		StatusCode:  200,
+		Latency:     42,
+		Response:    `{"text":"hi"}`,
+	}
+}
+
+func testWebhookStoreSaveOutgoingDelivery(t *testing.T, rctx request.CTX, ss store.Store) {
+	d1 := buildOutgoingWebhookDelivery(model.NewId())
+
+	saved, err := ss.Webhook().SaveOutgoingDelivery(d1)
+	require.NoError(t, err)
+	require.NotEmpty(t, saved.Id)
+	require.Equal(t, 1, saved.Attempt)
+	require.NotZero(t, saved.CreateAt)
+
+	_, err = ss.Webhook().SaveOutgoingDelivery(d1)
+	require.Error(t, err, "shouldn't be able to update from save")
+
+	d2 := buildOutgoingWebhookDelivery(model.NewId())
+	d2.Status = ""
+	_, err = ss.Webhook().SaveOutgoingDelivery(d2)
+	require.Error(t, err, "shouldn't be able to save an invalid delivery")
+}
+
+func testWebhookStoreGetOutgoingDeliveries(t *testing.T, rctx request.CTX, ss store.Store) {
+	hookID := model.NewId()
+
+	d1 := buildOutgoingWebhookDelivery(hookID)
+	d1.CreateAt = 1000
+	_, err := ss.Webhook().SaveOutgoingDelivery(d1)
+	require.NoError(t, err)
+
+	d2 := buildOutgoingWebhookDelivery(hookID)
+	d2.CreateAt = 2000
+	d2.Status = model.OutgoingWebhookDeliveryStatusFailed
+	d2.StatusCode = 500
+	_, err = ss.Webhook().SaveOutgoingDelivery(d2)
+	require.NoError(t, err)
+
+	_, err = ss.Webhook().SaveOutgoingDelivery(buildOutgoingWebhookDelivery(model.NewId()))
+	require.NoError(t, err)
+
+	deliveries, err := ss.Webhook().GetOutgoingDeliveries(hookID, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, d2.Id, deliveries[0].Id, "newest delivery should be returned first")
+	require.Equal(t, 500, deliveries[0].StatusCode)
+	require.Equal(t, d1.Id, deliveries[1].Id)
+	require.Equal(t, d1.Payload, deliveries[1].Payload)
+
+	deliveries, err = ss.Webhook().GetOutgoingDeliveries(hookID, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, d1.Id, deliveries[0].Id)
+
+	deliveries, err = ss.Webhook().GetOutgoingDeliveries(model.NewId(), 0, 100)
+	require.NoError(t, err)
+	require.Empty(t, deliveries)
+}
+
+func testWebhookStoreOutgoingDeliveryRetries(t *testing.T, rctx request.CTX, ss store.Store) {
+	now := model.GetMillis()
+
+	due := buildOutgoingWebhookDelivery(model.NewId())
+	due.Status = model.OutgoingWebhookDeliveryStatusFailed
+	due.NextRetryAt = now - 1000
+	due.SignedBody = `{"token":"secret","text":"hello"}`
+	due.SignedAt = now / 1000
+	_, err := ss.Webhook().SaveOutgoingDelivery(due)
+	require.NoError(t, err)
+
+	notDue := buildOutgoingWebhookDelivery(model.NewId())
+	notDue.Status = model.OutgoingWebhookDeliveryStatusFailed
+	notDue.NextRetryAt = now + 60000
+	_, err = ss.Webhook().SaveOutgoingDelivery(notDue)
+	require.NoError(t, err)
+
+	noRetry := buildOutgoingWebhookDelivery(model.NewId())
+	_, err = ss.Webhook().SaveOutgoingDelivery(noRetry)
+	require.NoError(t, err)
+
+	deliveries, err := ss.Webhook().GetOutgoingDeliveriesToRetry(now, 1000)
+	require.NoError(t, err)
+	ids := make([]string, 0, len(deliveries))
+	for _, d := range deliveries {
+		ids = append(ids, d.Id)
+	}
+	require.Contains(t, ids, due.Id)
+	for _, d := range deliveries {
+		if d.Id == due.Id {
+			require.Equal(t, due.Id, d.DeliveryId)
+			require.Equal(t, due.SignedBody, d.SignedBody)
+			require.Equal(t, due.SignedAt, d.SignedAt)
+		}
+	}
+	require.NotContains(t, ids, notDue.Id)
+	require.NotContains(t, ids, noRetry.Id)
+
+	claimed, err := ss.Webhook().ClaimOutgoingDeliveryRetry(due.Id)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	claimed, err = ss.Webhook().ClaimOutgoingDeliveryRetry(due.Id)
+	require.NoError(t, err)
+	require.False(t, claimed, "a retry should only be claimed once")
+
+	claimed, err = ss.Webhook().ClaimOutgoingDeliveryRetry(noRetry.Id)
+	require.NoError(t, err)
+	require.False(t, claimed, "a delivery without a pending retry can't be claimed")
+
+	deliveries, err = ss.Webhook().GetOutgoingDeliveriesToRetry(now, 1000)
+	require.NoError(t, err)
+	for _, d := range deliveries {
+		require.NotEqual(t, due.Id, d.Id)
+	}
+}
+
+func testWebhookStorePermanentDeleteOutgoingDeliveriesBefore(t *testing.T, rctx request.CTX, ss store.Store) {
+	hookID := model.NewId()
+
+	old := buildOutgoingWebhookDelivery(hookID)
+	old.CreateAt = 1000
+	_, err := ss.Webhook().SaveOutgoingDelivery(old)
+	require.NoError(t, err)
+
+	oldPendingRetry := buildOutgoingWebhookDelivery(hookID)
+	oldPendingRetry.CreateAt = 1000
+	oldPendingRetry.Status = model.OutgoingWebhookDeliveryStatusFailed
+	oldPendingRetry.NextRetryAt = model.GetMillis()
+	_, err = ss.Webhook().SaveOutgoingDelivery(oldPendingRetry)
+	require.NoError(t, err)
+
+	recent := buildOutgoingWebhookDelivery(hookID)
+	_, err = ss.Webhook().SaveOutgoingDelivery(recent)
+	require.NoError(t, err)
+
+	deleted, err := ss.Webhook().PermanentDeleteOutgoingDeliveriesBefore(2000)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, deleted, int64(1))
+
+	deliveries, err := ss.Webhook().GetOutgoingDeliveries(hookID, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	require.Equal(t, recent.Id, deliveries[0].Id)
+	require.Equal(t, oldPendingRetry.Id, deliveries[1].Id)
 }
 
 func testWebhookStoreCountIncoming(t *testing.T, rctx request.CTX, ss store.Store) {
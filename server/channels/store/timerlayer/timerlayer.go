@@ -42,6 +42,7 @@ type TimerLayer struct {
 	PluginStore                     store.PluginStore
 	PostStore                       store.PostStore
 	PostAcknowledgementStore        store.PostAcknowledgementStore
+	PostEscalationStore             store.PostEscalationStore
 	PostPersistentNotificationStore store.PostPersistentNotificationStore
 	PostPriorityStore               store.PostPriorityStore
 	PreferenceStore                 store.PreferenceStore
@@ -54,6 +55,7 @@ type TimerLayer struct {
 	SchemeStore                     store.SchemeStore
 	SessionStore                    store.SessionStore
 	SharedChannelStore              store.SharedChannelStore
+	SharedDraftStore                store.SharedDraftStore
 	StatusStore                     store.StatusStore
 	SystemStore                     store.SystemStore
 	TeamStore                       store.TeamStore
@@ -64,6 +66,7 @@ type TimerLayer struct {
 	UserStore                       store.UserStore
 	UserAccessTokenStore            store.UserAccessTokenStore
 	UserTermsOfServiceStore         store.UserTermsOfServiceStore
+	WebAuthnCredentialStore         store.WebAuthnCredentialStore
 	WebhookStore                    store.WebhookStore
 }
 
@@ -159,6 +162,10 @@ func (s *TimerLayer) PostAcknowledgement() store.PostAcknowledgementStore {
 	return s.PostAcknowledgementStore
 }
 
+func (s *TimerLayer) PostEscalation() store.PostEscalationStore {
+	return s.PostEscalationStore
+}
+
 func (s *TimerLayer) PostPersistentNotification() store.PostPersistentNotificationStore {
 	return s.PostPersistentNotificationStore
 }
@@ -207,6 +214,10 @@ func (s *TimerLayer) SharedChannel() store.SharedChannelStore {
 	return s.SharedChannelStore
 }
 
+func (s *TimerLayer) SharedDraft() store.SharedDraftStore {
+	return s.SharedDraftStore
+}
+
 func (s *TimerLayer) Status() store.StatusStore {
 	return s.StatusStore
 }
@@ -247,6 +258,10 @@ func (s *TimerLayer) UserTermsOfService() store.UserTermsOfServiceStore {
 	return s.UserTermsOfServiceStore
 }
 
+func (s *TimerLayer) WebAuthnCredential() store.WebAuthnCredentialStore {
+	return s.WebAuthnCredentialStore
+}
+
 func (s *TimerLayer) Webhook() store.WebhookStore {
 	return s.WebhookStore
 }
@@ -366,6 +381,11 @@ type TimerLayerPostAcknowledgementStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerPostEscalationStore struct {
+	store.PostEscalationStore
+	Root *TimerLayer
+}
+
 type TimerLayerPostPersistentNotificationStore struct {
 	store.PostPersistentNotificationStore
 	Root *TimerLayer
@@ -426,6 +446,11 @@ type TimerLayerSharedChannelStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerSharedDraftStore struct {
+	store.SharedDraftStore
+	Root *TimerLayer
+}
+
 type TimerLayerStatusStore struct {
 	store.StatusStore
 	Root *TimerLayer
@@ -476,6 +501,11 @@ type TimerLayerUserTermsOfServiceStore struct {
 	Root *TimerLayer
 }
 
+type TimerLayerWebAuthnCredentialStore struct {
+	store.WebAuthnCredentialStore
+	Root *TimerLayer
+}
+
 type TimerLayerWebhookStore struct {
 	store.WebhookStore
 	Root *TimerLayer
@@ -497,6 +527,22 @@ func (s *TimerLayerAuditStore) Get(userID string, offset int, limit int) (model.
 	return result, err
 }
 
+func (s *TimerLayerAuditStore) GetRecordsAfter(sequence int64, limit int) ([]*model.AuditRecord, error) {
+	start := time.Now()
+
+	result, err := s.AuditStore.GetRecordsAfter(sequence, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.GetRecordsAfter", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerAuditStore) PermanentDeleteByUser(userID string) error {
 	start := time.Now()
 
@@ -529,6 +575,38 @@ func (s *TimerLayerAuditStore) Save(audit *model.Audit) error {
 	return err
 }
 
+func (s *TimerLayerAuditStore) SaveRecord(record *model.AuditRecord) (*model.AuditRecord, error) {
+	start := time.Now()
+
+	result, err := s.AuditStore.SaveRecord(record)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.SaveRecord", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerAuditStore) SearchRecords(opts model.AuditRecordSearchOpts) ([]*model.AuditRecord, error) {
+	start := time.Now()
+
+	result, err := s.AuditStore.SearchRecords(opts)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("AuditStore.SearchRecords", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerBotStore) Get(userID string, includeDeleted bool) (*model.Bot, error) {
 	start := time.Now()
 
@@ -2616,6 +2694,22 @@ func (s *TimerLayerChannelBookmarkStore) GetBookmarksForChannelSince(channelID s
 	return result, err
 }
 
+func (s *TimerLayerChannelBookmarkStore) GetLinkBookmarksToCheck(checkedBefore int64, limit int) ([]*model.ChannelBookmarkWithFileInfo, error) {
+	start := time.Now()
+
+	result, err := s.ChannelBookmarkStore.GetLinkBookmarksToCheck(checkedBefore, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.GetLinkBookmarksToCheck", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerChannelBookmarkStore) Save(bookmark *model.ChannelBookmark, increaseSortOrder bool) (*model.ChannelBookmarkWithFileInfo, error) {
 	start := time.Now()
 
@@ -2648,6 +2742,38 @@ func (s *TimerLayerChannelBookmarkStore) Update(bookmark *model.ChannelBookmark)
 	return err
 }
 
+func (s *TimerLayerChannelBookmarkStore) UpdateLinkCheck(bookmark *model.ChannelBookmark, updateAt int64) error {
+	start := time.Now()
+
+	err := s.ChannelBookmarkStore.UpdateLinkCheck(bookmark, updateAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.UpdateLinkCheck", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerChannelBookmarkStore) UpdateLinkCheckedAt(bookmarkIDs []string, checkedAt int64) error {
+	start := time.Now()
+
+	err := s.ChannelBookmarkStore.UpdateLinkCheckedAt(bookmarkIDs, checkedAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.UpdateLinkCheckedAt", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerChannelBookmarkStore) UpdateSortOrder(bookmarkID string, channelID string, newIndex int64) ([]*model.ChannelBookmarkWithFileInfo, error) {
 	start := time.Now()
 
@@ -2664,6 +2790,22 @@ func (s *TimerLayerChannelBookmarkStore) UpdateSortOrder(bookmarkID string, chan
 	return result, err
 }
 
+func (s *TimerLayerChannelBookmarkStore) UpsertForSync(bookmark *model.ChannelBookmark) error {
+	start := time.Now()
+
+	err := s.ChannelBookmarkStore.UpsertForSync(bookmark)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelBookmarkStore.UpsertForSync", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerChannelMemberHistoryStore) DeleteOrphanedRows(limit int) (int64, error) {
 	start := time.Now()
 
@@ -2696,6 +2838,22 @@ func (s *TimerLayerChannelMemberHistoryStore) GetChannelsLeftSince(userID string
 	return result, err
 }
 
+func (s *TimerLayerChannelMemberHistoryStore) GetMembershipChangesSince(channelID string, since int64, limit int) ([]*model.ChannelMemberHistoryResult, error) {
+	start := time.Now()
+
+	result, err := s.ChannelMemberHistoryStore.GetMembershipChangesSince(channelID, since, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("ChannelMemberHistoryStore.GetMembershipChangesSince", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerChannelMemberHistoryStore) GetUsersInChannelDuring(startTime int64, endTime int64, channelID string) ([]*model.ChannelMemberHistoryResult, error) {
 	start := time.Now()
 
@@ -3670,6 +3828,22 @@ func (s *TimerLayerFileInfoStore) GetFromMaster(id string) (*model.FileInfo, err
 	return result, err
 }
 
+func (s *TimerLayerFileInfoStore) GetOrphanedBlobs(updatedBefore int64, limit int) ([]*model.FileBlob, error) {
+	start := time.Now()
+
+	result, err := s.FileInfoStore.GetOrphanedBlobs(updatedBefore, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetOrphanedBlobs", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerFileInfoStore) GetStorageUsage(allowFromCache bool, includeDeleted bool) (int64, error) {
 	start := time.Now()
 
@@ -3718,6 +3892,22 @@ func (s *TimerLayerFileInfoStore) GetWithOptions(page int, perPage int, opt *mod
 	return result, err
 }
 
+func (s *TimerLayerFileInfoStore) GetWithoutContentHash(afterID string, limit int) ([]*model.FileInfo, error) {
+	start := time.Now()
+
+	result, err := s.FileInfoStore.GetWithoutContentHash(afterID, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.GetWithoutContentHash", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerFileInfoStore) InvalidateFileInfosForPostCache(postID string, deleted bool) {
 	start := time.Now()
 
@@ -3765,6 +3955,22 @@ func (s *TimerLayerFileInfoStore) PermanentDeleteBatch(ctx request.CTX, endTime
 	return result, err
 }
 
+func (s *TimerLayerFileInfoStore) PermanentDeleteBlob(contentHash string, updatedBefore int64) (bool, error) {
+	start := time.Now()
+
+	result, err := s.FileInfoStore.PermanentDeleteBlob(contentHash, updatedBefore)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.PermanentDeleteBlob", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerFileInfoStore) PermanentDeleteByUser(ctx request.CTX, userID string) (int64, error) {
 	start := time.Now()
 
@@ -3845,6 +4051,38 @@ func (s *TimerLayerFileInfoStore) SetContent(ctx request.CTX, fileID string, con
 	return err
 }
 
+func (s *TimerLayerFileInfoStore) SetContentHash(ctx request.CTX, path string, contentHash string, blobPath string) (int64, error) {
+	start := time.Now()
+
+	result, err := s.FileInfoStore.SetContentHash(ctx, path, contentHash, blobPath)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.SetContentHash", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerFileInfoStore) TouchBlob(contentHash string) (bool, error) {
+	start := time.Now()
+
+	result, err := s.FileInfoStore.TouchBlob(contentHash)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("FileInfoStore.TouchBlob", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerFileInfoStore) Upsert(rctx request.CTX, info *model.FileInfo) (*model.FileInfo, error) {
 	start := time.Now()
 
@@ -5317,6 +5555,22 @@ func (s *TimerLayerOAuthStore) GetPreviousAccessData(userID string, clientID str
 	return result, err
 }
 
+func (s *TimerLayerOAuthStore) ImportApp(app *model.OAuthApp) (*model.OAuthApp, error) {
+	start := time.Now()
+
+	result, err := s.OAuthStore.ImportApp(app)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("OAuthStore.ImportApp", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerOAuthStore) PermanentDeleteAuthDataByUser(userID string) error {
 	start := time.Now()
 
@@ -5796,6 +6050,22 @@ func (s *TimerLayerPostStore) Delete(rctx request.CTX, postID string, timestamp
 	return err
 }
 
+func (s *TimerLayerPostStore) DeletePostReminder(postID string, userID string) error {
+	start := time.Now()
+
+	err := s.PostStore.DeletePostReminder(postID, userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.DeletePostReminder", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerPostStore) Get(ctx context.Context, id string, opts model.GetPostsOptions, userID string, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	start := time.Now()
 
@@ -6036,6 +6306,22 @@ func (s *TimerLayerPostStore) GetPostIdBeforeTime(channelID string, timestamp in
 	return result, err
 }
 
+func (s *TimerLayerPostStore) GetPostReminder(postID string, userID string) (*model.PostReminder, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostReminder(postID, userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostReminder", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPostStore) GetPostReminderMetadata(postID string) (*store.PostReminderMetadata, error) {
 	start := time.Now()
 
@@ -6068,6 +6354,22 @@ func (s *TimerLayerPostStore) GetPostReminders(now int64) ([]*model.PostReminder
 	return result, err
 }
 
+func (s *TimerLayerPostStore) GetPostRemindersForUser(userID string) ([]*model.PostReminder, error) {
+	start := time.Now()
+
+	result, err := s.PostStore.GetPostRemindersForUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostStore.GetPostRemindersForUser", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerPostStore) GetPosts(options model.GetPostsOptions, allowFromCache bool, sanitizeOptions map[string]bool) (*model.PostList, error) {
 	start := time.Now()
 
@@ -6547,10 +6849,10 @@ func (s *TimerLayerPostAcknowledgementStore) GetForPosts(postIds []string) ([]*m
 	return result, err
 }
 
-func (s *TimerLayerPostAcknowledgementStore) Save(postID string, userID string, acknowledgedAt int64) (*model.PostAcknowledgement, error) {
+func (s *TimerLayerPostAcknowledgementStore) GetForPostsSince(postIDs []string, since int64, excludeRemoteID string, inclDeleted bool) ([]*model.PostAcknowledgement, error) {
 	start := time.Now()
 
-	result, err := s.PostAcknowledgementStore.Save(postID, userID, acknowledgedAt)
+	result, err := s.PostAcknowledgementStore.GetForPostsSince(postIDs, since, excludeRemoteID, inclDeleted)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6558,15 +6860,15 @@ func (s *TimerLayerPostAcknowledgementStore) Save(postID string, userID string,
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Save", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.GetForPostsSince", success, elapsed)
 	}
 	return result, err
 }
 
-func (s *TimerLayerPostPersistentNotificationStore) Delete(postIds []string) error {
+func (s *TimerLayerPostAcknowledgementStore) Save(postID string, userID string, acknowledgedAt int64) (*model.PostAcknowledgement, error) {
 	start := time.Now()
 
-	err := s.PostPersistentNotificationStore.Delete(postIds)
+	result, err := s.PostAcknowledgementStore.Save(postID, userID, acknowledgedAt)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6574,15 +6876,15 @@ func (s *TimerLayerPostPersistentNotificationStore) Delete(postIds []string) err
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostPersistentNotificationStore.Delete", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.Save", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPostPersistentNotificationStore) DeleteByChannel(channelIds []string) error {
+func (s *TimerLayerPostAcknowledgementStore) SaveForSync(acknowledgement *model.PostAcknowledgement) (*model.PostAcknowledgement, error) {
 	start := time.Now()
 
-	err := s.PostPersistentNotificationStore.DeleteByChannel(channelIds)
+	result, err := s.PostAcknowledgementStore.SaveForSync(acknowledgement)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6590,15 +6892,15 @@ func (s *TimerLayerPostPersistentNotificationStore) DeleteByChannel(channelIds [
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostPersistentNotificationStore.DeleteByChannel", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostAcknowledgementStore.SaveForSync", success, elapsed)
 	}
-	return err
+	return result, err
 }
 
-func (s *TimerLayerPostPersistentNotificationStore) DeleteByTeam(teamIds []string) error {
+func (s *TimerLayerPostEscalationStore) Get(postID string) (*model.PostEscalation, error) {
 	start := time.Now()
 
-	err := s.PostPersistentNotificationStore.DeleteByTeam(teamIds)
+	result, err := s.PostEscalationStore.Get(postID)
 
 	elapsed := float64(time.Since(start)) / float64(time.Second)
 	if s.Root.Metrics != nil {
@@ -6606,9 +6908,121 @@ func (s *TimerLayerPostPersistentNotificationStore) DeleteByTeam(teamIds []strin
 		if err == nil {
 			success = "true"
 		}
-		s.Root.Metrics.ObserveStoreMethodDuration("PostPersistentNotificationStore.DeleteByTeam", success, elapsed)
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEscalationStore.Get", success, elapsed)
 	}
-	return err
+	return result, err
+}
+
+func (s *TimerLayerPostEscalationStore) GetDue(now int64, limit int) ([]*model.PostEscalation, error) {
+	start := time.Now()
+
+	result, err := s.PostEscalationStore.GetDue(now, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEscalationStore.GetDue", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostEscalationStore) GetForPosts(postIDs []string) ([]*model.PostEscalation, error) {
+	start := time.Now()
+
+	result, err := s.PostEscalationStore.GetForPosts(postIDs)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEscalationStore.GetForPosts", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostEscalationStore) Save(escalation *model.PostEscalation) (*model.PostEscalation, error) {
+	start := time.Now()
+
+	result, err := s.PostEscalationStore.Save(escalation)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEscalationStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerPostEscalationStore) Update(escalation *model.PostEscalation) error {
+	start := time.Now()
+
+	err := s.PostEscalationStore.Update(escalation)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostEscalationStore.Update", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPostPersistentNotificationStore) Delete(postIds []string) error {
+	start := time.Now()
+
+	err := s.PostPersistentNotificationStore.Delete(postIds)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostPersistentNotificationStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPostPersistentNotificationStore) DeleteByChannel(channelIds []string) error {
+	start := time.Now()
+
+	err := s.PostPersistentNotificationStore.DeleteByChannel(channelIds)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostPersistentNotificationStore.DeleteByChannel", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerPostPersistentNotificationStore) DeleteByTeam(teamIds []string) error {
+	start := time.Now()
+
+	err := s.PostPersistentNotificationStore.DeleteByTeam(teamIds)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("PostPersistentNotificationStore.DeleteByTeam", success, elapsed)
+	}
+	return err
 }
 
 func (s *TimerLayerPostPersistentNotificationStore) DeleteExpired(maxSentCount int16) error {
@@ -7235,6 +7649,22 @@ func (s *TimerLayerRemoteClusterStore) Save(rc *model.RemoteCluster) (*model.Rem
 	return result, err
 }
 
+func (s *TimerLayerRemoteClusterStore) SetCapabilities(remoteClusterID string, capabilities model.Bitmask) error {
+	start := time.Now()
+
+	err := s.RemoteClusterStore.SetCapabilities(remoteClusterID, capabilities)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("RemoteClusterStore.SetCapabilities", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerRemoteClusterStore) SetLastPingAt(remoteClusterID string) error {
 	start := time.Now()
 
@@ -8691,6 +9121,22 @@ func (s *TimerLayerSharedChannelStore) UpdateRemote(remote *model.SharedChannelR
 	return result, err
 }
 
+func (s *TimerLayerSharedChannelStore) UpdateRemoteBookmarkCursor(id string, lastBookmarkUpdateAt int64) error {
+	start := time.Now()
+
+	err := s.SharedChannelStore.UpdateRemoteBookmarkCursor(id, lastBookmarkUpdateAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateRemoteBookmarkCursor", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor model.GetPostsSinceForSyncCursor) error {
 	start := time.Now()
 
@@ -8707,6 +9153,22 @@ func (s *TimerLayerSharedChannelStore) UpdateRemoteCursor(id string, cursor mode
 	return err
 }
 
+func (s *TimerLayerSharedChannelStore) UpdateRemoteMembershipCursor(id string, lastMembersSyncAt int64) error {
+	start := time.Now()
+
+	err := s.SharedChannelStore.UpdateRemoteMembershipCursor(id, lastMembersSyncAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedChannelStore.UpdateRemoteMembershipCursor", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerSharedChannelStore) UpdateUserLastSyncAt(userID string, channelID string, remoteID string) error {
 	start := time.Now()
 
@@ -8739,6 +9201,134 @@ func (s *TimerLayerSharedChannelStore) UpsertAttachment(remote *model.SharedChan
 	return result, err
 }
 
+func (s *TimerLayerSharedDraftStore) Delete(id string) error {
+	start := time.Now()
+
+	err := s.SharedDraftStore.Delete(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedDraftStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerSharedDraftStore) DeleteIfUnchanged(id string, lastUpdateAt int64) error {
+	start := time.Now()
+
+	err := s.SharedDraftStore.DeleteIfUnchanged(id, lastUpdateAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedDraftStore.DeleteIfUnchanged", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerSharedDraftStore) DeleteMember(id string, userID string) error {
+	start := time.Now()
+
+	err := s.SharedDraftStore.DeleteMember(id, userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedDraftStore.DeleteMember", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerSharedDraftStore) Get(id string) (*model.SharedDraft, error) {
+	start := time.Now()
+
+	result, err := s.SharedDraftStore.Get(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedDraftStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerSharedDraftStore) GetForUser(userID string) ([]*model.SharedDraft, error) {
+	start := time.Now()
+
+	result, err := s.SharedDraftStore.GetForUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedDraftStore.GetForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerSharedDraftStore) Save(draft *model.SharedDraft) (*model.SharedDraft, error) {
+	start := time.Now()
+
+	result, err := s.SharedDraftStore.Save(draft)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedDraftStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerSharedDraftStore) SaveMembers(id string, userIDs []string) error {
+	start := time.Now()
+
+	err := s.SharedDraftStore.SaveMembers(id, userIDs)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedDraftStore.SaveMembers", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerSharedDraftStore) Update(draft *model.SharedDraft, lastUpdateAt int64) (*model.SharedDraft, error) {
+	start := time.Now()
+
+	result, err := s.SharedDraftStore.Update(draft, lastUpdateAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("SharedDraftStore.Update", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerStatusStore) Get(userID string) (*model.Status, error) {
 	start := time.Now()
 
@@ -11724,6 +12314,22 @@ func (s *TimerLayerUserStore) UpdatePassword(userID string, newPassword string)
 	return err
 }
 
+func (s *TimerLayerUserStore) UpdatePasswordHash(userID string, oldHash string, newHash string) error {
+	start := time.Now()
+
+	err := s.UserStore.UpdatePasswordHash(userID, oldHash, newHash)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("UserStore.UpdatePasswordHash", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerUserStore) UpdateUpdateAt(userID string) (int64, error) {
 	start := time.Now()
 
@@ -11964,6 +12570,102 @@ func (s *TimerLayerUserTermsOfServiceStore) Save(userTermsOfService *model.UserT
 	return result, err
 }
 
+func (s *TimerLayerWebAuthnCredentialStore) Delete(id string) error {
+	start := time.Now()
+
+	err := s.WebAuthnCredentialStore.Delete(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.Delete", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) Get(id string) (*model.WebAuthnCredential, error) {
+	start := time.Now()
+
+	result, err := s.WebAuthnCredentialStore.Get(id)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.Get", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) GetForUser(userID string) ([]*model.WebAuthnCredential, error) {
+	start := time.Now()
+
+	result, err := s.WebAuthnCredentialStore.GetForUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.GetForUser", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) PermanentDeleteByUser(userID string) error {
+	start := time.Now()
+
+	err := s.WebAuthnCredentialStore.PermanentDeleteByUser(userID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.PermanentDeleteByUser", success, elapsed)
+	}
+	return err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) Save(credential *model.WebAuthnCredential) (*model.WebAuthnCredential, error) {
+	start := time.Now()
+
+	result, err := s.WebAuthnCredentialStore.Save(credential)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.Save", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebAuthnCredentialStore) UpdateSignCount(id string, signCount int64, lastUsedAt int64) error {
+	start := time.Now()
+
+	err := s.WebAuthnCredentialStore.UpdateSignCount(id, signCount, lastUsedAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebAuthnCredentialStore.UpdateSignCount", success, elapsed)
+	}
+	return err
+}
+
 func (s *TimerLayerWebhookStore) AnalyticsIncomingCount(teamID string, userID string) (int64, error) {
 	start := time.Now()
 
@@ -11996,6 +12698,22 @@ func (s *TimerLayerWebhookStore) AnalyticsOutgoingCount(teamID string) (int64, e
 	return result, err
 }
 
+func (s *TimerLayerWebhookStore) ClaimOutgoingDeliveryRetry(deliveryID string) (bool, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.ClaimOutgoingDeliveryRetry(deliveryID)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.ClaimOutgoingDeliveryRetry", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerWebhookStore) ClearCaches() {
 	start := time.Now()
 
@@ -12219,6 +12937,38 @@ func (s *TimerLayerWebhookStore) GetOutgoingByTeamByUser(teamID string, userID s
 	return result, err
 }
 
+func (s *TimerLayerWebhookStore) GetOutgoingDeliveries(hookID string, offset int, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.GetOutgoingDeliveries(hookID, offset, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.GetOutgoingDeliveries", success, elapsed)
+	}
+	return result, err
+}
+
+func (s *TimerLayerWebhookStore) GetOutgoingDeliveriesToRetry(before int64, limit int) ([]*model.OutgoingWebhookDelivery, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.GetOutgoingDeliveriesToRetry(before, limit)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.GetOutgoingDeliveriesToRetry", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerWebhookStore) GetOutgoingList(offset int, limit int) ([]*model.OutgoingWebhook, error) {
 	start := time.Now()
 
@@ -12251,6 +13001,22 @@ func (s *TimerLayerWebhookStore) GetOutgoingListByUser(userID string, offset int
 	return result, err
 }
 
+func (s *TimerLayerWebhookStore) ImportIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.ImportIncoming(webhook)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.ImportIncoming", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerWebhookStore) InvalidateWebhookCache(webhook string) {
 	start := time.Now()
 
@@ -12330,6 +13096,22 @@ func (s *TimerLayerWebhookStore) PermanentDeleteOutgoingByUser(userID string) er
 	return err
 }
 
+func (s *TimerLayerWebhookStore) PermanentDeleteOutgoingDeliveriesBefore(createAt int64) (int64, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.PermanentDeleteOutgoingDeliveriesBefore(createAt)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.PermanentDeleteOutgoingDeliveriesBefore", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerWebhookStore) SaveIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	start := time.Now()
 
@@ -12362,6 +13144,22 @@ func (s *TimerLayerWebhookStore) SaveOutgoing(webhook *model.OutgoingWebhook) (*
 	return result, err
 }
 
+func (s *TimerLayerWebhookStore) SaveOutgoingDelivery(delivery *model.OutgoingWebhookDelivery) (*model.OutgoingWebhookDelivery, error) {
+	start := time.Now()
+
+	result, err := s.WebhookStore.SaveOutgoingDelivery(delivery)
+
+	elapsed := float64(time.Since(start)) / float64(time.Second)
+	if s.Root.Metrics != nil {
+		success := "false"
+		if err == nil {
+			success = "true"
+		}
+		s.Root.Metrics.ObserveStoreMethodDuration("WebhookStore.SaveOutgoingDelivery", success, elapsed)
+	}
+	return result, err
+}
+
 func (s *TimerLayerWebhookStore) UpdateIncoming(webhook *model.IncomingWebhook) (*model.IncomingWebhook, error) {
 	start := time.Now()
 
@@ -12459,6 +13257,7 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	newStore.PluginStore = &TimerLayerPluginStore{PluginStore: childStore.Plugin(), Root: &newStore}
 	newStore.PostStore = &TimerLayerPostStore{PostStore: childStore.Post(), Root: &newStore}
 	newStore.PostAcknowledgementStore = &TimerLayerPostAcknowledgementStore{PostAcknowledgementStore: childStore.PostAcknowledgement(), Root: &newStore}
+	newStore.PostEscalationStore = &TimerLayerPostEscalationStore{PostEscalationStore: childStore.PostEscalation(), Root: &newStore}
 	newStore.PostPersistentNotificationStore = &TimerLayerPostPersistentNotificationStore{PostPersistentNotificationStore: childStore.PostPersistentNotification(), Root: &newStore}
 	newStore.PostPriorityStore = &TimerLayerPostPriorityStore{PostPriorityStore: childStore.PostPriority(), Root: &newStore}
 	newStore.PreferenceStore = &TimerLayerPreferenceStore{PreferenceStore: childStore.Preference(), Root: &newStore}
@@ -12471,6 +13270,7 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	newStore.SchemeStore = &TimerLayerSchemeStore{SchemeStore: childStore.Scheme(), Root: &newStore}
 	newStore.SessionStore = &TimerLayerSessionStore{SessionStore: childStore.Session(), Root: &newStore}
 	newStore.SharedChannelStore = &TimerLayerSharedChannelStore{SharedChannelStore: childStore.SharedChannel(), Root: &newStore}
+	newStore.SharedDraftStore = &TimerLayerSharedDraftStore{SharedDraftStore: childStore.SharedDraft(), Root: &newStore}
 	newStore.StatusStore = &TimerLayerStatusStore{StatusStore: childStore.Status(), Root: &newStore}
 	newStore.SystemStore = &TimerLayerSystemStore{SystemStore: childStore.System(), Root: &newStore}
 	newStore.TeamStore = &TimerLayerTeamStore{TeamStore: childStore.Team(), Root: &newStore}
@@ -12481,6 +13281,7 @@ func New(childStore store.Store, metrics einterfaces.MetricsInterface) *TimerLay
 	newStore.UserStore = &TimerLayerUserStore{UserStore: childStore.User(), Root: &newStore}
 	newStore.UserAccessTokenStore = &TimerLayerUserAccessTokenStore{UserAccessTokenStore: childStore.UserAccessToken(), Root: &newStore}
 	newStore.UserTermsOfServiceStore = &TimerLayerUserTermsOfServiceStore{UserTermsOfServiceStore: childStore.UserTermsOfService(), Root: &newStore}
+	newStore.WebAuthnCredentialStore = &TimerLayerWebAuthnCredentialStore{WebAuthnCredentialStore: childStore.WebAuthnCredential(), Root: &newStore}
 	newStore.WebhookStore = &TimerLayerWebhookStore{WebhookStore: childStore.Webhook(), Root: &newStore}
 	return &newStore
 }
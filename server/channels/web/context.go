@@ -199,6 +199,13 @@ func (c *Context) MfaRequired() {
 		c.Err = model.NewAppError("MfaRequired", "api.context.mfa_required.app_error", nil, "", http.StatusForbidden)
 		return
 	}
+
+	// When enforced, the session must have been authenticated with a security key or passkey, unless
+	// it is the session of a personal access token or bot, which no authenticator can be used with
+	if *c.App.Config().ServiceSettings.EnableWebAuthn && *c.App.Config().ServiceSettings.EnforceWebAuthn && !c.AppContext.Session().IsIntegration() && c.AppContext.Session().Props[model.SessionPropWebAuthn] != "true" {
+		c.Err = model.NewAppError("MfaRequired", "api.context.webauthn_required.app_error", nil, "", http.StatusForbidden)
+		return
+	}
 }
 
 // ExtendSessionExpiryIfNeeded will update Session.ExpiresAt based on session lengths in config.
@@ -663,6 +670,28 @@ func (c *Context) RequireJobId() *Context {
 	return c
 }
 
+func (c *Context) RequireSharedDraftId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.SharedDraftId) {
+		c.SetInvalidURLParam("shared_draft_id")
+	}
+	return c
+}
+
+func (c *Context) RequireWebAuthnCredentialId() *Context {
+	if c.Err != nil {
+		return c
+	}
+
+	if !model.IsValidId(c.Params.WebAuthnCredentialId) {
+		c.SetInvalidURLParam("credential_id")
+	}
+	return c
+}
+
 func (c *Context) RequireJobType() *Context {
 	if c.Err != nil {
 		return c
@@ -89,3 +89,72 @@ func TestMfaRequired(t *testing.T) {
 
 	assert.Equal(t, c.Err.Id, "api.context.get_user.app_error")
 }
+
+func TestMfaRequiredWebAuthn(t *testing.T) {
+	th := SetupWithStoreMock(t)
+	defer th.TearDown()
+
+	mockStore := th.App.Srv().Store().(*mocks.Store)
+	mockUserStore := mocks.UserStore{}
+	mockUserStore.On("Count", mock.Anything).Return(int64(10), nil)
+	mockUserStore.On("Get", context.Background(), "userid").Return(&model.User{Id: "userid", MfaActive: true}, nil)
+	mockPostStore := mocks.PostStore{}
+	mockPostStore.On("GetMaxPostSize").Return(65535, nil)
+	mockSystemStore := mocks.SystemStore{}
+	mockSystemStore.On("GetByName", "UpgradedFromTE").Return(&model.System{Name: "UpgradedFromTE", Value: "false"}, nil)
+	mockSystemStore.On("GetByName", "InstallationDate").Return(&model.System{Name: "InstallationDate", Value: "10"}, nil)
+
+	mockStore.On("User").Return(&mockUserStore)
+	mockStore.On("Post").Return(&mockPostStore)
+	mockStore.On("System").Return(&mockSystemStore)
+	mockStore.On("GetDBSchemaVersion").Return(1, nil)
+
+	th.App.Srv().SetLicense(model.NewTestLicense("mfa"))
+
+	th.App.UpdateConfig(func(cfg *model.Config) {
+		*cfg.AnnouncementSettings.UserNoticesEnabled = false
+		*cfg.AnnouncementSettings.AdminNoticesEnabled = false
+		*cfg.ServiceSettings.EnableMultifactorAuthentication = true
+		*cfg.ServiceSettings.EnforceMultifactorAuthentication = true
+		*cfg.ServiceSettings.EnableWebAuthn = true
+		*cfg.ServiceSettings.EnforceWebAuthn = true
+	})
+
+	for name, tc := range map[string]struct {
+		Session     *model.Session
+		ExpectedErr string
+	}{
+		"password session": {
+			Session:     &model.Session{Id: "abc", UserId: "userid"},
+			ExpectedErr: "api.context.webauthn_required.app_error",
+		},
+		"webauthn session": {
+			Session: &model.Session{Id: "abc", UserId: "userid", Props: model.StringMap{model.SessionPropWebAuthn: "true"}},
+		},
+		"personal access token session": {
+			Session: &model.Session{Id: "abc", UserId: "userid", Props: model.StringMap{model.SessionPropType: model.SessionTypeUserAccessToken}},
+		},
+		"bot session": {
+			Session: &model.Session{Id: "abc", UserId: "userid", Props: model.StringMap{model.SessionPropIsBot: model.SessionPropIsBotValue}},
+		},
+		"oauth session": {
+			Session: &model.Session{Id: "abc", UserId: "userid", IsOAuth: true},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := &Context{
+				App:        th.App,
+				AppContext: th.Context.WithSession(tc.Session),
+			}
+
+			c.MfaRequired()
+
+			if tc.ExpectedErr == "" {
+				assert.Nil(t, c.Err)
+			} else {
+				require.NotNil(t, c.Err)
+				assert.Equal(t, tc.ExpectedErr, c.Err.Id)
+			}
+		})
+	}
+}
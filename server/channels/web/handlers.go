@@ -288,6 +288,11 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Rate limit by the classes matching the route and method, before looking up the session
+	if c.App.Srv().RateLimiter != nil && c.App.Srv().RateLimiter.ClassRateLimitByAddr(r, w) {
+		return
+	}
+
 	token, tokenLocation := app.ParseAuthTokenFromRequest(r)
 
 	if token != "" && tokenLocation != app.TokenLocationCloudHeader && tokenLocation != app.TokenLocationRemoteClusterHeader {
@@ -351,6 +356,13 @@ func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	)
 	c.AppContext = c.AppContext.WithLogger(c.Logger)
 
+	// Rate limit by the classes matching the user roles
+	if c.Err == nil && c.App.Srv().RateLimiter != nil {
+		if c.App.Srv().RateLimiter.ClassRateLimit(c.AppContext.Session(), r, w) {
+			return
+		}
+	}
+
 	if c.Err == nil && h.RequireSession {
 		c.SessionRequired()
 	}
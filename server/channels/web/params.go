@@ -109,6 +109,12 @@ type Params struct {
 	ChannelBookmarkId string
 	BookmarksSince    int64
 
+	// Drafts
+	SharedDraftId string
+
+	// WebAuthn
+	WebAuthnCredentialId string
+
 	// Cloud
 	InvoiceId string
 }
@@ -178,6 +184,8 @@ func ParamsFromRequest(r *http.Request) *Params {
 	params.ExcludeHome, _ = strconv.ParseBool(query.Get("exclude_home"))
 	params.ExcludeRemote, _ = strconv.ParseBool(query.Get("exclude_remote"))
 	params.ChannelBookmarkId = props["bookmark_id"]
+	params.SharedDraftId = props["shared_draft_id"]
+	params.WebAuthnCredentialId = props["credential_id"]
 	params.Scope = query.Get("scope")
 
 	if val, err := strconv.Atoi(query.Get("page")); err != nil || val < 0 {
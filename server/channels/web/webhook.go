@@ -8,7 +8,6 @@ import (
 	"io"
 	"mime"
 	"net/http"
-	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/gorilla/schema"
@@ -63,10 +62,14 @@ func incomingWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
-	if mediaType == "application/x-www-form-urlencoded" {
-		payload := strings.NewReader(r.FormValue("payload"))
+	hook, err := c.App.GetIncomingWebhookForRequest(c.AppContext, id)
+	if err != nil {
+		c.Err = err
+		return
+	}
 
-		incomingWebhookPayload, err = decodePayload(payload)
+	if mediaType == "application/x-www-form-urlencoded" {
+		incomingWebhookPayload, err = c.App.DecodeIncomingWebhookPayload(hook, []byte(r.FormValue("payload")))
 		if err != nil {
 			c.Err = err
 			return
@@ -87,14 +90,25 @@ func incomingWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
 			return
 		}
 	} else {
-		incomingWebhookPayload, err = decodePayload(r.Body)
+		body, readErr := io.ReadAll(r.Body)
+		if readErr != nil {
+			c.Err = model.NewAppError("incomingWebhook",
+				"api.webhook.incoming.error",
+				nil,
+				"webhook_id="+id+", error: "+readErr.Error(),
+				http.StatusBadRequest,
+			)
+			return
+		}
+
+		incomingWebhookPayload, err = c.App.DecodeIncomingWebhookPayload(hook, body)
 		if err != nil {
 			c.Err = err
 			return
 		}
 	}
 
-	err = c.App.HandleIncomingWebhook(c.AppContext, id, incomingWebhookPayload)
+	err = c.App.HandleIncomingWebhook(c.AppContext, hook, incomingWebhookPayload)
 	if err != nil {
 		c.Err = err
 		return
@@ -123,13 +137,3 @@ func commandWebhook(c *Context, w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "text/plain")
 	w.Write([]byte("ok"))
 }
-
-func decodePayload(payload io.Reader) (*model.IncomingWebhookRequest, *model.AppError) {
-	incomingWebhookPayload, decodeError := model.IncomingWebhookRequestFromJSON(payload)
-
-	if decodeError != nil {
-		return nil, decodeError
-	}
-
-	return incomingWebhookPayload, nil
-}
@@ -5,6 +5,7 @@ package web
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -229,6 +230,53 @@ func TestIncomingWebhook(t *testing.T) {
 		assert.True(t, resp.StatusCode == http.StatusForbidden)
 	})
 
+	t.Run("RateLimitedWebhook", func(t *testing.T) {
+		hook, err := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id, RateLimitPerMinute: 1, RateLimitBurst: 1})
+		require.Nil(t, err)
+
+		apiHookURL := apiClient.URL + "/hooks/" + hook.Id
+
+		for i := 0; i < 2; i++ {
+			resp, err2 := http.Post(apiHookURL, "application/json", strings.NewReader("{\"text\":\"this is a test\"}"))
+			require.NoError(t, err2)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		}
+
+		resp, err2 := http.Post(apiHookURL, "application/json", strings.NewReader("{\"text\":\"this is a test\"}"))
+		require.NoError(t, err2)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+		var appErr model.AppError
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&appErr))
+		assert.Equal(t, "web.incoming_webhook.rate_limited.app_error", appErr.Id)
+	})
+
+	t.Run("TemplatedWebhook", func(t *testing.T) {
+		hook, err := th.App.CreateIncomingWebhookForChannel(th.BasicUser.Id, th.BasicChannel, &model.IncomingWebhook{ChannelId: th.BasicChannel.Id, PayloadTemplate: "{{ .repository.name }}: {{ .action }}"})
+		require.Nil(t, err)
+
+		apiHookURL := apiClient.URL + "/hooks/" + hook.Id
+
+		resp, err2 := http.Post(apiHookURL, "application/json", strings.NewReader(`{"action": "opened", "repository": {"name": "mattermost"}}`))
+		require.NoError(t, err2)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		posts, appErr := th.App.GetPosts(th.BasicChannel.Id, 0, 1)
+		require.Nil(t, appErr)
+		require.Len(t, posts.Order, 1)
+		assert.Equal(t, "mattermost: opened", posts.Posts[posts.Order[0]].Message)
+
+		resp, err2 = http.Post(apiHookURL, "application/json", strings.NewReader(`{"action": "opened"}`))
+		require.NoError(t, err2)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		var respErr model.AppError
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&respErr))
+		assert.Equal(t, "model.incoming_hook.payload_template.execute.app_error", respErr.Id)
+	})
+
 	t.Run("DisableWebhooks", func(t *testing.T) {
 		th.App.UpdateConfig(func(cfg *model.Config) { *cfg.ServiceSettings.EnableIncomingWebhooks = false })
 		resp, err := http.Post(url, "application/json", strings.NewReader("{\"text\":\"this is a test\"}"))
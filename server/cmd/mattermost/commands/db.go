@@ -325,8 +325,11 @@ func dbVersionCmdF(command *cobra.Command, args []string) error {
 func ConfigToFileBackendSettings(s *model.FileSettings, enableComplianceFeature bool, skipVerify bool) filestore.FileBackendSettings {
 	if *s.DriverName == model.ImageDriverLocal {
 		return filestore.FileBackendSettings{
-			DriverName: *s.DriverName,
-			Directory:  *s.Directory,
+			DriverName:                *s.DriverName,
+			Directory:                 *s.Directory,
+			EnableAtRestEncryption:    *s.EnableAtRestEncryption,
+			AtRestEncryptionKeyFile:   *s.AtRestEncryptionKeyFile,
+			AtRestEncryptionKeyEnvVar: *s.AtRestEncryptionKeyEnvVar,
 		}
 	}
 	return filestore.FileBackendSettings{
@@ -343,5 +346,8 @@ func ConfigToFileBackendSettings(s *model.FileSettings, enableComplianceFeature
 		AmazonS3Trace:                      s.AmazonS3Trace != nil && *s.AmazonS3Trace,
 		AmazonS3RequestTimeoutMilliseconds: *s.AmazonS3RequestTimeoutMilliseconds,
 		SkipVerify:                         skipVerify,
+		EnableAtRestEncryption:             *s.EnableAtRestEncryption,
+		AtRestEncryptionKeyFile:            *s.AtRestEncryptionKeyFile,
+		AtRestEncryptionKeyEnvVar:          *s.AtRestEncryptionKeyEnvVar,
 	}
 }
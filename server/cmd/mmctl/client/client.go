@@ -96,6 +96,9 @@ type Client interface {
 	PatchConfig(context.Context, *model.Config) (*model.Config, *model.Response, error)
 	ReloadConfig(ctx context.Context) (*model.Response, error)
 	MigrateConfig(ctx context.Context, from, to string) (*model.Response, error)
+	GetConfigHistory(ctx context.Context, page, perPage int) ([]*model.ConfigHistoryEntry, *model.Response, error)
+	GetConfigVersionDiff(ctx context.Context, configID string) ([]*model.ConfigChange, *model.Response, error)
+	RestoreConfigVersion(ctx context.Context, configID string) (*model.Config, *model.Response, error)
 	SyncLdap(ctx context.Context, includeRemovedMembers bool) (*model.Response, error)
 	MigrateIdLdap(ctx context.Context, toAttribute string) (*model.Response, error)
 	GetUsers(ctx context.Context, page, perPage int, etag string) ([]*model.User, *model.Response, error)
@@ -114,6 +117,8 @@ type Client interface {
 	ClearServerBusy(ctx context.Context) (*model.Response, error)
 	GetServerBusy(ctx context.Context) (*model.ServerBusyState, *model.Response, error)
 	CheckIntegrity(ctx context.Context) ([]model.IntegrityCheckResult, *model.Response, error)
+	SearchAuditRecords(ctx context.Context, opts model.AuditRecordSearchOpts) ([]*model.AuditRecord, *model.Response, error)
+	VerifyAuditRecords(ctx context.Context) (*model.AuditRecordVerification, *model.Response, error)
 	InstallPluginFromURL(context.Context, string, bool) (*model.Manifest, *model.Response, error)
 	InstallMarketplacePlugin(context.Context, *model.InstallMarketplacePluginRequest) (*model.Manifest, *model.Response, error)
 	GetMarketplacePlugins(context.Context, *model.MarketplacePluginFilter) ([]*model.MarketplacePlugin, *model.Response, error)
@@ -146,6 +151,8 @@ type Client interface {
 	GetOutgoingWebhooksForChannel(ctx context.Context, channelID string, page int, perPage int, etag string) ([]*model.OutgoingWebhook, *model.Response, error)
 	GetOutgoingWebhooksForTeam(ctx context.Context, teamID string, page int, perPage int, etag string) ([]*model.OutgoingWebhook, *model.Response, error)
 	RegenOutgoingHookToken(ctx context.Context, hookID string) (*model.OutgoingWebhook, *model.Response, error)
+	RegenOutgoingHookSecret(ctx context.Context, hookID string) (*model.OutgoingWebhook, *model.Response, error)
+	GetOutgoingWebhookDeliveries(ctx context.Context, hookID string, page int, perPage int) ([]*model.OutgoingWebhookDelivery, *model.Response, error)
 	DeleteOutgoingWebhook(ctx context.Context, hookID string) (*model.Response, error)
 	ListExports(ctx context.Context) ([]string, *model.Response, error)
 	DeleteExport(ctx context.Context, name string) (*model.Response, error)
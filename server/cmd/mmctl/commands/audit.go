@@ -0,0 +1,165 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+
+	"github.com/spf13/cobra"
+)
+
+var AuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Management of audit records",
+	Long:  "Search and verify the audit records stored in the database. Requires the database audit target to be enabled.",
+}
+
+var searchAuditRecordsCmd = &cobra.Command{
+	Use:   "search",
+	Short: "Search audit records",
+	Long:  "Search the audit records stored in the database, most recent first.",
+	Example: `  audit search
+	audit search --user userID --status fail
+	audit search --event login --since 2024-01-01T00:00:00+00:00 --until 2024-02-01T00:00:00+00:00
+	audit search --event login --all`,
+	Args: cobra.NoArgs,
+	RunE: withClient(searchAuditRecordsCmdF),
+}
+
+var verifyAuditRecordsCmd = &cobra.Command{
+	Use:     "verify",
+	Short:   "Verify the audit records hash chain",
+	Long:    "Verify that no audit record stored in the database was altered or removed by checking the hash chain linking them.",
+	Example: `  audit verify`,
+	Args:    cobra.NoArgs,
+	RunE:    withClient(verifyAuditRecordsCmdF),
+}
+
+func init() {
+	searchAuditRecordsCmd.Flags().String("user", "", "Filter by the ID of the user who performed the action")
+	searchAuditRecordsCmd.Flags().String("event", "", "Filter by event name")
+	searchAuditRecordsCmd.Flags().String("status", "", "Filter by status")
+	searchAuditRecordsCmd.Flags().String("since", "", "Only show records created at or after a certain time (ISO 8601)")
+	searchAuditRecordsCmd.Flags().String("until", "", "Only show records created at or before a certain time (ISO 8601)")
+	searchAuditRecordsCmd.Flags().Int("page", 0, "Page number to fetch for the list of audit records")
+	searchAuditRecordsCmd.Flags().Int("per-page", DefaultPageSize, "Number of audit records to be fetched")
+	searchAuditRecordsCmd.Flags().Bool("all", false, "Fetch all matching audit records. --page flag will be ignored if provided")
+
+	AuditCmd.AddCommand(
+		searchAuditRecordsCmd,
+		verifyAuditRecordsCmd,
+	)
+
+	RootCmd.AddCommand(AuditCmd)
+}
+
+func parseAuditTimeFlag(cmd *cobra.Command, name string) (int64, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return 0, err
+	}
+	if value == "" {
+		return 0, nil
+	}
+
+	t, err := time.Parse(ISO8601Layout, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s time '%s'", name, value)
+	}
+	return model.GetMillisForTime(t), nil
+}
+
+func searchAuditRecordsCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	var opts model.AuditRecordSearchOpts
+	var err error
+
+	if opts.UserId, err = cmd.Flags().GetString("user"); err != nil {
+		return err
+	}
+	if opts.EventName, err = cmd.Flags().GetString("event"); err != nil {
+		return err
+	}
+	if opts.Status, err = cmd.Flags().GetString("status"); err != nil {
+		return err
+	}
+	if opts.StartTime, err = parseAuditTimeFlag(cmd, "since"); err != nil {
+		return err
+	}
+	if opts.EndTime, err = parseAuditTimeFlag(cmd, "until"); err != nil {
+		return err
+	}
+	if opts.Page, err = cmd.Flags().GetInt("page"); err != nil {
+		return err
+	}
+	if opts.PerPage, err = cmd.Flags().GetInt("per-page"); err != nil {
+		return err
+	}
+	showAll, err := cmd.Flags().GetBool("all")
+	if err != nil {
+		return err
+	}
+
+	if showAll {
+		opts.Page = 0
+	}
+
+	for {
+		records, _, err := c.SearchAuditRecords(context.TODO(), opts)
+		if err != nil {
+			return fmt.Errorf("failed to search audit records: %w", err)
+		}
+
+		if len(records) == 0 {
+			if !showAll || opts.Page == 0 {
+				printer.Print("No audit records found")
+			}
+			return nil
+		}
+
+		for _, record := range records {
+			printAuditRecord(record)
+		}
+
+		if !showAll {
+			break
+		}
+
+		opts.Page++
+	}
+
+	return nil
+}
+
+func printAuditRecord(record *model.AuditRecord) {
+	printer.PrintT(fmt.Sprintf(`  Sequence: {{.Sequence}}
+  Event: {{.EventName}}
+  Status: {{.Status}}
+  User: {{.UserId}}
+  IP Address: {{.IpAddress}}
+  Created: %s
+`,
+		time.UnixMilli(record.CreateAt).Format(ISO8601Layout)), record)
+}
+
+func verifyAuditRecordsCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	result, _, err := c.VerifyAuditRecords(context.TODO())
+	if err != nil {
+		return fmt.Errorf("failed to verify audit records: %w", err)
+	}
+
+	if !result.Valid {
+		printer.PrintT("Audit record chain is broken at sequence {{.BrokenSequence}}: {{.Reason}}", result)
+		return errors.New("audit record chain verification failed")
+	}
+
+	printer.PrintT("Verified {{.Checked}} audit records, the chain is intact", result)
+	return nil
+}
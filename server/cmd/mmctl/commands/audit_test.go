@@ -0,0 +1,201 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+
+	"github.com/spf13/cobra"
+)
+
+func newSearchAuditRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("user", "", "")
+	cmd.Flags().String("event", "", "")
+	cmd.Flags().String("status", "", "")
+	cmd.Flags().String("since", "", "")
+	cmd.Flags().String("until", "", "")
+	cmd.Flags().Int("page", 0, "")
+	cmd.Flags().Int("per-page", 10, "")
+	cmd.Flags().Bool("all", false, "")
+	return cmd
+}
+
+func (s *MmctlUnitTestSuite) TestSearchAuditRecordsCmdF() {
+	s.Run("no records found", func() {
+		printer.Clean()
+
+		cmd := newSearchAuditRecordsCmd()
+
+		s.client.
+			EXPECT().
+			SearchAuditRecords(context.TODO(), model.AuditRecordSearchOpts{PerPage: 10}).
+			Return([]*model.AuditRecord{}, &model.Response{}, nil).
+			Times(1)
+
+		err := searchAuditRecordsCmdF(s.client, cmd, nil)
+		s.Require().NoError(err)
+		s.Len(printer.GetLines(), 1)
+		s.Empty(printer.GetErrorLines())
+		s.Equal("No audit records found", printer.GetLines()[0])
+	})
+
+	s.Run("filters are passed to the server", func() {
+		printer.Clean()
+
+		userID := model.NewId()
+		since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		until := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+		records := []*model.AuditRecord{
+			{Sequence: 2, EventName: "login", UserId: userID},
+			{Sequence: 1, EventName: "login", UserId: userID},
+		}
+
+		cmd := newSearchAuditRecordsCmd()
+		s.Require().NoError(cmd.Flags().Set("user", userID))
+		s.Require().NoError(cmd.Flags().Set("event", "login"))
+		s.Require().NoError(cmd.Flags().Set("status", "fail"))
+		s.Require().NoError(cmd.Flags().Set("since", since.Format(ISO8601Layout)))
+		s.Require().NoError(cmd.Flags().Set("until", until.Format(ISO8601Layout)))
+
+		s.client.
+			EXPECT().
+			SearchAuditRecords(context.TODO(), model.AuditRecordSearchOpts{
+				UserId:    userID,
+				EventName: "login",
+				Status:    "fail",
+				StartTime: model.GetMillisForTime(since),
+				EndTime:   model.GetMillisForTime(until),
+				PerPage:   10,
+			}).
+			Return(records, &model.Response{}, nil).
+			Times(1)
+
+		err := searchAuditRecordsCmdF(s.client, cmd, nil)
+		s.Require().NoError(err)
+		s.Len(printer.GetLines(), len(records))
+		s.Empty(printer.GetErrorLines())
+		for i, line := range printer.GetLines() {
+			s.Equal(records[i], line.(*model.AuditRecord))
+		}
+	})
+
+	s.Run("all pages are fetched", func() {
+		printer.Clean()
+
+		cmd := newSearchAuditRecordsCmd()
+		s.Require().NoError(cmd.Flags().Set("all", "true"))
+		s.Require().NoError(cmd.Flags().Set("page", "3"))
+
+		s.client.
+			EXPECT().
+			SearchAuditRecords(context.TODO(), model.AuditRecordSearchOpts{Page: 0, PerPage: 10}).
+			Return([]*model.AuditRecord{{Sequence: 2}}, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			SearchAuditRecords(context.TODO(), model.AuditRecordSearchOpts{Page: 1, PerPage: 10}).
+			Return([]*model.AuditRecord{{Sequence: 1}}, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			SearchAuditRecords(context.TODO(), model.AuditRecordSearchOpts{Page: 2, PerPage: 10}).
+			Return([]*model.AuditRecord{}, &model.Response{}, nil).
+			Times(1)
+
+		err := searchAuditRecordsCmdF(s.client, cmd, nil)
+		s.Require().NoError(err)
+		s.Len(printer.GetLines(), 2)
+		s.Empty(printer.GetErrorLines())
+	})
+
+	s.Run("invalid since time", func() {
+		printer.Clean()
+
+		cmd := newSearchAuditRecordsCmd()
+		s.Require().NoError(cmd.Flags().Set("since", "yesterday"))
+
+		err := searchAuditRecordsCmdF(s.client, cmd, nil)
+		s.Require().EqualError(err, "invalid since time 'yesterday'")
+		s.Empty(printer.GetLines())
+	})
+
+	s.Run("server error", func() {
+		printer.Clean()
+
+		cmd := newSearchAuditRecordsCmd()
+
+		s.client.
+			EXPECT().
+			SearchAuditRecords(context.TODO(), model.AuditRecordSearchOpts{PerPage: 10}).
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := searchAuditRecordsCmdF(s.client, cmd, nil)
+		s.Require().EqualError(err, "failed to search audit records: mock error")
+		s.Empty(printer.GetLines())
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestVerifyAuditRecordsCmdF() {
+	s.Run("chain is intact", func() {
+		printer.Clean()
+
+		result := &model.AuditRecordVerification{Valid: true, Checked: 5, LastSequence: 5}
+
+		s.client.
+			EXPECT().
+			VerifyAuditRecords(context.TODO()).
+			Return(result, &model.Response{}, nil).
+			Times(1)
+
+		err := verifyAuditRecordsCmdF(s.client, &cobra.Command{}, nil)
+		s.Require().NoError(err)
+		s.Len(printer.GetLines(), 1)
+		s.Equal(result, printer.GetLines()[0])
+	})
+
+	s.Run("chain is broken", func() {
+		printer.Clean()
+
+		result := &model.AuditRecordVerification{
+			Valid:          false,
+			Checked:        2,
+			LastSequence:   2,
+			BrokenSequence: 3,
+			Reason:         model.AuditRecordChainReasonHashMismatch,
+		}
+
+		s.client.
+			EXPECT().
+			VerifyAuditRecords(context.TODO()).
+			Return(result, &model.Response{}, nil).
+			Times(1)
+
+		err := verifyAuditRecordsCmdF(s.client, &cobra.Command{}, nil)
+		s.Require().EqualError(err, "audit record chain verification failed")
+		s.Len(printer.GetLines(), 1)
+		s.Equal(result, printer.GetLines()[0])
+	})
+
+	s.Run("server error", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			VerifyAuditRecords(context.TODO()).
+			Return(nil, &model.Response{}, errors.New("mock error")).
+			Times(1)
+
+		err := verifyAuditRecordsCmdF(s.client, &cobra.Command{}, nil)
+		s.Require().EqualError(err, "failed to verify audit records: mock error")
+		s.Empty(printer.GetLines())
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/v8/channels/utils"
@@ -104,6 +105,33 @@ var ConfigMigrateCmd = &cobra.Command{
 	RunE:    withClient(configMigrateCmdF),
 }
 
+var ConfigHistoryCmd = &cobra.Command{
+	Use:     "history",
+	Short:   "List the saved configurations",
+	Long:    "Lists the configurations saved by the server, most recent first, along with who saved them and the settings they changed. Sensitive values are redacted. Only available when the configuration is stored in the database.",
+	Example: "config history --per-page 10",
+	Args:    cobra.NoArgs,
+	RunE:    withClient(configHistoryCmdF),
+}
+
+var ConfigDiffCmd = &cobra.Command{
+	Use:     "diff [config_id]",
+	Short:   "Show the changes restoring a saved configuration would make",
+	Long:    "Shows the settings that differ between a saved configuration and the active configuration. Sensitive values are redacted.",
+	Example: "config diff 7k1cnqq1h7fcbxgtyx3kcmt84w",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(configDiffCmdF),
+}
+
+var ConfigRollbackCmd = &cobra.Command{
+	Use:     "rollback [config_id]",
+	Short:   "Restore a saved configuration",
+	Long:    "Makes a configuration listed by the history command the active configuration again.",
+	Example: "config rollback 7k1cnqq1h7fcbxgtyx3kcmt84w",
+	Args:    cobra.ExactArgs(1),
+	RunE:    withClient(configRollbackCmdF),
+}
+
 var ConfigSubpathCmd = &cobra.Command{
 	Use:   "subpath",
 	Short: "Update client asset loading to use the configured subpath",
@@ -128,6 +156,11 @@ func init() {
 	ConfigSubpathCmd.Flags().StringP("path", "p", "", "path to update the assets with")
 	_ = ConfigSubpathCmd.MarkFlagRequired("path")
 
+	ConfigHistoryCmd.Flags().Int("page", 0, "Page number to fetch for the list of saved configurations")
+	ConfigHistoryCmd.Flags().Int("per-page", model.ConfigHistoryDefaultPerPage, "Number of saved configurations to be fetched")
+
+	ConfigRollbackCmd.Flags().Bool("confirm", false, "confirm you really want to restore the configuration")
+
 	ConfigCmd.AddCommand(
 		ConfigGetCmd,
 		ConfigSetCmd,
@@ -137,6 +170,9 @@ func init() {
 		ConfigShowCmd,
 		ConfigReloadCmd,
 		ConfigMigrateCmd,
+		ConfigHistoryCmd,
+		ConfigDiffCmd,
+		ConfigRollbackCmd,
 		ConfigSubpathCmd,
 	)
 	RootCmd.AddCommand(ConfigCmd)
@@ -537,6 +573,69 @@ func configMigrateCmdF(c client.Client, cmd *cobra.Command, args []string) error
 	return nil
 }
 
+func configHistoryCmdF(c client.Client, cmd *cobra.Command, _ []string) error {
+	page, _ := cmd.Flags().GetInt("page")
+	perPage, _ := cmd.Flags().GetInt("per-page")
+
+	entries, _, err := c.GetConfigHistory(context.TODO(), page, perPage)
+	if err != nil {
+		return fmt.Errorf("failed to get configuration history: %w", err)
+	}
+
+	if len(entries) == 0 {
+		printer.Print("No saved configurations found")
+		return nil
+	}
+
+	for _, entry := range entries {
+		printer.PrintT(fmt.Sprintf(`  ID: {{.Id}}
+  Created: %s
+  Author: {{if .UserId}}{{.UserId}}{{else}}server{{end}}
+  Active: {{.Active}}
+  Changes:{{range .Changes}}
+    {{.Path}}: {{.BaseVal}} -> {{.ActualVal}}{{else}} none{{end}}
+`,
+			time.UnixMilli(entry.CreateAt).Format(ISO8601Layout)), entry)
+	}
+
+	return nil
+}
+
+func configDiffCmdF(c client.Client, _ *cobra.Command, args []string) error {
+	changes, _, err := c.GetConfigVersionDiff(context.TODO(), args[0])
+	if err != nil {
+		return fmt.Errorf("failed to get configuration diff: %w", err)
+	}
+
+	if len(changes) == 0 {
+		printer.Print("The configuration is identical to the active configuration")
+		return nil
+	}
+
+	for _, change := range changes {
+		printer.PrintT("{{.Path}}: {{.BaseVal}} -> {{.ActualVal}}", change)
+	}
+
+	return nil
+}
+
+func configRollbackCmdF(c client.Client, cmd *cobra.Command, args []string) error {
+	confirmFlag, _ := cmd.Flags().GetBool("confirm")
+	if !confirmFlag {
+		if err := getConfirmation(fmt.Sprintf("Are you sure you want to restore configuration %s? (YES/NO): ", args[0]), false); err != nil {
+			return err
+		}
+	}
+
+	newConfig, _, err := c.RestoreConfigVersion(context.TODO(), args[0])
+	if err != nil {
+		return fmt.Errorf("failed to restore configuration: %w", err)
+	}
+
+	printer.PrintT("Configuration restored successfully", newConfig)
+	return nil
+}
+
 func configSubpathCmdF(cmd *cobra.Command, _ []string) error {
 	assetsDir, _ := cmd.Flags().GetString("assets-dir")
 	path, _ := cmd.Flags().GetString("path")
@@ -893,6 +893,171 @@ func (s *MmctlUnitTestSuite) TestConfigMigrateCmd() {
 	})
 }
 
+func (s *MmctlUnitTestSuite) TestConfigHistoryCmd() {
+	s.Run("Should list saved configurations", func() {
+		printer.Clean()
+		entries := []*model.ConfigHistoryEntry{
+			{
+				Id:     model.NewId(),
+				UserId: model.NewId(),
+				Active: true,
+				Changes: []*model.ConfigChange{
+					{Path: "TeamSettings.SiteName", BaseVal: "Mattermost", ActualVal: "Site"},
+				},
+			},
+			{Id: model.NewId(), Changes: []*model.ConfigChange{}},
+		}
+
+		s.client.
+			EXPECT().
+			GetConfigHistory(context.TODO(), 1, 2).
+			Return(entries, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("page", 1, "")
+		cmd.Flags().Int("per-page", 2, "")
+
+		err := configHistoryCmdF(s.client, cmd, []string{})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 2)
+		s.Require().Equal(entries[0], printer.GetLines()[0])
+		s.Require().Equal(entries[1], printer.GetLines()[1])
+		s.Require().Len(printer.GetErrorLines(), 0)
+	})
+
+	s.Run("Should report when there is no saved configuration", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetConfigHistory(context.TODO(), 0, model.ConfigHistoryDefaultPerPage).
+			Return([]*model.ConfigHistoryEntry{}, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("page", 0, "")
+		cmd.Flags().Int("per-page", model.ConfigHistoryDefaultPerPage, "")
+
+		err := configHistoryCmdF(s.client, cmd, []string{})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal("No saved configurations found", printer.GetLines()[0])
+	})
+
+	s.Run("Should fail on error when getting the history", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetConfigHistory(context.TODO(), 0, model.ConfigHistoryDefaultPerPage).
+			Return(nil, &model.Response{StatusCode: http.StatusNotImplemented}, errors.New("some-error")).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("page", 0, "")
+		cmd.Flags().Int("per-page", model.ConfigHistoryDefaultPerPage, "")
+
+		err := configHistoryCmdF(s.client, cmd, []string{})
+		s.Require().NotNil(err)
+		s.Require().Len(printer.GetLines(), 0)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestConfigDiffCmd() {
+	configID := model.NewId()
+
+	s.Run("Should print the changes", func() {
+		printer.Clean()
+		changes := []*model.ConfigChange{
+			{Path: "TeamSettings.SiteName", BaseVal: "Site", ActualVal: "Mattermost"},
+			{Path: "EmailSettings.SMTPPassword", BaseVal: model.FakeSetting, ActualVal: model.FakeSetting},
+		}
+
+		s.client.
+			EXPECT().
+			GetConfigVersionDiff(context.TODO(), configID).
+			Return(changes, &model.Response{}, nil).
+			Times(1)
+
+		err := configDiffCmdF(s.client, &cobra.Command{}, []string{configID})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 2)
+		s.Require().Equal(changes[0], printer.GetLines()[0])
+		s.Require().Equal(changes[1], printer.GetLines()[1])
+	})
+
+	s.Run("Should report when there are no changes", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetConfigVersionDiff(context.TODO(), configID).
+			Return([]*model.ConfigChange{}, &model.Response{}, nil).
+			Times(1)
+
+		err := configDiffCmdF(s.client, &cobra.Command{}, []string{configID})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal("The configuration is identical to the active configuration", printer.GetLines()[0])
+	})
+
+	s.Run("Should fail on error when getting the diff", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetConfigVersionDiff(context.TODO(), configID).
+			Return(nil, &model.Response{StatusCode: http.StatusNotFound}, errors.New("some-error")).
+			Times(1)
+
+		err := configDiffCmdF(s.client, &cobra.Command{}, []string{configID})
+		s.Require().NotNil(err)
+	})
+}
+
+func (s *MmctlUnitTestSuite) TestConfigRollbackCmd() {
+	configID := model.NewId()
+
+	s.Run("Should restore the configuration", func() {
+		printer.Clean()
+		restoredConfig := &model.Config{}
+		restoredConfig.SetDefaults()
+
+		s.client.
+			EXPECT().
+			RestoreConfigVersion(context.TODO(), configID).
+			Return(restoredConfig, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("confirm", true, "")
+
+		err := configRollbackCmdF(s.client, cmd, []string{configID})
+		s.Require().Nil(err)
+		s.Require().Len(printer.GetLines(), 1)
+		s.Require().Equal(restoredConfig, printer.GetLines()[0])
+		s.Require().Len(printer.GetErrorLines(), 0)
+	})
+
+	s.Run("Should fail on error when restoring the configuration", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			RestoreConfigVersion(context.TODO(), configID).
+			Return(nil, &model.Response{StatusCode: http.StatusNotFound}, errors.New("some-error")).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("confirm", true, "")
+
+		err := configRollbackCmdF(s.client, cmd, []string{configID})
+		s.Require().NotNil(err)
+		s.Require().Len(printer.GetLines(), 0)
+	})
+}
+
 func TestCloudRestricted(t *testing.T) {
 	cfg := &model.Config{
 		ServiceSettings: model.ServiceSettings{
@@ -103,6 +103,8 @@ func init() {
 	ExportCreateCmd.Flags().Bool("include-archived-channels", false, "Include archived channels in the export file.")
 	ExportCreateCmd.Flags().Bool("include-profile-pictures", false, "Include profile pictures in the export file.")
 	ExportCreateCmd.Flags().Bool("no-roles-and-schemes", false, "Exclude roles and custom permission schemes from the export file.")
+	ExportCreateCmd.Flags().Bool("no-integrations", false, "Exclude incoming and outgoing webhooks, slash commands and OAuth apps from the export file.")
+	ExportCreateCmd.Flags().Bool("include-integration-secrets", false, "Keep the webhook URLs, tokens and OAuth client secrets of the exported integrations. Without it, the importing server generates new ones.")
 
 	ExportDownloadCmd.Flags().Bool("resume", false, "Set to true to resume an export download.")
 	_ = ExportDownloadCmd.Flags().MarkHidden("resume")
@@ -144,6 +146,16 @@ func exportCreateCmdF(c client.Client, command *cobra.Command, args []string) er
 		data["include_roles_and_schemes"] = "true"
 	}
 
+	excludeIntegrations, _ := command.Flags().GetBool("no-integrations")
+	if !excludeIntegrations {
+		data["include_integrations"] = "true"
+	}
+
+	includeIntegrationSecrets, _ := command.Flags().GetBool("include-integration-secrets")
+	if includeIntegrationSecrets {
+		data["include_integration_secrets"] = "true"
+	}
+
 	includeArchivedChannels, _ := command.Flags().GetBool("include-archived-channels")
 	if includeArchivedChannels {
 		data["include_archived_channels"] = "true"
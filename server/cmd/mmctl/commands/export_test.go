@@ -22,6 +22,7 @@ func (s *MmctlUnitTestSuite) TestExportCreateCmdF() {
 			Data: map[string]string{
 				"include_attachments":       "true",
 				"include_roles_and_schemes": "true",
+				"include_integrations":      "true",
 			},
 		}
 
@@ -44,6 +45,7 @@ func (s *MmctlUnitTestSuite) TestExportCreateCmdF() {
 			Type: model.JobTypeExportProcess,
 			Data: map[string]string{
 				"include_roles_and_schemes": "true",
+				"include_integrations":      "true",
 			},
 		}
 
@@ -68,7 +70,8 @@ func (s *MmctlUnitTestSuite) TestExportCreateCmdF() {
 		mockJob := &model.Job{
 			Type: model.JobTypeExportProcess,
 			Data: map[string]string{
-				"include_attachments": "true",
+				"include_attachments":  "true",
+				"include_integrations": "true",
 			},
 		}
 
@@ -87,6 +90,60 @@ func (s *MmctlUnitTestSuite) TestExportCreateCmdF() {
 		s.Empty(printer.GetErrorLines())
 		s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
 	})
+
+	s.Run("create export without integrations", func() {
+		printer.Clean()
+		mockJob := &model.Job{
+			Type: model.JobTypeExportProcess,
+			Data: map[string]string{
+				"include_attachments":       "true",
+				"include_roles_and_schemes": "true",
+			},
+		}
+
+		s.client.
+			EXPECT().
+			CreateJob(context.TODO(), mockJob).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("no-integrations", true, "")
+
+		err := exportCreateCmdF(s.client, cmd, nil)
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Empty(printer.GetErrorLines())
+		s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
+	})
+
+	s.Run("create export with integration secrets", func() {
+		printer.Clean()
+		mockJob := &model.Job{
+			Type: model.JobTypeExportProcess,
+			Data: map[string]string{
+				"include_attachments":         "true",
+				"include_roles_and_schemes":   "true",
+				"include_integrations":        "true",
+				"include_integration_secrets": "true",
+			},
+		}
+
+		s.client.
+			EXPECT().
+			CreateJob(context.TODO(), mockJob).
+			Return(mockJob, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Bool("include-integration-secrets", true, "")
+
+		err := exportCreateCmdF(s.client, cmd, nil)
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Empty(printer.GetErrorLines())
+		s.Equal(mockJob, printer.GetLines()[0].(*model.Job))
+	})
 }
 
 func (s *MmctlUnitTestSuite) TestExportDeleteCmdF() {
@@ -4,6 +4,7 @@
 package commands
 
 import (
+	"archive/zip"
 	"context"
 	"errors"
 	"fmt"
@@ -19,9 +20,12 @@ import (
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/spf13/cobra"
 
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/client"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/commands/importer"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
+	"github.com/mattermost/mattermost/server/v8/platform/services/rocketchatimport"
+	"github.com/mattermost/mattermost/server/v8/platform/services/teamsimport"
 )
 
 var ImportCmd = &cobra.Command{
@@ -100,6 +104,17 @@ var ImportValidateCmd = &cobra.Command{
 	},
 }
 
+var ImportConvertCmd = &cobra.Command{
+	Use:   "convert [rocketchat|teams] [filepath]",
+	Short: "Convert a Rocket.Chat or Microsoft Teams export into an import file",
+	Long: "Convert a Rocket.Chat or Microsoft Teams export into an import file that can be validated with \"import validate\" and processed with \"import process\". " +
+		"Users, channels, threads, reactions and file attachments are converted; the entities that can't be converted are listed in the report.",
+	Example: `  import convert rocketchat rocketchat_export.zip --team myteam --output import.zip
+  import convert teams teams_export.zip --dry-run`,
+	Args: cobra.ExactArgs(2),
+	RunE: importConvertCmdF,
+}
+
 func init() {
 	ImportUploadCmd.Flags().Bool("resume", false, "Set to true to resume an incomplete import upload.")
 	ImportUploadCmd.Flags().String("upload", "", "The ID of the import upload to resume.")
@@ -113,6 +128,10 @@ func init() {
 	ImportValidateCmd.Flags().Bool("ignore-attachments", false, "Don't check if the attached files are present in the archive")
 	ImportValidateCmd.Flags().Bool("check-server-duplicates", true, "Set to false to ignore teams, channels, and users already present on the server")
 
+	ImportConvertCmd.Flags().String("team", "", "The team to import the channels into. Required for Rocket.Chat exports; for Microsoft Teams exports, each team is imported as a new team when not set")
+	ImportConvertCmd.Flags().String("output", "import.zip", "The path of the import file to write")
+	ImportConvertCmd.Flags().Bool("dry-run", false, "Only report the entities that would be converted, without writing the import file")
+
 	ImportProcessCmd.Flags().Bool("bypass-upload", false, "If this is set, the file is not processed from the server, but rather directly read from the filesystem. Works only in --local mode.")
 	ImportProcessCmd.Flags().Bool("extract-content", true, "If this is set, document attachments will be extracted and indexed during the import process. It is advised to disable it to improve performance.")
 
@@ -130,6 +149,7 @@ func init() {
 		ImportProcessCmd,
 		ImportJobCmd,
 		ImportValidateCmd,
+		ImportConvertCmd,
 	)
 	RootCmd.AddCommand(ImportCmd)
 }
@@ -334,6 +354,11 @@ type Statistics struct {
 	DirectChannels uint64 `json:"direct_channels"`
 	DirectPosts    uint64 `json:"direct_posts"`
 	Attachments    uint64 `json:"attachments"`
+
+	IncomingWebhooks uint64 `json:"incoming_webhooks"`
+	OutgoingWebhooks uint64 `json:"outgoing_webhooks"`
+	Commands         uint64 `json:"commands"`
+	OAuthApps        uint64 `json:"oauth_apps"`
 }
 
 type ImportValidationResult struct {
@@ -496,6 +521,11 @@ func importValidateCmdF(c client.Client, command *cobra.Command, args []string)
 		DirectPosts:    (validator.DirectPostCount()),
 		Emojis:         (validator.Emojis()),
 		Attachments:    uint64(len(validator.Attachments())),
+
+		IncomingWebhooks: validator.IncomingWebhookCount(),
+		OutgoingWebhooks: validator.OutgoingWebhookCount(),
+		Commands:         validator.CommandCount(),
+		OAuthApps:        validator.OAuthAppCount(),
 	}
 
 	printStatistics(stat)
@@ -520,6 +550,75 @@ func importValidateCmdF(c client.Client, command *cobra.Command, args []string)
 	return nil
 }
 
+func importConvertCmdF(command *cobra.Command, args []string) error {
+	configurePrinter()
+
+	team, _ := command.Flags().GetString("team")
+	output, _ := command.Flags().GetString("output")
+	dryRun, _ := command.Flags().GetBool("dry-run")
+
+	zipReader, err := zip.OpenReader(args[1])
+	if err != nil {
+		return fmt.Errorf("failed to open export file: %w", err)
+	}
+	defer zipReader.Close()
+
+	var export *imports.ConvertedExport
+	switch args[0] {
+	case "rocketchat":
+		if team == "" {
+			return errors.New("the --team flag is required to convert a Rocket.Chat export")
+		}
+		export, err = rocketchatimport.Convert(&zipReader.Reader, rocketchatimport.Options{Team: team})
+	case "teams":
+		export, err = teamsimport.Convert(&zipReader.Reader, teamsimport.Options{Team: team})
+	default:
+		return fmt.Errorf("unsupported export format %q, expected rocketchat or teams", args[0])
+	}
+	if err != nil {
+		return fmt.Errorf("failed to convert export file: %w", err)
+	}
+
+	printConversionReport(export.Report())
+	if dryRun {
+		return nil
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("failed to create import file: %w", err)
+	}
+	if err := export.WriteArchive(file); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write import file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write import file: %w", err)
+	}
+
+	printer.PrintT("Import file written to {{ .Output }}\n", struct {
+		Output string `json:"output"`
+	}{output})
+	return nil
+}
+
+func printConversionReport(report *imports.ConversionReport) {
+	tmpl := "\n" +
+		"Teams           {{ .Teams }}\n" +
+		"Channels        {{ .Channels }}\n" +
+		"Users           {{ .Users }}\n" +
+		"Posts           {{ .Posts }}\n" +
+		"Replies         {{ .Replies }}\n" +
+		"Direct Channels {{ .DirectChannels }}\n" +
+		"Direct Posts    {{ .DirectPosts }}\n" +
+		"Reactions       {{ .Reactions }}\n" +
+		"Attachments     {{ .Attachments }}\n" +
+		"{{ if .Unmapped }}\nUnmapped entities ({{ len .Unmapped }}):\n" +
+		"{{ range .Unmapped }}  {{ .Kind }} {{ .Id }}: {{ .Reason }}\n{{ end }}{{ end }}"
+
+	printer.PrintT(tmpl, report)
+}
+
 func configurePrinter() {
 	// we want to manage the newlines ourselves
 	printer.SetNoNewline(true)
@@ -539,7 +638,11 @@ func printStatistics(stat Statistics) {
 		"Posts           {{ .Posts }}\n" +
 		"Direct Channels {{ .DirectChannels }}\n" +
 		"Direct Posts    {{ .DirectPosts }}\n" +
-		"Attachments     {{ .Attachments }}\n"
+		"Attachments     {{ .Attachments }}\n" +
+		"Incoming Hooks  {{ .IncomingWebhooks }}\n" +
+		"Outgoing Hooks  {{ .OutgoingWebhooks }}\n" +
+		"Commands        {{ .Commands }}\n" +
+		"OAuth Apps      {{ .OAuthApps }}\n"
 
 	printer.PrintT(tmpl, stat)
 }
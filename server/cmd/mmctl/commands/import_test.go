@@ -15,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
 	"github.com/mattermost/mattermost/server/v8/cmd/mmctl/printer"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -476,3 +477,109 @@ func (s *MmctlUnitTestSuite) TestImportValidateCmdF() {
 		s.Equal("Validation complete\n", printer.GetLines()[2])
 	})
 }
+
+func (s *MmctlUnitTestSuite) TestImportConvertCmdF() {
+	exportFilePath := filepath.Join(s.T().TempDir(), "rocketchat_export.zip")
+	file, err := os.Create(exportFilePath)
+	s.Require().NoError(err)
+	zipWr := zip.NewWriter(file)
+	for name, content := range map[string]string{
+		"users.json":           `{"_id":"u1","username":"alice","name":"Alice","emails":[{"address":"alice@example.com"}],"active":true,"type":"user"}`,
+		"rocketchat_room.json": `{"_id":"r1","t":"c","name":"general"}`,
+		"rocketchat_message.json": `{"_id":"m1","rid":"r1","msg":"hello","ts":{"$date":"2024-01-02T10:00:00.000Z"},"u":{"_id":"u1","username":"alice"},"file":{"_id":"f1","name":"notes.txt"}}
+{"_id":"m2","rid":"r1","msg":"","ts":{"$date":"2024-01-02T10:01:00.000Z"},"u":{"_id":"u1","username":"alice"},"t":"uj"}`,
+		"uploads/f1": "file content",
+	} {
+		wr, err := zipWr.Create(name)
+		s.Require().NoError(err)
+		_, err = wr.Write([]byte(content))
+		s.Require().NoError(err)
+	}
+	s.Require().NoError(zipWr.Close())
+	s.Require().NoError(file.Close())
+
+	s.Run("requires a team for Rocket.Chat exports", func() {
+		printer.Clean()
+		cmd := &cobra.Command{}
+		cmd.Flags().String("team", "", "")
+
+		err := importConvertCmdF(cmd, []string{"rocketchat", exportFilePath})
+		s.Require().EqualError(err, "the --team flag is required to convert a Rocket.Chat export")
+	})
+
+	s.Run("unsupported format", func() {
+		printer.Clean()
+		cmd := &cobra.Command{}
+		cmd.Flags().String("team", "myteam", "")
+
+		err := importConvertCmdF(cmd, []string{"hipchat", exportFilePath})
+		s.Require().Error(err)
+	})
+
+	s.Run("dry run", func() {
+		printer.Clean()
+		outputFilePath := filepath.Join(s.T().TempDir(), "import.zip")
+		cmd := &cobra.Command{}
+		cmd.Flags().String("team", "myteam", "")
+		cmd.Flags().String("output", outputFilePath, "")
+		cmd.Flags().Bool("dry-run", true, "")
+
+		err := importConvertCmdF(cmd, []string{"rocketchat", exportFilePath})
+		s.Require().NoError(err)
+
+		s.Require().Len(printer.GetLines(), 1)
+		report := printer.GetLines()[0].(*imports.ConversionReport)
+		s.Equal(1, report.Users)
+		s.Equal(1, report.Channels)
+		s.Equal(1, report.Posts)
+		s.Equal(1, report.Attachments)
+		s.Len(report.Unmapped, 1)
+		s.NoFileExists(outputFilePath)
+	})
+
+	s.Run("converted file validates", func() {
+		printer.Clean()
+		outputFilePath := filepath.Join(s.T().TempDir(), "import.zip")
+		cmd := &cobra.Command{}
+		cmd.Flags().String("team", "myteam", "")
+		cmd.Flags().String("output", outputFilePath, "")
+		cmd.Flags().Bool("dry-run", false, "")
+
+		err := importConvertCmdF(cmd, []string{"rocketchat", exportFilePath})
+		s.Require().NoError(err)
+		s.Require().FileExists(outputFilePath)
+
+		printer.Clean()
+		s.client.
+			EXPECT().
+			GetUsers(context.TODO(), 0, 200, "").
+			Return(nil, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetAllTeams(context.TODO(), "", 0, 200).
+			Return(nil, &model.Response{}, nil).
+			Times(1)
+		s.client.
+			EXPECT().
+			GetOldClientConfig(context.TODO(), "").
+			Return(map[string]string{
+				"MaxPostSize": fmt.Sprintf("%d", model.PostMessageMaxRunesV2),
+			}, &model.Response{}, nil).
+			Times(1)
+
+		err = importValidateCmdF(s.client, ImportValidateCmd, []string{outputFilePath})
+		s.Require().NoError(err)
+
+		s.Empty(printer.GetErrorLines())
+		s.Equal(Statistics{
+			Teams:       1, // created automatically
+			Channels:    1,
+			Users:       1,
+			Posts:       1,
+			Attachments: 1,
+		}, printer.GetLines()[0].(Statistics))
+		res := printer.GetLines()[2].(ImportValidationResult)
+		s.Empty(res.Errors)
+	})
+}
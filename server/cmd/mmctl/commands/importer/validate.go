@@ -68,6 +68,11 @@ type Validator struct { //nolint:govet
 	directPosts    uint64
 	emojis         map[string]ImportFileInfo
 
+	incomingWebhooks uint64
+	outgoingWebhooks uint64
+	commands         uint64
+	oauthApps        uint64
+
 	maxPostSize int
 
 	start time.Time
@@ -88,6 +93,11 @@ const (
 	LineTypeDirectChannel = "direct_channel"
 	LineTypeDirectPost    = "direct_post"
 	LineTypeEmoji         = "emoji"
+
+	LineTypeIncomingWebhook = "incoming_webhook"
+	LineTypeOutgoingWebhook = "outgoing_webhook"
+	LineTypeCommand         = "command"
+	LineTypeOAuthApp        = "oauth_app"
 )
 
 func NewValidator(
@@ -176,6 +186,22 @@ func (v *Validator) Emojis() uint64 {
 	return uint64(len(v.emojis))
 }
 
+func (v *Validator) IncomingWebhookCount() uint64 {
+	return v.incomingWebhooks
+}
+
+func (v *Validator) OutgoingWebhookCount() uint64 {
+	return v.outgoingWebhooks
+}
+
+func (v *Validator) CommandCount() uint64 {
+	return v.commands
+}
+
+func (v *Validator) OAuthAppCount() uint64 {
+	return v.oauthApps
+}
+
 func (v *Validator) StartTime() time.Time {
 	return v.start
 }
@@ -421,6 +447,14 @@ func (v *Validator) validateLine(info ImportFileInfo, line imports.LineImportDat
 		err = v.validateDirectPost(info, line)
 	case LineTypeEmoji:
 		err = v.validateEmoji(info, line)
+	case LineTypeIncomingWebhook:
+		err = v.validateIncomingWebhook(info, line)
+	case LineTypeOutgoingWebhook:
+		err = v.validateOutgoingWebhook(info, line)
+	case LineTypeCommand:
+		err = v.validateCommand(info, line)
+	case LineTypeOAuthApp:
+		err = v.validateOAuthApp(info, line)
 	default:
 		err = v.onError(&ImportValidationError{
 			ImportFileInfo: info,
@@ -1085,6 +1119,134 @@ func (v *Validator) validateSupportedImage(info ImportFileInfo, zfile *zip.File)
 	return nil
 }
 
+// checkIntegrationReferences makes sure the team, channel and user an integration refers to
+// are part of the import file; the validator has no access to the server, so references to
+// existing data are reported too. Missing teams are added when createMissingTeams is set. Nil
+// references are not checked.
+func (v *Validator) checkIntegrationReferences(info ImportFileInfo, name string, team, channel, user *string) *ImportValidationError {
+	if team != nil {
+		if _, ok := v.teams[*team]; !ok {
+			if v.createMissingTeams {
+				v.createTeam(*team)
+			} else {
+				return &ImportValidationError{
+					ImportFileInfo: info,
+					FieldName:      name + ".team",
+					Err:            fmt.Errorf("reference to unknown team %q", *team),
+				}
+			}
+		}
+	}
+	if team != nil && channel != nil && *channel != "" {
+		if _, ok := v.channels[ChannelTeam{Channel: *channel, Team: *team}]; !ok {
+			return &ImportValidationError{
+				ImportFileInfo: info,
+				FieldName:      name + ".channel",
+				Err:            fmt.Errorf("reference to unknown channel \"%s/%s\"", *team, *channel),
+			}
+		}
+	}
+	if user != nil {
+		if _, ok := v.users[*user]; !ok {
+			return &ImportValidationError{
+				ImportFileInfo: info,
+				FieldName:      name + ".creator",
+				Err:            fmt.Errorf("reference to unknown user %q", *user),
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *Validator) validateIncomingWebhook(info ImportFileInfo, line imports.LineImportData) (err error) {
+	ivErr := validateNotNil(info, "incoming_webhook", line.IncomingWebhook, func(data imports.IncomingWebhookImportData) *ImportValidationError {
+		appErr := imports.ValidateIncomingWebhookImportData(&data)
+		if appErr != nil {
+			return &ImportValidationError{
+				ImportFileInfo: info,
+				FieldName:      "incoming_webhook",
+				Err:            appErr,
+			}
+		}
+
+		return v.checkIntegrationReferences(info, "incoming_webhook", data.Team, data.Channel, data.User)
+	})
+	if ivErr != nil {
+		return v.onError(ivErr)
+	}
+
+	v.incomingWebhooks++
+
+	return nil
+}
+
+func (v *Validator) validateOutgoingWebhook(info ImportFileInfo, line imports.LineImportData) (err error) {
+	ivErr := validateNotNil(info, "outgoing_webhook", line.OutgoingWebhook, func(data imports.OutgoingWebhookImportData) *ImportValidationError {
+		appErr := imports.ValidateOutgoingWebhookImportData(&data)
+		if appErr != nil {
+			return &ImportValidationError{
+				ImportFileInfo: info,
+				FieldName:      "outgoing_webhook",
+				Err:            appErr,
+			}
+		}
+
+		return v.checkIntegrationReferences(info, "outgoing_webhook", data.Team, data.Channel, data.Creator)
+	})
+	if ivErr != nil {
+		return v.onError(ivErr)
+	}
+
+	v.outgoingWebhooks++
+
+	return nil
+}
+
+func (v *Validator) validateCommand(info ImportFileInfo, line imports.LineImportData) (err error) {
+	ivErr := validateNotNil(info, "command", line.Command, func(data imports.CommandImportData) *ImportValidationError {
+		appErr := imports.ValidateCommandImportData(&data)
+		if appErr != nil {
+			return &ImportValidationError{
+				ImportFileInfo: info,
+				FieldName:      "command",
+				Err:            appErr,
+			}
+		}
+
+		return v.checkIntegrationReferences(info, "command", data.Team, nil, data.Creator)
+	})
+	if ivErr != nil {
+		return v.onError(ivErr)
+	}
+
+	v.commands++
+
+	return nil
+}
+
+func (v *Validator) validateOAuthApp(info ImportFileInfo, line imports.LineImportData) (err error) {
+	ivErr := validateNotNil(info, "oauth_app", line.OAuthApp, func(data imports.OAuthAppImportData) *ImportValidationError {
+		appErr := imports.ValidateOAuthAppImportData(&data)
+		if appErr != nil {
+			return &ImportValidationError{
+				ImportFileInfo: info,
+				FieldName:      "oauth_app",
+				Err:            appErr,
+			}
+		}
+
+		return v.checkIntegrationReferences(info, "oauth_app", nil, nil, data.Creator)
+	})
+	if ivErr != nil {
+		return v.onError(ivErr)
+	}
+
+	v.oauthApps++
+
+	return nil
+}
+
 func validateNotNil[T any](info ImportFileInfo, name string, value *T, then func(T) *ImportValidationError) *ImportValidationError {
 	if value == nil {
 		return &ImportValidationError{
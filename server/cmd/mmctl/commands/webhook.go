@@ -86,6 +86,15 @@ var DeleteWebhookCmd = &cobra.Command{
 	RunE:    withClient(deleteWebhookCmdF),
 }
 
+var WebhookDeliveriesCmd = &cobra.Command{
+	Use:     "deliveries [webhookId]",
+	Short:   "List outgoing webhook deliveries",
+	Long:    "List the most recent delivery attempts of the outgoing webhook specified by [webhookId]",
+	Args:    cobra.ExactArgs(1),
+	Example: "  webhook deliveries w16zb5tu3n1zkqo18goqry1je --per-page 20",
+	RunE:    withClient(webhookDeliveriesCmdF),
+}
+
 func listWebhookCmdF(c client.Client, command *cobra.Command, args []string) error {
 	var teams []*model.Team
 
@@ -164,15 +173,21 @@ func createIncomingWebhookCmdF(c client.Client, command *cobra.Command, args []s
 	description, _ := command.Flags().GetString("description")
 	iconURL, _ := command.Flags().GetString("icon")
 	channelLocked, _ := command.Flags().GetBool("lock-to-channel")
+	rateLimitPerMinute, _ := command.Flags().GetInt("rate-limit-per-minute")
+	rateLimitBurst, _ := command.Flags().GetInt("rate-limit-burst")
+	payloadTemplate, _ := command.Flags().GetString("payload-template")
 
 	incomingWebhook := &model.IncomingWebhook{
-		ChannelId:     channel.Id,
-		DisplayName:   displayName,
-		Description:   description,
-		IconURL:       iconURL,
-		ChannelLocked: channelLocked,
-		Username:      user.Username,
-		UserId:        user.Id,
+		ChannelId:          channel.Id,
+		DisplayName:        displayName,
+		Description:        description,
+		IconURL:            iconURL,
+		ChannelLocked:      channelLocked,
+		Username:           user.Username,
+		UserId:             user.Id,
+		RateLimitPerMinute: rateLimitPerMinute,
+		RateLimitBurst:     rateLimitBurst,
+		PayloadTemplate:    payloadTemplate,
 	}
 
 	createdIncoming, _, err := c.CreateIncomingWebhook(context.TODO(), incomingWebhook)
@@ -222,6 +237,15 @@ func modifyIncomingWebhookCmdF(c client.Client, command *cobra.Command, args []s
 	}
 	channelLocked, _ := command.Flags().GetBool("lock-to-channel")
 	updatedHook.ChannelLocked = channelLocked
+	if command.Flags().Changed("rate-limit-per-minute") {
+		updatedHook.RateLimitPerMinute, _ = command.Flags().GetInt("rate-limit-per-minute")
+	}
+	if command.Flags().Changed("rate-limit-burst") {
+		updatedHook.RateLimitBurst, _ = command.Flags().GetInt("rate-limit-burst")
+	}
+	if command.Flags().Changed("payload-template") {
+		updatedHook.PayloadTemplate, _ = command.Flags().GetString("payload-template")
+	}
 
 	var newHook *model.IncomingWebhook
 	if newHook, _, err = c.UpdateIncomingWebhook(context.TODO(), updatedHook); err != nil {
@@ -426,6 +450,29 @@ func showWebhookCmdF(c client.Client, command *cobra.Command, args []string) err
 	return errors.New("Webhook with id '" + webhookID + "' not found")
 }
 
+func webhookDeliveriesCmdF(c client.Client, command *cobra.Command, args []string) error {
+	page, err := command.Flags().GetInt("page")
+	if err != nil {
+		return err
+	}
+	perPage, err := command.Flags().GetInt("per-page")
+	if err != nil {
+		return err
+	}
+
+	webhookID := args[0]
+	deliveries, _, err := c.GetOutgoingWebhookDeliveries(context.TODO(), webhookID, page, perPage)
+	if err != nil {
+		return errors.Wrapf(err, "Unable to get deliveries for outgoing webhook '%s'", webhookID)
+	}
+
+	for _, delivery := range deliveries {
+		printer.PrintT("{{.Id}}: attempt {{.Attempt}} to {{.CallbackURL}} {{.Status}} (status code {{.StatusCode}}, {{.Latency}}ms)", delivery)
+	}
+
+	return nil
+}
+
 func init() {
 	CreateIncomingWebhookCmd.Flags().String("channel", "", "Channel ID (required)")
 	_ = CreateIncomingWebhookCmd.MarkFlagRequired("channel")
@@ -435,12 +482,18 @@ func init() {
 	CreateIncomingWebhookCmd.Flags().String("description", "", "Incoming webhook description")
 	CreateIncomingWebhookCmd.Flags().String("icon", "", "Icon URL")
 	CreateIncomingWebhookCmd.Flags().Bool("lock-to-channel", false, "Lock to channel")
+	CreateIncomingWebhookCmd.Flags().Int("rate-limit-per-minute", 0, "Maximum number of posts per minute, 0 for no limit")
+	CreateIncomingWebhookCmd.Flags().Int("rate-limit-burst", 0, "Number of posts allowed above the rate limit, defaults to the per minute limit")
+	CreateIncomingWebhookCmd.Flags().String("payload-template", "", "Go template rendering the post message from the request's JSON payload")
 
 	ModifyIncomingWebhookCmd.Flags().String("channel", "", "Channel ID")
 	ModifyIncomingWebhookCmd.Flags().String("display-name", "", "Incoming webhook display name")
 	ModifyIncomingWebhookCmd.Flags().String("description", "", "Incoming webhook description")
 	ModifyIncomingWebhookCmd.Flags().String("icon", "", "Icon URL")
 	ModifyIncomingWebhookCmd.Flags().Bool("lock-to-channel", false, "Lock to channel")
+	ModifyIncomingWebhookCmd.Flags().Int("rate-limit-per-minute", 0, "Maximum number of posts per minute, 0 for no limit")
+	ModifyIncomingWebhookCmd.Flags().Int("rate-limit-burst", 0, "Number of posts allowed above the rate limit, defaults to the per minute limit")
+	ModifyIncomingWebhookCmd.Flags().String("payload-template", "", "Go template rendering the post message from the request's JSON payload")
 
 	CreateOutgoingWebhookCmd.Flags().String("team", "", "Team name or ID (required)")
 	_ = CreateOutgoingWebhookCmd.MarkFlagRequired("team")
@@ -467,6 +520,9 @@ func init() {
 	ModifyOutgoingWebhookCmd.Flags().StringArray("url", []string{}, "Callback URL")
 	ModifyOutgoingWebhookCmd.Flags().String("content-type", "", "Content-type")
 
+	WebhookDeliveriesCmd.Flags().Int("page", 0, "Page number to fetch for the list of deliveries")
+	WebhookDeliveriesCmd.Flags().Int("per-page", DefaultPageSize, "Number of deliveries to be fetched")
+
 	WebhookCmd.AddCommand(
 		ListWebhookCmd,
 		CreateIncomingWebhookCmd,
@@ -475,6 +531,7 @@ func init() {
 		ModifyOutgoingWebhookCmd,
 		DeleteWebhookCmd,
 		ShowWebhookCmd,
+		WebhookDeliveriesCmd,
 	)
 
 	RootCmd.AddCommand(WebhookCmd)
@@ -377,6 +377,46 @@ func (s *MmctlUnitTestSuite) TestModifyIncomingWebhookCmd() {
 		s.Require().Equal(&updatedIncomingWebhook, printer.GetLines()[0])
 	})
 
+	s.Run("Successfully modify incoming webhook rate limit and payload template", func() {
+		printer.Clean()
+
+		mockIncomingWebhook := model.IncomingWebhook{
+			Id:          incomingWebhookID,
+			ChannelId:   channelID,
+			Username:    userName,
+			DisplayName: displayName,
+		}
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("rate-limit-per-minute", 0, "")
+		cmd.Flags().Int("rate-limit-burst", 0, "")
+		cmd.Flags().String("payload-template", "", "")
+		_ = cmd.Flags().Set("rate-limit-per-minute", "30")
+		_ = cmd.Flags().Set("payload-template", "{{ .text }}")
+
+		updatedIncomingWebhook := mockIncomingWebhook
+		updatedIncomingWebhook.RateLimitPerMinute = 30
+		updatedIncomingWebhook.PayloadTemplate = "{{ .text }}"
+
+		s.client.
+			EXPECT().
+			GetIncomingWebhook(context.TODO(), incomingWebhookID, "").
+			Return(&mockIncomingWebhook, &model.Response{}, nil).
+			Times(1)
+
+		s.client.
+			EXPECT().
+			UpdateIncomingWebhook(context.TODO(), &updatedIncomingWebhook).
+			Return(&updatedIncomingWebhook, &model.Response{}, nil).
+			Times(1)
+
+		err := modifyIncomingWebhookCmdF(s.client, cmd, []string{incomingWebhookID})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Len(printer.GetErrorLines(), 0)
+		s.Require().Equal(&updatedIncomingWebhook, printer.GetLines()[0])
+	})
+
 	s.Run("modify incoming webhook errored", func() {
 		printer.Clean()
 
@@ -788,3 +828,71 @@ func (s *MmctlUnitTestSuite) TestShowWebhookCmd() {
 		s.Require().Equal("Webhook with id '"+nonExistentID+"' not found", err.Error())
 	})
 }
+
+func (s *MmctlUnitTestSuite) TestWebhookDeliveriesCmd() {
+	outgoingWebhookID := "outgoingWebhookID"
+
+	s.Run("Successfully list deliveries", func() {
+		printer.Clean()
+
+		mockDelivery := model.OutgoingWebhookDelivery{Id: "deliveryID", HookId: outgoingWebhookID, Attempt: 1, Status: model.OutgoingWebhookDeliveryStatusSuccess}
+
+		s.client.
+			EXPECT().
+			GetOutgoingWebhookDeliveries(context.TODO(), outgoingWebhookID, 0, DefaultPageSize).
+			Return([]*model.OutgoingWebhookDelivery{&mockDelivery}, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("page", 0, "")
+		cmd.Flags().Int("per-page", DefaultPageSize, "")
+
+		err := webhookDeliveriesCmdF(s.client, cmd, []string{outgoingWebhookID})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 1)
+		s.Len(printer.GetErrorLines(), 0)
+		s.Require().Equal(&mockDelivery, printer.GetLines()[0])
+	})
+
+	s.Run("Successfully list deliveries with paging", func() {
+		printer.Clean()
+
+		s.client.
+			EXPECT().
+			GetOutgoingWebhookDeliveries(context.TODO(), outgoingWebhookID, 2, 5).
+			Return([]*model.OutgoingWebhookDelivery{}, &model.Response{}, nil).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("page", 0, "")
+		cmd.Flags().Int("per-page", DefaultPageSize, "")
+		_ = cmd.Flags().Set("page", "2")
+		_ = cmd.Flags().Set("per-page", "5")
+
+		err := webhookDeliveriesCmdF(s.client, cmd, []string{outgoingWebhookID})
+		s.Require().Nil(err)
+		s.Len(printer.GetLines(), 0)
+		s.Len(printer.GetErrorLines(), 0)
+	})
+
+	s.Run("Error when listing deliveries", func() {
+		printer.Clean()
+
+		mockError := errors.New("mock error")
+
+		s.client.
+			EXPECT().
+			GetOutgoingWebhookDeliveries(context.TODO(), outgoingWebhookID, 0, DefaultPageSize).
+			Return(nil, &model.Response{}, mockError).
+			Times(1)
+
+		cmd := &cobra.Command{}
+		cmd.Flags().Int("page", 0, "")
+		cmd.Flags().Int("per-page", DefaultPageSize, "")
+
+		err := webhookDeliveriesCmdF(s.client, cmd, []string{outgoingWebhookID})
+		s.Require().Error(err)
+		s.Len(printer.GetLines(), 0)
+		s.Require().Contains(err.Error(), mockError.Error())
+	})
+}
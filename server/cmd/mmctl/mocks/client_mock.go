@@ -763,6 +763,38 @@ func (mr *MockClientMockRecorder) GetConfig(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockClient)(nil).GetConfig), arg0)
 }
 
+// GetConfigHistory mocks base method.
+func (m *MockClient) GetConfigHistory(arg0 context.Context, arg1, arg2 int) ([]*model.ConfigHistoryEntry, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*model.ConfigHistoryEntry)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConfigHistory indicates an expected call of GetConfigHistory.
+func (mr *MockClientMockRecorder) GetConfigHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigHistory", reflect.TypeOf((*MockClient)(nil).GetConfigHistory), arg0, arg1, arg2)
+}
+
+// GetConfigVersionDiff mocks base method.
+func (m *MockClient) GetConfigVersionDiff(arg0 context.Context, arg1 string) ([]*model.ConfigChange, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigVersionDiff", arg0, arg1)
+	ret0, _ := ret[0].([]*model.ConfigChange)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConfigVersionDiff indicates an expected call of GetConfigVersionDiff.
+func (mr *MockClientMockRecorder) GetConfigVersionDiff(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigVersionDiff", reflect.TypeOf((*MockClient)(nil).GetConfigVersionDiff), arg0, arg1)
+}
+
 // GetDeletedChannelsForTeam mocks base method.
 func (m *MockClient) GetDeletedChannelsForTeam(arg0 context.Context, arg1 string, arg2, arg3 int, arg4 string) ([]*model.Channel, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1005,6 +1037,22 @@ func (mr *MockClientMockRecorder) GetOutgoingWebhook(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingWebhook", reflect.TypeOf((*MockClient)(nil).GetOutgoingWebhook), arg0, arg1)
 }
 
+// GetOutgoingWebhookDeliveries mocks base method.
+func (m *MockClient) GetOutgoingWebhookDeliveries(arg0 context.Context, arg1 string, arg2, arg3 int) ([]*model.OutgoingWebhookDelivery, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutgoingWebhookDeliveries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*model.OutgoingWebhookDelivery)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOutgoingWebhookDeliveries indicates an expected call of GetOutgoingWebhookDeliveries.
+func (mr *MockClientMockRecorder) GetOutgoingWebhookDeliveries(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingWebhookDeliveries", reflect.TypeOf((*MockClient)(nil).GetOutgoingWebhookDeliveries), arg0, arg1, arg2, arg3)
+}
+
 // GetOutgoingWebhooks mocks base method.
 func (m *MockClient) GetOutgoingWebhooks(arg0 context.Context, arg1, arg2 int, arg3 string) ([]*model.OutgoingWebhook, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1825,6 +1873,22 @@ func (mr *MockClientMockRecorder) PromoteGuestToUser(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteGuestToUser", reflect.TypeOf((*MockClient)(nil).PromoteGuestToUser), arg0, arg1)
 }
 
+// RegenOutgoingHookSecret mocks base method.
+func (m *MockClient) RegenOutgoingHookSecret(arg0 context.Context, arg1 string) (*model.OutgoingWebhook, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenOutgoingHookSecret", arg0, arg1)
+	ret0, _ := ret[0].(*model.OutgoingWebhook)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RegenOutgoingHookSecret indicates an expected call of RegenOutgoingHookSecret.
+func (mr *MockClientMockRecorder) RegenOutgoingHookSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenOutgoingHookSecret", reflect.TypeOf((*MockClient)(nil).RegenOutgoingHookSecret), arg0, arg1)
+}
+
 // RegenOutgoingHookToken mocks base method.
 func (m *MockClient) RegenOutgoingHookToken(arg0 context.Context, arg1 string) (*model.OutgoingWebhook, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1948,6 +2012,22 @@ func (mr *MockClientMockRecorder) RestoreChannel(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreChannel", reflect.TypeOf((*MockClient)(nil).RestoreChannel), arg0, arg1)
 }
 
+// RestoreConfigVersion mocks base method.
+func (m *MockClient) RestoreConfigVersion(arg0 context.Context, arg1 string) (*model.Config, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreConfigVersion", arg0, arg1)
+	ret0, _ := ret[0].(*model.Config)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// RestoreConfigVersion indicates an expected call of RestoreConfigVersion.
+func (mr *MockClientMockRecorder) RestoreConfigVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreConfigVersion", reflect.TypeOf((*MockClient)(nil).RestoreConfigVersion), arg0, arg1)
+}
+
 // RestoreGroup mocks base method.
 func (m *MockClient) RestoreGroup(arg0 context.Context, arg1, arg2 string) (*model.Group, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -1995,6 +2075,22 @@ func (mr *MockClientMockRecorder) RevokeUserAccessToken(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserAccessToken", reflect.TypeOf((*MockClient)(nil).RevokeUserAccessToken), arg0, arg1)
 }
 
+// SearchAuditRecords mocks base method.
+func (m *MockClient) SearchAuditRecords(arg0 context.Context, arg1 model.AuditRecordSearchOpts) ([]*model.AuditRecord, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAuditRecords", arg0, arg1)
+	ret0, _ := ret[0].([]*model.AuditRecord)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchAuditRecords indicates an expected call of SearchAuditRecords.
+func (mr *MockClientMockRecorder) SearchAuditRecords(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAuditRecords", reflect.TypeOf((*MockClient)(nil).SearchAuditRecords), arg0, arg1)
+}
+
 // SearchTeams mocks base method.
 func (m *MockClient) SearchTeams(arg0 context.Context, arg1 *model.TeamSearch) ([]*model.Team, *model.Response, error) {
 	m.ctrl.T.Helper()
@@ -2367,6 +2463,22 @@ func (mr *MockClientMockRecorder) UploadPluginForced(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadPluginForced", reflect.TypeOf((*MockClient)(nil).UploadPluginForced), arg0, arg1)
 }
 
+// VerifyAuditRecords mocks base method.
+func (m *MockClient) VerifyAuditRecords(arg0 context.Context) (*model.AuditRecordVerification, *model.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAuditRecords", arg0)
+	ret0, _ := ret[0].(*model.AuditRecordVerification)
+	ret1, _ := ret[1].(*model.Response)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// VerifyAuditRecords indicates an expected call of VerifyAuditRecords.
+func (mr *MockClientMockRecorder) VerifyAuditRecords(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAuditRecords", reflect.TypeOf((*MockClient)(nil).VerifyAuditRecords), arg0)
+}
+
 // VerifyUserEmailWithoutToken mocks base method.
 func (m *MockClient) VerifyUserEmailWithoutToken(arg0 context.Context, arg1 string) (*model.User, *model.Response, error) {
 	m.ctrl.T.Helper()
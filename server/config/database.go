@@ -200,7 +200,12 @@ func parseDSN(dsn string) (string, string, error) {
 
 // Set replaces the current configuration in its entirety and updates the backing store.
 func (ds *DatabaseStore) Set(newCfg *model.Config) error {
-	return ds.persist(newCfg)
+	return ds.persist(newCfg, "")
+}
+
+// SetWithAuthor is like Set, additionally recording the user who made the change.
+func (ds *DatabaseStore) SetWithAuthor(newCfg *model.Config, author string) error {
+	return ds.persist(newCfg, author)
 }
 
 // maxLength identifies the maximum length of a configuration or configuration file
@@ -213,7 +218,7 @@ func (ds *DatabaseStore) checkLength(length int) error {
 }
 
 // persist writes the configuration to the configured database.
-func (ds *DatabaseStore) persist(cfg *model.Config) error {
+func (ds *DatabaseStore) persist(cfg *model.Config, author string) error {
 	b, err := marshalConfig(cfg)
 	if err != nil {
 		return errors.Wrap(err, "failed to serialize")
@@ -291,9 +296,10 @@ func (ds *DatabaseStore) persist(cfg *model.Config) error {
 		"create_at": model.GetMillis(),
 		"key":       "ConfigurationId",
 		"sha":       hex.EncodeToString(sum[0:]),
+		"author":    author,
 	}
 
-	if _, err := tx.NamedExec("INSERT INTO Configurations (Id, Value, CreateAt, Active, SHA) VALUES (:id, :value, :create_at, TRUE, :sha)", params); err != nil {
+	if _, err := tx.NamedExec("INSERT INTO Configurations (Id, Value, CreateAt, Active, SHA, Author) VALUES (:id, :value, :create_at, TRUE, :sha, :author)", params); err != nil {
 		return errors.Wrap(err, "failed to record new configuration")
 	}
 
@@ -324,6 +330,75 @@ func (ds *DatabaseStore) Load() ([]byte, error) {
 	return configurationData, nil
 }
 
+// getVersions fetches saved configurations, most recent first.
+func (ds *DatabaseStore) getVersions(offset, limit int) ([]*Version, error) {
+	query, args, err := sqlx.Named("SELECT Id, Value, CreateAt, Active, COALESCE(Author, '') FROM Configurations ORDER BY CreateAt DESC, Id DESC LIMIT :limit OFFSET :offset", map[string]any{
+		"limit":  limit,
+		"offset": offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := ds.db.Queryx(ds.db.Rebind(query), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query configurations")
+	}
+	defer rows.Close()
+
+	versions := []*Version{}
+	for rows.Next() {
+		version, err := scanVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate configurations")
+	}
+
+	return versions, nil
+}
+
+// getVersion fetches a saved configuration, returning ErrVersionNotFound if there is none
+// with the given id.
+func (ds *DatabaseStore) getVersion(id string) (*Version, error) {
+	query, args, err := sqlx.Named("SELECT Id, Value, CreateAt, Active, COALESCE(Author, '') FROM Configurations WHERE Id = :id", map[string]any{
+		"id": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	version, err := scanVersion(ds.db.QueryRowx(ds.db.Rebind(query), args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrVersionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return version, nil
+}
+
+func scanVersion(row interface{ Scan(...any) error }) (*Version, error) {
+	var version Version
+	var value []byte
+	var active sql.NullBool
+	if err := row.Scan(&version.Id, &value, &version.CreateAt, &active, &version.Author); err != nil {
+		return nil, errors.Wrap(err, "failed to scan configuration")
+	}
+	version.Active = active.Valid && active.Bool
+
+	cfg, err := unmarshalVersion(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse configuration %s", version.Id)
+	}
+	version.Config = cfg
+
+	return &version, nil
+}
+
 // GetFile fetches the contents of a previously persisted configuration file.
 func (ds *DatabaseStore) GetFile(name string) ([]byte, error) {
 	query, args, err := sqlx.Named("SELECT Data FROM ConfigurationFiles WHERE Name = :name", map[string]any{
@@ -876,7 +876,7 @@ func TestDatabaseStoreLoad(t *testing.T) {
 		newCfg := minimalConfig.Clone()
 		dbStore, ok := ds.backingStore.(*DatabaseStore)
 		require.True(t, ok)
-		err = dbStore.persist(newCfg)
+		err = dbStore.persist(newCfg, "")
 		require.NoError(t, err)
 
 		err = ds.Load()
@@ -1159,3 +1159,57 @@ func TestCleanUp(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, count+3 == initialCount)
 }
+
+func TestDatabaseStoreHistory(t *testing.T) {
+	if testing.Short() {
+		t.SkipNow()
+	}
+
+	t.Run("versions listed most recent first with author", func(t *testing.T) {
+		initialID, tearDown := setupConfigDatabase(t, minimalConfig, nil)
+		defer tearDown()
+
+		ds, err := newTestDatabaseStore(nil)
+		require.NoError(t, err)
+		defer ds.Close()
+
+		newCfg := minimalConfig.Clone()
+		newCfg.ServiceSettings.SiteURL = model.NewPointer("http://history")
+		_, _, err = ds.SetWithAuthor(newCfg, "userid")
+		require.NoError(t, err)
+
+		versions, err := ds.GetHistory(0, 10)
+		require.NoError(t, err)
+		require.Len(t, versions, 2)
+
+		assert.True(t, versions[0].Active)
+		assert.Equal(t, "userid", versions[0].Author)
+		assert.Equal(t, "http://history", *versions[0].Config.ServiceSettings.SiteURL)
+
+		assert.False(t, versions[1].Active)
+		assert.Equal(t, initialID, versions[1].Id)
+		assert.Equal(t, "", versions[1].Author)
+
+		versions, err = ds.GetHistory(1, 10)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+		assert.Equal(t, initialID, versions[0].Id)
+	})
+
+	t.Run("get version", func(t *testing.T) {
+		initialID, tearDown := setupConfigDatabase(t, minimalConfig, nil)
+		defer tearDown()
+
+		ds, err := newTestDatabaseStore(nil)
+		require.NoError(t, err)
+		defer ds.Close()
+
+		version, err := ds.GetVersion(initialID)
+		require.NoError(t, err)
+		assert.Equal(t, initialID, version.Id)
+		assert.Equal(t, *minimalConfig.ServiceSettings.SiteURL, *version.Config.ServiceSettings.SiteURL)
+
+		_, err = ds.GetVersion(model.NewId())
+		require.ErrorIs(t, err, ErrVersionNotFound)
+	})
+}
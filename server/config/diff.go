@@ -44,6 +44,7 @@ var configSensitivePaths = map[string]bool{
 	"SqlSettings.AtRestEncryptKey":                           true,
 	"SqlSettings.DataSourceReplicas":                         true,
 	"SqlSettings.DataSourceSearchReplicas":                   true,
+	"SqlSettings.ReplicaLagSettings":                         true,
 	"EmailSettings.SMTPPassword":                             true,
 	"GitLabSettings.Secret":                                  true,
 	"GoogleSettings.Secret":                                  true,
@@ -55,6 +56,7 @@ var configSensitivePaths = map[string]bool{
 	"MessageExportSettings.GlobalRelaySettings.EmailAddress": true,
 	"ServiceSettings.SplitKey":                               true,
 	"PluginSettings.Plugins":                                 true,
+	"CacheSettings.RedisPassword":                            true,
 }
 
 // Sanitize replaces sensitive config values in the diff with asterisks filled strings.
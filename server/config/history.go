@@ -0,0 +1,77 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package config
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+var (
+	// ErrHistoryNotSupported is returned when the backing store does not retain previous
+	// configurations. Only the database backing store does.
+	ErrHistoryNotSupported = errors.New("configuration history is not supported by the backing store")
+
+	// ErrVersionNotFound is returned when no saved configuration matches the requested id.
+	ErrVersionNotFound = errors.New("configuration version not found")
+)
+
+// Version is a configuration saved to the backing store.
+type Version struct {
+	Id       string
+	CreateAt int64
+	// Author is the id of the user who saved the configuration, if known.
+	Author string
+	Active bool
+	Config *model.Config
+}
+
+// unmarshalVersion parses a saved configuration, filling in the settings that did not
+// exist yet when it was saved so that it can be compared with newer versions.
+func unmarshalVersion(data []byte) (*model.Config, error) {
+	cfg := &model.Config{}
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+	if cfg.ServiceSettings.SiteURL == nil {
+		cfg.ServiceSettings.SiteURL = model.NewPointer("")
+	}
+	cfg.SetDefaults()
+	return cfg, nil
+}
+
+// SetWithAuthor is like Set, additionally recording the user who made the change in the
+// configuration history when the backing store keeps one.
+func (s *Store) SetWithAuthor(newCfg *model.Config, author string) (*model.Config, *model.Config, error) {
+	return s.set(newCfg, author)
+}
+
+// GetHistory fetches the configurations saved to the backing store, most recent first.
+func (s *Store) GetHistory(offset, limit int) ([]*Version, error) {
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+
+	switch bs := s.backingStore.(type) {
+	case *DatabaseStore:
+		return bs.getVersions(offset, limit)
+	default:
+		return nil, ErrHistoryNotSupported
+	}
+}
+
+// GetVersion fetches a configuration saved to the backing store.
+func (s *Store) GetVersion(id string) (*Version, error) {
+	s.configLock.RLock()
+	defer s.configLock.RUnlock()
+
+	switch bs := s.backingStore.(type) {
+	case *DatabaseStore:
+		return bs.getVersion(id)
+	default:
+		return nil, ErrHistoryNotSupported
+	}
+}
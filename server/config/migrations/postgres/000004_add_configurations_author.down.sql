@@ -0,0 +1 @@
+ALTER TABLE Configurations DROP COLUMN IF EXISTS Author;
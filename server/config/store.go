@@ -166,6 +166,10 @@ func (s *Store) SetReadOnlyFF(readOnly bool) {
 // Set replaces the current configuration in its entirety and updates the backing store.
 // It returns both old and new versions of the config.
 func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error) {
+	return s.set(newCfg, "")
+}
+
+func (s *Store) set(newCfg *model.Config, author string) (*model.Config, *model.Config, error) {
 	s.configLock.Lock()
 	defer s.configLock.Unlock()
 
@@ -210,7 +214,7 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 		newCfgNoEnv.FeatureFlags = nil
 	}
 
-	if err := s.backingStore.Set(newCfgNoEnv); err != nil {
+	if err := s.persist(newCfgNoEnv, author); err != nil {
 		return nil, nil, errors.Wrap(err, "failed to persist")
 	}
 
@@ -240,6 +244,17 @@ func (s *Store) Set(newCfg *model.Config) (*model.Config, *model.Config, error)
 	return oldCfg, newCfgCopy, nil
 }
 
+// persist writes the configuration to the backing store, recording its author if the
+// backing store keeps the configuration history.
+func (s *Store) persist(cfg *model.Config, author string) error {
+	switch bs := s.backingStore.(type) {
+	case *DatabaseStore:
+		return bs.SetWithAuthor(cfg, author)
+	default:
+		return s.backingStore.Set(cfg)
+	}
+}
+
 // Load updates the current configuration from the backing store, possibly initializing.
 func (s *Store) Load() error {
 	s.configLock.Lock()
@@ -101,3 +101,14 @@ func TestNewStoreReadOnly(t *testing.T) {
 		fs.Close()
 	})
 }
+
+func TestStoreHistoryNotSupported(t *testing.T) {
+	ms := NewTestMemoryStore()
+	defer ms.Close()
+
+	_, err := ms.GetHistory(0, 10)
+	require.ErrorIs(t, err, ErrHistoryNotSupported)
+
+	_, err = ms.GetVersion("id")
+	require.ErrorIs(t, err, ErrHistoryNotSupported)
+}
@@ -0,0 +1,423 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.enterprise for license information.
+
+package eml_export
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	gomail "gopkg.in/mail.v2"
+
+	"github.com/mattermost/mattermost/server/v8/enterprise/message_export/common_export"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+const (
+	EmlExportFilename  = "eml_export.zip"
+	MboxExportFilename = "mbox_export.mbox"
+	EmlWarningFilename = "warning.txt"
+
+	ChannelNameHeader = "X-Mattermost-ChannelName"
+	ChannelIDHeader   = "X-Mattermost-ChannelID"
+	ChannelTypeHeader = "X-Mattermost-ChannelType"
+	TeamNameHeader    = "X-Mattermost-TeamName"
+
+	dayLayout  = "2006-01-02"
+	timeLayout = "2006-01-02 15:04:05 MST"
+)
+
+// ChannelDay holds everything that happened in a channel during a single UTC day of the export period.
+type ChannelDay struct {
+	Day         string
+	Channel     common_export.MetadataChannel
+	Posts       []*model.MessageExport
+	Members     common_export.ChannelMembers
+	Attachments map[string][]*model.FileInfo // keyed by post id
+	Joins       []common_export.ChannelMemberJoin
+	Leaves      []common_export.ChannelMemberLeave
+}
+
+// event is a line of the transcript of a channel day.
+type event struct {
+	Time int64
+	Text string
+}
+
+// EmlExport writes the posts as RFC 5322 messages, one per channel and UTC day. Messages are either stored as
+// separate .eml files in a zip archive, or concatenated in a single mbox file if mbox is true.
+func EmlExport(rctx request.CTX, posts []*model.MessageExport, db store.Store, exportBackend filestore.FileBackend, fileAttachmentBackend filestore.FileBackend, exportDirectory string, mbox bool) (warningCount int64, appErr *model.AppError) {
+	channelDays, appErr := getChannelDays(posts, db)
+	if appErr != nil {
+		return warningCount, appErr
+	}
+
+	filename := EmlExportFilename
+	if mbox {
+		filename = MboxExportFilename
+	}
+
+	dest, err := os.CreateTemp("", filename)
+	if err != nil {
+		return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.file.creation.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	defer os.Remove(dest.Name())
+	defer dest.Close()
+
+	var missingFiles []string
+	var zipFile *zip.Writer
+	var mboxFile *MboxWriter
+	if mbox {
+		mboxFile = NewMboxWriter(dest)
+	} else {
+		zipFile = zip.NewWriter(dest)
+	}
+
+	for _, channelDay := range channelDays {
+		var w io.Writer
+		if mbox {
+			w = mboxFile.NewMessage(time.UnixMilli(lastEventTime(channelDay)))
+		} else {
+			w, err = zipFile.Create(fmt.Sprintf("%s - (%s) - %s.eml", channelDay.Channel.ChannelName, channelDay.Channel.ChannelId, channelDay.Day))
+			if err != nil {
+				return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.zip.creation.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+
+		missing, err := writeChannelDay(rctx, fileAttachmentBackend, channelDay, w)
+		if err != nil {
+			return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.generate_email.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		missingFiles = append(missingFiles, missing...)
+	}
+
+	warningCount = int64(len(missingFiles))
+	if mbox {
+		if err = mboxFile.Close(); err != nil {
+			return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.generate_email.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		if warningCount > 0 {
+			// The mbox file can't hold anything but messages, so warnings are written next to it.
+			if _, err = exportBackend.WriteFile(strings.NewReader(strings.Join(missingFiles, "\n")+"\n"), path.Join(exportDirectory, EmlWarningFilename)); err != nil {
+				return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.warning.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+	} else {
+		if warningCount > 0 {
+			warningFile, err := zipFile.Create(EmlWarningFilename)
+			if err != nil {
+				return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.warning.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			if _, err = warningFile.Write([]byte(strings.Join(missingFiles, "\n") + "\n")); err != nil {
+				return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.warning.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+		}
+		if err = zipFile.Close(); err != nil {
+			return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.zip.close.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+	}
+
+	if _, err = dest.Seek(0, 0); err != nil {
+		return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.seek.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	// Try to write the file without a timeout due to the potential size of the file.
+	if _, err = filestore.TryWriteFileContext(rctx.Context(), exportBackend, dest, path.Join(exportDirectory, filename)); err != nil {
+		return warningCount, model.NewAppError("EmlExport", "ent.compliance.eml.write_file.appError", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	return warningCount, nil
+}
+
+// getChannelDays groups the posts by channel and UTC day, sorted by channel name and day, and looks up the
+// attachments and the channel members joining and leaving during that time.
+func getChannelDays(posts []*model.MessageExport, db store.Store) ([]*ChannelDay, *model.AppError) {
+	channelDaysByKey := map[string]*ChannelDay{}
+	channelDays := []*ChannelDay{}
+
+	for _, post := range posts {
+		day := time.UnixMilli(*post.PostCreateAt).UTC().Format(dayLayout)
+		key := *post.ChannelId + "/" + day
+
+		channelDay, ok := channelDaysByKey[key]
+		if !ok {
+			channelDay = &ChannelDay{
+				Day:         day,
+				Members:     common_export.ChannelMembers{},
+				Attachments: map[string][]*model.FileInfo{},
+			}
+			metadata := common_export.Metadata{Channels: map[string]common_export.MetadataChannel{}}
+			metadata.Update(post, 0)
+			channelDay.Channel = metadata.Channels[*post.ChannelId]
+			channelDaysByKey[key] = channelDay
+			channelDays = append(channelDays, channelDay)
+		}
+
+		channelDay.Posts = append(channelDay.Posts, post)
+		channelDay.Channel.EndTime = *post.PostCreateAt
+		channelDay.Channel.MessagesCount++
+		channelDay.Members[*post.UserId] = common_export.ChannelMember{
+			UserId:   *post.UserId,
+			Username: *post.Username,
+			IsBot:    post.IsBot,
+			Email:    *post.UserEmail,
+		}
+
+		if len(post.PostFileIds) > 0 {
+			attachments, err := db.FileInfo().GetForPost(*post.PostId, true, true, false)
+			if err != nil {
+				return nil, model.NewAppError("getChannelDays", "ent.message_export.eml_export.get_attachment_error", nil, "", http.StatusInternalServerError).Wrap(err)
+			}
+			channelDay.Attachments[*post.PostId] = attachments
+			channelDay.Channel.AttachmentsCount += len(attachments)
+		}
+	}
+
+	for _, channelDay := range channelDays {
+		channelMembersHistory, err := db.ChannelMemberHistory().GetUsersInChannelDuring(channelDay.Channel.StartTime, channelDay.Channel.EndTime, channelDay.Channel.ChannelId)
+		if err != nil {
+			return nil, model.NewAppError("getChannelDays", "ent.get_users_in_channel_during", nil, "", http.StatusInternalServerError).Wrap(err)
+		}
+		channelDay.Joins, channelDay.Leaves = common_export.GetJoinsAndLeavesForChannel(channelDay.Channel.StartTime, channelDay.Channel.EndTime, channelMembersHistory, channelDay.Members)
+	}
+
+	sort.SliceStable(channelDays, func(i, j int) bool {
+		if channelDays[i].Channel.ChannelName != channelDays[j].Channel.ChannelName {
+			return channelDays[i].Channel.ChannelName < channelDays[j].Channel.ChannelName
+		}
+		if channelDays[i].Channel.ChannelId != channelDays[j].Channel.ChannelId {
+			return channelDays[i].Channel.ChannelId < channelDays[j].Channel.ChannelId
+		}
+		return channelDays[i].Day < channelDays[j].Day
+	})
+	return channelDays, nil
+}
+
+// writeChannelDay writes the channel day as a MIME message with a plain text transcript and the attachments as
+// separate parts. It returns a warning for each attachment missing from the file backend.
+func writeChannelDay(rctx request.CTX, fileAttachmentBackend filestore.FileBackend, channelDay *ChannelDay, w io.Writer) ([]string, error) {
+	var missingFiles []string
+	attachments := []*model.FileInfo{}
+	for _, post := range channelDay.Posts {
+		for _, attachment := range channelDay.Attachments[*post.PostId] {
+			exists, err := fileAttachmentBackend.FileExists(attachment.Path)
+			if err != nil || !exists {
+				missingFiles = append(missingFiles, "Warning:"+common_export.MissingFileMessage+" - Post: "+*post.PostId+" - "+attachment.Path)
+				rctx.Logger().Warn(common_export.MissingFileMessage, mlog.String("PostId", *post.PostId), mlog.String("FileName", attachment.Path))
+				continue
+			}
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	participants := getParticipants(channelDay)
+	to := make([]string, 0, len(participants))
+	for _, participant := range participants {
+		to = append(to, participant.Email)
+	}
+	// Our conversations aren't initiated by anyone, so the first participant is used as the sender.
+	from := ""
+	if len(to) > 0 {
+		from = to[0]
+	}
+
+	channel := channelDay.Channel
+	headers := map[string][]string{
+		"From":            {from},
+		"To":              {strings.Join(to, ",")},
+		"Subject":         {encodeRFC2047Word(fmt.Sprintf("Mattermost Compliance Export: %s - %s", channel.ChannelDisplayName, channelDay.Day))},
+		"Message-ID":      {fmt.Sprintf("<%s.%d@mattermost.export>", channel.ChannelId, channel.StartTime)},
+		"Auto-Submitted":  {"auto-generated"},
+		ChannelNameHeader: {encodeRFC2047Word(channel.ChannelName)},
+		ChannelIDHeader:   {channel.ChannelId},
+		ChannelTypeHeader: {common_export.ChannelTypeDisplayName(channel.ChannelType)},
+	}
+	if channel.TeamName != nil && *channel.TeamName != "" {
+		headers[TeamNameHeader] = []string{encodeRFC2047Word(*channel.TeamName)}
+	}
+
+	m := gomail.NewMessage(gomail.SetCharset("UTF-8"))
+	m.SetHeaders(headers)
+	m.SetDateHeader("Date", time.UnixMilli(lastEventTime(channelDay)).UTC())
+	m.SetBody("text/plain", transcript(rctx, channelDay, participants))
+
+	for _, attachment := range attachments {
+		filePath := attachment.Path
+		m.Attach(attachment.Name, gomail.SetCopyFunc(func(writer io.Writer) error {
+			reader, err := fileAttachmentBackend.Reader(filePath)
+			if err != nil {
+				return err
+			}
+			defer reader.Close()
+
+			_, err = io.Copy(writer, reader)
+			return err
+		}))
+	}
+
+	if _, err := m.WriteTo(w); err != nil {
+		return nil, err
+	}
+	return missingFiles, nil
+}
+
+func getParticipants(channelDay *ChannelDay) []common_export.ChannelMember {
+	participantsMap := map[string]common_export.ChannelMember{}
+	for _, join := range channelDay.Joins {
+		participantsMap[join.UserId] = common_export.ChannelMember{UserId: join.UserId, IsBot: join.IsBot, Email: join.Email, Username: join.Username}
+	}
+	for _, member := range channelDay.Members {
+		participantsMap[member.UserId] = member
+	}
+
+	participants := make([]common_export.ChannelMember, 0, len(participantsMap))
+	for _, participant := range participantsMap {
+		participants = append(participants, participant)
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].Username < participants[j].Username
+	})
+	return participants
+}
+
+func transcript(rctx request.CTX, channelDay *ChannelDay, participants []common_export.ChannelMember) string {
+	channel := channelDay.Channel
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Channel: %s (%s)\n", channel.ChannelDisplayName, channel.ChannelName)
+	fmt.Fprintf(&sb, "Channel ID: %s\n", channel.ChannelId)
+	fmt.Fprintf(&sb, "Channel type: %s\n", common_export.ChannelTypeDisplayName(channel.ChannelType))
+	if channel.TeamDisplayName != nil && *channel.TeamDisplayName != "" {
+		fmt.Fprintf(&sb, "Team: %s (%s)\n", *channel.TeamDisplayName, *channel.TeamName)
+	}
+	fmt.Fprintf(&sb, "Period: %s - %s\n", formatTime(channel.StartTime), formatTime(channel.EndTime))
+
+	sb.WriteString("\nParticipants:\n")
+	for _, participant := range participants {
+		fmt.Fprintf(&sb, "  %s\n", formatUser(participant.Username, participant.Email, participant.IsBot))
+	}
+
+	sb.WriteString("\nMessages:\n")
+	for _, e := range channelDayEvents(rctx, channelDay) {
+		fmt.Fprintf(&sb, "[%s] %s\n", formatTime(e.Time), strings.ReplaceAll(e.Text, "\n", "\n    "))
+	}
+	return sb.String()
+}
+
+// channelDayEvents returns the posts, attachments, joins and leaves of the channel day, sorted by time.
+func channelDayEvents(rctx request.CTX, channelDay *ChannelDay) []event {
+	events := []event{}
+	for _, join := range channelDay.Joins {
+		text := formatUser(join.Username, join.Email, join.IsBot) + " joined the channel"
+		if join.Datetime <= channelDay.Channel.StartTime {
+			text = formatUser(join.Username, join.Email, join.IsBot) + " was already in the channel"
+		}
+		events = append(events, event{Time: max(join.Datetime, channelDay.Channel.StartTime), Text: text})
+	}
+	for _, leave := range channelDay.Leaves {
+		events = append(events, event{Time: leave.Datetime, Text: formatUser(leave.Username, leave.Email, leave.IsBot) + " left the channel"})
+	}
+
+	for _, post := range channelDay.Posts {
+		sender := formatUser(*post.Username, *post.UserEmail, post.IsBot)
+		if name := overrideUsername(rctx, post); name != "" {
+			sender = fmt.Sprintf("%s as %q", sender, name)
+		}
+
+		var details []string
+		if post.PostType != nil && *post.PostType != "" {
+			details = append(details, "type: "+*post.PostType)
+		}
+		if post.PostRootId != nil && *post.PostRootId != "" {
+			details = append(details, "reply to: "+*post.PostRootId)
+		}
+		if post.PostOriginalId != nil && *post.PostOriginalId != "" {
+			details = append(details, "edited by: "+*post.PostOriginalId)
+		}
+		if previewID := post.PreviewID(); previewID != "" {
+			details = append(details, "previews: "+previewID)
+		}
+		detail := ""
+		if len(details) > 0 {
+			detail = " (" + strings.Join(details, ", ") + ")"
+		}
+
+		events = append(events, event{Time: *post.PostCreateAt, Text: fmt.Sprintf("%s%s: %s", sender, detail, *post.PostMessage)})
+		if post.PostDeleteAt != nil && *post.PostDeleteAt > 0 {
+			events = append(events, event{Time: *post.PostDeleteAt, Text: fmt.Sprintf("%s deleted the message %s", sender, *post.PostId)})
+		}
+
+		for _, attachment := range channelDay.Attachments[*post.PostId] {
+			events = append(events, event{Time: *post.PostCreateAt, Text: fmt.Sprintf("%s uploaded the file %s (%s)", sender, attachment.Name, attachment.Id)})
+			if attachment.DeleteAt > 0 {
+				events = append(events, event{Time: attachment.DeleteAt, Text: fmt.Sprintf("%s deleted the file %s (%s)", sender, attachment.Name, attachment.Id)})
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time < events[j].Time
+	})
+	return events
+}
+
+func lastEventTime(channelDay *ChannelDay) int64 {
+	last := channelDay.Channel.EndTime
+	for _, post := range channelDay.Posts {
+		if post.PostDeleteAt != nil && *post.PostDeleteAt > last {
+			last = *post.PostDeleteAt
+		}
+	}
+	for _, leave := range channelDay.Leaves {
+		last = max(last, leave.Datetime)
+	}
+	return last
+}
+
+// overrideUsername returns the username set by a webhook or an integration for the post, if any.
+func overrideUsername(rctx request.CTX, post *model.MessageExport) string {
+	if post.PostProps == nil {
+		return ""
+	}
+
+	var props map[string]any
+	if err := json.Unmarshal([]byte(*post.PostProps), &props); err != nil {
+		rctx.Logger().Warn("Failed to unmarshal post Props into JSON. Ignoring username override.", mlog.Err(err))
+		return ""
+	}
+
+	if name, ok := props[model.PostPropsOverrideUsername].(string); ok && name != "" {
+		return name
+	}
+	name, _ := props[model.PostPropsWebhookDisplayName].(string)
+	return name
+}
+
+func formatUser(username, email string, isBot bool) string {
+	user := fmt.Sprintf("%s <%s>", username, email)
+	if isBot {
+		user += " (bot)"
+	}
+	return user
+}
+
+func formatTime(millis int64) string {
+	return time.UnixMilli(millis).UTC().Format(timeLayout)
+}
+
+func encodeRFC2047Word(s string) string {
+	return mime.BEncoding.Encode("utf-8", s)
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.enterprise for license information.
+
+package eml_export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store/storetest"
+	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
+)
+
+var (
+	day1 = time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC).UnixMilli()
+	day2 = time.Date(2024, 1, 3, 9, 30, 0, 0, time.UTC).UnixMilli()
+)
+
+func newTestPost(id string, createAt int64, message string, fileIds ...string) *model.MessageExport {
+	chanTypeOpen := model.ChannelTypeOpen
+	return &model.MessageExport{
+		PostId:             model.NewPointer(id),
+		PostOriginalId:     model.NewPointer(""),
+		TeamId:             model.NewPointer("team-id"),
+		TeamName:           model.NewPointer("team-name"),
+		TeamDisplayName:    model.NewPointer("Team"),
+		ChannelId:          model.NewPointer("channel-id"),
+		ChannelName:        model.NewPointer("channel-name"),
+		ChannelDisplayName: model.NewPointer("Channel"),
+		ChannelType:        &chanTypeOpen,
+		PostCreateAt:       model.NewPointer(createAt),
+		PostMessage:        model.NewPointer(message),
+		PostType:           model.NewPointer(""),
+		PostProps:          model.NewPointer("{}"),
+		UserEmail:          model.NewPointer("sender@test.com"),
+		UserId:             model.NewPointer("sender-id"),
+		Username:           model.NewPointer("sender"),
+		PostFileIds:        fileIds,
+	}
+}
+
+func setupEmlExport(t *testing.T) ([]*model.MessageExport, *storetest.Store, filestore.FileBackend) {
+	posts := []*model.MessageExport{
+		newTestPost("post-1", day1, "hello\nhow are you?", "file-1"),
+		newTestPost("post-2", day1+1000, "with a missing file", "file-2"),
+		newTestPost("post-3", day2, "the next day"),
+	}
+
+	fileBackend, err := filestore.NewFileBackend(filestore.FileBackendSettings{
+		DriverName: model.ImageDriverLocal,
+		Directory:  t.TempDir(),
+	})
+	require.NoError(t, err)
+	_, err = fileBackend.WriteFile(strings.NewReader("file content"), "data/file-1.txt")
+	require.NoError(t, err)
+
+	mockStore := &storetest.Store{}
+	t.Cleanup(func() { mockStore.AssertExpectations(t) })
+	mockStore.FileInfoStore.On("GetForPost", "post-1", true, true, false).Return([]*model.FileInfo{{Id: "file-1", Name: "file-1.txt", Path: "data/file-1.txt"}}, nil)
+	mockStore.FileInfoStore.On("GetForPost", "post-2", true, true, false).Return([]*model.FileInfo{{Id: "file-2", Name: "file-2.txt", Path: "data/file-2.txt"}}, nil)
+	mockStore.ChannelMemberHistoryStore.On("GetUsersInChannelDuring", day1, day1+1000, "channel-id").Return([]*model.ChannelMemberHistoryResult{
+		{JoinTime: 0, UserId: "sender-id", UserEmail: "sender@test.com", Username: "sender"},
+		{JoinTime: day1 + 500, LeaveTime: model.NewPointer(day1 + 800), UserId: "other-id", UserEmail: "other@test.com", Username: "other"},
+	}, nil)
+	mockStore.ChannelMemberHistoryStore.On("GetUsersInChannelDuring", day2, day2, "channel-id").Return([]*model.ChannelMemberHistoryResult{
+		{JoinTime: 0, UserId: "sender-id", UserEmail: "sender@test.com", Username: "sender"},
+	}, nil)
+
+	return posts, mockStore, fileBackend
+}
+
+// readMessage parses the message and returns its plain text transcript and its attachments by name.
+func readMessage(t *testing.T, data []byte) (*mail.Message, string, map[string]string) {
+	t.Helper()
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	require.NoError(t, err)
+
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		var body io.Reader = msg.Body
+		if msg.Header.Get("Content-Transfer-Encoding") == "quoted-printable" {
+			body = quotedprintable.NewReader(body)
+		}
+		content, err := io.ReadAll(body)
+		require.NoError(t, err)
+		return msg, string(content), nil
+	}
+
+	var body string
+	attachments := map[string]string{}
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		var partReader io.Reader = part
+		if part.Header.Get("Content-Transfer-Encoding") == "base64" {
+			partReader = base64.NewDecoder(base64.StdEncoding, part)
+		}
+		content, err := io.ReadAll(partReader)
+		require.NoError(t, err)
+		if part.FileName() != "" {
+			attachments[part.FileName()] = string(content)
+		} else {
+			body = string(content)
+		}
+	}
+	return msg, body, attachments
+}
+
+func TestEmlExport(t *testing.T) {
+	rctx := request.TestContext(t)
+	posts, mockStore, fileBackend := setupEmlExport(t)
+
+	warningCount, appErr := EmlExport(rctx, posts, mockStore, fileBackend, fileBackend, "export", false)
+	require.Nil(t, appErr)
+	assert.Equal(t, int64(1), warningCount)
+
+	zipBytes, err := fileBackend.ReadFile("export/" + EmlExportFilename)
+	require.NoError(t, err)
+	zipReader, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+	require.NoError(t, err)
+
+	files := map[string][]byte{}
+	for _, file := range zipReader.File {
+		f, err := file.Open()
+		require.NoError(t, err)
+		files[file.Name], err = io.ReadAll(f)
+		require.NoError(t, err)
+		f.Close()
+	}
+	require.Len(t, files, 3)
+	assert.Contains(t, string(files[EmlWarningFilename]), "Post: post-2 - data/file-2.txt")
+
+	t.Run("first day", func(t *testing.T) {
+		data := files["channel-name - (channel-id) - 2024-01-02.eml"]
+		require.NotEmpty(t, data)
+		assert.Contains(t, string(data), "\r\n", "messages should use CRLF line endings")
+
+		msg, body, attachments := readMessage(t, data)
+		assert.Equal(t, "other@test.com", msg.Header.Get("From"))
+		assert.Equal(t, "other@test.com,sender@test.com", msg.Header.Get("To"))
+		assert.Equal(t, "channel-id", msg.Header.Get(ChannelIDHeader))
+		assert.Equal(t, "public", msg.Header.Get(ChannelTypeHeader))
+		date, err := msg.Header.Date()
+		require.NoError(t, err)
+		assert.Equal(t, day1+1000, date.UnixMilli())
+
+		decoder := new(mime.WordDecoder)
+		subject, err := decoder.DecodeHeader(msg.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Mattermost Compliance Export: Channel - 2024-01-02", subject)
+
+		body = strings.ReplaceAll(body, "\r\n", "\n")
+		assert.Contains(t, body, "Team: Team (team-name)\n")
+		assert.Contains(t, body, "Participants:\n  other <other@test.com>\n  sender <sender@test.com>\n")
+		assert.Contains(t, body, strings.Join([]string{
+			"[2024-01-02 10:00:00 UTC] sender <sender@test.com> was already in the channel",
+			"[2024-01-02 10:00:00 UTC] sender <sender@test.com>: hello",
+			"    how are you?",
+			"[2024-01-02 10:00:00 UTC] sender <sender@test.com> uploaded the file file-1.txt (file-1)",
+			"[2024-01-02 10:00:00 UTC] other <other@test.com> joined the channel",
+			"[2024-01-02 10:00:00 UTC] other <other@test.com> left the channel",
+			"[2024-01-02 10:00:01 UTC] sender <sender@test.com>: with a missing file",
+			"[2024-01-02 10:00:01 UTC] sender <sender@test.com> uploaded the file file-2.txt (file-2)",
+		}, "\n"))
+
+		assert.Equal(t, map[string]string{"file-1.txt": "file content"}, attachments)
+	})
+
+	t.Run("second day", func(t *testing.T) {
+		data := files["channel-name - (channel-id) - 2024-01-03.eml"]
+		require.NotEmpty(t, data)
+
+		msg, body, attachments := readMessage(t, data)
+		assert.Equal(t, "sender@test.com", msg.Header.Get("To"))
+		assert.Contains(t, body, "[2024-01-03 09:30:00 UTC] sender <sender@test.com>: the next day")
+		assert.Empty(t, attachments)
+	})
+}
+
+func TestMboxExport(t *testing.T) {
+	rctx := request.TestContext(t)
+	posts, mockStore, fileBackend := setupEmlExport(t)
+
+	warningCount, appErr := EmlExport(rctx, posts, mockStore, fileBackend, fileBackend, "export", true)
+	require.Nil(t, appErr)
+	assert.Equal(t, int64(1), warningCount)
+
+	warnings, err := fileBackend.ReadFile("export/" + EmlWarningFilename)
+	require.NoError(t, err)
+	assert.Contains(t, string(warnings), "Post: post-2 - data/file-2.txt")
+
+	data, err := fileBackend.ReadFile("export/" + MboxExportFilename)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "\r\n", "mbox files should use LF line endings")
+
+	separators := regexp.MustCompile(`(?m)^From MAILER-DAEMON (.*)\n`)
+	assert.Equal(t, [][]string{
+		{"From MAILER-DAEMON Tue Jan  2 10:00:01 2024\n", "Tue Jan  2 10:00:01 2024"},
+		{"From MAILER-DAEMON Wed Jan  3 09:30:00 2024\n", "Wed Jan  3 09:30:00 2024"},
+	}, separators.FindAllStringSubmatch(string(data), -1))
+
+	messages := separators.Split(string(data), -1)
+	require.Len(t, messages, 3)
+	assert.Empty(t, messages[0])
+
+	msg, body, attachments := readMessage(t, []byte(messages[1]))
+	assert.Equal(t, "channel-id", msg.Header.Get(ChannelIDHeader))
+	assert.Contains(t, body, "sender <sender@test.com>: with a missing file")
+	assert.Equal(t, map[string]string{"file-1.txt": "file content"}, attachments)
+
+	_, body, _ = readMessage(t, []byte(messages[2]))
+	assert.Contains(t, body, "sender <sender@test.com>: the next day")
+}
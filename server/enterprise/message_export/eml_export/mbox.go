@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.enterprise for license information.
+
+package eml_export
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+// MboxWriter writes messages in the mboxrd format: each message starts with a "From " separator line,
+// lines of the message matching ">*From " are quoted with an additional ">" and line endings are
+// converted to LF. Messages are streamed, only the current line is buffered.
+type MboxWriter struct {
+	w       io.Writer
+	line    []byte
+	started bool
+	err     error
+}
+
+func NewMboxWriter(w io.Writer) *MboxWriter {
+	return &MboxWriter{w: w}
+}
+
+// NewMessage ends the previous message, if any, and starts a new one received at the given time.
+// The returned writer receives the message in the RFC 5322 format.
+func (mw *MboxWriter) NewMessage(receivedAt time.Time) io.Writer {
+	mw.endMessage()
+	mw.write([]byte("From MAILER-DAEMON " + receivedAt.UTC().Format(time.ANSIC) + "\n"))
+	mw.started = true
+	return mw
+}
+
+// Write adds data to the current message.
+func (mw *MboxWriter) Write(p []byte) (int, error) {
+	if mw.err != nil {
+		return 0, mw.err
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		i := bytes.IndexByte(p, '\n')
+		if i < 0 {
+			mw.line = append(mw.line, p...)
+			break
+		}
+		mw.line = append(mw.line, p[:i]...)
+		mw.flushLine()
+		p = p[i+1:]
+	}
+	return n, mw.err
+}
+
+// Close ends the last message. It doesn't close the underlying writer.
+func (mw *MboxWriter) Close() error {
+	mw.endMessage()
+	return mw.err
+}
+
+func (mw *MboxWriter) endMessage() {
+	if !mw.started {
+		return
+	}
+
+	if len(mw.line) > 0 {
+		mw.flushLine()
+	}
+	// Messages are separated by an empty line.
+	mw.write([]byte("\n"))
+	mw.started = false
+}
+
+func (mw *MboxWriter) flushLine() {
+	line := bytes.TrimSuffix(mw.line, []byte("\r"))
+	if bytes.HasPrefix(bytes.TrimLeft(line, ">"), []byte("From ")) {
+		mw.write([]byte(">"))
+	}
+	mw.write(line)
+	mw.write([]byte("\n"))
+	mw.line = mw.line[:0]
+}
+
+func (mw *MboxWriter) write(p []byte) {
+	if mw.err != nil {
+		return
+	}
+	_, mw.err = mw.w.Write(p)
+}
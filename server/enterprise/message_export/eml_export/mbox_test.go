@@ -0,0 +1,44 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.enterprise for license information.
+
+package eml_export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMboxWriter(t *testing.T) {
+	var buf bytes.Buffer
+	mw := NewMboxWriter(&buf)
+
+	w := mw.NewMessage(time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC))
+	// Lines are split across writes to check they're buffered until complete.
+	for _, chunk := range []string{"Subject: test\r\n\r\nFr", "om here\r\n>From there\r\nnot From\r\n", ">>From ", "everywhere"} {
+		_, err := w.Write([]byte(chunk))
+		require.NoError(t, err)
+	}
+
+	w = mw.NewMessage(time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC))
+	_, err := w.Write([]byte("Subject: other\r\n\r\nbody\r\n"))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	assert.Equal(t, "From MAILER-DAEMON Tue Jan  2 10:00:00 2024\n"+
+		"Subject: test\n"+
+		"\n"+
+		">From here\n"+
+		">>From there\n"+
+		"not From\n"+
+		">>>From everywhere\n"+
+		"\n"+
+		"From MAILER-DAEMON Wed Jan  3 10:00:00 2024\n"+
+		"Subject: other\n"+
+		"\n"+
+		"body\n"+
+		"\n", buf.String())
+}
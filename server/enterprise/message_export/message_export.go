@@ -28,6 +28,7 @@ import (
 
 	"github.com/mattermost/mattermost/server/v8/enterprise/message_export/actiance_export"
 	"github.com/mattermost/mattermost/server/v8/enterprise/message_export/csv_export"
+	"github.com/mattermost/mattermost/server/v8/enterprise/message_export/eml_export"
 	"github.com/mattermost/mattermost/server/v8/enterprise/message_export/global_relay_export"
 )
 
@@ -188,6 +189,10 @@ func runExportByType(rctx request.CTX, exportType string, postsToExport []*model
 		rctx.Logger().Debug("Exporting Actiance")
 		return actiance_export.ActianceExport(rctx, postsToExport, db, exportBackend, fileAttachmentBackend, exportDirectory)
 
+	case model.ComplianceExportTypeEml, model.ComplianceExportTypeMbox:
+		rctx.Logger().Debug("Exporting EML", mlog.String("format", exportType))
+		return eml_export.EmlExport(rctx, postsToExport, db, exportBackend, fileAttachmentBackend, exportDirectory, exportType == model.ComplianceExportTypeMbox)
+
 	case model.ComplianceExportTypeGlobalrelay, model.ComplianceExportTypeGlobalrelayZip:
 		rctx.Logger().Debug("Exporting GlobalRelay")
 		f, err := os.CreateTemp("", "")
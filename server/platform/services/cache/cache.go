@@ -65,4 +65,17 @@ type ExternalCache interface {
 	// Decrement will decrement the
 	// number stored at that key by the value.
 	Decrement(key string, val int) error
+
+	// The following methods allow the cache to be used as a
+	// GCRA rate limit store shared by all nodes.
+
+	// GetWithTime returns the number stored at the key, or -1 if it
+	// doesn't exist, along with the current time at the cache.
+	GetWithTime(key string) (int64, time.Time, error)
+	// SetIfNotExistsWithTTL sets the number at the key only if it
+	// doesn't exist yet, and reports whether it was set.
+	SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error)
+	// CompareAndSwapWithTTL atomically replaces the number at the key
+	// with new if it's equal to old, and reports whether it was swapped.
+	CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error)
 }
@@ -0,0 +1,405 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/rueidis"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/einterfaces"
+)
+
+const (
+	invalidationChannel         = "cache_invalidation"
+	invalidationResubscribeWait = time.Second
+)
+
+// invalidationMessage is published when entries of a hybrid cache change so that the
+// other nodes drop them from their local tier.
+type invalidationMessage struct {
+	Node  string   `json:"node"`
+	Cache string   `json:"cache"`
+	Keys  []string `json:"keys,omitempty"`
+	Purge bool     `json:"purge,omitempty"`
+}
+
+// Hybrid is a two-tier cache: a small in-process LRU in front of a Redis cache.
+// Changes are written to Redis and invalidate the local tier of all the nodes.
+// Local entries also expire after the local TTL, bounding how long a node can
+// serve a stale value when an invalidation is lost.
+type Hybrid struct {
+	name     string
+	local    Cache
+	remote   ExternalCache
+	localTTL time.Duration
+	metrics  einterfaces.MetricsInterface
+	publish  func(msg *invalidationMessage) error
+
+	// generation is incremented on every invalidation, so that a value read from Redis
+	// isn't stored locally if it was invalidated during the read.
+	generation atomic.Int64
+}
+
+// NewHybrid creates a hybrid cache in front of remote. The local tier is an LRU, or an
+// LRUStriped when opts.Striped is set, of opts.Size entries. Local entries don't outlive
+// the default expiry of the cache.
+func NewHybrid(opts *CacheOptions, remote ExternalCache, localTTL time.Duration, publish func(msg *invalidationMessage) error) (*Hybrid, error) {
+	if opts.DefaultExpiry > 0 {
+		localTTL = min(localTTL, opts.DefaultExpiry)
+	}
+
+	localOpts := *opts
+	local, err := NewProvider().NewCache(&localOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the local cache: %w", err)
+	}
+
+	return &Hybrid{
+		name:     opts.Name,
+		local:    local,
+		remote:   remote,
+		localTTL: localTTL,
+		publish:  publish,
+	}, nil
+}
+
+func (h *Hybrid) localName() string {
+	return h.name + "_local"
+}
+
+func (h *Hybrid) remoteName() string {
+	return h.name + "_redis"
+}
+
+// Purge is used to completely clear the cache.
+func (h *Hybrid) Purge() error {
+	if err := h.remote.Purge(); err != nil {
+		return err
+	}
+
+	return h.invalidate(nil, true)
+}
+
+// SetWithDefaultExpiry adds the given key and value to the store with the default expiry. If
+// the key already exists, it will overwrite the previous value
+func (h *Hybrid) SetWithDefaultExpiry(key string, value any) error {
+	return h.setWithExpiry(key, value, 0, true)
+}
+
+// SetWithExpiry adds the given key and value to the cache with the given expiry. If the key
+// already exists, it will overwrite the previous value
+func (h *Hybrid) SetWithExpiry(key string, value any, ttl time.Duration) error {
+	return h.setWithExpiry(key, value, ttl, false)
+}
+
+func (h *Hybrid) setWithExpiry(key string, value any, ttl time.Duration, defaultExpiry bool) error {
+	var err error
+	if defaultExpiry {
+		err = h.remote.SetWithDefaultExpiry(key, value)
+	} else {
+		err = h.remote.SetWithExpiry(key, value, ttl)
+	}
+	if err != nil {
+		return err
+	}
+
+	return h.invalidate([]string{key}, false)
+}
+
+// Get the content stored in the cache for the given key, and decode it into the value interface.
+// Returns ErrKeyNotFound if the key is missing from the cache
+func (h *Hybrid) Get(key string, value any) error {
+	if err := h.local.Get(key, value); err == nil {
+		h.incrementHit(h.localName(), 1)
+		return nil
+	}
+	h.incrementMiss(h.localName(), 1)
+
+	generation := h.generation.Load()
+	if err := h.remote.Get(key, value); err != nil {
+		if err == ErrKeyNotFound {
+			h.incrementMiss(h.remoteName(), 1)
+		}
+		return err
+	}
+	h.incrementHit(h.remoteName(), 1)
+
+	h.storeLocal(generation, key, value)
+
+	return nil
+}
+
+// GetMulti returns values for multiple keys in a single operation, only fetching from Redis the
+// ones missing from the local tier.
+func (h *Hybrid) GetMulti(keys []string, values []any) []error {
+	errs := h.local.GetMulti(keys, values)
+
+	var missingKeys []string
+	var missingValues []any
+	var missingIndexes []int
+	for i, err := range errs {
+		if err != nil {
+			missingKeys = append(missingKeys, keys[i])
+			missingValues = append(missingValues, values[i])
+			missingIndexes = append(missingIndexes, i)
+		}
+	}
+	h.incrementHit(h.localName(), len(keys)-len(missingKeys))
+	h.incrementMiss(h.localName(), len(missingKeys))
+	if len(missingKeys) == 0 {
+		return errs
+	}
+
+	generation := h.generation.Load()
+	remoteErrs := h.remote.GetMulti(missingKeys, missingValues)
+	hits, misses := 0, 0
+	for i, err := range remoteErrs {
+		errs[missingIndexes[i]] = err
+		if err == nil {
+			hits++
+			h.storeLocal(generation, missingKeys[i], missingValues[i])
+		} else if err == ErrKeyNotFound {
+			misses++
+		}
+	}
+	h.incrementHit(h.remoteName(), hits)
+	h.incrementMiss(h.remoteName(), misses)
+
+	return errs
+}
+
+// storeLocal stores a value read from Redis in the local tier, unless an invalidation was
+// received since the given generation.
+func (h *Hybrid) storeLocal(generation int64, key string, value any) {
+	if h.generation.Load() != generation {
+		return
+	}
+	// Failing to cache the value locally only costs another read from Redis.
+	_ = h.local.SetWithExpiry(key, value, h.localTTL)
+}
+
+// Remove deletes the value for a given key.
+func (h *Hybrid) Remove(key string) error {
+	if err := h.remote.Remove(key); err != nil {
+		return err
+	}
+
+	return h.invalidate([]string{key}, false)
+}
+
+// RemoveMulti deletes multiple keys in a single operation.
+func (h *Hybrid) RemoveMulti(keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := h.remote.RemoveMulti(keys); err != nil {
+		return err
+	}
+
+	return h.invalidate(keys, false)
+}
+
+// Scan iterates over the keys stored in Redis.
+func (h *Hybrid) Scan(f func([]string) error) error {
+	return h.remote.Scan(f)
+}
+
+// Increment will increment the number stored at that key by the value.
+func (h *Hybrid) Increment(key string, val int) error {
+	if err := h.remote.Increment(key, val); err != nil {
+		return err
+	}
+
+	return h.invalidate([]string{key}, false)
+}
+
+// Decrement will decrement the number stored at that key by the value.
+func (h *Hybrid) Decrement(key string, val int) error {
+	if err := h.remote.Decrement(key, val); err != nil {
+		return err
+	}
+
+	return h.invalidate([]string{key}, false)
+}
+
+// GetWithTime bypasses the local tier, see Redis.GetWithTime.
+func (h *Hybrid) GetWithTime(key string) (int64, time.Time, error) {
+	return h.remote.GetWithTime(key)
+}
+
+// SetIfNotExistsWithTTL bypasses the local tier, see Redis.SetIfNotExistsWithTTL.
+func (h *Hybrid) SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error) {
+	return h.remote.SetIfNotExistsWithTTL(key, value, ttl)
+}
+
+// CompareAndSwapWithTTL bypasses the local tier, see Redis.CompareAndSwapWithTTL.
+func (h *Hybrid) CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error) {
+	return h.remote.CompareAndSwapWithTTL(key, old, new, ttl)
+}
+
+// GetInvalidateClusterEvent returns the cluster event configured when this cache was created.
+// The hybrid cache invalidates the other nodes by itself.
+func (h *Hybrid) GetInvalidateClusterEvent() model.ClusterEvent {
+	return model.ClusterEventNone
+}
+
+func (h *Hybrid) Name() string {
+	return h.name
+}
+
+// invalidate drops the keys, or all the entries when purge is set, from the local tier of
+// all the nodes. It is called once Redis has been written, so failing to reach the other nodes
+// is only logged: their local entries expire after the local TTL, and returning an error would
+// let the callers believe the write failed.
+func (h *Hybrid) invalidate(keys []string, purge bool) error {
+	h.invalidateLocal(keys, purge)
+
+	if err := h.publish(&invalidationMessage{Cache: h.name, Keys: keys, Purge: purge}); err != nil {
+		mlog.Warn("Failed to invalidate the cache of the other nodes", mlog.String("cache", h.name), mlog.Err(err))
+	}
+
+	return nil
+}
+
+func (h *Hybrid) invalidateLocal(keys []string, purge bool) {
+	h.generation.Add(1)
+
+	if purge {
+		_ = h.local.Purge()
+	} else {
+		_ = h.local.RemoveMulti(keys)
+	}
+
+	if h.metrics != nil {
+		h.metrics.IncrementMemCacheInvalidationCounter(h.localName())
+	}
+}
+
+func (h *Hybrid) incrementHit(name string, count int) {
+	if h.metrics != nil && count > 0 {
+		h.metrics.AddMemCacheHitCounter(name, float64(count))
+	}
+}
+
+func (h *Hybrid) incrementMiss(name string, count int) {
+	if h.metrics != nil && count > 0 {
+		h.metrics.AddMemCacheMissCounter(name, float64(count))
+	}
+}
+
+// invalidationBus publishes and receives the invalidations of the hybrid caches of a node.
+type invalidationBus struct {
+	client rueidis.Client
+	nodeID string
+
+	mut    sync.RWMutex
+	caches map[string]*Hybrid
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newInvalidationBus(client rueidis.Client) *invalidationBus {
+	return &invalidationBus{
+		client: client,
+		nodeID: model.NewId(),
+		caches: make(map[string]*Hybrid),
+	}
+}
+
+func (b *invalidationBus) register(h *Hybrid) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	b.caches[h.name] = h
+}
+
+func (b *invalidationBus) publish(msg *invalidationMessage) error {
+	msg.Node = b.nodeID
+	buf, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return b.client.Do(context.Background(),
+		b.client.B().Publish().
+			Channel(invalidationChannel).
+			Message(rueidis.BinaryString(buf)).
+			Build(),
+	).Error()
+}
+
+func (b *invalidationBus) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	b.cancel = cancel
+	b.done = make(chan struct{})
+
+	go func() {
+		defer close(b.done)
+
+		subscribe := b.client.B().Subscribe().Channel(invalidationChannel).Build()
+		for {
+			err := b.client.Receive(ctx, subscribe, func(msg rueidis.PubSubMessage) {
+				b.receive([]byte(msg.Message))
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			mlog.Warn("Lost the cache invalidation subscription, subscribing again", mlog.Err(err))
+
+			// Invalidations may have been missed while disconnected.
+			b.purgeLocal()
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(invalidationResubscribeWait):
+			}
+		}
+	}()
+}
+
+func (b *invalidationBus) stop() {
+	if b.cancel == nil {
+		return
+	}
+	b.cancel()
+	<-b.done
+}
+
+func (b *invalidationBus) receive(data []byte) {
+	var msg invalidationMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		mlog.Warn("Failed to decode a cache invalidation", mlog.Err(err))
+		return
+	}
+	if msg.Node == b.nodeID {
+		return
+	}
+
+	b.mut.RLock()
+	h, ok := b.caches[msg.Cache]
+	b.mut.RUnlock()
+	if ok {
+		h.invalidateLocal(msg.Keys, msg.Purge)
+	}
+}
+
+func (b *invalidationBus) purgeLocal() {
+	b.mut.RLock()
+	defer b.mut.RUnlock()
+
+	for _, h := range b.caches {
+		h.invalidateLocal(nil, true)
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/v8/einterfaces/mocks"
+)
+
+// fakeRemoteCache is an in-memory ExternalCache standing in for Redis.
+type fakeRemoteCache struct {
+	Cache
+	gets int
+}
+
+func (c *fakeRemoteCache) Get(key string, value any) error {
+	c.gets++
+	return c.Cache.Get(key, value)
+}
+
+func (c *fakeRemoteCache) GetMulti(keys []string, values []any) []error {
+	c.gets += len(keys)
+	return c.Cache.GetMulti(keys, values)
+}
+
+func (c *fakeRemoteCache) Increment(key string, val int) error {
+	var current int64
+	if err := c.Cache.Get(key, &current); err != nil && err != ErrKeyNotFound {
+		return err
+	}
+	return c.SetWithDefaultExpiry(key, current+int64(val))
+}
+
+func (c *fakeRemoteCache) Decrement(key string, val int) error {
+	return c.Increment(key, -val)
+}
+
+func (c *fakeRemoteCache) GetWithTime(key string) (int64, time.Time, error) {
+	return -1, time.Now(), nil
+}
+
+func (c *fakeRemoteCache) SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error) {
+	return false, nil
+}
+
+func (c *fakeRemoteCache) CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error) {
+	return false, nil
+}
+
+// newTestHybridNodes creates hybrid caches sharing the same remote cache, as if they were
+// running on different nodes, with their invalidations delivered synchronously.
+func newTestHybridNodes(t *testing.T, count int, localTTL time.Duration) ([]*Hybrid, *fakeRemoteCache) {
+	t.Helper()
+
+	opts := &CacheOptions{Name: "test", Size: 100}
+	remote := &fakeRemoteCache{Cache: NewLRU(opts)}
+
+	buses := make([]*invalidationBus, count)
+	nodes := make([]*Hybrid, count)
+	for i := range nodes {
+		bus := newInvalidationBus(nil)
+		publish := func(msg *invalidationMessage) error {
+			msg.Node = bus.nodeID
+			buf, err := json.Marshal(msg)
+			require.NoError(t, err)
+			for _, b := range buses {
+				b.receive(buf)
+			}
+			return nil
+		}
+
+		h, err := NewHybrid(opts, remote, localTTL, publish)
+		require.NoError(t, err)
+		bus.register(h)
+
+		buses[i] = bus
+		nodes[i] = h
+	}
+
+	return nodes, remote
+}
+
+func TestHybrid(t *testing.T) {
+	t.Run("reads from the local tier", func(t *testing.T) {
+		nodes, remote := newTestHybridNodes(t, 1, time.Minute)
+		h := nodes[0]
+
+		require.NoError(t, h.SetWithDefaultExpiry("key", "value"))
+
+		for range 3 {
+			var value string
+			require.NoError(t, h.Get("key", &value))
+			assert.Equal(t, "value", value)
+		}
+		assert.Equal(t, 1, remote.gets)
+
+		var value string
+		assert.Equal(t, ErrKeyNotFound, h.Get("missing", &value))
+	})
+
+	t.Run("invalidates the other nodes", func(t *testing.T) {
+		nodes, _ := newTestHybridNodes(t, 2, time.Minute)
+
+		require.NoError(t, nodes[0].SetWithDefaultExpiry("key", "old"))
+		var value string
+		require.NoError(t, nodes[1].Get("key", &value))
+		assert.Equal(t, "old", value)
+
+		require.NoError(t, nodes[0].SetWithDefaultExpiry("key", "new"))
+		require.NoError(t, nodes[1].Get("key", &value))
+		assert.Equal(t, "new", value)
+
+		require.NoError(t, nodes[0].Remove("key"))
+		assert.Equal(t, ErrKeyNotFound, nodes[1].Get("key", &value))
+
+		require.NoError(t, nodes[0].SetWithDefaultExpiry("key", "value"))
+		require.NoError(t, nodes[1].Get("key", &value))
+		require.NoError(t, nodes[0].Purge())
+		assert.Equal(t, ErrKeyNotFound, nodes[1].Get("key", &value))
+	})
+
+	t.Run("counters", func(t *testing.T) {
+		nodes, _ := newTestHybridNodes(t, 2, time.Minute)
+
+		var count int64
+		require.NoError(t, nodes[0].Increment("count", 2))
+		require.NoError(t, nodes[1].Get("count", &count))
+		assert.EqualValues(t, 2, count)
+
+		require.NoError(t, nodes[0].Decrement("count", 1))
+		require.NoError(t, nodes[1].Get("count", &count))
+		assert.EqualValues(t, 1, count)
+	})
+
+	t.Run("local ttl", func(t *testing.T) {
+		nodes, remote := newTestHybridNodes(t, 1, 10*time.Millisecond)
+		h := nodes[0]
+
+		require.NoError(t, h.SetWithDefaultExpiry("key", "value"))
+		var value string
+		require.NoError(t, h.Get("key", &value))
+		time.Sleep(20 * time.Millisecond)
+		require.NoError(t, h.Get("key", &value))
+		assert.Equal(t, 2, remote.gets)
+	})
+
+	t.Run("get multi", func(t *testing.T) {
+		nodes, remote := newTestHybridNodes(t, 1, time.Minute)
+		h := nodes[0]
+
+		require.NoError(t, h.SetWithDefaultExpiry("key1", "value1"))
+		require.NoError(t, h.SetWithDefaultExpiry("key2", "value2"))
+		var value string
+		require.NoError(t, h.Get("key1", &value))
+		remote.gets = 0
+
+		var value1, value2, value3 string
+		errs := h.GetMulti([]string{"key1", "key2", "key3"}, []any{&value1, &value2, &value3})
+		assert.NoError(t, errs[0])
+		assert.NoError(t, errs[1])
+		assert.Equal(t, ErrKeyNotFound, errs[2])
+		assert.Equal(t, "value1", value1)
+		assert.Equal(t, "value2", value2)
+		// Only the keys missing from the local tier are read from the remote cache.
+		assert.Equal(t, 2, remote.gets)
+	})
+
+	t.Run("failing to invalidate the other nodes", func(t *testing.T) {
+		opts := &CacheOptions{Name: "test", Size: 100}
+		remote := &fakeRemoteCache{Cache: NewLRU(opts)}
+		h, err := NewHybrid(opts, remote, time.Minute, func(msg *invalidationMessage) error {
+			return errors.New("redis unavailable")
+		})
+		require.NoError(t, err)
+
+		// The write reached Redis, so it succeeds.
+		require.NoError(t, h.SetWithDefaultExpiry("key", "value"))
+		var value string
+		require.NoError(t, remote.Get("key", &value))
+		assert.Equal(t, "value", value)
+
+		require.NoError(t, h.Remove("key"))
+		assert.Equal(t, ErrKeyNotFound, h.Get("key", &value))
+	})
+
+	t.Run("metrics", func(t *testing.T) {
+		nodes, _ := newTestHybridNodes(t, 1, time.Minute)
+		h := nodes[0]
+
+		metrics := &mocks.MetricsInterface{}
+		metrics.On("IncrementMemCacheInvalidationCounter", "test_local").Return()
+		metrics.On("AddMemCacheMissCounter", "test_local", float64(1)).Return().Once()
+		metrics.On("AddMemCacheHitCounter", "test_redis", float64(1)).Return().Once()
+		metrics.On("AddMemCacheHitCounter", "test_local", float64(1)).Return().Once()
+		h.metrics = metrics
+
+		require.NoError(t, h.SetWithDefaultExpiry("key", "value"))
+		var value string
+		require.NoError(t, h.Get("key", &value))
+		require.NoError(t, h.Get("key", &value))
+
+		metrics.AssertExpectations(t)
+		metrics.AssertNotCalled(t, "AddMemCacheMissCounter", "test_redis", mock.Anything)
+	})
+}
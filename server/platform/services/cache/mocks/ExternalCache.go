@@ -16,6 +16,34 @@ type ExternalCache struct {
 	mock.Mock
 }
 
+// CompareAndSwapWithTTL provides a mock function with given fields: key, old, new, ttl
+func (_m *ExternalCache) CompareAndSwapWithTTL(key string, old int64, new int64, ttl time.Duration) (bool, error) {
+	ret := _m.Called(key, old, new, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompareAndSwapWithTTL")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64, time.Duration) (bool, error)); ok {
+		return rf(key, old, new, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64, time.Duration) bool); ok {
+		r0 = rf(key, old, new, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64, time.Duration) error); ok {
+		r1 = rf(key, old, new, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Decrement provides a mock function with given fields: key, val
 func (_m *ExternalCache) Decrement(key string, val int) error {
 	ret := _m.Called(key, val)
@@ -90,6 +118,41 @@ func (_m *ExternalCache) GetMulti(keys []string, values []interface{}) []error {
 	return r0
 }
 
+// GetWithTime provides a mock function with given fields: key
+func (_m *ExternalCache) GetWithTime(key string) (int64, time.Time, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithTime")
+	}
+
+	var r0 int64
+	var r1 time.Time
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) (int64, time.Time, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) time.Time); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(time.Time)
+	}
+
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(key)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Increment provides a mock function with given fields: key, val
 func (_m *ExternalCache) Increment(key string, val int) error {
 	ret := _m.Called(key, val)
@@ -198,6 +261,34 @@ func (_m *ExternalCache) Scan(f func([]string) error) error {
 	return r0
 }
 
+// SetIfNotExistsWithTTL provides a mock function with given fields: key, value, ttl
+func (_m *ExternalCache) SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error) {
+	ret := _m.Called(key, value, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetIfNotExistsWithTTL")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, time.Duration) (bool, error)); ok {
+		return rf(key, value, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, time.Duration) bool); ok {
+		r0 = rf(key, value, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, time.Duration) error); ok {
+		r1 = rf(key, value, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetWithDefaultExpiry provides a mock function with given fields: key, value
 func (_m *ExternalCache) SetWithDefaultExpiry(key string, value interface{}) error {
 	ret := _m.Called(key, value)
@@ -76,6 +76,9 @@ func (c *cacheProvider) Type() string {
 type redisProvider struct {
 	client  rueidis.Client
 	metrics einterfaces.MetricsInterface
+	// localCacheTTL enables the hybrid caches when positive.
+	localCacheTTL time.Duration
+	bus           *invalidationBus
 }
 
 type RedisOptions struct {
@@ -83,6 +86,9 @@ type RedisOptions struct {
 	RedisPassword string
 	RedisDB       int
 	DisableCache  bool
+	// LocalCacheTTL, when positive, makes the provider create hybrid caches keeping
+	// the entries in memory for at most this duration.
+	LocalCacheTTL time.Duration
 }
 
 // NewProvider creates a new CacheProvider
@@ -104,14 +110,33 @@ func NewRedisProvider(opts *RedisOptions) (Provider, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &redisProvider{client: client}, nil
+
+	provider := &redisProvider{client: client, localCacheTTL: opts.LocalCacheTTL}
+	if provider.localCacheTTL > 0 {
+		provider.bus = newInvalidationBus(client)
+	}
+	return provider, nil
 }
 
 // NewCache creates a new cache with given opts
 func (r *redisProvider) NewCache(opts *CacheOptions) (Cache, error) {
 	rr, err := NewRedis(opts, r.client)
+	if err != nil {
+		return nil, err
+	}
 	rr.metrics = r.metrics
-	return rr, err
+
+	if r.bus == nil {
+		return rr, nil
+	}
+
+	h, err := NewHybrid(opts, rr, r.localCacheTTL, r.bus.publish)
+	if err != nil {
+		return nil, err
+	}
+	h.metrics = r.metrics
+	r.bus.register(h)
+	return h, nil
 }
 
 // Connect opens a new connection to the cache using specific provider parameters.
@@ -120,6 +145,9 @@ func (r *redisProvider) Connect() (string, error) {
 	if err != nil {
 		return "", fmt.Errorf("unable to establish connection with redis: %v", err)
 	}
+	if r.bus != nil {
+		r.bus.start()
+	}
 	return res, nil
 }
 
@@ -133,6 +161,9 @@ func (r *redisProvider) Type() string {
 
 // Close releases any resources used by the cache provider.
 func (r *redisProvider) Close() error {
+	if r.bus != nil {
+		r.bus.stop()
+	}
 	r.client.Close()
 	return nil
 }
@@ -21,6 +21,17 @@ import (
 
 const clientSideTTL = 5 * time.Minute
 
+// compareAndSwapScript sets KEYS[1] to ARGV[2] with a TTL of ARGV[3] milliseconds
+// if its current value is ARGV[1].
+var compareAndSwapScript = rueidis.NewLuaScript(`
+local v = redis.call('get', KEYS[1])
+if v == false or v ~= ARGV[1] then
+  return 0
+end
+redis.call('set', KEYS[1], ARGV[2], 'PX', ARGV[3])
+return 1
+`)
+
 type Redis struct {
 	name          string
 	client        rueidis.Client
@@ -124,6 +135,99 @@ func (r *Redis) Decrement(key string, val int) error {
 	).Error()
 }
 
+// GetWithTime returns the number stored at the key, or -1 if it doesn't exist, along with the
+// current time at Redis so that all nodes share the same clock. Unlike Get, it bypasses the
+// client side cache.
+func (r *Redis) GetWithTime(key string) (int64, time.Time, error) {
+	now := time.Now()
+	defer func() {
+		if r.metrics != nil {
+			elapsed := time.Since(now).Seconds()
+			r.metrics.ObserveRedisEndpointDuration(r.name, "GetWithTime", elapsed)
+		}
+	}()
+
+	resps := r.client.DoMulti(context.Background(),
+		r.client.B().Get().Key(r.name+":"+key).Build(),
+		r.client.B().Time().Build(),
+	)
+
+	redisTime, err := resps[1].AsIntSlice()
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	if len(redisTime) != 2 {
+		return 0, time.Time{}, fmt.Errorf("unexpected response from TIME: %v", redisTime)
+	}
+	t := time.Unix(redisTime[0], redisTime[1]*int64(time.Microsecond))
+
+	val, err := resps[0].AsInt64()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return -1, t, nil
+		}
+		return 0, t, err
+	}
+
+	return val, t, nil
+}
+
+// SetIfNotExistsWithTTL sets the number at the key only if it doesn't exist yet, and reports
+// whether it was set.
+func (r *Redis) SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	defer func() {
+		if r.metrics != nil {
+			elapsed := time.Since(now).Seconds()
+			r.metrics.ObserveRedisEndpointDuration(r.name, "SetIfNotExists", elapsed)
+		}
+	}()
+
+	err := r.client.Do(context.Background(),
+		r.client.B().Set().
+			Key(r.name+":"+key).
+			Value(strconv.FormatInt(value, 10)).
+			Nx().
+			PxMilliseconds(ttlMilliseconds(ttl)).
+			Build(),
+	).Error()
+	if err != nil {
+		if rueidis.IsRedisNil(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CompareAndSwapWithTTL atomically replaces the number at the key with new if it's equal to old,
+// and reports whether it was swapped. It returns false with no error if the key doesn't exist.
+func (r *Redis) CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	defer func() {
+		if r.metrics != nil {
+			elapsed := time.Since(now).Seconds()
+			r.metrics.ObserveRedisEndpointDuration(r.name, "CompareAndSwap", elapsed)
+		}
+	}()
+
+	swapped, err := compareAndSwapScript.Exec(context.Background(), r.client,
+		[]string{r.name + ":" + key},
+		[]string{strconv.FormatInt(old, 10), strconv.FormatInt(new, 10), strconv.FormatInt(ttlMilliseconds(ttl), 10)},
+	).AsInt64()
+	if err != nil {
+		return false, err
+	}
+
+	return swapped == 1, nil
+}
+
+// ttlMilliseconds converts a TTL to the milliseconds Redis expects, which must be positive.
+func ttlMilliseconds(ttl time.Duration) int64 {
+	return max(ttl.Milliseconds(), 1)
+}
+
 // Get the content stored in the cache for the given key, and decode it into the value interface.
 // Return ErrKeyNotFound if the key is missing from the cache
 func (r *Redis) Get(key string, value any) error {
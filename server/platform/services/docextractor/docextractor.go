@@ -5,6 +5,7 @@ package docextractor
 
 import (
 	"io"
+	"time"
 
 	"github.com/mattermost/mattermost/server/public/shared/mlog"
 )
@@ -14,6 +15,14 @@ type ExtractSettings struct {
 	ArchiveRecursion bool
 	MMPreviewURL     string
 	MMPreviewSecret  string
+
+	// OCREnabled enables the text recognition of images and scanned PDFs through OCRCommand.
+	OCREnabled             bool
+	OCRCommand             string
+	OCRLanguages           string
+	OCRPDFRasterizeCommand string
+	OCRMaxPages            int
+	OCRTimeout             time.Duration
 }
 
 // Extract extract the text from a document using the system default extractors
@@ -30,7 +39,11 @@ func ExtractWithExtraExtractors(logger mlog.LoggerIFace, filename string, r io.R
 		enabledExtractors.Add(extraExtractor)
 	}
 	enabledExtractors.Add(&documentExtractor{})
-	enabledExtractors.Add(&pdfExtractor{})
+	if settings.OCREnabled {
+		enabledExtractors.Add(newOCRPDFExtractor(settings))
+	} else {
+		enabledExtractors.Add(&pdfExtractor{})
+	}
 
 	if settings.ArchiveRecursion {
 		enabledExtractors.Add(&archiveExtractor{SubExtractor: enabledExtractors})
@@ -41,6 +54,9 @@ func ExtractWithExtraExtractors(logger mlog.LoggerIFace, filename string, r io.R
 	if settings.MMPreviewURL != "" {
 		enabledExtractors.Add(newMMPreviewExtractor(settings.MMPreviewURL, settings.MMPreviewSecret, pdfExtractor{}))
 	}
+	if settings.OCREnabled {
+		enabledExtractors.Add(newOCRExtractor(settings))
+	}
 	enabledExtractors.Add(&plainExtractor{})
 
 	if enabledExtractors.Match(filename) {
@@ -0,0 +1,221 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package docextractor
+
+// The OCR extractors run a local OCR command, tesseract compatible, to extract
+// the text of images. Scanned PDFs, without any text layer, are first converted
+// to images with a pdftoppm compatible command.
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultOCRMaxPages = 10
+	defaultOCRTimeout  = time.Minute
+	ocrPDFResolution   = 300
+)
+
+var ocrSupportedExtensions = map[string]bool{
+	"png":  true,
+	"jpg":  true,
+	"jpeg": true,
+	"gif":  true,
+	"bmp":  true,
+	"tif":  true,
+	"tiff": true,
+	"webp": true,
+}
+
+type ocrExtractor struct {
+	command   string
+	languages string
+	timeout   time.Duration
+}
+
+func newOCRExtractor(settings ExtractSettings) *ocrExtractor {
+	timeout := settings.OCRTimeout
+	if timeout <= 0 {
+		timeout = defaultOCRTimeout
+	}
+
+	return &ocrExtractor{
+		command:   settings.OCRCommand,
+		languages: settings.OCRLanguages,
+		timeout:   timeout,
+	}
+}
+
+func (oe *ocrExtractor) Name() string {
+	return "ocrExtractor"
+}
+
+func (oe *ocrExtractor) Match(filename string) bool {
+	extension := strings.ToLower(strings.TrimPrefix(path.Ext(filename), "."))
+	return ocrSupportedExtensions[extension]
+}
+
+func (oe *ocrExtractor) Extract(filename string, r io.ReadSeeker) (string, error) {
+	dir, err := os.MkdirTemp("", "ocr")
+	if err != nil {
+		return "", errors.Wrap(err, "error creating temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	imagePath, err := writeTempFile(dir, "image"+path.Ext(filename), r)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), oe.timeout)
+	defer cancel()
+
+	return oe.recognize(ctx, imagePath)
+}
+
+// recognize runs the OCR command on the image at imagePath, writing the text to the standard output.
+func (oe *ocrExtractor) recognize(ctx context.Context, imagePath string) (string, error) {
+	args := []string{imagePath, "stdout"}
+	if oe.languages != "" {
+		args = append(args, "-l", oe.languages)
+	}
+
+	out, err := runCommand(ctx, oe.command, args...)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to recognize the image text")
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// ocrPDFExtractor extracts the text layer of PDFs, falling back to OCR on the first pages when
+// there is none.
+type ocrPDFExtractor struct {
+	pdfExtractor     pdfExtractor
+	ocrExtractor     *ocrExtractor
+	rasterizeCommand string
+	maxPages         int
+}
+
+func newOCRPDFExtractor(settings ExtractSettings) *ocrPDFExtractor {
+	maxPages := settings.OCRMaxPages
+	if maxPages <= 0 {
+		maxPages = defaultOCRMaxPages
+	}
+
+	return &ocrPDFExtractor{
+		ocrExtractor:     newOCRExtractor(settings),
+		rasterizeCommand: settings.OCRPDFRasterizeCommand,
+		maxPages:         maxPages,
+	}
+}
+
+func (ope *ocrPDFExtractor) Name() string {
+	return "ocrPDFExtractor"
+}
+
+func (ope *ocrPDFExtractor) Match(filename string) bool {
+	return ope.pdfExtractor.Match(filename)
+}
+
+func (ope *ocrPDFExtractor) Extract(filename string, r io.ReadSeeker) (string, error) {
+	text, err := ope.pdfExtractor.Extract(filename, r)
+	if err == nil && strings.TrimSpace(text) != "" {
+		return text, nil
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", errors.Wrap(err, "unable to seek to the start of the file")
+	}
+
+	dir, err := os.MkdirTemp("", "ocrpdf")
+	if err != nil {
+		return "", errors.Wrap(err, "error creating temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	pdfPath, err := writeTempFile(dir, "document.pdf", r)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ope.ocrExtractor.timeout)
+	defer cancel()
+
+	pagePrefix := filepath.Join(dir, "page")
+	if _, err := runCommand(ctx, ope.rasterizeCommand,
+		"-r", strconv.Itoa(ocrPDFResolution),
+		"-f", "1",
+		"-l", strconv.Itoa(ope.maxPages),
+		"-png", pdfPath, pagePrefix,
+	); err != nil {
+		return "", errors.Wrap(err, "unable to convert the pdf pages to images")
+	}
+
+	pages, err := filepath.Glob(pagePrefix + "*.png")
+	if err != nil {
+		return "", errors.Wrap(err, "unable to list the pdf pages")
+	}
+	// Page numbers are zero padded to the same width.
+	sort.Strings(pages)
+	if len(pages) > ope.maxPages {
+		pages = pages[:ope.maxPages]
+	}
+
+	texts := make([]string, 0, len(pages))
+	for _, page := range pages {
+		pageText, err := ope.ocrExtractor.recognize(ctx, page)
+		if err != nil {
+			return "", err
+		}
+		if pageText != "" {
+			texts = append(texts, pageText)
+		}
+	}
+
+	return strings.Join(texts, "\n"), nil
+}
+
+func writeTempFile(dir, name string, r io.Reader) (string, error) {
+	filePath := filepath.Join(dir, name)
+	f, err := os.Create(filePath)
+	if err != nil {
+		return "", errors.Wrap(err, "error creating temporary file")
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(f, r); err != nil {
+		return "", errors.Wrap(err, "error copying data into temporary file")
+	}
+
+	return filePath, nil
+}
+
+func runCommand(ctx context.Context, command string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, errors.Wrapf(ctx.Err(), "%s timed out", command)
+		}
+		return nil, errors.Wrapf(err, "%s failed: %s", command, strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
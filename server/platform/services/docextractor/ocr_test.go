@@ -0,0 +1,109 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package docextractor
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/utils/testutils"
+)
+
+func writeTestScript(t *testing.T, name, script string) string {
+	t.Helper()
+
+	scriptPath := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(scriptPath, []byte("#!/bin/sh\n"+script), 0700))
+	return scriptPath
+}
+
+func TestOCRExtract(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test commands are shell scripts")
+	}
+
+	logger := mlog.CreateConsoleTestLogger(t)
+
+	// Prints the name of the recognized image and the requested languages.
+	ocrCommand := writeTestScript(t, "ocr", `echo "text of $(basename "$1") $4"`)
+	// Renders three pages whatever the requested range.
+	rasterizeCommand := writeTestScript(t, "rasterize", `for last; do true; done
+for page in 1 2 3; do touch "$last-$page.png"; done`)
+
+	settings := ExtractSettings{
+		OCREnabled:             true,
+		OCRCommand:             ocrCommand,
+		OCRLanguages:           "eng+fra",
+		OCRPDFRasterizeCommand: rasterizeCommand,
+		OCRMaxPages:            2,
+		OCRTimeout:             10 * time.Second,
+	}
+
+	t.Run("image", func(t *testing.T) {
+		text, err := Extract(logger, "screenshot.PNG", bytes.NewReader([]byte("image")), settings)
+		require.NoError(t, err)
+		assert.Equal(t, "text of image.PNG eng+fra", text)
+	})
+
+	t.Run("image without ocr", func(t *testing.T) {
+		data, err := testutils.ReadTestFile("testjpg.jpg")
+		require.NoError(t, err)
+
+		text, err := Extract(logger, "testjpg.jpg", bytes.NewReader(data), ExtractSettings{})
+		require.NoError(t, err)
+		assert.Equal(t, "", text)
+	})
+
+	t.Run("pdf with text", func(t *testing.T) {
+		data, err := testutils.ReadTestFile("sample-doc.pdf")
+		require.NoError(t, err)
+
+		text, err := Extract(logger, "sample-doc.pdf", bytes.NewReader(data), settings)
+		require.NoError(t, err)
+		assert.Contains(t, text, "simple")
+		assert.NotContains(t, text, "text of")
+	})
+
+	t.Run("scanned pdf", func(t *testing.T) {
+		text, err := Extract(logger, "scan.pdf", bytes.NewReader([]byte("scanned")), settings)
+		require.NoError(t, err)
+		assert.Equal(t, "text of page-1.png eng+fra\ntext of page-2.png eng+fra", text)
+	})
+
+	t.Run("failing command", func(t *testing.T) {
+		failingSettings := settings
+		failingSettings.OCRCommand = writeTestScript(t, "failing", "echo failure >&2; exit 1")
+
+		_, err := newOCRExtractor(failingSettings).Extract("image.png", bytes.NewReader([]byte("image")))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failure")
+
+		// Falls back to the other extractors.
+		data, err := testutils.ReadTestFile("testjpg.jpg")
+		require.NoError(t, err)
+		text, err := Extract(logger, "testjpg.jpg", bytes.NewReader(data), failingSettings)
+		require.NoError(t, err)
+		assert.Equal(t, "", text)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		slowSettings := settings
+		slowSettings.OCRCommand = writeTestScript(t, "slow", "exec sleep 5")
+		slowSettings.OCRTimeout = 100 * time.Millisecond
+
+		start := time.Now()
+		_, err := newOCRExtractor(slowSettings).Extract("image.png", bytes.NewReader([]byte("image")))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "timed out")
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
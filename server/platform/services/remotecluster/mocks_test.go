@@ -17,9 +17,10 @@ import (
 )
 
 type mockServer struct {
-	remotes []*model.RemoteCluster
-	logger  *mlog.Logger
-	user    *model.User
+	remotes      []*model.RemoteCluster
+	logger       *mlog.Logger
+	user         *model.User
+	capabilities sync.Map // remoteId -> model.Bitmask saved with SetCapabilities
 }
 
 func newMockServer(t *testing.T, remotes []*model.RemoteCluster) *mockServer {
@@ -54,6 +55,9 @@ func (ms *mockServer) GetStore() store.Store {
 	remoteClusterStoreMock.On("GetByTopic", "share").Return(ms.remotes, nil)
 	remoteClusterStoreMock.On("GetAll", 0, 999999, anyQueryFilter).Return(ms.remotes, nil)
 	remoteClusterStoreMock.On("SetLastPingAt", anyId).Return(nil)
+	remoteClusterStoreMock.On("SetCapabilities", anyId, mock.AnythingOfType("model.Bitmask")).Return(nil).Run(func(args mock.Arguments) {
+		ms.capabilities.Store(args.String(0), args.Get(1))
+	})
 
 	userStoreMock := &mocks.UserStore{}
 	userStoreMock.On("Get", context.Background(), anyUserId).Return(ms.user, nil)
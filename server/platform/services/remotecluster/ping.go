@@ -142,6 +142,19 @@ func (rcs *Service) pingRemote(rc *model.RemoteCluster) error {
 		)
 	}
 
+	// Capabilities are only known for remotes reached over HTTP; plugins don't advertise any.
+	if !rc.IsPlugin() && ping.Capabilities != rc.Capabilities {
+		if err := rcs.server.GetStore().RemoteCluster().SetCapabilities(rc.RemoteId, ping.Capabilities); err != nil {
+			rcs.server.Log().Log(mlog.LvlRemoteClusterServiceError, "Failed to update capabilities for remote cluster",
+				mlog.String("remote", rc.DisplayName),
+				mlog.String("remoteId", rc.RemoteId),
+				mlog.Err(err),
+			)
+		} else {
+			rc.Capabilities = ping.Capabilities
+		}
+	}
+
 	if metrics := rcs.server.GetMetrics(); metrics != nil {
 		sentAt := time.Unix(0, ping.SentAt*int64(time.Millisecond))
 		elapsed := time.Since(sentAt).Seconds()
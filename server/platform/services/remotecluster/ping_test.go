@@ -159,6 +159,41 @@ func TestPing(t *testing.T) {
 		assert.NoError(t, merr.ErrorOrNil())
 	})
 
+	t.Run("Capabilities", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var frame model.RemoteClusterFrame
+			if err := json.NewDecoder(r.Body).Decode(&frame); err != nil {
+				w.WriteHeader(400)
+				return
+			}
+			var ping model.RemoteClusterPing
+			if err := json.Unmarshal(frame.Msg.Payload, &ping); err != nil {
+				w.WriteHeader(400)
+				return
+			}
+			ping.RecvAt = model.GetMillis()
+			ping.Capabilities = model.RemoteCapabilityBookmarks
+			_ = json.NewEncoder(w).Encode(ping)
+		}))
+		defer ts.Close()
+
+		remotes := makeRemoteClusters(1, ts.URL, false)
+		remotes[0].Capabilities = model.RemoteCapabilities
+		mockServer := newMockServer(t, remotes)
+		mockApp := newMockApp(t, nil)
+
+		service, err := NewRemoteClusterService(mockServer, mockApp)
+		require.NoError(t, err)
+
+		err = service.pingRemote(remotes[0])
+		require.NoError(t, err)
+
+		capabilities, ok := mockServer.capabilities.Load(remotes[0].RemoteId)
+		require.True(t, ok, "capabilities should be saved")
+		assert.Equal(t, model.RemoteCapabilityBookmarks, capabilities)
+		assert.Equal(t, model.RemoteCapabilityBookmarks, remotes[0].Capabilities)
+	})
+
 	t.Run("Plugin ping", func(t *testing.T) {
 		mockServer := newMockServer(t, makeRemoteClusters(NumRemotes, model.NewId(), true))
 		offline := []string{mockServer.remotes[0].PluginID, mockServer.remotes[1].PluginID}
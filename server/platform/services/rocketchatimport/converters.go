@@ -0,0 +1,58 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package rocketchatimport
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	rcBoldRegexp          = regexp.MustCompile(`(^|[\s.;,(])\*([^*\s](?:[^*\n]*[^*\s])?)\*`)
+	rcStrikethroughRegexp = regexp.MustCompile(`(^|[\s.;,(])~([^~\s](?:[^~\n]*[^~\s])?)~`)
+	rcUserMentionRegexp   = regexp.MustCompile(`(^|[^\w@])@([\w.\-]+)`)
+	rcRoomMentionRegexp   = regexp.MustCompile(`(^|[^\w#&/])#([\w.\-]+)`)
+)
+
+// rcConvertMarkup converts the Rocket.Chat flavour of markdown, where single asterisks and tildes
+// mark bold and strikethrough text, and rewrites the mentions of users and rooms whose names changed.
+// usernames maps Rocket.Chat usernames to the imported ones and rooms maps room names to channel names.
+func rcConvertMarkup(text string, usernames map[string]string, rooms map[string]string) string {
+	text = rcBoldRegexp.ReplaceAllString(text, "$1**$2**")
+	text = rcStrikethroughRegexp.ReplaceAllString(text, "$1~~$2~~")
+
+	text = rcUserMentionRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		groups := rcUserMentionRegexp.FindStringSubmatch(match)
+		if username, ok := usernames[groups[2]]; ok {
+			return groups[1] + "@" + username
+		}
+		return match
+	})
+
+	return rcRoomMentionRegexp.ReplaceAllStringFunc(text, func(match string) string {
+		groups := rcRoomMentionRegexp.FindStringSubmatch(match)
+		if channelName, ok := rooms[groups[2]]; ok {
+			return groups[1] + "~" + channelName
+		}
+		return match
+	})
+}
+
+// rcConvertEmojiName converts the :name: of a Rocket.Chat reaction into an emoji name.
+func rcConvertEmojiName(reaction string) string {
+	return strings.Trim(reaction, ":")
+}
+
+func splitName(name string) (string, string) {
+	firstName, lastName, _ := strings.Cut(strings.TrimSpace(name), " ")
+	return firstName, strings.TrimSpace(lastName)
+}
+
+func truncateRunes(s string, i int) string {
+	runes := []rune(s)
+	if len(runes) > i {
+		return string(runes[:i])
+	}
+	return s
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package rocketchatimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// mongoDate is a date of a MongoDB export in milliseconds. mongoexport writes dates in the extended
+// JSON format, either relaxed ({"$date": "2021-03-04T10:00:00.000Z"}) or canonical
+// ({"$date": {"$numberLong": "1614852000000"}}); plain strings and numbers are accepted as well.
+type mongoDate int64
+
+func (d *mongoDate) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	var wrapped struct {
+		Date json.RawMessage `json:"$date"`
+	}
+	if len(b) > 0 && b[0] == '{' {
+		if err := json.Unmarshal(b, &wrapped); err != nil {
+			return err
+		}
+		b = wrapped.Date
+	}
+
+	var numberLong struct {
+		NumberLong string `json:"$numberLong"`
+	}
+	switch {
+	case len(b) == 0:
+		return nil
+	case b[0] == '{':
+		if err := json.Unmarshal(b, &numberLong); err != nil {
+			return err
+		}
+		millis, err := strconv.ParseInt(numberLong.NumberLong, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid date %s: %w", b, err)
+		}
+		*d = mongoDate(millis)
+	case b[0] == '"':
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return fmt.Errorf("invalid date %s: %w", s, err)
+		}
+		*d = mongoDate(t.UnixMilli())
+	default:
+		var millis int64
+		if err := json.Unmarshal(b, &millis); err != nil {
+			return fmt.Errorf("invalid date %s: %w", b, err)
+		}
+		*d = mongoDate(millis)
+	}
+	return nil
+}
+
+// parseDocuments decodes the documents of a collection exported by mongoexport, either one document
+// per line (the default) or a JSON array (--jsonArray).
+func parseDocuments[T any](data io.Reader) ([]T, error) {
+	reader := bufio.NewReader(data)
+	var documents []T
+
+	first, err := peekNonSpace(reader)
+	if errors.Is(err, io.EOF) {
+		return documents, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(reader)
+	if first == '[' {
+		if err := decoder.Decode(&documents); err != nil {
+			return nil, err
+		}
+		return documents, nil
+	}
+
+	for {
+		var document T
+		if err := decoder.Decode(&document); errors.Is(err, io.EOF) {
+			return documents, nil
+		} else if err != nil {
+			return documents, err
+		}
+		documents = append(documents, document)
+	}
+}
+
+func peekNonSpace(reader *bufio.Reader) (byte, error) {
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b, reader.UnreadByte()
+	}
+}
@@ -0,0 +1,534 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package rocketchatimport
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
+)
+
+const (
+	rcUsersFile         = "users.json"
+	rcRoomsFile         = "rocketchat_room.json"
+	rcSubscriptionsFile = "rocketchat_subscription.json"
+	rcMessagesFile      = "rocketchat_message.json"
+	rcUploadsDir        = "uploads"
+
+	rcRoomTypePublic  = "c"
+	rcRoomTypePrivate = "p"
+	rcRoomTypeDirect  = "d"
+
+	rcImportMaxFileSize = 1024 * 1024 * 1024
+
+	// attachmentsDir is the directory of the converted attachments in the data directory of the archive.
+	attachmentsDir = "rocketchat"
+)
+
+type rcUserRef struct {
+	Id       string `json:"_id"`
+	Username string `json:"username"`
+}
+
+type rcEmail struct {
+	Address string `json:"address"`
+}
+
+type rcUser struct {
+	Id       string    `json:"_id"`
+	Username string    `json:"username"`
+	Name     string    `json:"name"`
+	Emails   []rcEmail `json:"emails"`
+	Roles    []string  `json:"roles"`
+	Active   bool      `json:"active"`
+	Type     string    `json:"type"`
+}
+
+type rcRoom struct {
+	Id          string   `json:"_id"`
+	Type        string   `json:"t"`
+	Name        string   `json:"name"`
+	FName       string   `json:"fname"`
+	Topic       string   `json:"topic"`
+	Description string   `json:"description"`
+	Archived    bool     `json:"archived"`
+	UserIds     []string `json:"uids"`
+	Usernames   []string `json:"usernames"`
+}
+
+type rcSubscription struct {
+	RoomId string    `json:"rid"`
+	User   rcUserRef `json:"u"`
+	Roles  []string  `json:"roles"`
+}
+
+type rcFile struct {
+	Id   string `json:"_id"`
+	Name string `json:"name"`
+}
+
+type rcReaction struct {
+	Usernames []string `json:"usernames"`
+}
+
+type rcMessage struct {
+	Id        string                `json:"_id"`
+	RoomId    string                `json:"rid"`
+	Text      string                `json:"msg"`
+	TimeStamp mongoDate             `json:"ts"`
+	EditedAt  mongoDate             `json:"editedAt"`
+	User      rcUserRef             `json:"u"`
+	Type      string                `json:"t"`
+	ThreadId  string                `json:"tmid"`
+	Reactions map[string]rcReaction `json:"reactions"`
+	File      *rcFile               `json:"file"`
+	Files     []*rcFile             `json:"files"`
+	Pinned    bool                  `json:"pinned"`
+}
+
+// Options configures the conversion of a Rocket.Chat export.
+type Options struct {
+	// Team is the name of the team the channels are imported into. It must exist on the server.
+	Team string
+}
+
+type converter struct {
+	opts   Options
+	export *imports.ConvertedExport
+	now    int64
+
+	uploads map[string]*zip.File
+
+	users         map[string]*imports.UserImportData // by Rocket.Chat user id
+	usernames     map[string]string                  // Rocket.Chat username to username
+	channels      map[string]*imports.ChannelImportData
+	channelNames  map[string]string   // Rocket.Chat room name to channel name
+	directMembers map[string][]string // usernames of the members of direct rooms, by room id
+	memberships   map[string]map[string]string
+}
+
+// Convert converts a Rocket.Chat export into bulk import data. The export is a zip archive holding
+// the users, rocketchat_room, rocketchat_subscription and rocketchat_message collections exported
+// by mongoexport as <collection>.json files, and the files of the FileSystem upload storage in an
+// uploads directory, each named after its file id. Entities that can't be mapped are reported in
+// the Unmapped list of the result.
+func Convert(zipReader *zip.Reader, opts Options) (*imports.ConvertedExport, error) {
+	if opts.Team == "" {
+		return nil, errors.New("the team to import the channels into is required")
+	}
+
+	c := &converter{
+		opts:          opts,
+		export:        &imports.ConvertedExport{},
+		now:           model.GetMillis(),
+		uploads:       make(map[string]*zip.File),
+		users:         make(map[string]*imports.UserImportData),
+		usernames:     make(map[string]string),
+		channels:      make(map[string]*imports.ChannelImportData),
+		channelNames:  make(map[string]string),
+		directMembers: make(map[string][]string),
+		memberships:   make(map[string]map[string]string),
+	}
+
+	var (
+		users         []rcUser
+		rooms         []rcRoom
+		subscriptions []rcSubscription
+		messages      []rcMessage
+		found         = make(map[string]bool)
+	)
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		var err error
+		name := path.Base(file.Name)
+		switch {
+		case path.Base(path.Dir(file.Name)) == rcUploadsDir:
+			c.uploads[name] = file
+			continue
+		case name == rcUsersFile:
+			users, err = parseZipFile[rcUser](file)
+		case name == rcRoomsFile:
+			rooms, err = parseZipFile[rcRoom](file)
+		case name == rcSubscriptionsFile:
+			subscriptions, err = parseZipFile[rcSubscription](file)
+		case name == rcMessagesFile:
+			messages, err = parseZipFile[rcMessage](file)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file.Name, err)
+		}
+		found[name] = true
+	}
+	for _, required := range []string{rcUsersFile, rcRoomsFile, rcMessagesFile} {
+		if !found[required] {
+			return nil, fmt.Errorf("%s is missing from the export", required)
+		}
+	}
+
+	c.convertUsers(users)
+	c.convertRooms(rooms)
+	c.convertSubscriptions(subscriptions)
+	c.convertMessages(messages)
+	c.addMemberships()
+
+	return c.export, nil
+}
+
+func parseZipFile[T any](file *zip.File) ([]T, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return parseDocuments[T](utils.NewLimitedReaderWithError(reader, rcImportMaxFileSize))
+}
+
+func (c *converter) convertUsers(users []rcUser) {
+	used := make(map[string]bool)
+	for _, user := range users {
+		if user.Type == "bot" || user.Type == "app" {
+			c.export.AddUnmapped("user", user.Id, "bot and app accounts are not imported")
+			continue
+		}
+		if len(user.Emails) == 0 || user.Emails[0].Address == "" {
+			c.export.AddUnmapped("user", user.Id, "the user has no email address")
+			continue
+		}
+		username := imports.CleanUsername(user.Username)
+		if username == "" {
+			c.export.AddUnmapped("user", user.Id, fmt.Sprintf("the username %q can't be converted", user.Username))
+			continue
+		}
+		username = imports.UniqueName(used, username, model.UserNameMaxLength)
+
+		roles := model.SystemUserRoleId
+		if slices.Contains(user.Roles, "admin") {
+			roles += " " + model.SystemAdminRoleId
+		}
+		firstName, lastName := splitName(user.Name)
+		data := &imports.UserImportData{
+			Username:  model.NewPointer(username),
+			Email:     model.NewPointer(strings.ToLower(user.Emails[0].Address)),
+			FirstName: model.NewPointer(firstName),
+			LastName:  model.NewPointer(lastName),
+			Roles:     model.NewPointer(roles),
+		}
+		if !user.Active {
+			data.DeleteAt = model.NewPointer(c.now)
+		}
+
+		c.users[user.Id] = data
+		c.usernames[user.Username] = username
+		c.export.Users = append(c.export.Users, data)
+	}
+}
+
+func (c *converter) convertRooms(rooms []rcRoom) {
+	used := make(map[string]bool)
+	for _, room := range rooms {
+		switch room.Type {
+		case rcRoomTypePublic, rcRoomTypePrivate:
+			name := imports.CleanChannelName(room.Name)
+			if name == "" {
+				name = "rocketchat-" + strings.ToLower(room.Id)
+			}
+			name = imports.UniqueName(used, name, model.ChannelNameMaxLength)
+
+			displayName := room.FName
+			if displayName == "" {
+				displayName = room.Name
+			}
+			channelType := model.ChannelTypeOpen
+			if room.Type == rcRoomTypePrivate {
+				channelType = model.ChannelTypePrivate
+			}
+			channel := &imports.ChannelImportData{
+				Team:        model.NewPointer(c.opts.Team),
+				Name:        model.NewPointer(name),
+				DisplayName: model.NewPointer(truncateRunes(displayName, model.ChannelDisplayNameMaxRunes)),
+				Type:        &channelType,
+				Header:      model.NewPointer(truncateRunes(room.Topic, model.ChannelHeaderMaxRunes)),
+				Purpose:     model.NewPointer(truncateRunes(room.Description, model.ChannelPurposeMaxRunes)),
+			}
+			if room.Archived {
+				channel.DeletedAt = model.NewPointer(c.now)
+			}
+
+			c.channels[room.Id] = channel
+			c.channelNames[room.Name] = name
+			c.export.Channels = append(c.export.Channels, channel)
+		case rcRoomTypeDirect:
+			c.convertDirectRoom(room)
+		default:
+			c.export.AddUnmapped("room", room.Id, fmt.Sprintf("rooms of type %q are not imported", room.Type))
+		}
+	}
+}
+
+func (c *converter) convertDirectRoom(room rcRoom) {
+	var members []string
+	if len(room.UserIds) > 0 {
+		for _, userID := range room.UserIds {
+			user, ok := c.users[userID]
+			if !ok {
+				c.export.AddUnmapped("room", room.Id, fmt.Sprintf("the member %s of the direct message is not imported", userID))
+				return
+			}
+			members = append(members, *user.Username)
+		}
+	} else {
+		for _, rcUsername := range room.Usernames {
+			username, ok := c.usernames[rcUsername]
+			if !ok {
+				c.export.AddUnmapped("room", room.Id, fmt.Sprintf("the member %s of the direct message is not imported", rcUsername))
+				return
+			}
+			members = append(members, username)
+		}
+	}
+
+	slices.Sort(members)
+	members = slices.Compact(members)
+	if len(members) == 1 {
+		// a direct message with oneself.
+		members = append(members, members[0])
+	}
+	if len(members) == 0 || len(members) > model.ChannelGroupMaxUsers {
+		c.export.AddUnmapped("room", room.Id, fmt.Sprintf("direct messages with %d members are not supported", len(members)))
+		return
+	}
+
+	c.directMembers[room.Id] = members
+	c.export.DirectChannels = append(c.export.DirectChannels, &imports.DirectChannelImportData{
+		Members: &members,
+		Header:  model.NewPointer(truncateRunes(room.Topic, model.ChannelHeaderMaxRunes)),
+	})
+}
+
+func (c *converter) convertSubscriptions(subscriptions []rcSubscription) {
+	for _, subscription := range subscriptions {
+		if _, ok := c.channels[subscription.RoomId]; !ok {
+			continue
+		}
+		if _, ok := c.users[subscription.User.Id]; !ok {
+			continue
+		}
+
+		roles := model.ChannelUserRoleId
+		if slices.Contains(subscription.Roles, "owner") || slices.Contains(subscription.Roles, "moderator") {
+			roles += " " + model.ChannelAdminRoleId
+		}
+		c.addMembership(subscription.User.Id, subscription.RoomId, roles)
+	}
+}
+
+func (c *converter) addMembership(userID, roomID, roles string) {
+	channels, ok := c.memberships[userID]
+	if !ok {
+		channels = make(map[string]string)
+		c.memberships[userID] = channels
+	}
+	if _, ok := channels[roomID]; !ok || roles != model.ChannelUserRoleId {
+		channels[roomID] = roles
+	}
+}
+
+func (c *converter) convertMessages(messages []rcMessage) {
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].TimeStamp < messages[j].TimeStamp
+	})
+
+	posts := make(map[string]*imports.PostImportData)
+	directPosts := make(map[string]*imports.DirectPostImportData)
+	for _, message := range messages {
+		channel, isChannel := c.channels[message.RoomId]
+		members, isDirect := c.directMembers[message.RoomId]
+		switch {
+		case !isChannel && !isDirect:
+			c.export.AddUnmapped("message", message.Id, "the room of the message is not imported")
+			continue
+		case message.Type != "":
+			c.export.AddUnmapped("message", message.Id, fmt.Sprintf("system messages of type %q are not imported", message.Type))
+			continue
+		case message.TimeStamp == 0:
+			c.export.AddUnmapped("message", message.Id, "the message has no timestamp")
+			continue
+		}
+		user, ok := c.users[message.User.Id]
+		if !ok {
+			c.export.AddUnmapped("message", message.Id, "the author of the message is not imported")
+			continue
+		}
+
+		text := rcConvertMarkup(message.Text, c.usernames, c.channelNames)
+		createAt := int64(message.TimeStamp)
+		var editAt *int64
+		if message.EditedAt != 0 {
+			editAt = model.NewPointer(int64(message.EditedAt))
+		}
+		reactions := c.convertReactions(message, createAt)
+		attachments := c.convertFiles(message)
+		if text == "" && attachments == nil {
+			c.export.AddUnmapped("message", message.Id, "the message is empty")
+			continue
+		}
+
+		if isChannel {
+			c.addMembership(message.User.Id, message.RoomId, model.ChannelUserRoleId)
+		}
+
+		if message.ThreadId != "" {
+			reply := imports.ReplyImportData{
+				User:        user.Username,
+				Message:     model.NewPointer(text),
+				CreateAt:    model.NewPointer(createAt),
+				EditAt:      editAt,
+				Reactions:   reactions,
+				Attachments: attachments,
+				IsPinned:    model.NewPointer(message.Pinned),
+			}
+			if post, ok := posts[message.ThreadId]; ok && isChannel && *post.Channel == *channel.Name {
+				*post.Replies = append(*post.Replies, reply)
+				continue
+			}
+			if post, ok := directPosts[message.ThreadId]; ok && isDirect && slices.Equal(*post.ChannelMembers, members) {
+				*post.Replies = append(*post.Replies, reply)
+				continue
+			}
+			// the root of the thread is missing; the reply is imported as a root post.
+		}
+
+		if isChannel {
+			post := &imports.PostImportData{
+				Team:        model.NewPointer(c.opts.Team),
+				Channel:     channel.Name,
+				User:        user.Username,
+				Message:     model.NewPointer(text),
+				CreateAt:    model.NewPointer(createAt),
+				EditAt:      editAt,
+				Reactions:   reactions,
+				Replies:     &[]imports.ReplyImportData{},
+				Attachments: attachments,
+				IsPinned:    model.NewPointer(message.Pinned),
+			}
+			posts[message.Id] = post
+			c.export.Posts = append(c.export.Posts, post)
+			continue
+		}
+
+		post := &imports.DirectPostImportData{
+			ChannelMembers: &members,
+			User:           user.Username,
+			Message:        model.NewPointer(text),
+			CreateAt:       model.NewPointer(createAt),
+			EditAt:         editAt,
+			Reactions:      reactions,
+			Replies:        &[]imports.ReplyImportData{},
+			Attachments:    attachments,
+			IsPinned:       model.NewPointer(message.Pinned),
+		}
+		directPosts[message.Id] = post
+		c.export.DirectPosts = append(c.export.DirectPosts, post)
+	}
+}
+
+func (c *converter) convertReactions(message rcMessage, createAt int64) *[]imports.ReactionImportData {
+	if len(message.Reactions) == 0 {
+		return nil
+	}
+
+	emojis := make([]string, 0, len(message.Reactions))
+	for emoji := range message.Reactions {
+		emojis = append(emojis, emoji)
+	}
+	sort.Strings(emojis)
+
+	reactions := []imports.ReactionImportData{}
+	for _, emoji := range emojis {
+		emojiName := rcConvertEmojiName(emoji)
+		if emojiName == "" || len(emojiName) > model.EmojiNameMaxLength {
+			c.export.AddUnmapped("reaction", message.Id+" "+emoji, "the emoji name can't be converted")
+			continue
+		}
+		for _, rcUsername := range message.Reactions[emoji].Usernames {
+			username, ok := c.usernames[rcUsername]
+			if !ok {
+				c.export.AddUnmapped("reaction", message.Id+" "+emoji, fmt.Sprintf("the user %s is not imported", rcUsername))
+				continue
+			}
+			reactions = append(reactions, imports.ReactionImportData{
+				User:      model.NewPointer(username),
+				CreateAt:  model.NewPointer(createAt),
+				EmojiName: model.NewPointer(emojiName),
+			})
+		}
+	}
+	return &reactions
+}
+
+func (c *converter) convertFiles(message rcMessage) *[]imports.AttachmentImportData {
+	files := message.Files
+	if len(files) == 0 && message.File != nil {
+		files = []*rcFile{message.File}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	attachments := []imports.AttachmentImportData{}
+	for _, file := range files {
+		data, ok := c.uploads[file.Id]
+		if !ok {
+			c.export.AddUnmapped("file", file.Id, "the content of the file is missing from the export")
+			continue
+		}
+		name := path.Base(file.Name)
+		if name == "." || name == "/" {
+			name = file.Id
+		}
+		attachments = append(attachments, imports.AttachmentImportData{
+			Path: model.NewPointer(path.Join(attachmentsDir, file.Id, name)),
+			Data: data,
+		})
+	}
+	if len(attachments) == 0 {
+		return nil
+	}
+	return &attachments
+}
+
+func (c *converter) addMemberships() {
+	for userID, user := range c.users {
+		channels := []imports.UserChannelImportData{}
+		for roomID, roles := range c.memberships[userID] {
+			channels = append(channels, imports.UserChannelImportData{
+				Name:  c.channels[roomID].Name,
+				Roles: model.NewPointer(roles),
+			})
+		}
+		sort.Slice(channels, func(i, j int) bool {
+			return *channels[i].Name < *channels[j].Name
+		})
+
+		user.Teams = &[]imports.UserTeamImportData{{
+			Name:     model.NewPointer(c.opts.Team),
+			Roles:    model.NewPointer(model.TeamUserRoleId),
+			Channels: &channels,
+		}}
+	}
+}
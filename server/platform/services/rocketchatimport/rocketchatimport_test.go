@@ -0,0 +1,243 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package rocketchatimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+)
+
+func newZipReader(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	return zr
+}
+
+const testUsers = `
+{"_id":"u1","username":"alice","name":"Alice Smith","emails":[{"address":"Alice@example.com"}],"roles":["user","admin"],"active":true,"type":"user"}
+{"_id":"u2","username":"bob.jones","name":"Bob","emails":[{"address":"bob@example.com"}],"roles":["user"],"active":false,"type":"user"}
+{"_id":"u3","username":"rocket.cat","name":"Rocket.Cat","roles":["bot"],"active":true,"type":"bot"}
+{"_id":"u4","username":"noemail","name":"No Email","emails":[],"active":true,"type":"user"}
+`
+
+const testRooms = `[
+{"_id":"GENERAL","t":"c","name":"general","topic":"Everything","description":"The general room"},
+{"_id":"r2","t":"p","name":"Secret Room","fname":"Secret Room","archived":true},
+{"_id":"u1u2","t":"d","uids":["u1","u2"],"usernames":["alice","bob.jones"]},
+{"_id":"l1","t":"l","name":"livechat"}
+]`
+
+const testSubscriptions = `
+{"rid":"GENERAL","u":{"_id":"u1","username":"alice"},"roles":["owner"]}
+{"rid":"r2","u":{"_id":"u2","username":"bob.jones"}}
+`
+
+const testMessages = `
+{"_id":"m1","rid":"GENERAL","msg":"hello *world* @bob.jones, see #general","ts":{"$date":"2024-01-02T10:00:00.000Z"},"u":{"_id":"u1","username":"alice"},"reactions":{":thumbsup:":{"usernames":["bob.jones","rocket.cat"]}}}
+{"_id":"m2","rid":"GENERAL","msg":"a reply","ts":{"$date":{"$numberLong":"1704189660000"}},"u":{"_id":"u2","username":"bob.jones"},"tmid":"m1"}
+{"_id":"m3","rid":"GENERAL","msg":"","ts":{"$date":"2024-01-02T10:02:00.000Z"},"u":{"_id":"u1","username":"alice"},"t":"uj"}
+{"_id":"m4","rid":"u1u2","msg":"","ts":{"$date":"2024-01-02T10:03:00.000Z"},"u":{"_id":"u2","username":"bob.jones"},"file":{"_id":"f1","name":"notes.txt"}}
+{"_id":"m5","rid":"u1u2","msg":"missing file","ts":{"$date":"2024-01-02T10:04:00.000Z"},"u":{"_id":"u1","username":"alice"},"files":[{"_id":"f2","name":"gone.txt"}]}
+{"_id":"m6","rid":"l1","msg":"hi","ts":{"$date":"2024-01-02T10:05:00.000Z"},"u":{"_id":"u1","username":"alice"}}
+{"_id":"m7","rid":"GENERAL","msg":"beep","ts":{"$date":"2024-01-02T10:06:00.000Z"},"u":{"_id":"u3","username":"rocket.cat"}}
+`
+
+func TestConvert(t *testing.T) {
+	zr := newZipReader(t, map[string]string{
+		"export/users.json":                    testUsers,
+		"export/rocketchat_room.json":          testRooms,
+		"export/rocketchat_subscription.json":  testSubscriptions,
+		"export/rocketchat_message.json":       testMessages,
+		"export/uploads/f1":                    "file content",
+		"export/uploads/unreferenced-upload-1": "unused",
+	})
+
+	export, err := Convert(zr, Options{Team: "myteam"})
+	require.NoError(t, err)
+
+	t.Run("users", func(t *testing.T) {
+		require.Len(t, export.Users, 2)
+
+		alice := export.Users[0]
+		assert.Equal(t, "alice", *alice.Username)
+		assert.Equal(t, "alice@example.com", *alice.Email)
+		assert.Equal(t, "Alice", *alice.FirstName)
+		assert.Equal(t, "Smith", *alice.LastName)
+		assert.Equal(t, "system_user system_admin", *alice.Roles)
+		assert.Nil(t, alice.DeleteAt)
+		require.NotNil(t, alice.Teams)
+		require.Len(t, *alice.Teams, 1)
+		team := (*alice.Teams)[0]
+		assert.Equal(t, "myteam", *team.Name)
+		require.Len(t, *team.Channels, 1)
+		assert.Equal(t, "general", *(*team.Channels)[0].Name)
+		assert.Equal(t, "channel_user channel_admin", *(*team.Channels)[0].Roles)
+
+		bob := export.Users[1]
+		assert.Equal(t, "bob.jones", *bob.Username)
+		assert.NotNil(t, bob.DeleteAt)
+		channels := (*bob.Teams)[0].Channels
+		require.Len(t, *channels, 2)
+		assert.Equal(t, "general", *(*channels)[0].Name)
+		assert.Equal(t, "secret-room", *(*channels)[1].Name)
+	})
+
+	t.Run("channels", func(t *testing.T) {
+		require.Len(t, export.Channels, 2)
+		assert.Equal(t, "general", *export.Channels[0].Name)
+		assert.Equal(t, model.ChannelTypeOpen, *export.Channels[0].Type)
+		assert.Equal(t, "Everything", *export.Channels[0].Header)
+		assert.Equal(t, "The general room", *export.Channels[0].Purpose)
+		assert.Equal(t, "secret-room", *export.Channels[1].Name)
+		assert.Equal(t, "Secret Room", *export.Channels[1].DisplayName)
+		assert.Equal(t, model.ChannelTypePrivate, *export.Channels[1].Type)
+		assert.NotNil(t, export.Channels[1].DeletedAt)
+
+		require.Len(t, export.DirectChannels, 1)
+		assert.Equal(t, []string{"alice", "bob.jones"}, *export.DirectChannels[0].Members)
+	})
+
+	t.Run("posts", func(t *testing.T) {
+		require.Len(t, export.Posts, 1)
+		post := export.Posts[0]
+		assert.Equal(t, "myteam", *post.Team)
+		assert.Equal(t, "general", *post.Channel)
+		assert.Equal(t, "alice", *post.User)
+		assert.Equal(t, "hello **world** @bob.jones, see ~general", *post.Message)
+		assert.Equal(t, int64(1704189600000), *post.CreateAt)
+		require.Len(t, *post.Reactions, 1)
+		assert.Equal(t, "bob.jones", *(*post.Reactions)[0].User)
+		assert.Equal(t, "thumbsup", *(*post.Reactions)[0].EmojiName)
+		require.Len(t, *post.Replies, 1)
+		assert.Equal(t, "a reply", *(*post.Replies)[0].Message)
+		assert.Equal(t, int64(1704189660000), *(*post.Replies)[0].CreateAt)
+
+		require.Len(t, export.DirectPosts, 2)
+		withFile := export.DirectPosts[0]
+		assert.Equal(t, []string{"alice", "bob.jones"}, *withFile.ChannelMembers)
+		require.Len(t, *withFile.Attachments, 1)
+		assert.Equal(t, "rocketchat/f1/notes.txt", *(*withFile.Attachments)[0].Path)
+		assert.Nil(t, export.DirectPosts[1].Attachments)
+	})
+
+	t.Run("unmapped entities", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"user u3", "user u4", "room l1", "reaction m1 :thumbsup:", "message m3", "file f2", "message m6", "message m7"}, unmappedIds(export))
+	})
+
+	t.Run("report", func(t *testing.T) {
+		report := export.Report()
+		assert.Equal(t, 2, report.Users)
+		assert.Equal(t, 2, report.Channels)
+		assert.Equal(t, 1, report.Posts)
+		assert.Equal(t, 1, report.Replies)
+		assert.Equal(t, 1, report.DirectChannels)
+		assert.Equal(t, 2, report.DirectPosts)
+		assert.Equal(t, 1, report.Reactions)
+		assert.Equal(t, 1, report.Attachments)
+		assert.Len(t, report.Unmapped, 8)
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, export.WriteArchive(&buf))
+
+		archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		files := map[string]string{}
+		for _, file := range archive.File {
+			r, err := file.Open()
+			require.NoError(t, err)
+			content, err := io.ReadAll(r)
+			require.NoError(t, err)
+			r.Close()
+			files[file.Name] = string(content)
+		}
+		require.Len(t, files, 2)
+		assert.Equal(t, "file content", files["data/rocketchat/f1/notes.txt"])
+
+		lines := strings.Split(strings.TrimSpace(files[imports.ArchiveImportFilename]), "\n")
+		var types []string
+		for _, line := range lines {
+			types = append(types, strings.SplitN(strings.TrimPrefix(line, `{"type":"`), `"`, 2)[0])
+		}
+		assert.Equal(t, []string{"version", "channel", "channel", "user", "user", "post", "direct_channel", "direct_post", "direct_post"}, types)
+	})
+}
+
+func TestConvertRequiresFiles(t *testing.T) {
+	_, err := Convert(newZipReader(t, map[string]string{"users.json": testUsers}), Options{Team: "myteam"})
+	require.Error(t, err)
+
+	_, err = Convert(newZipReader(t, map[string]string{}), Options{})
+	require.Error(t, err)
+}
+
+func TestParseDocuments(t *testing.T) {
+	type document struct {
+		Id string    `json:"_id"`
+		Ts mongoDate `json:"ts"`
+	}
+
+	for name, data := range map[string]string{
+		"one per line": `{"_id":"a","ts":{"$date":"2024-01-02T10:00:00Z"}}` + "\n" + `{"_id":"b","ts":{"$date":{"$numberLong":"1704189600000"}}}`,
+		"array":        `[{"_id":"a","ts":{"$date":1704189600000}},{"_id":"b","ts":"2024-01-02T10:00:00.000Z"}]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			documents, err := parseDocuments[document](strings.NewReader(data))
+			require.NoError(t, err)
+			require.Len(t, documents, 2)
+			assert.Equal(t, "a", documents[0].Id)
+			assert.Equal(t, mongoDate(1704189600000), documents[0].Ts)
+			assert.Equal(t, mongoDate(1704189600000), documents[1].Ts)
+		})
+	}
+
+	documents, err := parseDocuments[document](strings.NewReader("  \n"))
+	require.NoError(t, err)
+	assert.Empty(t, documents)
+}
+
+func TestConvertMarkup(t *testing.T) {
+	usernames := map[string]string{"John.Doe": "john.doe"}
+	rooms := map[string]string{"Dev Team": "dev-team", "random": "random"}
+
+	for input, expected := range map[string]string{
+		"*bold* and **already bold**":     "**bold** and **already bold**",
+		"~gone~ text":                     "~~gone~~ text",
+		"ping @John.Doe and @unknown":     "ping @john.doe and @unknown",
+		"see #random or http://x/#random": "see ~random or http://x/#random",
+		"mail john@John.Doe":              "mail john@John.Doe",
+	} {
+		assert.Equal(t, expected, rcConvertMarkup(input, usernames, rooms), input)
+	}
+}
+
+func unmappedIds(export *imports.ConvertedExport) []string {
+	ids := make([]string, 0, len(export.Unmapped))
+	for _, entity := range export.Unmapped {
+		ids = append(ids, entity.Kind+" "+entity.Id)
+	}
+	return ids
+}
@@ -474,6 +474,26 @@ func (_m *MockAppIface) Publish(message *model.WebSocketEvent) {
 	_m.Called(message)
 }
 
+// RemoveSharedChannelMember provides a mock function with given fields: c, userID, channel
+func (_m *MockAppIface) RemoveSharedChannelMember(c request.CTX, userID string, channel *model.Channel) *model.AppError {
+	ret := _m.Called(c, userID, channel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveSharedChannelMember")
+	}
+
+	var r0 *model.AppError
+	if rf, ok := ret.Get(0).(func(request.CTX, string, *model.Channel) *model.AppError); ok {
+		r0 = rf(c, userID, channel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AppError)
+		}
+	}
+
+	return r0
+}
+
 // SaveAndBroadcastStatus provides a mock function with given fields: status
 func (_m *MockAppIface) SaveAndBroadcastStatus(status *model.Status) {
 	_m.Called(status)
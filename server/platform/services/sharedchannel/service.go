@@ -16,6 +16,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/shared/request"
 	"github.com/mattermost/mattermost/server/v8/channels/store"
 	"github.com/mattermost/mattermost/server/v8/einterfaces"
+	"github.com/mattermost/mattermost/server/v8/platform/services/cache"
 	"github.com/mattermost/mattermost/server/v8/platform/services/remotecluster"
 	"github.com/mattermost/mattermost/server/v8/platform/shared/filestore"
 )
@@ -24,12 +25,18 @@ const (
 	TopicSync                    = "sharedchannel_sync"
 	TopicChannelInvite           = "sharedchannel_invite"
 	TopicUploadCreate            = "sharedchannel_upload"
+	TopicUserSearch              = "sharedchannel_user_search"
 	MaxRetries                   = 3
 	MaxUsersPerSync              = 25
 	NotifyRemoteOfflineThreshold = time.Second * 10
 	NotifyMinimumDelay           = time.Second * 2
 	MaxUpsertRetries             = 25
 	ProfileImageSyncTimeout      = time.Second * 5
+	MaxMembershipChangesPerSync  = 100
+	MaxUserSearchResults         = 20
+	UserSearchTimeout            = time.Second * 5
+	UserDirectoryCacheSize       = 1000
+	UserDirectoryCacheExpiry     = time.Minute * 5
 )
 
 // Mocks can be re-generated with `make sharedchannel-mocks`.
@@ -56,6 +63,7 @@ type AppIface interface {
 	UserCanSeeOtherUser(c request.CTX, userID string, otherUserId string) (bool, *model.AppError)
 	AddUserToChannel(c request.CTX, user *model.User, channel *model.Channel, skipTeamMemberIntegrityCheck bool) (*model.ChannelMember, *model.AppError)
 	AddUserToTeamByTeamId(c request.CTX, teamId string, user *model.User) *model.AppError
+	RemoveSharedChannelMember(c request.CTX, userID string, channel *model.Channel) *model.AppError
 	PermanentDeleteChannel(c request.CTX, channel *model.Channel) *model.AppError
 	CreatePost(c request.CTX, post *model.Post, channel *model.Channel, flags model.CreatePostFlags) (savedPost *model.Post, err *model.AppError)
 	UpdatePost(c request.CTX, post *model.Post, safeUpdate bool) (*model.Post, *model.AppError)
@@ -97,7 +105,10 @@ type Service struct {
 	syncTopicListenerId       string
 	inviteTopicListenerId     string
 	uploadTopicListenerId     string
+	userSearchListenerId      string
 	siteURL                   *url.URL
+
+	userDirectoryCache cache.Cache
 }
 
 // NewSharedChannelService creates a RemoteClusterService instance.
@@ -108,6 +119,11 @@ func NewSharedChannelService(server ServerIface, platform PlatformIface, app App
 		app:          app,
 		changeSignal: make(chan struct{}, 1),
 		tasks:        make(map[string]syncTask),
+		userDirectoryCache: cache.NewLRU(&cache.CacheOptions{
+			Size:          UserDirectoryCacheSize,
+			DefaultExpiry: UserDirectoryCacheExpiry,
+			Name:          "SharedChannelUserDirectory",
+		}),
 	}
 	parsed, err := url.Parse(*server.Config().ServiceSettings.SiteURL)
 	if err != nil {
@@ -129,6 +145,7 @@ func (scs *Service) Start() error {
 	scs.syncTopicListenerId = rcs.AddTopicListener(TopicSync, scs.onReceiveSyncMessage)
 	scs.inviteTopicListenerId = rcs.AddTopicListener(TopicChannelInvite, scs.onReceiveChannelInvite)
 	scs.uploadTopicListenerId = rcs.AddTopicListener(TopicUploadCreate, scs.onReceiveUploadCreate)
+	scs.userSearchListenerId = rcs.AddTopicListener(TopicUserSearch, scs.onReceiveUserSearch)
 	scs.connectionStateListenerId = rcs.AddConnectionStateListener(scs.onConnectionStateChange)
 	scs.mux.Unlock()
 
@@ -150,6 +167,8 @@ func (scs *Service) Shutdown() error {
 	scs.syncTopicListenerId = ""
 	rcs.RemoveTopicListener(scs.inviteTopicListenerId)
 	scs.inviteTopicListenerId = ""
+	rcs.RemoveTopicListener(scs.userSearchListenerId)
+	scs.userSearchListenerId = ""
 	rcs.RemoveConnectionStateListener(scs.connectionStateListenerId)
 	scs.connectionStateListenerId = ""
 	scs.mux.Unlock()
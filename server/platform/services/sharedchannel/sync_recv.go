@@ -53,10 +53,13 @@ func (scs *Service) processSyncMessage(c request.CTX, syncMsg *model.SyncMsg, rc
 
 	var err error
 	syncResp := model.SyncResponse{
-		UserErrors:     make([]string, 0),
-		UsersSyncd:     make([]string, 0),
-		PostErrors:     make([]string, 0),
-		ReactionErrors: make([]string, 0),
+		UserErrors:            make([]string, 0),
+		UsersSyncd:            make([]string, 0),
+		PostErrors:            make([]string, 0),
+		ReactionErrors:        make([]string, 0),
+		AcknowledgementErrors: make([]string, 0),
+		BookmarkErrors:        make([]string, 0),
+		MembershipErrors:      make([]string, 0),
 	}
 
 	scs.server.Log().Log(mlog.LvlSharedChannelServiceDebug, "Sync msg received",
@@ -65,6 +68,9 @@ func (scs *Service) processSyncMessage(c request.CTX, syncMsg *model.SyncMsg, rc
 		mlog.Int("user_count", len(syncMsg.Users)),
 		mlog.Int("post_count", len(syncMsg.Posts)),
 		mlog.Int("reaction_count", len(syncMsg.Reactions)),
+		mlog.Int("acknowledgement_count", len(syncMsg.Acknowledgements)),
+		mlog.Int("bookmark_count", len(syncMsg.Bookmarks)),
+		mlog.Int("membership_change_count", len(syncMsg.MembershipChanges)),
 		mlog.Int("status_count", len(syncMsg.Statuses)),
 	)
 
@@ -103,6 +109,22 @@ func (scs *Service) processSyncMessage(c request.CTX, syncMsg *model.SyncMsg, rc
 		}
 	}
 
+	// add/remove channel members after users are known and before their posts
+	for _, change := range syncMsg.MembershipChanges {
+		if err := scs.processSyncMembershipChange(c, change, targetChannel, rc); err != nil {
+			syncResp.MembershipErrors = append(syncResp.MembershipErrors, change.UserId)
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Error processing sync membership change",
+				mlog.String("remote", rc.Name),
+				mlog.String("channel_id", syncMsg.ChannelId),
+				mlog.String("user_id", change.UserId),
+				mlog.Bool("is_add", change.IsAdd),
+				mlog.Err(err),
+			)
+		} else if syncResp.MembershipsLastUpdateAt < change.ChangeTime {
+			syncResp.MembershipsLastUpdateAt = change.ChangeTime
+		}
+	}
+
 	for _, post := range syncMsg.Posts {
 		if syncMsg.ChannelId != post.ChannelId {
 			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "ChannelId mismatch",
@@ -176,6 +198,35 @@ func (scs *Service) processSyncMessage(c request.CTX, syncMsg *model.SyncMsg, rc
 		}
 	}
 
+	// add/remove acknowledgements
+	for _, ack := range syncMsg.Acknowledgements {
+		if err := scs.upsertSyncAcknowledgement(ack, targetChannel, rc); err != nil {
+			syncResp.AcknowledgementErrors = append(syncResp.AcknowledgementErrors, ack.PostId)
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Error upserting sync acknowledgement",
+				mlog.String("remote", rc.Name),
+				mlog.String("user_id", ack.UserId),
+				mlog.String("post_id", ack.PostId),
+				mlog.Int("acknowledged_at", ack.AcknowledgedAt),
+				mlog.Err(err),
+			)
+		}
+	}
+
+	// add/update/delete bookmarks
+	for _, bookmark := range syncMsg.Bookmarks {
+		if err := scs.upsertSyncBookmark(bookmark, targetChannel, rc); err != nil {
+			syncResp.BookmarkErrors = append(syncResp.BookmarkErrors, bookmark.Id)
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Error upserting sync bookmark",
+				mlog.String("remote", rc.Name),
+				mlog.String("channel_id", syncMsg.ChannelId),
+				mlog.String("bookmark_id", bookmark.Id),
+				mlog.Err(err),
+			)
+		} else if syncResp.BookmarksLastUpdateAt < bookmark.UpdateAt {
+			syncResp.BookmarksLastUpdateAt = bookmark.UpdateAt
+		}
+	}
+
 	for _, status := range syncMsg.Statuses {
 		scs.app.SaveAndBroadcastStatus(status)
 	}
@@ -186,6 +237,37 @@ func (scs *Service) processSyncMessage(c request.CTX, syncMsg *model.SyncMsg, rc
 }
 
 func (scs *Service) upsertSyncUser(c request.CTX, user *model.User, channel *model.Channel, rc *model.RemoteCluster) (*model.User, error) {
+	userSaved, err := scs.upsertSyncUserProfile(c, user, channel, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add user to team and channel. We do this here regardless of whether the user was
+	// just created or patched since there are three steps to adding a user
+	// (insert rec, add to team, add to channel) and any one could fail.
+	// Instead of undoing what succeeded on any failure we simply do all steps each
+	// time. AddUserToChannel & AddUserToTeamByTeamId do not error if user was already
+	// added and exit quickly.  Not needed for DMs where teamId is empty.
+	// Remotes that synchronize channel membership add their users to the channel
+	// with membership changes instead, so users who left aren't added back.
+	if channel.TeamId != "" {
+		// add user to team
+		if err := scs.app.AddUserToTeamByTeamId(request.EmptyContext(scs.server.Log()), channel.TeamId, userSaved); err != nil {
+			return nil, fmt.Errorf("error adding sync user to Team: %w", err)
+		}
+		// add user to channel
+		if !rc.HasCapability(model.RemoteCapabilityMembership) {
+			if _, err := scs.app.AddUserToChannel(c, userSaved, channel, false); err != nil {
+				return nil, fmt.Errorf("error adding sync user to ChannelMembers: %w", err)
+			}
+		}
+	}
+
+	return userSaved, nil
+}
+
+// upsertSyncUserProfile inserts or updates a user belonging to the remote, without adding it to any team or channel.
+func (scs *Service) upsertSyncUserProfile(c request.CTX, user *model.User, channel *model.Channel, rc *model.RemoteCluster) (*model.User, error) {
 	var err error
 
 	// Check if user already exists
@@ -232,24 +314,6 @@ func (scs *Service) upsertSyncUser(c request.CTX, user *model.User, channel *mod
 			return nil, err
 		}
 	}
-
-	// Add user to team and channel. We do this here regardless of whether the user was
-	// just created or patched since there are three steps to adding a user
-	// (insert rec, add to team, add to channel) and any one could fail.
-	// Instead of undoing what succeeded on any failure we simply do all steps each
-	// time. AddUserToChannel & AddUserToTeamByTeamId do not error if user was already
-	// added and exit quickly.  Not needed for DMs where teamId is empty.
-	if channel.TeamId != "" {
-		// add user to team
-		if err := scs.app.AddUserToTeamByTeamId(request.EmptyContext(scs.server.Log()), channel.TeamId, userSaved); err != nil {
-			return nil, fmt.Errorf("error adding sync user to Team: %w", err)
-		}
-		// add user to channel
-		if _, err := scs.app.AddUserToChannel(c, userSaved, channel, false); err != nil {
-			return nil, fmt.Errorf("error adding sync user to ChannelMembers: %w", err)
-		}
-	}
-
 	return userSaved, nil
 }
 
@@ -471,3 +535,175 @@ func (scs *Service) upsertSyncReaction(reaction *model.Reaction, targetChannel *
 	}
 	return savedReaction, retErr
 }
+
+// processSyncMembershipChange adds a user of the remote to the channel, or removes it. Only the memberships of the
+// remote's own users can be changed; adding an existing member or removing a user who isn't a member does nothing.
+func (scs *Service) processSyncMembershipChange(c request.CTX, change *model.MembershipChangeMsg, targetChannel *model.Channel, rc *model.RemoteCluster) error {
+	if change.ChannelId != targetChannel.Id {
+		return fmt.Errorf("membership change sync failed: %w", ErrChannelIDMismatch)
+	}
+
+	// the members of direct and group messages never change.
+	if targetChannel.IsGroupOrDirect() {
+		return nil
+	}
+
+	user, err := scs.server.GetStore().User().Get(context.TODO(), change.UserId)
+	if err != nil {
+		return fmt.Errorf("error fetching user for membership change sync: %w", err)
+	}
+	if user.GetRemoteID() != rc.RemoteId {
+		return fmt.Errorf("membership change sync failed: %w", ErrRemoteIDMismatch)
+	}
+
+	if change.IsAdd {
+		if appErr := scs.app.AddUserToTeamByTeamId(c, targetChannel.TeamId, user); appErr != nil {
+			return fmt.Errorf("error adding sync user to Team: %w", appErr)
+		}
+		if _, appErr := scs.app.AddUserToChannel(c, user, targetChannel, false); appErr != nil {
+			return fmt.Errorf("error adding sync user to ChannelMembers: %w", appErr)
+		}
+		return nil
+	}
+
+	if _, err := scs.server.GetStore().Channel().GetMember(context.TODO(), targetChannel.Id, user.Id); err != nil {
+		if isNotFoundError(err) {
+			return nil
+		}
+		return fmt.Errorf("error fetching channel member for membership change sync: %w", err)
+	}
+	if appErr := scs.app.RemoveSharedChannelMember(c, user.Id, targetChannel); appErr != nil {
+		return fmt.Errorf("error removing sync user from ChannelMembers: %w", appErr)
+	}
+	return nil
+}
+
+// upsertSyncAcknowledgement adds or removes (AcknowledgedAt == 0) an acknowledgement received from a remote.
+// Only acknowledgements of the remote's own users are accepted, and the most recent change wins.
+func (scs *Service) upsertSyncAcknowledgement(ack *model.PostAcknowledgement, targetChannel *model.Channel, rc *model.RemoteCluster) error {
+	// check that the acknowledgement's post is in the target channel. This ensures the acknowledgement can only be
+	// associated with a post that is in a channel shared with the remote.
+	rctx := request.EmptyContext(scs.server.Log())
+	post, err := scs.server.GetStore().Post().GetSingle(rctx, ack.PostId, true)
+	if err != nil {
+		return fmt.Errorf("error fetching post for acknowledgement sync: %w", err)
+	}
+	if post.ChannelId != targetChannel.Id {
+		return fmt.Errorf("acknowledgement sync failed: %w", ErrChannelIDMismatch)
+	}
+
+	user, err := scs.server.GetStore().User().Get(context.TODO(), ack.UserId)
+	if err != nil {
+		return fmt.Errorf("error fetching user for acknowledgement sync: %w", err)
+	}
+	if user.GetRemoteID() != rc.RemoteId {
+		return fmt.Errorf("acknowledgement sync failed: %w", ErrRemoteIDMismatch)
+	}
+
+	ack.RemoteId = model.NewPointer(rc.RemoteId)
+	if ack.UpdateAt == 0 {
+		ack.UpdateAt = model.GetMillis()
+	}
+
+	savedAck, err := scs.server.GetStore().PostAcknowledgement().SaveForSync(ack)
+	if err != nil {
+		if _, ok := isConflictError(err); ok {
+			// a more recent change exists locally; it will be sent to the remote.
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceDebug, "Sync acknowledgement ignored; local change is newer",
+				mlog.String("post_id", ack.PostId),
+				mlog.String("user_id", ack.UserId),
+			)
+			return nil
+		}
+		return fmt.Errorf("error saving acknowledgement for sync: %w", err)
+	}
+
+	scs.server.GetStore().Post().InvalidateLastPostTimeCache(targetChannel.Id)
+
+	event := model.WebsocketEventAcknowledgementAdded
+	if savedAck.AcknowledgedAt == 0 {
+		event = model.WebsocketEventAcknowledgementRemoved
+	}
+	message := model.NewWebSocketEvent(event, "", targetChannel.Id, "", nil, "")
+	ackJSON, err := json.Marshal(savedAck)
+	if err != nil {
+		return fmt.Errorf("error marshalling acknowledgement for sync: %w", err)
+	}
+	message.Add("acknowledgement", string(ackJSON))
+	scs.app.Publish(message)
+
+	return nil
+}
+
+// upsertSyncBookmark creates, updates or deletes a link bookmark received from a remote. New bookmarks must
+// be owned by one of the remote's users; changes to existing bookmarks are accepted from any remote the
+// channel is shared with, since channel members on any side can edit them. The most recent change wins.
+func (scs *Service) upsertSyncBookmark(bookmark *model.ChannelBookmark, targetChannel *model.Channel, rc *model.RemoteCluster) error {
+	if bookmark.ChannelId != targetChannel.Id {
+		return fmt.Errorf("bookmark sync failed: %w", ErrChannelIDMismatch)
+	}
+	if bookmark.Type != model.ChannelBookmarkLink {
+		return fmt.Errorf("bookmark sync failed: unsupported bookmark type %q", bookmark.Type)
+	}
+
+	existing, err := scs.server.GetStore().ChannelBookmark().Get(bookmark.Id, true)
+	if err != nil && !isNotFoundError(err) {
+		return fmt.Errorf("error fetching bookmark for sync: %w", err)
+	}
+
+	if existing == nil {
+		user, err := scs.server.GetStore().User().Get(context.TODO(), bookmark.OwnerId)
+		if err != nil {
+			return fmt.Errorf("error fetching user for bookmark sync: %w", err)
+		}
+		if user.GetRemoteID() != rc.RemoteId {
+			return fmt.Errorf("bookmark sync failed: %w", ErrRemoteIDMismatch)
+		}
+	} else if existing.ChannelId != targetChannel.Id {
+		return fmt.Errorf("bookmark sync failed: %w", ErrChannelIDMismatch)
+	}
+
+	bookmark.RemoteId = model.NewPointer(rc.RemoteId)
+	bookmark.FileId = ""
+	bookmark.LinkStatus = ""
+	bookmark.LinkCheckedAt = 0
+
+	if err := scs.server.GetStore().ChannelBookmark().UpsertForSync(bookmark); err != nil {
+		if _, ok := isConflictError(err); ok {
+			// a more recent change exists locally; it will be sent to the remote.
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceDebug, "Sync bookmark ignored; local change is newer",
+				mlog.String("bookmark_id", bookmark.Id),
+				mlog.String("channel_id", bookmark.ChannelId),
+			)
+			return nil
+		}
+		return fmt.Errorf("error saving bookmark for sync: %w", err)
+	}
+
+	var message *model.WebSocketEvent
+	var payload any
+	switch {
+	case existing == nil && bookmark.DeleteAt == 0:
+		message = model.NewWebSocketEvent(model.WebsocketEventChannelBookmarkCreated, "", targetChannel.Id, "", nil, "")
+		payload = bookmark.ToBookmarkWithFileInfo(nil)
+	case bookmark.DeleteAt != 0:
+		message = model.NewWebSocketEvent(model.WebsocketEventChannelBookmarkDeleted, "", targetChannel.Id, "", nil, "")
+		payload = bookmark.ToBookmarkWithFileInfo(nil)
+	default:
+		message = model.NewWebSocketEvent(model.WebsocketEventChannelBookmarkUpdated, "", targetChannel.Id, "", nil, "")
+		payload = &model.UpdateChannelBookmarkResponse{Updated: bookmark.ToBookmarkWithFileInfo(nil)}
+	}
+
+	bookmarkJSON, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error marshalling bookmark for sync: %w", err)
+	}
+	if message.EventType() == model.WebsocketEventChannelBookmarkUpdated {
+		message.Add("bookmarks", string(bookmarkJSON))
+	} else {
+		message.Add("bookmark", string(bookmarkJSON))
+	}
+	scs.app.Publish(message)
+
+	return nil
+}
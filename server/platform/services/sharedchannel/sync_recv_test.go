@@ -0,0 +1,293 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sharedchannel
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest/mock"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/channels/store/storetest/mocks"
+)
+
+func setupSyncRecvTest(t *testing.T) (*Service, *mocks.Store, *MockAppIface) {
+	mockServer := &MockServerIface{}
+	mockServer.On("Log").Return(mlog.CreateConsoleTestLogger(t))
+	mockStore := &mocks.Store{}
+	mockServer.On("GetStore").Return(mockStore)
+	mockApp := &MockAppIface{}
+
+	scs := &Service{
+		server: mockServer,
+		app:    mockApp,
+	}
+	return scs, mockStore, mockApp
+}
+
+func TestUpsertSyncAcknowledgement(t *testing.T) {
+	rc := &model.RemoteCluster{RemoteId: model.NewId(), Name: "remote"}
+	channel := &model.Channel{Id: model.NewId()}
+	post := &model.Post{Id: model.NewId(), ChannelId: channel.Id}
+	remoteUser := &model.User{Id: model.NewId(), RemoteId: model.NewPointer(rc.RemoteId)}
+	localUser := &model.User{Id: model.NewId()}
+
+	t.Run("saves the acknowledgement and publishes an event", func(t *testing.T) {
+		scs, mockStore, mockApp := setupSyncRecvTest(t)
+		ack := &model.PostAcknowledgement{PostId: post.Id, UserId: remoteUser.Id, AcknowledgedAt: 10, UpdateAt: 10}
+
+		mockPostStore := &mocks.PostStore{}
+		mockPostStore.On("GetSingle", mock.Anything, post.Id, true).Return(post, nil)
+		mockPostStore.On("InvalidateLastPostTimeCache", channel.Id).Once()
+		mockUserStore := &mocks.UserStore{}
+		mockUserStore.On("Get", mockTypeContext, remoteUser.Id).Return(remoteUser, nil)
+		mockAckStore := &mocks.PostAcknowledgementStore{}
+		mockAckStore.On("SaveForSync", mock.MatchedBy(func(a *model.PostAcknowledgement) bool {
+			return a.GetRemoteID() == rc.RemoteId
+		})).Return(ack, nil).Once()
+		mockStore.On("Post").Return(mockPostStore)
+		mockStore.On("User").Return(mockUserStore)
+		mockStore.On("PostAcknowledgement").Return(mockAckStore)
+		mockApp.On("Publish", mock.MatchedBy(func(ev *model.WebSocketEvent) bool {
+			return ev.EventType() == model.WebsocketEventAcknowledgementAdded
+		})).Once()
+
+		err := scs.upsertSyncAcknowledgement(ack, channel, rc)
+		require.NoError(t, err)
+		mockAckStore.AssertExpectations(t)
+		mockPostStore.AssertExpectations(t)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("rejects acknowledgements of users that don't belong to the remote", func(t *testing.T) {
+		scs, mockStore, _ := setupSyncRecvTest(t)
+		ack := &model.PostAcknowledgement{PostId: post.Id, UserId: localUser.Id, AcknowledgedAt: 10, UpdateAt: 10}
+
+		mockPostStore := &mocks.PostStore{}
+		mockPostStore.On("GetSingle", mock.Anything, post.Id, true).Return(post, nil)
+		mockUserStore := &mocks.UserStore{}
+		mockUserStore.On("Get", mockTypeContext, localUser.Id).Return(localUser, nil)
+		mockStore.On("Post").Return(mockPostStore)
+		mockStore.On("User").Return(mockUserStore)
+
+		err := scs.upsertSyncAcknowledgement(ack, channel, rc)
+		assert.ErrorIs(t, err, ErrRemoteIDMismatch)
+		mockStore.AssertNotCalled(t, "PostAcknowledgement")
+	})
+
+	t.Run("rejects acknowledgements of posts in other channels", func(t *testing.T) {
+		scs, mockStore, _ := setupSyncRecvTest(t)
+		otherPost := &model.Post{Id: model.NewId(), ChannelId: model.NewId()}
+		ack := &model.PostAcknowledgement{PostId: otherPost.Id, UserId: remoteUser.Id, AcknowledgedAt: 10, UpdateAt: 10}
+
+		mockPostStore := &mocks.PostStore{}
+		mockPostStore.On("GetSingle", mock.Anything, otherPost.Id, true).Return(otherPost, nil)
+		mockStore.On("Post").Return(mockPostStore)
+
+		err := scs.upsertSyncAcknowledgement(ack, channel, rc)
+		assert.ErrorIs(t, err, ErrChannelIDMismatch)
+	})
+
+	t.Run("ignores stale acknowledgements", func(t *testing.T) {
+		scs, mockStore, mockApp := setupSyncRecvTest(t)
+		ack := &model.PostAcknowledgement{PostId: post.Id, UserId: remoteUser.Id, AcknowledgedAt: 0, UpdateAt: 10}
+
+		mockPostStore := &mocks.PostStore{}
+		mockPostStore.On("GetSingle", mock.Anything, post.Id, true).Return(post, nil)
+		mockUserStore := &mocks.UserStore{}
+		mockUserStore.On("Get", mockTypeContext, remoteUser.Id).Return(remoteUser, nil)
+		mockAckStore := &mocks.PostAcknowledgementStore{}
+		mockAckStore.On("SaveForSync", mock.Anything).Return(nil, store.NewErrConflict("PostAcknowledgement", errors.New("stale"), ""))
+		mockStore.On("Post").Return(mockPostStore)
+		mockStore.On("User").Return(mockUserStore)
+		mockStore.On("PostAcknowledgement").Return(mockAckStore)
+
+		err := scs.upsertSyncAcknowledgement(ack, channel, rc)
+		require.NoError(t, err)
+		mockApp.AssertNotCalled(t, "Publish", mock.Anything)
+	})
+}
+
+func TestUpsertSyncBookmark(t *testing.T) {
+	rc := &model.RemoteCluster{RemoteId: model.NewId(), Name: "remote"}
+	channel := &model.Channel{Id: model.NewId()}
+	remoteUser := &model.User{Id: model.NewId(), RemoteId: model.NewPointer(rc.RemoteId)}
+	localUser := &model.User{Id: model.NewId()}
+
+	newBookmark := func(ownerID string) *model.ChannelBookmark {
+		return &model.ChannelBookmark{
+			Id:            model.NewId(),
+			ChannelId:     channel.Id,
+			OwnerId:       ownerID,
+			DisplayName:   "link",
+			LinkUrl:       "https://mattermost.com",
+			Type:          model.ChannelBookmarkLink,
+			LinkStatus:    model.ChannelBookmarkLinkStatusBroken,
+			LinkCheckedAt: 5,
+			UpdateAt:      10,
+		}
+	}
+
+	t.Run("creates a new bookmark owned by a remote user", func(t *testing.T) {
+		scs, mockStore, mockApp := setupSyncRecvTest(t)
+		bookmark := newBookmark(remoteUser.Id)
+
+		mockBookmarkStore := &mocks.ChannelBookmarkStore{}
+		mockBookmarkStore.On("Get", bookmark.Id, true).Return(nil, store.NewErrNotFound("ChannelBookmark", bookmark.Id))
+		mockBookmarkStore.On("UpsertForSync", mock.MatchedBy(func(b *model.ChannelBookmark) bool {
+			return b.GetRemoteID() == rc.RemoteId && b.LinkStatus == "" && b.LinkCheckedAt == 0
+		})).Return(nil).Once()
+		mockUserStore := &mocks.UserStore{}
+		mockUserStore.On("Get", mockTypeContext, remoteUser.Id).Return(remoteUser, nil)
+		mockStore.On("ChannelBookmark").Return(mockBookmarkStore)
+		mockStore.On("User").Return(mockUserStore)
+		mockApp.On("Publish", mock.MatchedBy(func(ev *model.WebSocketEvent) bool {
+			return ev.EventType() == model.WebsocketEventChannelBookmarkCreated
+		})).Once()
+
+		err := scs.upsertSyncBookmark(bookmark, channel, rc)
+		require.NoError(t, err)
+		mockBookmarkStore.AssertExpectations(t)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("rejects new bookmarks owned by local users", func(t *testing.T) {
+		scs, mockStore, _ := setupSyncRecvTest(t)
+		bookmark := newBookmark(localUser.Id)
+
+		mockBookmarkStore := &mocks.ChannelBookmarkStore{}
+		mockBookmarkStore.On("Get", bookmark.Id, true).Return(nil, store.NewErrNotFound("ChannelBookmark", bookmark.Id))
+		mockUserStore := &mocks.UserStore{}
+		mockUserStore.On("Get", mockTypeContext, localUser.Id).Return(localUser, nil)
+		mockStore.On("ChannelBookmark").Return(mockBookmarkStore)
+		mockStore.On("User").Return(mockUserStore)
+
+		err := scs.upsertSyncBookmark(bookmark, channel, rc)
+		assert.ErrorIs(t, err, ErrRemoteIDMismatch)
+		mockBookmarkStore.AssertNotCalled(t, "UpsertForSync", mock.Anything)
+	})
+
+	t.Run("updates an existing bookmark", func(t *testing.T) {
+		scs, mockStore, mockApp := setupSyncRecvTest(t)
+		bookmark := newBookmark(localUser.Id)
+		existing := &model.ChannelBookmarkWithFileInfo{ChannelBookmark: &model.ChannelBookmark{Id: bookmark.Id, ChannelId: channel.Id}}
+
+		mockBookmarkStore := &mocks.ChannelBookmarkStore{}
+		mockBookmarkStore.On("Get", bookmark.Id, true).Return(existing, nil)
+		mockBookmarkStore.On("UpsertForSync", mock.Anything).Return(nil).Once()
+		mockStore.On("ChannelBookmark").Return(mockBookmarkStore)
+		mockApp.On("Publish", mock.MatchedBy(func(ev *model.WebSocketEvent) bool {
+			return ev.EventType() == model.WebsocketEventChannelBookmarkUpdated
+		})).Once()
+
+		err := scs.upsertSyncBookmark(bookmark, channel, rc)
+		require.NoError(t, err)
+		mockBookmarkStore.AssertExpectations(t)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("rejects bookmarks of other channels", func(t *testing.T) {
+		scs, mockStore, _ := setupSyncRecvTest(t)
+		bookmark := newBookmark(remoteUser.Id)
+		bookmark.ChannelId = model.NewId()
+
+		err := scs.upsertSyncBookmark(bookmark, channel, rc)
+		assert.ErrorIs(t, err, ErrChannelIDMismatch)
+		mockStore.AssertNotCalled(t, "ChannelBookmark")
+	})
+
+	t.Run("rejects file bookmarks", func(t *testing.T) {
+		scs, mockStore, _ := setupSyncRecvTest(t)
+		bookmark := newBookmark(remoteUser.Id)
+		bookmark.Type = model.ChannelBookmarkFile
+
+		err := scs.upsertSyncBookmark(bookmark, channel, rc)
+		require.Error(t, err)
+		mockStore.AssertNotCalled(t, "ChannelBookmark")
+	})
+}
+
+func TestProcessSyncMembershipChange(t *testing.T) {
+	rc := &model.RemoteCluster{RemoteId: model.NewId(), Name: "remote"}
+	channel := &model.Channel{Id: model.NewId(), TeamId: model.NewId(), Type: model.ChannelTypeOpen}
+	remoteUser := &model.User{Id: model.NewId(), RemoteId: model.NewPointer(rc.RemoteId)}
+	localUser := &model.User{Id: model.NewId()}
+
+	t.Run("adds the user to the team and the channel", func(t *testing.T) {
+		scs, mockStore, mockApp := setupSyncRecvTest(t)
+		change := &model.MembershipChangeMsg{ChannelId: channel.Id, UserId: remoteUser.Id, IsAdd: true, ChangeTime: 10}
+
+		mockUserStore := &mocks.UserStore{}
+		mockUserStore.On("Get", mockTypeContext, remoteUser.Id).Return(remoteUser, nil)
+		mockStore.On("User").Return(mockUserStore)
+		mockApp.On("AddUserToTeamByTeamId", mock.Anything, channel.TeamId, remoteUser).Return(nil).Once()
+		mockApp.On("AddUserToChannel", mock.Anything, remoteUser, channel, false).Return(&model.ChannelMember{}, nil).Once()
+
+		err := scs.processSyncMembershipChange(request.TestContext(t), change, channel, rc)
+		require.NoError(t, err)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("removes the user from the channel", func(t *testing.T) {
+		scs, mockStore, mockApp := setupSyncRecvTest(t)
+		change := &model.MembershipChangeMsg{ChannelId: channel.Id, UserId: remoteUser.Id, IsAdd: false, ChangeTime: 10}
+
+		mockUserStore := &mocks.UserStore{}
+		mockUserStore.On("Get", mockTypeContext, remoteUser.Id).Return(remoteUser, nil)
+		mockChannelStore := &mocks.ChannelStore{}
+		mockChannelStore.On("GetMember", mockTypeContext, channel.Id, remoteUser.Id).Return(&model.ChannelMember{}, nil)
+		mockStore.On("User").Return(mockUserStore)
+		mockStore.On("Channel").Return(mockChannelStore)
+		mockApp.On("RemoveSharedChannelMember", mock.Anything, remoteUser.Id, channel).Return(nil).Once()
+
+		err := scs.processSyncMembershipChange(request.TestContext(t), change, channel, rc)
+		require.NoError(t, err)
+		mockApp.AssertExpectations(t)
+	})
+
+	t.Run("ignores the removal of users that aren't members", func(t *testing.T) {
+		scs, mockStore, mockApp := setupSyncRecvTest(t)
+		change := &model.MembershipChangeMsg{ChannelId: channel.Id, UserId: remoteUser.Id, IsAdd: false, ChangeTime: 10}
+
+		mockUserStore := &mocks.UserStore{}
+		mockUserStore.On("Get", mockTypeContext, remoteUser.Id).Return(remoteUser, nil)
+		mockChannelStore := &mocks.ChannelStore{}
+		mockChannelStore.On("GetMember", mockTypeContext, channel.Id, remoteUser.Id).Return(nil, store.NewErrNotFound("ChannelMember", remoteUser.Id))
+		mockStore.On("User").Return(mockUserStore)
+		mockStore.On("Channel").Return(mockChannelStore)
+
+		err := scs.processSyncMembershipChange(request.TestContext(t), change, channel, rc)
+		require.NoError(t, err)
+		mockApp.AssertNotCalled(t, "RemoveSharedChannelMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects changes of users that don't belong to the remote", func(t *testing.T) {
+		scs, mockStore, mockApp := setupSyncRecvTest(t)
+		change := &model.MembershipChangeMsg{ChannelId: channel.Id, UserId: localUser.Id, IsAdd: false, ChangeTime: 10}
+
+		mockUserStore := &mocks.UserStore{}
+		mockUserStore.On("Get", mockTypeContext, localUser.Id).Return(localUser, nil)
+		mockStore.On("User").Return(mockUserStore)
+
+		err := scs.processSyncMembershipChange(request.TestContext(t), change, channel, rc)
+		assert.ErrorIs(t, err, ErrRemoteIDMismatch)
+		mockApp.AssertNotCalled(t, "RemoveSharedChannelMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ignores changes to direct messages", func(t *testing.T) {
+		scs, mockStore, _ := setupSyncRecvTest(t)
+		dm := &model.Channel{Id: model.NewId(), Type: model.ChannelTypeDirect}
+		change := &model.MembershipChangeMsg{ChannelId: dm.Id, UserId: remoteUser.Id, IsAdd: true, ChangeTime: 10}
+
+		err := scs.processSyncMembershipChange(request.TestContext(t), change, dm, rc)
+		require.NoError(t, err)
+		mockStore.AssertNotCalled(t, "User")
+	})
+}
@@ -534,6 +534,36 @@ func (scs *Service) updateCursorForRemote(scrId string, rc *model.RemoteCluster,
 	)
 }
 
+func (scs *Service) updateBookmarkCursorForRemote(scrId string, rc *model.RemoteCluster, lastBookmarkUpdateAt int64) {
+	if err := scs.server.GetStore().SharedChannel().UpdateRemoteBookmarkCursor(scrId, lastBookmarkUpdateAt); err != nil {
+		scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "error updating bookmark cursor for shared channel remote",
+			mlog.String("remote", rc.DisplayName),
+			mlog.Err(err),
+		)
+		return
+	}
+	scs.server.Log().Log(mlog.LvlSharedChannelServiceDebug, "updated bookmark cursor for remote",
+		mlog.String("remote_id", rc.RemoteId),
+		mlog.String("remote", rc.DisplayName),
+		mlog.Int("last_bookmark_update_at", lastBookmarkUpdateAt),
+	)
+}
+
+func (scs *Service) updateMembershipCursorForRemote(scrId string, rc *model.RemoteCluster, lastMembersSyncAt int64) {
+	if err := scs.server.GetStore().SharedChannel().UpdateRemoteMembershipCursor(scrId, lastMembersSyncAt); err != nil {
+		scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "error updating membership cursor for shared channel remote",
+			mlog.String("remote", rc.DisplayName),
+			mlog.Err(err),
+		)
+		return
+	}
+	scs.server.Log().Log(mlog.LvlSharedChannelServiceDebug, "updated membership cursor for remote",
+		mlog.String("remote_id", rc.RemoteId),
+		mlog.String("remote", rc.DisplayName),
+		mlog.Int("last_members_sync_at", lastMembersSyncAt),
+	)
+}
+
 func (scs *Service) getUserTranslations(userId string) i18n.TranslateFunc {
 	var locale string
 	user, err := scs.server.GetStore().User().Get(context.Background(), userId)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -31,15 +32,20 @@ type syncData struct {
 	rc   *model.RemoteCluster
 	scr  *model.SharedChannelRemote
 
-	users         map[string]*model.User
-	profileImages map[string]*model.User
-	posts         []*model.Post
-	reactions     []*model.Reaction
-	statuses      []*model.Status
-	attachments   []attachment
-
-	resultRepeat     bool
-	resultNextCursor model.GetPostsSinceForSyncCursor
+	users             map[string]*model.User
+	profileImages     map[string]*model.User
+	posts             []*model.Post
+	reactions         []*model.Reaction
+	acknowledgements  []*model.PostAcknowledgement
+	bookmarks         []*model.ChannelBookmark
+	membershipChanges []*model.MembershipChangeMsg
+	statuses          []*model.Status
+	attachments       []attachment
+
+	resultRepeat               bool
+	resultNextCursor           model.GetPostsSinceForSyncCursor
+	resultNextBookmarkCursor   int64
+	resultNextMembershipCursor int64
 }
 
 func newSyncData(task syncTask, rc *model.RemoteCluster, scr *model.SharedChannelRemote) *syncData {
@@ -53,11 +59,14 @@ func newSyncData(task syncTask, rc *model.RemoteCluster, scr *model.SharedChanne
 			LastPostUpdateAt: scr.LastPostUpdateAt, LastPostUpdateID: scr.LastPostUpdateID,
 			LastPostCreateAt: scr.LastPostCreateAt, LastPostCreateID: scr.LastPostCreateID,
 		},
+		resultNextBookmarkCursor:   scr.LastBookmarkUpdateAt,
+		resultNextMembershipCursor: scr.LastMembersSyncAt,
 	}
 }
 
 func (sd *syncData) isEmpty() bool {
-	return len(sd.users) == 0 && len(sd.profileImages) == 0 && len(sd.posts) == 0 && len(sd.reactions) == 0 && len(sd.attachments) == 0
+	return len(sd.users) == 0 && len(sd.profileImages) == 0 && len(sd.posts) == 0 && len(sd.reactions) == 0 && len(sd.attachments) == 0 &&
+		len(sd.acknowledgements) == 0 && len(sd.bookmarks) == 0 && len(sd.membershipChanges) == 0
 }
 
 func (sd *syncData) isCursorChanged() bool {
@@ -69,10 +78,21 @@ func (sd *syncData) isCursorChanged() bool {
 		sd.scr.LastPostUpdateAt != sd.resultNextCursor.LastPostUpdateAt || sd.scr.LastPostUpdateID != sd.resultNextCursor.LastPostUpdateID
 }
 
+func (sd *syncData) isBookmarkCursorChanged() bool {
+	return sd.scr.LastBookmarkUpdateAt != sd.resultNextBookmarkCursor
+}
+
+func (sd *syncData) isMembershipCursorChanged() bool {
+	return sd.scr.LastMembersSyncAt != sd.resultNextMembershipCursor
+}
+
 func (sd *syncData) setDataFromMsg(msg *model.SyncMsg) {
 	sd.users = msg.Users
 	sd.posts = msg.Posts
 	sd.reactions = msg.Reactions
+	sd.acknowledgements = msg.Acknowledgements
+	sd.bookmarks = msg.Bookmarks
+	sd.membershipChanges = msg.MembershipChanges
 	sd.statuses = msg.Statuses
 }
 
@@ -176,7 +196,22 @@ func (scs *Service) syncForRemote(task syncTask, rc *model.RemoteCluster) error
 		return fmt.Errorf("cannot fetch reactions for sync %v: %w", sd, err)
 	}
 
-	// fetch users associated with posts & reactions
+	// fetch acknowledgements for posts
+	if err := scs.fetchAcknowledgementsForSync(sd); err != nil {
+		return fmt.Errorf("cannot fetch acknowledgements for sync %v: %w", sd, err)
+	}
+
+	// fetch new, edited or deleted channel bookmarks
+	if err := scs.fetchBookmarksForSync(sd); err != nil {
+		return fmt.Errorf("cannot fetch bookmarks for sync %v: %w", sd, err)
+	}
+
+	// fetch users who joined or left the channel
+	if err := scs.fetchMembershipChangesForSync(sd); err != nil {
+		return fmt.Errorf("cannot fetch membership changes for sync %v: %w", sd, err)
+	}
+
+	// fetch users associated with posts, reactions, acknowledgements, bookmarks & membership changes
 	if err := scs.fetchPostUsersForSync(sd); err != nil {
 		return fmt.Errorf("cannot fetch post users for sync %v: %w", sd, err)
 	}
@@ -184,6 +219,11 @@ func (scs *Service) syncForRemote(task syncTask, rc *model.RemoteCluster) error
 	// filter out any posts that don't need to be sent.
 	scs.filterPostsForSync(sd)
 
+	// fetch the priority of new posts
+	if err := scs.fetchPostPrioritiesForSync(sd); err != nil {
+		return fmt.Errorf("cannot fetch post priorities for sync %v: %w", sd, err)
+	}
+
 	// fetch attachments for posts
 	if err := scs.fetchPostAttachmentsForSync(sd); err != nil {
 		return fmt.Errorf("cannot fetch post attachments for sync %v: %w", sd, err)
@@ -198,6 +238,12 @@ func (scs *Service) syncForRemote(task syncTask, rc *model.RemoteCluster) error
 		if sd.isCursorChanged() {
 			scs.updateCursorForRemote(sd.scr.Id, sd.rc, sd.resultNextCursor)
 		}
+		if sd.isBookmarkCursorChanged() {
+			scs.updateBookmarkCursorForRemote(sd.scr.Id, sd.rc, sd.resultNextBookmarkCursor)
+		}
+		if sd.isMembershipCursorChanged() {
+			scs.updateMembershipCursorForRemote(sd.scr.Id, sd.rc, sd.resultNextMembershipCursor)
+		}
 		return nil
 	}
 
@@ -209,6 +255,9 @@ func (scs *Service) syncForRemote(task syncTask, rc *model.RemoteCluster) error
 		mlog.Int("images", len(sd.profileImages)),
 		mlog.Int("posts", len(sd.posts)),
 		mlog.Int("reactions", len(sd.reactions)),
+		mlog.Int("acknowledgements", len(sd.acknowledgements)),
+		mlog.Int("bookmarks", len(sd.bookmarks)),
+		mlog.Int("membership_changes", len(sd.membershipChanges)),
 		mlog.Int("attachments", len(sd.attachments)),
 	)
 
@@ -366,6 +415,169 @@ func (scs *Service) fetchReactionsForSync(sd *syncData) error {
 	return merr.ErrorOrNil()
 }
 
+// fetchAcknowledgementsForSync populates the sync data with any acknowledgements added or removed
+// since the last sync. Acknowledgement changes also update the post, so they are fetched for the
+// posts found since the last post cursor, the same way reactions are.
+func (scs *Service) fetchAcknowledgementsForSync(sd *syncData) error {
+	if !sd.rc.HasCapability(model.RemoteCapabilityAcknowledgements) || len(sd.posts) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	defer func() {
+		if metrics := scs.server.GetMetrics(); metrics != nil {
+			metrics.ObserveSharedChannelsSyncCollectionStepDuration(sd.rc.RemoteId, "Acknowledgements", time.Since(start).Seconds())
+		}
+	}()
+
+	postIDs := make([]string, 0, len(sd.posts))
+	for _, post := range sd.posts {
+		postIDs = append(postIDs, post.Id)
+	}
+
+	// any acknowledgements last changed by the remote cluster are filtered out
+	acks, err := scs.server.GetStore().PostAcknowledgement().GetForPostsSince(postIDs, sd.scr.LastPostUpdateAt, sd.rc.RemoteId, true)
+	if err != nil {
+		return fmt.Errorf("could not get acknowledgements for posts: %w", err)
+	}
+	sd.acknowledgements = acks
+	return nil
+}
+
+// fetchBookmarksForSync populates the sync data with any channel bookmarks created, edited or deleted
+// since the last bookmark sync. Bookmarks have their own cursor since changing them doesn't update any post.
+func (scs *Service) fetchBookmarksForSync(sd *syncData) error {
+	if !sd.rc.HasCapability(model.RemoteCapabilityBookmarks) {
+		return nil
+	}
+
+	start := time.Now()
+	defer func() {
+		if metrics := scs.server.GetMetrics(); metrics != nil {
+			metrics.ObserveSharedChannelsSyncCollectionStepDuration(sd.rc.RemoteId, "Bookmarks", time.Since(start).Seconds())
+		}
+	}()
+
+	bookmarks, err := scs.server.GetStore().ChannelBookmark().GetBookmarksForChannelSince(sd.task.channelID, sd.scr.LastBookmarkUpdateAt)
+	if err != nil {
+		return fmt.Errorf("could not get bookmarks for channel %s: %w", sd.task.channelID, err)
+	}
+
+	for _, b := range bookmarks {
+		// the cursor is inclusive, skip what was already sent.
+		if b.UpdateAt <= sd.scr.LastBookmarkUpdateAt {
+			continue
+		}
+		if b.UpdateAt > sd.resultNextBookmarkCursor {
+			sd.resultNextBookmarkCursor = b.UpdateAt
+		}
+
+		// don't sync a change back to the remote it came from.
+		if b.GetRemoteID() == sd.rc.RemoteId {
+			continue
+		}
+
+		// file bookmarks would need their file uploaded to the remote first; only links are synchronized.
+		if b.Type != model.ChannelBookmarkLink {
+			continue
+		}
+
+		bookmark := b.ChannelBookmark.Clone()
+		// link status is checked by each server for itself.
+		bookmark.LinkStatus = ""
+		bookmark.LinkCheckedAt = 0
+		sd.bookmarks = append(sd.bookmarks, bookmark)
+	}
+	return nil
+}
+
+// fetchMembershipChangesForSync populates the sync data with the users who joined or left the channel
+// since the last membership sync, keeping only the latest change of each user.
+func (scs *Service) fetchMembershipChangesForSync(sd *syncData) error {
+	if !sd.rc.HasCapability(model.RemoteCapabilityMembership) {
+		return nil
+	}
+
+	start := time.Now()
+	defer func() {
+		if metrics := scs.server.GetMetrics(); metrics != nil {
+			metrics.ObserveSharedChannelsSyncCollectionStepDuration(sd.rc.RemoteId, "MembershipChanges", time.Since(start).Seconds())
+		}
+	}()
+
+	histories, err := scs.server.GetStore().ChannelMemberHistory().GetMembershipChangesSince(sd.task.channelID, sd.scr.LastMembersSyncAt, MaxMembershipChangesPerSync)
+	if err != nil {
+		return fmt.Errorf("could not get membership changes for channel %s: %w", sd.task.channelID, err)
+	}
+
+	if len(histories) == MaxMembershipChangesPerSync {
+		// more changes are pending. Changes sharing the same time as the last one may be cut off by the limit,
+		// so leave them for the next sync unless the whole batch happened at the same time.
+		last := membershipChangeTime(histories[len(histories)-1])
+		trimmed := histories
+		for len(trimmed) > 0 && membershipChangeTime(trimmed[len(trimmed)-1]) == last {
+			trimmed = trimmed[:len(trimmed)-1]
+		}
+		if len(trimmed) > 0 {
+			histories = trimmed
+		}
+		sd.resultRepeat = true
+	}
+
+	changes := make(map[string]*model.MembershipChangeMsg)
+	remoteIDs := make(map[string]string)
+	for _, history := range histories {
+		changeTime := membershipChangeTime(history)
+		if changeTime > sd.resultNextMembershipCursor {
+			sd.resultNextMembershipCursor = changeTime
+		}
+
+		remoteID, ok := remoteIDs[history.UserId]
+		if !ok {
+			user, err := scs.server.GetStore().User().Get(context.Background(), history.UserId)
+			if err != nil {
+				if isNotFoundError(err) {
+					continue
+				}
+				return fmt.Errorf("could not get user %s: %w", history.UserId, err)
+			}
+			remoteID = user.GetRemoteID()
+			remoteIDs[history.UserId] = remoteID
+		}
+
+		// don't sync a change back to the remote the user belongs to; it came from there.
+		if remoteID == sd.rc.RemoteId {
+			continue
+		}
+
+		if existing, ok := changes[history.UserId]; ok && existing.ChangeTime > changeTime {
+			continue
+		}
+		changes[history.UserId] = &model.MembershipChangeMsg{
+			ChannelId:  sd.task.channelID,
+			UserId:     history.UserId,
+			IsAdd:      history.LeaveTime == nil,
+			ChangeTime: changeTime,
+		}
+	}
+
+	for _, change := range changes {
+		sd.membershipChanges = append(sd.membershipChanges, change)
+	}
+	sort.Slice(sd.membershipChanges, func(i, j int) bool {
+		return sd.membershipChanges[i].ChangeTime < sd.membershipChanges[j].ChangeTime
+	})
+	return nil
+}
+
+// membershipChangeTime returns the time of the latest change of a membership period.
+func membershipChangeTime(history *model.ChannelMemberHistoryResult) int64 {
+	if history.LeaveTime != nil {
+		return *history.LeaveTime
+	}
+	return history.JoinTime
+}
+
 // fetchPostUsersForSync populates the sync data with all users associated with posts.
 func (scs *Service) fetchPostUsersForSync(sd *syncData) error {
 	start := time.Now()
@@ -391,6 +603,21 @@ func (scs *Service) fetchPostUsersForSync(sd *syncData) error {
 		userIDs[reaction.UserId] = p2mm{}
 	}
 
+	for _, ack := range sd.acknowledgements {
+		userIDs[ack.UserId] = p2mm{}
+	}
+
+	for _, bookmark := range sd.bookmarks {
+		userIDs[bookmark.OwnerId] = p2mm{}
+	}
+
+	// users joining must be known to the remote before they are added.
+	for _, change := range sd.membershipChanges {
+		if change.IsAdd {
+			userIDs[change.UserId] = p2mm{}
+		}
+	}
+
 	for _, post := range sd.posts {
 		// add author
 		userIDs[post.UserId] = p2mm{}
@@ -437,6 +664,44 @@ func (scs *Service) fetchPostUsersForSync(sd *syncData) error {
 	return merr.ErrorOrNil()
 }
 
+// fetchPostPrioritiesForSync adds the priority to the metadata of new posts so that the remote
+// saves it along with the post. The priority of a post cannot change once created.
+func (scs *Service) fetchPostPrioritiesForSync(sd *syncData) error {
+	postIDs := make([]string, 0, len(sd.posts))
+	for _, post := range sd.posts {
+		if post.EditAt == 0 && post.DeleteAt == 0 && post.RootId == "" {
+			postIDs = append(postIDs, post.Id)
+		}
+	}
+	if len(postIDs) == 0 {
+		return nil
+	}
+
+	priorities, err := scs.server.GetStore().PostPriority().GetForPosts(postIDs)
+	if err != nil {
+		return fmt.Errorf("could not get post priorities: %w", err)
+	}
+
+	byPostID := make(map[string]*model.PostPriority, len(priorities))
+	for _, priority := range priorities {
+		byPostID[priority.PostId] = priority
+	}
+
+	for _, post := range sd.posts {
+		if priority, ok := byPostID[post.Id]; ok {
+			if post.Metadata == nil {
+				post.Metadata = &model.PostMetadata{}
+			}
+			post.Metadata.Priority = &model.PostPriority{
+				Priority:                priority.Priority,
+				RequestedAck:            priority.RequestedAck,
+				PersistentNotifications: priority.PersistentNotifications,
+			}
+		}
+	}
+	return nil
+}
+
 // fetchPostAttachmentsForSync populates the sync data with any file attachments for new posts.
 func (scs *Service) fetchPostAttachmentsForSync(sd *syncData) error {
 	start := time.Now()
@@ -495,9 +760,10 @@ func (scs *Service) filterPostsForSync(sd *syncData) {
 	sd.posts = filtered
 }
 
-// sendSyncData sends all the collected users, posts, reactions, images, and attachments to the
-// remote cluster.
-// The order of items sent is important: users -> attachments -> posts -> reactions -> profile images
+// sendSyncData sends all the collected users, posts, reactions, acknowledgements, bookmarks, images,
+// and attachments to the remote cluster.
+// The order of items sent is important: users -> attachments -> membership changes -> posts -> reactions ->
+// acknowledgements -> bookmarks -> statuses -> profile images
 func (scs *Service) sendSyncData(sd *syncData) error {
 	start := time.Now()
 	defer func() {
@@ -521,6 +787,15 @@ func (scs *Service) sendSyncData(sd *syncData) error {
 		scs.sendAttachmentSyncData(sd)
 	}
 
+	// send membership changes
+	if len(sd.membershipChanges) != 0 {
+		if err := scs.sendMembershipSyncData(sd); err != nil {
+			merr.Append(fmt.Errorf("cannot send membership sync data: %w", err))
+		}
+	} else if sd.isMembershipCursorChanged() {
+		scs.updateMembershipCursorForRemote(sd.scr.Id, sd.rc, sd.resultNextMembershipCursor)
+	}
+
 	// send posts
 	if len(sd.posts) != 0 {
 		if err := scs.sendPostSyncData(sd); err != nil {
@@ -537,6 +812,22 @@ func (scs *Service) sendSyncData(sd *syncData) error {
 		}
 	}
 
+	// send acknowledgements
+	if len(sd.acknowledgements) != 0 {
+		if err := scs.sendAcknowledgementSyncData(sd); err != nil {
+			merr.Append(fmt.Errorf("cannot send acknowledgement sync data: %w", err))
+		}
+	}
+
+	// send bookmarks
+	if len(sd.bookmarks) != 0 {
+		if err := scs.sendBookmarkSyncData(sd); err != nil {
+			merr.Append(fmt.Errorf("cannot send bookmark sync data: %w", err))
+		}
+	} else if sd.isBookmarkCursorChanged() {
+		scs.updateBookmarkCursorForRemote(sd.scr.Id, sd.rc, sd.resultNextBookmarkCursor)
+	}
+
 	// send statuses
 	if len(sd.statuses) != 0 {
 		if err := scs.sendStatusSyncData(sd); err != nil {
@@ -586,6 +877,34 @@ func (scs *Service) sendUserSyncData(sd *syncData) error {
 	return err
 }
 
+// sendMembershipSyncData sends the collected membership changes to the remote cluster and moves the
+// membership cursor once the remote received them.
+func (scs *Service) sendMembershipSyncData(sd *syncData) error {
+	start := time.Now()
+	defer func() {
+		if metrics := scs.server.GetMetrics(); metrics != nil {
+			metrics.ObserveSharedChannelsSyncSendStepDuration(sd.rc.RemoteId, "MembershipChanges", time.Since(start).Seconds())
+		}
+	}()
+
+	msg := model.NewSyncMsg(sd.task.channelID)
+	msg.MembershipChanges = sd.membershipChanges
+
+	return scs.sendSyncMsgToRemote(msg, sd.rc, func(syncResp model.SyncResponse, errResp error) {
+		if errResp != nil {
+			return
+		}
+		if len(syncResp.MembershipErrors) != 0 {
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Response indicates error for membership change(s) sync",
+				mlog.String("channel_id", sd.task.channelID),
+				mlog.String("remote_id", sd.rc.RemoteId),
+				mlog.Array("users", syncResp.MembershipErrors),
+			)
+		}
+		scs.updateMembershipCursorForRemote(sd.scr.Id, sd.rc, sd.resultNextMembershipCursor)
+	})
+}
+
 // sendAttachmentSyncData sends the collected post updates to the remote cluster.
 func (scs *Service) sendAttachmentSyncData(sd *syncData) {
 	for _, a := range sd.attachments {
@@ -652,6 +971,57 @@ func (scs *Service) sendReactionSyncData(sd *syncData) error {
 	})
 }
 
+// sendAcknowledgementSyncData sends the collected acknowledgement updates to the remote cluster.
+func (scs *Service) sendAcknowledgementSyncData(sd *syncData) error {
+	start := time.Now()
+	defer func() {
+		if metrics := scs.server.GetMetrics(); metrics != nil {
+			metrics.ObserveSharedChannelsSyncSendStepDuration(sd.rc.RemoteId, "Acknowledgements", time.Since(start).Seconds())
+		}
+	}()
+
+	msg := model.NewSyncMsg(sd.task.channelID)
+	msg.Acknowledgements = sd.acknowledgements
+
+	return scs.sendSyncMsgToRemote(msg, sd.rc, func(syncResp model.SyncResponse, errResp error) {
+		if len(syncResp.AcknowledgementErrors) != 0 {
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Response indicates error for acknowledgement(s) sync",
+				mlog.String("channel_id", sd.task.channelID),
+				mlog.String("remote_id", sd.rc.RemoteId),
+				mlog.Array("acknowledgement_posts", syncResp.AcknowledgementErrors),
+			)
+		}
+	})
+}
+
+// sendBookmarkSyncData sends the collected bookmark updates to the remote cluster and moves the
+// bookmark cursor once the remote received them.
+func (scs *Service) sendBookmarkSyncData(sd *syncData) error {
+	start := time.Now()
+	defer func() {
+		if metrics := scs.server.GetMetrics(); metrics != nil {
+			metrics.ObserveSharedChannelsSyncSendStepDuration(sd.rc.RemoteId, "Bookmarks", time.Since(start).Seconds())
+		}
+	}()
+
+	msg := model.NewSyncMsg(sd.task.channelID)
+	msg.Bookmarks = sd.bookmarks
+
+	return scs.sendSyncMsgToRemote(msg, sd.rc, func(syncResp model.SyncResponse, errResp error) {
+		if errResp != nil {
+			return
+		}
+		if len(syncResp.BookmarkErrors) != 0 {
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Response indicates error for bookmark(s) sync",
+				mlog.String("channel_id", sd.task.channelID),
+				mlog.String("remote_id", sd.rc.RemoteId),
+				mlog.Array("bookmarks", syncResp.BookmarkErrors),
+			)
+		}
+		scs.updateBookmarkCursorForRemote(sd.scr.Id, sd.rc, sd.resultNextBookmarkCursor)
+	})
+}
+
 // sendStatusSyncData sends the collected status updates to the remote cluster.
 func (scs *Service) sendStatusSyncData(sd *syncData) error {
 	msg := model.NewSyncMsg(sd.task.channelID)
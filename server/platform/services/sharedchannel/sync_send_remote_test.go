@@ -0,0 +1,106 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sharedchannel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/store/storetest/mocks"
+	"github.com/mattermost/mattermost/server/v8/einterfaces"
+)
+
+func TestFetchMembershipChangesForSync(t *testing.T) {
+	rc := &model.RemoteCluster{RemoteId: model.NewId(), Name: "remote", Capabilities: model.RemoteCapabilityMembership}
+	channelID := model.NewId()
+	localUser := &model.User{Id: model.NewId()}
+	otherUser := &model.User{Id: model.NewId()}
+	remoteUser := &model.User{Id: model.NewId(), RemoteId: model.NewPointer(rc.RemoteId)}
+
+	setup := func(t *testing.T, histories []*model.ChannelMemberHistoryResult) (*Service, *syncData) {
+		mockServer := &MockServerIface{}
+		mockServer.On("Log").Return(mlog.CreateConsoleTestLogger(t))
+		mockServer.On("GetMetrics").Return((einterfaces.MetricsInterface)(nil))
+		mockStore := &mocks.Store{}
+		mockServer.On("GetStore").Return(mockStore)
+
+		mockHistoryStore := &mocks.ChannelMemberHistoryStore{}
+		mockHistoryStore.On("GetMembershipChangesSince", channelID, int64(5), MaxMembershipChangesPerSync).Return(histories, nil)
+		mockUserStore := &mocks.UserStore{}
+		for _, user := range []*model.User{localUser, otherUser, remoteUser} {
+			mockUserStore.On("Get", mockTypeContext, user.Id).Return(user, nil)
+		}
+		mockStore.On("ChannelMemberHistory").Return(mockHistoryStore)
+		mockStore.On("User").Return(mockUserStore)
+
+		scr := &model.SharedChannelRemote{Id: model.NewId(), ChannelId: channelID, RemoteId: rc.RemoteId, LastMembersSyncAt: 5}
+		sd := newSyncData(syncTask{channelID: channelID, remoteID: rc.RemoteId}, rc, scr)
+		return &Service{server: mockServer}, sd
+	}
+
+	t.Run("keeps the latest change of each user", func(t *testing.T) {
+		scs, sd := setup(t, []*model.ChannelMemberHistoryResult{
+			{ChannelId: channelID, UserId: localUser.Id, JoinTime: 6, LeaveTime: model.NewPointer(int64(7))},
+			{ChannelId: channelID, UserId: otherUser.Id, JoinTime: 8},
+			{ChannelId: channelID, UserId: localUser.Id, JoinTime: 9},
+			{ChannelId: channelID, UserId: remoteUser.Id, JoinTime: 10},
+		})
+
+		err := scs.fetchMembershipChangesForSync(sd)
+		require.NoError(t, err)
+
+		assert.Equal(t, []*model.MembershipChangeMsg{
+			{ChannelId: channelID, UserId: otherUser.Id, IsAdd: true, ChangeTime: 8},
+			{ChannelId: channelID, UserId: localUser.Id, IsAdd: true, ChangeTime: 9},
+		}, sd.membershipChanges)
+		assert.Equal(t, int64(10), sd.resultNextMembershipCursor)
+		assert.False(t, sd.resultRepeat)
+	})
+
+	t.Run("syncs removals", func(t *testing.T) {
+		scs, sd := setup(t, []*model.ChannelMemberHistoryResult{
+			{ChannelId: channelID, UserId: otherUser.Id, JoinTime: 1, LeaveTime: model.NewPointer(int64(12))},
+		})
+
+		err := scs.fetchMembershipChangesForSync(sd)
+		require.NoError(t, err)
+
+		assert.Equal(t, []*model.MembershipChangeMsg{
+			{ChannelId: channelID, UserId: otherUser.Id, IsAdd: false, ChangeTime: 12},
+		}, sd.membershipChanges)
+		assert.Equal(t, int64(12), sd.resultNextMembershipCursor)
+	})
+
+	t.Run("leaves changes at the end of a full batch for the next sync", func(t *testing.T) {
+		histories := make([]*model.ChannelMemberHistoryResult, 0, MaxMembershipChangesPerSync)
+		for i := 0; i < MaxMembershipChangesPerSync-1; i++ {
+			histories = append(histories, &model.ChannelMemberHistoryResult{ChannelId: channelID, UserId: localUser.Id, JoinTime: int64(10 + i)})
+		}
+		last := int64(10 + MaxMembershipChangesPerSync - 2)
+		histories = append(histories, &model.ChannelMemberHistoryResult{ChannelId: channelID, UserId: otherUser.Id, JoinTime: last})
+		scs, sd := setup(t, histories)
+
+		err := scs.fetchMembershipChangesForSync(sd)
+		require.NoError(t, err)
+
+		require.Len(t, sd.membershipChanges, 1)
+		assert.Equal(t, localUser.Id, sd.membershipChanges[0].UserId)
+		assert.Equal(t, last-1, sd.resultNextMembershipCursor)
+		assert.True(t, sd.resultRepeat)
+	})
+
+	t.Run("skipped when the remote doesn't support membership sync", func(t *testing.T) {
+		scs, sd := setup(t, nil)
+		sd.rc = &model.RemoteCluster{RemoteId: rc.RemoteId}
+
+		err := scs.fetchMembershipChangesForSync(sd)
+		require.NoError(t, err)
+		assert.Empty(t, sd.membershipChanges)
+		assert.False(t, sd.isMembershipCursorChanged())
+	})
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sharedchannel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/public/shared/request"
+	"github.com/mattermost/mattermost/server/v8/channels/store"
+	"github.com/mattermost/mattermost/server/v8/platform/services/remotecluster"
+)
+
+// userSearchMsg is sent to a remote cluster to search its user directory.
+type userSearchMsg struct {
+	Term  string `json:"term"`
+	Limit int    `json:"limit"`
+}
+
+// userSearchResponse is the payload of the response to a userSearchMsg.
+type userSearchResponse struct {
+	Users []*model.User `json:"users"`
+}
+
+// SearchRemoteUsers searches the user directories of the remotes the channel is shared with. Matching users
+// are stored locally as remote users and added to the channel's team, so they can be mentioned and added to
+// the channel before they ever post. Results are cached per remote, team and term.
+func (scs *Service) SearchRemoteUsers(channelID string, term string) ([]*model.User, error) {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return []*model.User{}, nil
+	}
+
+	channel, err := scs.server.GetStore().Channel().Get(channelID, true)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch channel %s for remote user search: %w", channelID, err)
+	}
+	if channel.IsGroupOrDirect() {
+		return []*model.User{}, nil
+	}
+
+	opts := model.SharedChannelRemoteFilterOpts{
+		ChannelId: channelID,
+	}
+	scrs, err := scs.server.GetStore().SharedChannel().GetRemotes(0, 999999, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch remotes for channel %s: %w", channelID, err)
+	}
+
+	var mux sync.Mutex
+	var wg sync.WaitGroup
+	userIDs := make([]string, 0)
+
+	for _, scr := range scrs {
+		rc, err := scs.server.GetStore().RemoteCluster().Get(scr.RemoteId, false)
+		if err != nil {
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Cannot fetch remote for user search",
+				mlog.String("remote_id", scr.RemoteId),
+				mlog.String("channel_id", channelID),
+				mlog.Err(err),
+			)
+			continue
+		}
+		if rc.IsPlugin() || !rc.IsOnline() || !rc.HasCapability(model.RemoteCapabilityUserDirectory) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(rc *model.RemoteCluster) {
+			defer wg.Done()
+
+			ids, err := scs.searchRemoteUserDirectory(channel, rc, term)
+			if err != nil {
+				scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Cannot search remote user directory",
+					mlog.String("remote", rc.DisplayName),
+					mlog.String("channel_id", channelID),
+					mlog.Err(err),
+				)
+				return
+			}
+
+			mux.Lock()
+			defer mux.Unlock()
+			userIDs = append(userIDs, ids...)
+		}(rc)
+	}
+	wg.Wait()
+
+	if len(userIDs) == 0 {
+		return []*model.User{}, nil
+	}
+	return scs.server.GetStore().User().GetProfileByIds(context.Background(), userIDs, &store.UserGetByIdsOpts{}, true)
+}
+
+// searchRemoteUserDirectory returns the ids of the local copies of the remote's users matching the term.
+func (scs *Service) searchRemoteUserDirectory(channel *model.Channel, rc *model.RemoteCluster, term string) ([]string, error) {
+	cacheKey := rc.RemoteId + ":" + channel.TeamId + ":" + term
+
+	var userIDs []string
+	if err := scs.userDirectoryCache.Get(cacheKey, &userIDs); err == nil {
+		return userIDs, nil
+	}
+
+	users, err := scs.sendUserSearch(rc, term)
+	if err != nil {
+		return nil, err
+	}
+
+	rctx := request.EmptyContext(scs.server.Log())
+	userIDs = make([]string, 0, len(users))
+	for _, user := range users {
+		userSaved, err := scs.upsertSyncUserProfile(rctx, user, channel, rc)
+		if err != nil {
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Error upserting remote directory user",
+				mlog.String("remote", rc.Name),
+				mlog.String("user_id", user.Id),
+				mlog.Err(err),
+			)
+			continue
+		}
+		if appErr := scs.app.AddUserToTeamByTeamId(rctx, channel.TeamId, userSaved); appErr != nil {
+			scs.server.Log().Log(mlog.LvlSharedChannelServiceError, "Error adding remote directory user to team",
+				mlog.String("remote", rc.Name),
+				mlog.String("user_id", user.Id),
+				mlog.String("team_id", channel.TeamId),
+				mlog.Err(appErr),
+			)
+			continue
+		}
+		userIDs = append(userIDs, userSaved.Id)
+	}
+
+	if err := scs.userDirectoryCache.SetWithDefaultExpiry(cacheKey, userIDs); err != nil {
+		scs.server.Log().Log(mlog.LvlSharedChannelServiceWarn, "Cannot cache remote user search results",
+			mlog.String("remote", rc.Name),
+			mlog.Err(err),
+		)
+	}
+	return userIDs, nil
+}
+
+// sendUserSearch synchronously searches the user directory of a remote cluster.
+func (scs *Service) sendUserSearch(rc *model.RemoteCluster, term string) ([]*model.User, error) {
+	rcs := scs.server.GetRemoteClusterService()
+	if rcs == nil {
+		return nil, fmt.Errorf("cannot search users of remote cluster %s; Remote Cluster Service not enabled", rc.Name)
+	}
+
+	b, err := json.Marshal(userSearchMsg{Term: term, Limit: MaxUserSearchResults})
+	if err != nil {
+		return nil, err
+	}
+	msg := model.NewRemoteClusterMsg(TopicUserSearch, b)
+
+	ctx, cancel := context.WithTimeout(context.Background(), UserSearchTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	var users []*model.User
+	var errResult error
+	err = rcs.SendMsg(ctx, msg, rc, func(_ model.RemoteClusterMsg, rc *model.RemoteCluster, resp *remotecluster.Response, errResp error) {
+		defer wg.Done()
+
+		if errResp != nil {
+			errResult = errResp
+			return
+		}
+		if !resp.IsSuccess() {
+			errResult = errors.New(resp.Err)
+			return
+		}
+
+		var searchResp userSearchResponse
+		if err := json.Unmarshal(resp.Payload, &searchResp); err != nil {
+			errResult = fmt.Errorf("invalid user search response from remote cluster %s: %w", rc.Name, err)
+			return
+		}
+		users = searchResp.Users
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	wg.Wait()
+	return users, errResult
+}
+
+// onReceiveUserSearch answers a user directory search from a remote cluster the server shares channels with.
+// Only active local users are returned, with their email and full name hidden according to the privacy settings.
+func (scs *Service) onReceiveUserSearch(msg model.RemoteClusterMsg, rc *model.RemoteCluster, response *remotecluster.Response) error {
+	if msg.Topic != TopicUserSearch {
+		return fmt.Errorf("wrong topic, expected `%s`, got `%s`", TopicUserSearch, msg.Topic)
+	}
+
+	var search userSearchMsg
+	if err := json.Unmarshal(msg.Payload, &search); err != nil {
+		return fmt.Errorf("invalid user search message: %w", err)
+	}
+	if search.Limit <= 0 || search.Limit > MaxUserSearchResults {
+		search.Limit = MaxUserSearchResults
+	}
+
+	// only remotes sharing at least one channel can search the directory.
+	opts := model.SharedChannelRemoteFilterOpts{
+		RemoteId: rc.RemoteId,
+	}
+	scrs, err := scs.server.GetStore().SharedChannel().GetRemotes(0, 1, opts)
+	if err != nil {
+		return fmt.Errorf("cannot check shared channels for remote %s: %w", rc.Name, err)
+	}
+	if len(scrs) == 0 {
+		return fmt.Errorf("cannot search users; no channel shared with remote %s: %w", rc.Name, ErrRemoteIDMismatch)
+	}
+
+	config := scs.server.Config()
+	searchOpts := &model.UserSearchOptions{
+		AllowFullNames: *config.PrivacySettings.ShowFullName,
+		Limit:          search.Limit,
+	}
+	users, err := scs.server.GetStore().User().Search(request.EmptyContext(scs.server.Log()), "", search.Term, searchOpts)
+	if err != nil {
+		return fmt.Errorf("cannot search users: %w", err)
+	}
+
+	sanitizeOpts := map[string]bool{
+		"email":    *config.PrivacySettings.ShowEmailAddress,
+		"fullname": *config.PrivacySettings.ShowFullName,
+	}
+	searchResp := userSearchResponse{
+		Users: make([]*model.User, 0, len(users)),
+	}
+	for _, user := range users {
+		// remote users belong to another directory and bots can't be mentioned across clusters.
+		if user.GetRemoteID() != "" || user.IsBot {
+			continue
+		}
+		user.SanitizeProfile(sanitizeOpts, false)
+		searchResp.Users = append(searchResp.Users, user)
+	}
+
+	return response.SetPayload(searchResp)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package sharedchannel
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest/mock"
+	"github.com/mattermost/mattermost/server/public/shared/mlog"
+	"github.com/mattermost/mattermost/server/v8/channels/store/storetest/mocks"
+	"github.com/mattermost/mattermost/server/v8/platform/services/remotecluster"
+)
+
+func TestOnReceiveUserSearch(t *testing.T) {
+	rc := &model.RemoteCluster{RemoteId: model.NewId(), Name: "remote"}
+
+	setup := func(t *testing.T, sharedChannels int) (*Service, *mocks.UserStore) {
+		config := &model.Config{}
+		config.SetDefaults()
+		config.PrivacySettings.ShowEmailAddress = model.NewPointer(false)
+		config.PrivacySettings.ShowFullName = model.NewPointer(true)
+
+		mockServer := &MockServerIface{}
+		mockServer.On("Log").Return(mlog.CreateConsoleTestLogger(t))
+		mockServer.On("Config").Return(config)
+		mockStore := &mocks.Store{}
+		mockServer.On("GetStore").Return(mockStore)
+
+		scrs := make([]*model.SharedChannelRemote, sharedChannels)
+		for i := range scrs {
+			scrs[i] = &model.SharedChannelRemote{Id: model.NewId(), RemoteId: rc.RemoteId}
+		}
+		mockSharedChannelStore := &mocks.SharedChannelStore{}
+		mockSharedChannelStore.On("GetRemotes", 0, 1, model.SharedChannelRemoteFilterOpts{RemoteId: rc.RemoteId}).Return(scrs, nil)
+		mockStore.On("SharedChannel").Return(mockSharedChannelStore)
+		mockUserStore := &mocks.UserStore{}
+		mockStore.On("User").Return(mockUserStore)
+
+		return &Service{server: mockServer}, mockUserStore
+	}
+
+	newSearchMsg := func(t *testing.T, term string, limit int) model.RemoteClusterMsg {
+		b, err := json.Marshal(userSearchMsg{Term: term, Limit: limit})
+		require.NoError(t, err)
+		return model.NewRemoteClusterMsg(TopicUserSearch, b)
+	}
+
+	t.Run("returns sanitized local users", func(t *testing.T) {
+		scs, mockUserStore := setup(t, 1)
+		localUser := &model.User{Id: model.NewId(), Username: "alice", Email: "alice@example.com", FirstName: "Alice"}
+		remoteUser := &model.User{Id: model.NewId(), Username: "alice2", RemoteId: model.NewPointer(model.NewId())}
+		bot := &model.User{Id: model.NewId(), Username: "alicebot", IsBot: true}
+		mockUserStore.On("Search", mock.Anything, "", "ali", mock.MatchedBy(func(opts *model.UserSearchOptions) bool {
+			return opts.Limit == MaxUserSearchResults && opts.AllowFullNames
+		})).Return([]*model.User{localUser, remoteUser, bot}, nil)
+
+		response := &remotecluster.Response{}
+		err := scs.onReceiveUserSearch(newSearchMsg(t, "ali", 1000), rc, response)
+		require.NoError(t, err)
+
+		var searchResp userSearchResponse
+		require.NoError(t, json.Unmarshal(response.Payload, &searchResp))
+		require.Len(t, searchResp.Users, 1)
+		assert.Equal(t, localUser.Id, searchResp.Users[0].Id)
+		assert.Equal(t, "Alice", searchResp.Users[0].FirstName)
+		assert.Empty(t, searchResp.Users[0].Email)
+	})
+
+	t.Run("rejects remotes without shared channels", func(t *testing.T) {
+		scs, mockUserStore := setup(t, 0)
+
+		err := scs.onReceiveUserSearch(newSearchMsg(t, "ali", 10), rc, &remotecluster.Response{})
+		assert.ErrorIs(t, err, ErrRemoteIDMismatch)
+		mockUserStore.AssertNotCalled(t, "Search", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("rejects other topics", func(t *testing.T) {
+		scs, _ := setup(t, 1)
+
+		err := scs.onReceiveUserSearch(model.NewRemoteClusterMsg(TopicSync, nil), rc, &remotecluster.Response{})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teamsimport
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var (
+	teamsWhitespaceRegexp = regexp.MustCompile(`\s+`)
+	teamsNewlinesRegexp   = regexp.MustCompile(`\n{3,}`)
+)
+
+// teamsReactionEmojis maps the reaction types of Microsoft Teams to emoji names.
+var teamsReactionEmojis = map[string]string{
+	"like":      "+1",
+	"heart":     "heart",
+	"laugh":     "laughing",
+	"surprised": "open_mouth",
+	"sad":       "cry",
+	"angry":     "angry",
+	"👍":         "+1",
+	"❤️":        "heart",
+	"😆":         "laughing",
+	"😮":         "open_mouth",
+	"😢":         "cry",
+	"😠":         "angry",
+}
+
+// htmlFrame captures the content of an element that is rewritten once it ends.
+type htmlFrame struct {
+	tag  string
+	attr string
+	text strings.Builder
+}
+
+// teamsConvertHTML converts the HTML body of a Microsoft Teams message into markdown. mentions maps
+// the ids of the <at> elements of the body to usernames; unknown mentions are kept as plain text.
+func teamsConvertHTML(content string, mentions map[int]string) string {
+	tokenizer := html.NewTokenizer(strings.NewReader(content))
+	frames := []*htmlFrame{{}}
+	out := func() *strings.Builder { return &frames[len(frames)-1].text }
+	push := func(tag, attr string) { frames = append(frames, &htmlFrame{tag: tag, attr: attr}) }
+	pop := func(tag string) (*htmlFrame, bool) {
+		if len(frames) == 1 || frames[len(frames)-1].tag != tag {
+			return nil, false
+		}
+		frame := frames[len(frames)-1]
+		frames = frames[:len(frames)-1]
+		return frame, true
+	}
+	inPre := 0
+
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			// io.EOF or a read error; either way the content read so far is kept.
+			break
+		}
+
+		token := tokenizer.Token()
+		switch tokenType {
+		case html.TextToken:
+			if inPre > 0 {
+				out().WriteString(token.Data)
+			} else {
+				text := teamsWhitespaceRegexp.ReplaceAllString(token.Data, " ")
+				if current := out().String(); current == "" || strings.HasSuffix(current, "\n") {
+					text = strings.TrimLeft(text, " ")
+				}
+				out().WriteString(text)
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch token.Data {
+			case "b", "strong":
+				out().WriteString("**")
+			case "i", "em":
+				out().WriteString("_")
+			case "s", "strike", "del":
+				out().WriteString("~~")
+			case "code":
+				if inPre == 0 {
+					out().WriteString("`")
+				}
+			case "pre":
+				inPre++
+				out().WriteString("\n```\n")
+			case "br":
+				out().WriteString("\n")
+			case "li":
+				out().WriteString("\n- ")
+			case "h1", "h2", "h3", "h4", "h5", "h6":
+				out().WriteString("\n### ")
+			case "img":
+				out().WriteString(attribute(token, "alt"))
+			case "a":
+				push("a", attribute(token, "href"))
+			case "at":
+				push("at", attribute(token, "id"))
+			case "blockquote":
+				push("blockquote", "")
+			}
+		case html.EndTagToken:
+			switch token.Data {
+			case "b", "strong":
+				out().WriteString("**")
+			case "i", "em":
+				out().WriteString("_")
+			case "s", "strike", "del":
+				out().WriteString("~~")
+			case "code":
+				if inPre == 0 {
+					out().WriteString("`")
+				}
+			case "pre":
+				if inPre > 0 {
+					inPre--
+				}
+				out().WriteString("\n```\n")
+			case "p", "div", "ul", "ol", "h1", "h2", "h3", "h4", "h5", "h6", "table", "tr":
+				out().WriteString("\n")
+			case "a":
+				if frame, ok := pop("a"); ok {
+					text := strings.TrimSpace(frame.text.String())
+					switch {
+					case frame.attr == "" || text == frame.attr:
+						out().WriteString(text)
+					case text == "":
+						out().WriteString(frame.attr)
+					default:
+						out().WriteString("[" + text + "](" + frame.attr + ")")
+					}
+				}
+			case "at":
+				if frame, ok := pop("at"); ok {
+					id, err := strconv.Atoi(frame.attr)
+					if username, found := mentions[id]; err == nil && found {
+						out().WriteString("@" + username)
+					} else {
+						out().WriteString(frame.text.String())
+					}
+				}
+			case "blockquote":
+				if frame, ok := pop("blockquote"); ok {
+					lines := strings.Split(strings.TrimSpace(frame.text.String()), "\n")
+					for i, line := range lines {
+						lines[i] = "> " + strings.TrimSpace(line)
+					}
+					out().WriteString("\n" + strings.Join(lines, "\n") + "\n")
+				}
+			}
+		}
+	}
+
+	// unclosed elements keep their content.
+	for len(frames) > 1 {
+		frame := frames[len(frames)-1]
+		frames = frames[:len(frames)-1]
+		out().WriteString(frame.text.String())
+	}
+
+	lines := strings.Split(out().String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	text := teamsNewlinesRegexp.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+	return strings.TrimSpace(text)
+}
+
+func attribute(token html.Token, key string) string {
+	for _, attr := range token.Attr {
+		if attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+func splitName(name string) (string, string) {
+	firstName, lastName, _ := strings.Cut(strings.TrimSpace(name), " ")
+	return firstName, strings.TrimSpace(lastName)
+}
+
+func truncateRunes(s string, i int) string {
+	runes := []rune(s)
+	if len(runes) > i {
+		return string(runes[:i])
+	}
+	return s
+}
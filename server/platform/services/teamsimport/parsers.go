@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teamsimport
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+)
+
+// parseCollection decodes a collection returned by the Microsoft Graph API, either the response
+// itself ({"value": [...]}), its value array or a single entity.
+func parseCollection[T any](data io.Reader) ([]T, error) {
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return nil, err
+	}
+
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	if b[0] == '[' {
+		var values []T
+		if err := json.Unmarshal(b, &values); err != nil {
+			return nil, err
+		}
+		return values, nil
+	}
+
+	var response struct {
+		Value []T `json:"value"`
+	}
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, err
+	}
+	if response.Value != nil {
+		return response.Value, nil
+	}
+
+	var value T
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+	return []T{value}, nil
+}
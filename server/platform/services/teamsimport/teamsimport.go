@@ -0,0 +1,751 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teamsimport
+
+import (
+	"archive/zip"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+	"github.com/mattermost/mattermost/server/v8/channels/utils"
+)
+
+const (
+	teamsUsersFile    = "users.json"
+	teamsTeamFile     = "team.json"
+	teamsChannelsFile = "channels.json"
+	teamsChatFile     = "chat.json"
+	teamsMembersFile  = "members.json"
+	teamsTeamsDir     = "teams"
+	teamsChannelsDir  = "channels"
+	teamsChatsDir     = "chats"
+	teamsFilesDir     = "files"
+
+	teamsImportMaxFileSize = 1024 * 1024 * 1024
+
+	// attachmentsDir is the directory of the converted attachments in the data directory of the archive.
+	attachmentsDir = "teams"
+)
+
+type teamsUser struct {
+	Id                string `json:"id"`
+	DisplayName       string `json:"displayName"`
+	GivenName         string `json:"givenName"`
+	Surname           string `json:"surname"`
+	Mail              string `json:"mail"`
+	UserPrincipalName string `json:"userPrincipalName"`
+	JobTitle          string `json:"jobTitle"`
+	AccountEnabled    *bool  `json:"accountEnabled"`
+}
+
+type teamsTeam struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+}
+
+type teamsChannel struct {
+	Id             string `json:"id"`
+	DisplayName    string `json:"displayName"`
+	Description    string `json:"description"`
+	MembershipType string `json:"membershipType"`
+}
+
+type teamsChat struct {
+	Id       string `json:"id"`
+	ChatType string `json:"chatType"`
+	Topic    string `json:"topic"`
+}
+
+type teamsMember struct {
+	UserId string   `json:"userId"`
+	Roles  []string `json:"roles"`
+}
+
+type teamsIdentity struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type teamsIdentitySet struct {
+	User         *teamsIdentity `json:"user"`
+	Application  *teamsIdentity `json:"application"`
+	Conversation *teamsIdentity `json:"conversation"`
+}
+
+type teamsMessageBody struct {
+	ContentType string `json:"contentType"`
+	Content     string `json:"content"`
+}
+
+type teamsAttachment struct {
+	Id          string `json:"id"`
+	ContentType string `json:"contentType"`
+	ContentURL  string `json:"contentUrl"`
+	Name        string `json:"name"`
+}
+
+type teamsMention struct {
+	Id        int               `json:"id"`
+	Mentioned *teamsIdentitySet `json:"mentioned"`
+}
+
+type teamsReaction struct {
+	ReactionType    string           `json:"reactionType"`
+	CreatedDateTime time.Time        `json:"createdDateTime"`
+	User            teamsIdentitySet `json:"user"`
+}
+
+type teamsMessage struct {
+	Id                 string            `json:"id"`
+	ReplyToId          string            `json:"replyToId"`
+	MessageType        string            `json:"messageType"`
+	CreatedDateTime    time.Time         `json:"createdDateTime"`
+	LastEditedDateTime *time.Time        `json:"lastEditedDateTime"`
+	DeletedDateTime    *time.Time        `json:"deletedDateTime"`
+	From               *teamsIdentitySet `json:"from"`
+	Body               teamsMessageBody  `json:"body"`
+	Attachments        []teamsAttachment `json:"attachments"`
+	Mentions           []teamsMention    `json:"mentions"`
+	Reactions          []teamsReaction   `json:"reactions"`
+	Replies            []teamsMessage    `json:"replies"`
+}
+
+type teamsTeamDump struct {
+	team            *teamsTeam
+	members         []teamsMember
+	channels        []teamsChannel
+	channelMembers  map[string][]teamsMember
+	channelMessages map[string][]teamsMessage
+}
+
+type teamsChatDump struct {
+	chat     *teamsChat
+	members  []teamsMember
+	messages []teamsMessage
+}
+
+// Options configures the conversion of a Microsoft Teams export.
+type Options struct {
+	// Team is the name of a team to import the channels of all the Microsoft Teams teams into. When
+	// empty, each Microsoft Teams team is imported as a new team.
+	Team string
+}
+
+type converter struct {
+	opts   Options
+	export *imports.ConvertedExport
+	now    int64
+
+	files     map[string]*zip.File
+	users     map[string]*imports.UserImportData // by user id
+	userTeams map[string]map[string]*userTeam    // by user id and team name
+}
+
+type userTeam struct {
+	roles    string
+	channels map[string]string // roles by channel name
+}
+
+// Convert converts a Microsoft Teams export into bulk import data. The export is a zip archive of
+// the JSON responses of the Microsoft Graph API, laid out as:
+//
+//	users.json                                      /users
+//	teams/<team-id>/team.json                       /teams/<team-id>
+//	teams/<team-id>/members.json                    /teams/<team-id>/members
+//	teams/<team-id>/channels.json                   /teams/<team-id>/channels
+//	teams/<team-id>/channels/<channel-id>/members.json
+//	teams/<team-id>/channels/<channel-id>/messages*.json (with the replies expanded)
+//	chats/<chat-id>/chat.json                       /chats/<chat-id>
+//	chats/<chat-id>/members.json
+//	chats/<chat-id>/messages*.json
+//	files/<attachment-id>                           the content of the file attachments
+//
+// Entities that can't be mapped are reported in the Unmapped list of the result.
+func Convert(zipReader *zip.Reader, opts Options) (*imports.ConvertedExport, error) {
+	c := &converter{
+		opts:      opts,
+		export:    &imports.ConvertedExport{},
+		now:       model.GetMillis(),
+		files:     make(map[string]*zip.File),
+		users:     make(map[string]*imports.UserImportData),
+		userTeams: make(map[string]map[string]*userTeam),
+	}
+
+	var users []teamsUser
+	foundUsers := false
+	teams := make(map[string]*teamsTeamDump)
+	chats := make(map[string]*teamsChatDump)
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		segments := strings.Split(strings.Trim(file.Name, "/"), "/")
+		// the dump may be wrapped in a directory.
+		for len(segments) > 1 && !slices.Contains([]string{teamsUsersFile, teamsTeamsDir, teamsChatsDir, teamsFilesDir}, segments[0]) {
+			segments = segments[1:]
+		}
+
+		var err error
+		switch {
+		case len(segments) == 1 && segments[0] == teamsUsersFile:
+			users, err = parseZipFile[teamsUser](file)
+			foundUsers = true
+		case len(segments) == 2 && segments[0] == teamsFilesDir:
+			c.files[segments[1]] = file
+		case len(segments) >= 3 && segments[0] == teamsTeamsDir:
+			team, ok := teams[segments[1]]
+			if !ok {
+				team = &teamsTeamDump{
+					channelMembers:  make(map[string][]teamsMember),
+					channelMessages: make(map[string][]teamsMessage),
+				}
+				teams[segments[1]] = team
+			}
+			err = team.parseFile(segments[2:], file)
+		case len(segments) == 3 && segments[0] == teamsChatsDir:
+			chat, ok := chats[segments[1]]
+			if !ok {
+				chat = &teamsChatDump{}
+				chats[segments[1]] = chat
+			}
+			err = chat.parseFile(segments[2], file)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file.Name, err)
+		}
+	}
+	if !foundUsers {
+		return nil, fmt.Errorf("%s is missing from the export", teamsUsersFile)
+	}
+
+	c.convertUsers(users)
+
+	teamIDs := make([]string, 0, len(teams))
+	for teamID := range teams {
+		teamIDs = append(teamIDs, teamID)
+	}
+	sort.Strings(teamIDs)
+	usedTeamNames := make(map[string]bool)
+	usedChannelNames := make(map[string]map[string]bool)
+	for _, teamID := range teamIDs {
+		c.convertTeam(teamID, teams[teamID], usedTeamNames, usedChannelNames)
+	}
+
+	chatIDs := make([]string, 0, len(chats))
+	for chatID := range chats {
+		chatIDs = append(chatIDs, chatID)
+	}
+	sort.Strings(chatIDs)
+	for _, chatID := range chatIDs {
+		c.convertChat(chatID, chats[chatID])
+	}
+
+	c.addMemberships()
+
+	return c.export, nil
+}
+
+func parseZipFile[T any](file *zip.File) ([]T, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return parseCollection[T](utils.NewLimitedReaderWithError(reader, teamsImportMaxFileSize))
+}
+
+func isMessagesFile(name string) bool {
+	return strings.HasPrefix(name, "messages") && path.Ext(name) == ".json"
+}
+
+func (t *teamsTeamDump) parseFile(segments []string, file *zip.File) error {
+	switch {
+	case len(segments) == 1 && segments[0] == teamsTeamFile:
+		teams, err := parseZipFile[teamsTeam](file)
+		if err != nil || len(teams) == 0 {
+			return err
+		}
+		t.team = &teams[0]
+	case len(segments) == 1 && segments[0] == teamsMembersFile:
+		members, err := parseZipFile[teamsMember](file)
+		if err != nil {
+			return err
+		}
+		t.members = append(t.members, members...)
+	case len(segments) == 1 && segments[0] == teamsChannelsFile:
+		channels, err := parseZipFile[teamsChannel](file)
+		if err != nil {
+			return err
+		}
+		t.channels = append(t.channels, channels...)
+	case len(segments) == 3 && segments[0] == teamsChannelsDir && segments[2] == teamsMembersFile:
+		members, err := parseZipFile[teamsMember](file)
+		if err != nil {
+			return err
+		}
+		t.channelMembers[segments[1]] = append(t.channelMembers[segments[1]], members...)
+	case len(segments) == 3 && segments[0] == teamsChannelsDir && isMessagesFile(segments[2]):
+		messages, err := parseZipFile[teamsMessage](file)
+		if err != nil {
+			return err
+		}
+		t.channelMessages[segments[1]] = append(t.channelMessages[segments[1]], messages...)
+	}
+	return nil
+}
+
+func (t *teamsChatDump) parseFile(name string, file *zip.File) error {
+	switch {
+	case name == teamsChatFile:
+		chats, err := parseZipFile[teamsChat](file)
+		if err != nil || len(chats) == 0 {
+			return err
+		}
+		t.chat = &chats[0]
+	case name == teamsMembersFile:
+		members, err := parseZipFile[teamsMember](file)
+		if err != nil {
+			return err
+		}
+		t.members = append(t.members, members...)
+	case isMessagesFile(name):
+		messages, err := parseZipFile[teamsMessage](file)
+		if err != nil {
+			return err
+		}
+		t.messages = append(t.messages, messages...)
+	}
+	return nil
+}
+
+func (c *converter) convertUsers(users []teamsUser) {
+	used := make(map[string]bool)
+	for _, user := range users {
+		email := user.Mail
+		if email == "" && strings.Contains(user.UserPrincipalName, "@") && !strings.Contains(user.UserPrincipalName, "#EXT#") {
+			email = user.UserPrincipalName
+		}
+		if email == "" {
+			c.export.AddUnmapped("user", user.Id, "the user has no email address")
+			continue
+		}
+
+		username := imports.CleanUsername(user.UserPrincipalName)
+		if username == "" {
+			username = imports.CleanUsername(email)
+		}
+		if username == "" {
+			c.export.AddUnmapped("user", user.Id, fmt.Sprintf("no username can be derived from %q", email))
+			continue
+		}
+		username = imports.UniqueName(used, username, model.UserNameMaxLength)
+
+		firstName, lastName := user.GivenName, user.Surname
+		if firstName == "" && lastName == "" {
+			firstName, lastName = splitName(user.DisplayName)
+		}
+		data := &imports.UserImportData{
+			Username:  model.NewPointer(username),
+			Email:     model.NewPointer(strings.ToLower(email)),
+			FirstName: model.NewPointer(firstName),
+			LastName:  model.NewPointer(lastName),
+			Position:  model.NewPointer(truncateRunes(user.JobTitle, model.UserPositionMaxRunes)),
+			Roles:     model.NewPointer(model.SystemUserRoleId),
+		}
+		if user.AccountEnabled != nil && !*user.AccountEnabled {
+			data.DeleteAt = model.NewPointer(c.now)
+		}
+
+		c.users[user.Id] = data
+		c.export.Users = append(c.export.Users, data)
+	}
+}
+
+func (c *converter) convertTeam(teamID string, dump *teamsTeamDump, usedTeamNames map[string]bool, usedChannelNames map[string]map[string]bool) {
+	teamDisplayName := teamID
+	if dump.team != nil && dump.team.DisplayName != "" {
+		teamDisplayName = dump.team.DisplayName
+	}
+
+	teamName := c.opts.Team
+	if teamName == "" {
+		teamName = imports.UniqueName(usedTeamNames, model.CleanTeamName(teamDisplayName), model.TeamNameMaxLength)
+		teamType := model.TeamInvite
+		description := ""
+		if dump.team != nil {
+			if dump.team.Visibility == "public" {
+				teamType = model.TeamOpen
+			}
+			description = truncateRunes(dump.team.Description, model.TeamDescriptionMaxLength)
+		}
+		c.export.Teams = append(c.export.Teams, &imports.TeamImportData{
+			Name:        model.NewPointer(teamName),
+			DisplayName: model.NewPointer(truncateRunes(teamDisplayName, model.TeamDisplayNameMaxRunes)),
+			Type:        model.NewPointer(teamType),
+			Description: model.NewPointer(description),
+		})
+	}
+	if usedChannelNames[teamName] == nil {
+		usedChannelNames[teamName] = make(map[string]bool)
+	}
+
+	for _, member := range dump.members {
+		roles := model.TeamUserRoleId
+		if slices.Contains(member.Roles, "owner") {
+			roles += " " + model.TeamAdminRoleId
+		}
+		c.addTeamMembership(member.UserId, teamName, roles)
+	}
+
+	converted := make(map[string]bool)
+	for _, channel := range dump.channels {
+		channelName := c.convertChannel(teamName, teamDisplayName, channel, usedChannelNames[teamName])
+		converted[channel.Id] = true
+
+		for _, member := range dump.channelMembers[channel.Id] {
+			roles := model.ChannelUserRoleId
+			if slices.Contains(member.Roles, "owner") {
+				roles += " " + model.ChannelAdminRoleId
+			}
+			c.addChannelMembership(member.UserId, teamName, channelName, roles)
+		}
+		c.convertChannelMessages(teamName, channelName, dump.channelMessages[channel.Id])
+	}
+
+	for channelID, messages := range dump.channelMessages {
+		if !converted[channelID] {
+			c.export.AddUnmapped("channel", channelID, fmt.Sprintf("the channel is missing from %s; its %d messages are not imported", teamsChannelsFile, len(messages)))
+		}
+	}
+}
+
+func (c *converter) convertChannel(teamName, teamDisplayName string, channel teamsChannel, used map[string]bool) string {
+	displayName := channel.DisplayName
+	if c.opts.Team != "" {
+		// the channels of several teams share the same team; keep them apart.
+		displayName = teamDisplayName + " - " + channel.DisplayName
+	}
+
+	name := imports.CleanChannelName(displayName)
+	if c.opts.Team == "" && strings.EqualFold(channel.DisplayName, "General") {
+		name = model.DefaultChannelName
+	} else if name == "" {
+		name = "teams-channel"
+	}
+	name = imports.UniqueName(used, name, model.ChannelNameMaxLength)
+
+	channelType := model.ChannelTypePrivate
+	if channel.MembershipType == "" || channel.MembershipType == "standard" {
+		channelType = model.ChannelTypeOpen
+	}
+	c.export.Channels = append(c.export.Channels, &imports.ChannelImportData{
+		Team:        model.NewPointer(teamName),
+		Name:        model.NewPointer(name),
+		DisplayName: model.NewPointer(truncateRunes(displayName, model.ChannelDisplayNameMaxRunes)),
+		Type:        &channelType,
+		Purpose:     model.NewPointer(truncateRunes(channel.Description, model.ChannelPurposeMaxRunes)),
+	})
+	return name
+}
+
+func (c *converter) convertChannelMessages(teamName, channelName string, messages []teamsMessage) {
+	posts := make(map[string]*imports.PostImportData)
+	for _, message := range flattenReplies(messages) {
+		converted, ok := c.convertMessage(message)
+		if !ok {
+			continue
+		}
+		c.addChannelMembership(message.From.User.Id, teamName, channelName, model.ChannelUserRoleId)
+
+		if message.ReplyToId != "" {
+			if post, ok := posts[message.ReplyToId]; ok {
+				*post.Replies = append(*post.Replies, imports.ReplyImportData{
+					User:        converted.User,
+					Message:     converted.Message,
+					CreateAt:    converted.CreateAt,
+					EditAt:      converted.EditAt,
+					Reactions:   converted.Reactions,
+					Attachments: converted.Attachments,
+				})
+				continue
+			}
+			// the root of the thread is missing; the reply is imported as a root post.
+		}
+
+		post := &imports.PostImportData{
+			Team:        model.NewPointer(teamName),
+			Channel:     model.NewPointer(channelName),
+			User:        converted.User,
+			Message:     converted.Message,
+			CreateAt:    converted.CreateAt,
+			EditAt:      converted.EditAt,
+			Reactions:   converted.Reactions,
+			Replies:     &[]imports.ReplyImportData{},
+			Attachments: converted.Attachments,
+		}
+		posts[message.Id] = post
+		c.export.Posts = append(c.export.Posts, post)
+	}
+}
+
+// flattenReplies lists the messages along with their expanded replies, oldest first.
+func flattenReplies(messages []teamsMessage) []teamsMessage {
+	var flattened []teamsMessage
+	for _, message := range messages {
+		replies := message.Replies
+		message.Replies = nil
+		flattened = append(flattened, message)
+		for _, reply := range replies {
+			if reply.ReplyToId == "" {
+				reply.ReplyToId = message.Id
+			}
+			flattened = append(flattened, reply)
+		}
+	}
+
+	sort.SliceStable(flattened, func(i, j int) bool {
+		return flattened[i].CreatedDateTime.Before(flattened[j].CreatedDateTime)
+	})
+	return flattened
+}
+
+func (c *converter) convertChat(chatID string, dump *teamsChatDump) {
+	var members []string
+	for _, member := range dump.members {
+		user, ok := c.users[member.UserId]
+		if !ok {
+			c.export.AddUnmapped("chat", chatID, fmt.Sprintf("the member %s of the chat is not imported", member.UserId))
+			return
+		}
+		members = append(members, *user.Username)
+	}
+
+	slices.Sort(members)
+	members = slices.Compact(members)
+	if len(members) == 1 {
+		// a chat with oneself.
+		members = append(members, members[0])
+	}
+	if len(members) == 0 || len(members) > model.ChannelGroupMaxUsers {
+		c.export.AddUnmapped("chat", chatID, fmt.Sprintf("chats with %d members are not supported", len(members)))
+		return
+	}
+
+	header := ""
+	if dump.chat != nil {
+		header = truncateRunes(dump.chat.Topic, model.ChannelHeaderMaxRunes)
+	}
+	c.export.DirectChannels = append(c.export.DirectChannels, &imports.DirectChannelImportData{
+		Members: &members,
+		Header:  model.NewPointer(header),
+	})
+
+	// chats have no threads, replies only quote other messages.
+	for _, message := range flattenReplies(dump.messages) {
+		converted, ok := c.convertMessage(message)
+		if !ok {
+			continue
+		}
+		c.export.DirectPosts = append(c.export.DirectPosts, &imports.DirectPostImportData{
+			ChannelMembers: &members,
+			User:           converted.User,
+			Message:        converted.Message,
+			CreateAt:       converted.CreateAt,
+			EditAt:         converted.EditAt,
+			Reactions:      converted.Reactions,
+			Replies:        &[]imports.ReplyImportData{},
+			Attachments:    converted.Attachments,
+		})
+	}
+}
+
+// convertMessage converts the fields messages share across channels and chats, and reports the
+// messages that can't be imported.
+func (c *converter) convertMessage(message teamsMessage) (*imports.ReplyImportData, bool) {
+	switch {
+	case message.MessageType != "" && message.MessageType != "message":
+		c.export.AddUnmapped("message", message.Id, fmt.Sprintf("messages of type %q are not imported", message.MessageType))
+		return nil, false
+	case message.DeletedDateTime != nil:
+		c.export.AddUnmapped("message", message.Id, "the message was deleted")
+		return nil, false
+	case message.From == nil || message.From.User == nil:
+		c.export.AddUnmapped("message", message.Id, "the message was not sent by a user")
+		return nil, false
+	case message.CreatedDateTime.IsZero():
+		c.export.AddUnmapped("message", message.Id, "the message has no creation date")
+		return nil, false
+	}
+	user, ok := c.users[message.From.User.Id]
+	if !ok {
+		c.export.AddUnmapped("message", message.Id, "the author of the message is not imported")
+		return nil, false
+	}
+
+	text := message.Body.Content
+	if message.Body.ContentType == "html" {
+		mentions := make(map[int]string)
+		for _, mention := range message.Mentions {
+			switch {
+			case mention.Mentioned == nil:
+			case mention.Mentioned.User != nil:
+				if mentioned, ok := c.users[mention.Mentioned.User.Id]; ok {
+					mentions[mention.Id] = *mentioned.Username
+				}
+			case mention.Mentioned.Conversation != nil:
+				mentions[mention.Id] = "channel"
+			}
+		}
+		text = teamsConvertHTML(text, mentions)
+	}
+
+	createAt := message.CreatedDateTime.UnixMilli()
+	converted := &imports.ReplyImportData{
+		User:        user.Username,
+		Message:     model.NewPointer(text),
+		CreateAt:    model.NewPointer(createAt),
+		Reactions:   c.convertReactions(message, createAt),
+		Attachments: c.convertAttachments(message),
+	}
+	if message.LastEditedDateTime != nil && !message.LastEditedDateTime.IsZero() {
+		converted.EditAt = model.NewPointer(message.LastEditedDateTime.UnixMilli())
+	}
+	if text == "" && converted.Attachments == nil {
+		c.export.AddUnmapped("message", message.Id, "the message is empty")
+		return nil, false
+	}
+	return converted, true
+}
+
+func (c *converter) convertReactions(message teamsMessage, createAt int64) *[]imports.ReactionImportData {
+	if len(message.Reactions) == 0 {
+		return nil
+	}
+
+	reactions := []imports.ReactionImportData{}
+	for _, reaction := range message.Reactions {
+		emojiName, ok := teamsReactionEmojis[reaction.ReactionType]
+		if !ok {
+			c.export.AddUnmapped("reaction", message.Id+" "+reaction.ReactionType, "the reaction has no matching emoji")
+			continue
+		}
+		if reaction.User.User == nil {
+			c.export.AddUnmapped("reaction", message.Id+" "+reaction.ReactionType, "the reaction was not added by a user")
+			continue
+		}
+		user, ok := c.users[reaction.User.User.Id]
+		if !ok {
+			c.export.AddUnmapped("reaction", message.Id+" "+reaction.ReactionType, fmt.Sprintf("the user %s is not imported", reaction.User.User.Id))
+			continue
+		}
+
+		reactionCreateAt := max(reaction.CreatedDateTime.UnixMilli(), createAt)
+		reactions = append(reactions, imports.ReactionImportData{
+			User:      user.Username,
+			CreateAt:  model.NewPointer(reactionCreateAt),
+			EmojiName: model.NewPointer(emojiName),
+		})
+	}
+	return &reactions
+}
+
+func (c *converter) convertAttachments(message teamsMessage) *[]imports.AttachmentImportData {
+	attachments := []imports.AttachmentImportData{}
+	for _, attachment := range message.Attachments {
+		if attachment.ContentType != "reference" {
+			c.export.AddUnmapped("attachment", attachment.Id, fmt.Sprintf("attachments of type %q are not imported", attachment.ContentType))
+			continue
+		}
+		data, ok := c.files[attachment.Id]
+		if !ok {
+			c.export.AddUnmapped("file", attachment.Id, "the content of the file is missing from the export")
+			continue
+		}
+
+		name := attachment.Name
+		if name == "" {
+			name = path.Base(attachment.ContentURL)
+		}
+		name = path.Base(name)
+		if name == "." || name == "/" {
+			name = attachment.Id
+		}
+		attachments = append(attachments, imports.AttachmentImportData{
+			Path: model.NewPointer(path.Join(attachmentsDir, attachment.Id, name)),
+			Data: data,
+		})
+	}
+	if len(attachments) == 0 {
+		return nil
+	}
+	return &attachments
+}
+
+func (c *converter) addTeamMembership(userID, teamName, roles string) *userTeam {
+	if _, ok := c.users[userID]; !ok {
+		return nil
+	}
+
+	teams, ok := c.userTeams[userID]
+	if !ok {
+		teams = make(map[string]*userTeam)
+		c.userTeams[userID] = teams
+	}
+	team, ok := teams[teamName]
+	if !ok {
+		team = &userTeam{roles: roles, channels: make(map[string]string)}
+		teams[teamName] = team
+	} else if roles != model.TeamUserRoleId {
+		team.roles = roles
+	}
+	return team
+}
+
+func (c *converter) addChannelMembership(userID, teamName, channelName, roles string) {
+	team := c.addTeamMembership(userID, teamName, model.TeamUserRoleId)
+	if team == nil {
+		return
+	}
+	if _, ok := team.channels[channelName]; !ok || roles != model.ChannelUserRoleId {
+		team.channels[channelName] = roles
+	}
+}
+
+func (c *converter) addMemberships() {
+	for userID, user := range c.users {
+		teams := []imports.UserTeamImportData{}
+		for teamName, team := range c.userTeams[userID] {
+			channels := []imports.UserChannelImportData{}
+			for channelName, roles := range team.channels {
+				channels = append(channels, imports.UserChannelImportData{
+					Name:  model.NewPointer(channelName),
+					Roles: model.NewPointer(roles),
+				})
+			}
+			sort.Slice(channels, func(i, j int) bool {
+				return *channels[i].Name < *channels[j].Name
+			})
+
+			teams = append(teams, imports.UserTeamImportData{
+				Name:     model.NewPointer(teamName),
+				Roles:    model.NewPointer(team.roles),
+				Channels: &channels,
+			})
+		}
+		sort.Slice(teams, func(i, j int) bool {
+			return *teams[i].Name < *teams[j].Name
+		})
+		user.Teams = &teams
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package teamsimport
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/v8/channels/app/imports"
+)
+
+func newZipReader(t *testing.T, files map[string]string) *zip.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	return zr
+}
+
+const testUsers = `{"value":[
+{"id":"u1","displayName":"Alice Smith","mail":"Alice@example.com","userPrincipalName":"alice@example.com","jobTitle":"Engineer"},
+{"id":"u2","displayName":"Bob Jones","givenName":"Bob","surname":"Jones","userPrincipalName":"bob.jones@example.com","accountEnabled":false},
+{"id":"u3","displayName":"Guest","userPrincipalName":"guest_other.com#EXT#@example.onmicrosoft.com"}
+]}`
+
+const testTeam = `{"id":"t1","displayName":"Product Team","description":"All things product","visibility":"public"}`
+
+const testTeamMembers = `{"value":[{"userId":"u1","roles":["owner"]},{"userId":"u2","roles":[]},{"userId":"u3","roles":["guest"]}]}`
+
+const testChannels = `{"value":[
+{"id":"c1","displayName":"General","membershipType":"standard"},
+{"id":"c2","displayName":"Design Review","description":"Reviews","membershipType":"private"}
+]}`
+
+const testChannelMembers = `[{"userId":"u2","roles":["owner"]}]`
+
+const testChannelMessages = `{"value":[
+{"id":"m1","messageType":"message","createdDateTime":"2024-01-02T10:00:00Z","from":{"user":{"id":"u1"}},
+ "body":{"contentType":"html","content":"<p>Hello <at id=\"0\">Bob Jones</at>, <b>look</b> at this</p>"},
+ "mentions":[{"id":0,"mentioned":{"user":{"id":"u2"}}}],
+ "reactions":[{"reactionType":"like","createdDateTime":"2024-01-02T09:00:00Z","user":{"user":{"id":"u2"}}},{"reactionType":"unknown","createdDateTime":"2024-01-02T10:05:00Z","user":{"user":{"id":"u2"}}}],
+ "attachments":[{"id":"a1","contentType":"reference","name":"spec.pdf"},{"id":"a2","contentType":"application/vnd.microsoft.card.adaptive"}],
+ "replies":[{"id":"m2","messageType":"message","createdDateTime":"2024-01-02T10:01:00Z","from":{"user":{"id":"u2"}},"body":{"contentType":"text","content":"thanks"}}]},
+{"id":"m3","messageType":"systemEventMessage","createdDateTime":"2024-01-02T10:02:00Z","body":{"contentType":"html","content":""}},
+{"id":"m4","messageType":"message","createdDateTime":"2024-01-02T10:03:00Z","deletedDateTime":"2024-01-02T10:04:00Z","from":{"user":{"id":"u1"}},"body":{"contentType":"text","content":"oops"}},
+{"id":"m5","messageType":"message","createdDateTime":"2024-01-02T10:04:00Z","from":{"application":{"id":"app1"}},"body":{"contentType":"text","content":"bot"}}
+]}`
+
+const testChat = `{"id":"chat1","chatType":"oneOnOne","topic":""}`
+
+const testChatMembers = `{"value":[{"userId":"u2"},{"userId":"u1"}]}`
+
+const testChatMessages = `[
+{"id":"dm1","messageType":"message","createdDateTime":"2024-01-03T10:00:00Z","lastEditedDateTime":"2024-01-03T10:01:00Z","from":{"user":{"id":"u2"}},"body":{"contentType":"text","content":"hi alice"}}
+]`
+
+func newTestExport(t *testing.T) *zip.Reader {
+	return newZipReader(t, map[string]string{
+		"dump/users.json":                               testUsers,
+		"dump/teams/t1/team.json":                       testTeam,
+		"dump/teams/t1/members.json":                    testTeamMembers,
+		"dump/teams/t1/channels.json":                   testChannels,
+		"dump/teams/t1/channels/c2/members.json":        testChannelMembers,
+		"dump/teams/t1/channels/c1/messages.json":       testChannelMessages,
+		"dump/teams/t1/channels/orphan/messages-1.json": `[]`,
+		"dump/chats/chat1/chat.json":                    testChat,
+		"dump/chats/chat1/members.json":                 testChatMembers,
+		"dump/chats/chat1/messages.json":                testChatMessages,
+		"dump/chats/chat2/members.json":                 `[{"userId":"u1"},{"userId":"u3"}]`,
+		"dump/files/a1":                                 "pdf content",
+	})
+}
+
+func TestConvert(t *testing.T) {
+	export, err := Convert(newTestExport(t), Options{})
+	require.NoError(t, err)
+
+	t.Run("users", func(t *testing.T) {
+		require.Len(t, export.Users, 2)
+
+		alice := export.Users[0]
+		assert.Equal(t, "alice", *alice.Username)
+		assert.Equal(t, "alice@example.com", *alice.Email)
+		assert.Equal(t, "Alice", *alice.FirstName)
+		assert.Equal(t, "Smith", *alice.LastName)
+		assert.Equal(t, "Engineer", *alice.Position)
+		assert.Nil(t, alice.DeleteAt)
+		require.Len(t, *alice.Teams, 1)
+		team := (*alice.Teams)[0]
+		assert.Equal(t, "product-team", *team.Name)
+		assert.Equal(t, "team_user team_admin", *team.Roles)
+		require.Len(t, *team.Channels, 1)
+		assert.Equal(t, model.DefaultChannelName, *(*team.Channels)[0].Name)
+
+		bob := export.Users[1]
+		assert.Equal(t, "bob.jones", *bob.Username)
+		assert.Equal(t, "bob.jones@example.com", *bob.Email)
+		assert.NotNil(t, bob.DeleteAt)
+		channels := *(*bob.Teams)[0].Channels
+		require.Len(t, channels, 2)
+		assert.Equal(t, "design-review", *channels[0].Name)
+		assert.Equal(t, "channel_user channel_admin", *channels[0].Roles)
+		assert.Equal(t, model.DefaultChannelName, *channels[1].Name)
+	})
+
+	t.Run("teams and channels", func(t *testing.T) {
+		require.Len(t, export.Teams, 1)
+		assert.Equal(t, "product-team", *export.Teams[0].Name)
+		assert.Equal(t, "Product Team", *export.Teams[0].DisplayName)
+		assert.Equal(t, model.TeamOpen, *export.Teams[0].Type)
+
+		require.Len(t, export.Channels, 2)
+		assert.Equal(t, model.DefaultChannelName, *export.Channels[0].Name)
+		assert.Equal(t, model.ChannelTypeOpen, *export.Channels[0].Type)
+		assert.Equal(t, "design-review", *export.Channels[1].Name)
+		assert.Equal(t, "Reviews", *export.Channels[1].Purpose)
+		assert.Equal(t, model.ChannelTypePrivate, *export.Channels[1].Type)
+
+		require.Len(t, export.DirectChannels, 1)
+		assert.Equal(t, []string{"alice", "bob.jones"}, *export.DirectChannels[0].Members)
+	})
+
+	t.Run("posts", func(t *testing.T) {
+		require.Len(t, export.Posts, 1)
+		post := export.Posts[0]
+		assert.Equal(t, "product-team", *post.Team)
+		assert.Equal(t, model.DefaultChannelName, *post.Channel)
+		assert.Equal(t, "alice", *post.User)
+		assert.Equal(t, "Hello @bob.jones, **look** at this", *post.Message)
+		assert.Equal(t, int64(1704189600000), *post.CreateAt)
+
+		require.Len(t, *post.Reactions, 1)
+		reaction := (*post.Reactions)[0]
+		assert.Equal(t, "+1", *reaction.EmojiName)
+		assert.Equal(t, *post.CreateAt, *reaction.CreateAt)
+
+		require.Len(t, *post.Attachments, 1)
+		assert.Equal(t, "teams/a1/spec.pdf", *(*post.Attachments)[0].Path)
+
+		require.Len(t, *post.Replies, 1)
+		assert.Equal(t, "thanks", *(*post.Replies)[0].Message)
+		assert.Equal(t, "bob.jones", *(*post.Replies)[0].User)
+
+		require.Len(t, export.DirectPosts, 1)
+		directPost := export.DirectPosts[0]
+		assert.Equal(t, []string{"alice", "bob.jones"}, *directPost.ChannelMembers)
+		assert.Equal(t, "hi alice", *directPost.Message)
+		assert.Equal(t, int64(1704276060000), *directPost.EditAt)
+	})
+
+	t.Run("unmapped entities", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{
+			"user u3",
+			"reaction m1 unknown",
+			"attachment a2",
+			"message m3",
+			"message m4",
+			"message m5",
+			"channel orphan",
+			"chat chat2",
+		}, unmappedIds(export))
+	})
+
+	t.Run("archive", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, export.WriteArchive(&buf))
+
+		archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		require.NoError(t, err)
+		files := map[string]string{}
+		for _, file := range archive.File {
+			r, err := file.Open()
+			require.NoError(t, err)
+			content, err := io.ReadAll(r)
+			require.NoError(t, err)
+			r.Close()
+			files[file.Name] = string(content)
+		}
+		require.Len(t, files, 2)
+		assert.Equal(t, "pdf content", files["data/teams/a1/spec.pdf"])
+		assert.Contains(t, files[imports.ArchiveImportFilename], `"type":"team"`)
+	})
+}
+
+func TestConvertIntoTeam(t *testing.T) {
+	export, err := Convert(newTestExport(t), Options{Team: "myteam"})
+	require.NoError(t, err)
+
+	assert.Empty(t, export.Teams)
+	require.Len(t, export.Channels, 2)
+	assert.Equal(t, "myteam", *export.Channels[0].Team)
+	assert.Equal(t, "product-team-general", *export.Channels[0].Name)
+	assert.Equal(t, "Product Team - General", *export.Channels[0].DisplayName)
+	assert.Equal(t, "product-team-design-review", *export.Channels[1].Name)
+
+	require.Len(t, export.Posts, 1)
+	assert.Equal(t, "myteam", *export.Posts[0].Team)
+	assert.Equal(t, "product-team-general", *export.Posts[0].Channel)
+}
+
+func TestConvertRequiresUsers(t *testing.T) {
+	_, err := Convert(newZipReader(t, map[string]string{"teams/t1/team.json": testTeam}), Options{})
+	require.Error(t, err)
+}
+
+func TestParseCollection(t *testing.T) {
+	type entity struct {
+		Id string `json:"id"`
+	}
+
+	for name, data := range map[string]string{
+		"response": `{"@odata.context":"x","value":[{"id":"a"},{"id":"b"}]}`,
+		"array":    `[{"id":"a"},{"id":"b"}]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			entities, err := parseCollection[entity](strings.NewReader(data))
+			require.NoError(t, err)
+			require.Len(t, entities, 2)
+			assert.Equal(t, "a", entities[0].Id)
+		})
+	}
+
+	entities, err := parseCollection[entity](strings.NewReader(`{"id":"a"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []entity{{Id: "a"}}, entities)
+}
+
+func TestConvertHTML(t *testing.T) {
+	mentions := map[int]string{0: "john.doe", 1: "channel"}
+
+	for input, expected := range map[string]string{
+		`<p>Hi <at id="0">John Doe</at> and <at id="1">General</at></p>`: "Hi @john.doe and @channel",
+		`<at id="7">Someone</at> left`:                                   "Someone left",
+		`<div><i>it</i> <s>was</s> <code>x</code></div>`:                 "_it_ ~~was~~ `x`",
+		`<a href="https://example.com">the site</a>`:                     "[the site](https://example.com)",
+		`line one<br>line two`:                                           "line one\nline two",
+		`<ul><li>one</li><li>two</li></ul>`:                              "- one\n- two",
+		`<blockquote>quoted<br>text</blockquote>reply`:                   "> quoted\n> text\nreply",
+		`<pre>a  b</pre>`:                                                "```\na  b\n```",
+		`<p>smile <img alt="😀" src="x"></p>`:                             "smile 😀",
+	} {
+		assert.Equal(t, expected, teamsConvertHTML(input, mentions), input)
+	}
+}
+
+func unmappedIds(export *imports.ConvertedExport) []string {
+	ids := make([]string, 0, len(export.Unmapped))
+	for _, entity := range export.Unmapped {
+		ids = append(ids, entity.Kind+" "+entity.Id)
+	}
+	return ids
+}
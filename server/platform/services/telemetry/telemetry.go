@@ -478,6 +478,7 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_incoming_webhooks":                                cfg.ServiceSettings.EnableIncomingWebhooks,
 		"enable_outgoing_webhooks":                                cfg.ServiceSettings.EnableOutgoingWebhooks,
 		"enable_outgoing_oauth_connections":                       cfg.ServiceSettings.EnableOutgoingOAuthConnections,
+		"outgoing_webhook_max_retries":                            *cfg.ServiceSettings.OutgoingWebhookMaxRetries,
 		"enable_commands":                                         *cfg.ServiceSettings.EnableCommands,
 		"outgoing_integrations_requests_timeout":                  cfg.ServiceSettings.OutgoingIntegrationRequestsTimeout,
 		"enable_post_username_override":                           cfg.ServiceSettings.EnablePostUsernameOverride,
@@ -493,6 +494,9 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_client_performance_debugging":                     *cfg.ServiceSettings.EnableClientPerformanceDebugging,
 		"enable_multifactor_authentication":                       *cfg.ServiceSettings.EnableMultifactorAuthentication,
 		"enforce_multifactor_authentication":                      *cfg.ServiceSettings.EnforceMultifactorAuthentication,
+		"enable_webauthn":                                         *cfg.ServiceSettings.EnableWebAuthn,
+		"enforce_webauthn":                                        *cfg.ServiceSettings.EnforceWebAuthn,
+		"enable_passkey_login":                                    *cfg.ServiceSettings.EnablePasskeyLogin,
 		"enable_oauth_service_provider":                           cfg.ServiceSettings.EnableOAuthServiceProvider,
 		"connection_security":                                     *cfg.ServiceSettings.ConnectionSecurity,
 		"tls_strict_transport":                                    *cfg.ServiceSettings.TLSStrictTransport,
@@ -554,6 +558,7 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_permalink_previews":                               *cfg.ServiceSettings.EnablePermalinkPreviews,
 		"enable_file_search":                                      *cfg.ServiceSettings.EnableFileSearch,
 		"restrict_link_previews":                                  isDefault(*cfg.ServiceSettings.RestrictLinkPreviews, ""),
+		"enable_channel_bookmark_link_checks":                     *cfg.ServiceSettings.EnableChannelBookmarkLinkChecks,
 		"enable_custom_groups":                                    *cfg.ServiceSettings.EnableCustomGroups,
 		"post_priority":                                           *cfg.ServiceSettings.PostPriority,
 		"allow_persistent_notifications":                          *cfg.ServiceSettings.AllowPersistentNotifications,
@@ -561,6 +566,7 @@ func (ts *TelemetryService) trackConfig() {
 		"persistent_notification_interval_minutes":                *cfg.ServiceSettings.PersistentNotificationIntervalMinutes,
 		"persistent_notification_max_count":                       *cfg.ServiceSettings.PersistentNotificationMaxCount,
 		"persistent_notification_max_recipients":                  *cfg.ServiceSettings.PersistentNotificationMaxRecipients,
+		"allow_post_escalations":                                  *cfg.ServiceSettings.AllowPostEscalations,
 		"allow_synced_drafts":                                     *cfg.ServiceSettings.AllowSyncedDrafts,
 		"refresh_post_stats_run_time":                             *cfg.ServiceSettings.RefreshPostStatsRunTime,
 		"maximum_payload_size":                                    *cfg.ServiceSettings.MaximumPayloadSizeBytes,
@@ -633,6 +639,7 @@ func (ts *TelemetryService) trackConfig() {
 		"file_compress":         *cfg.ExperimentalAuditSettings.FileCompress,
 		"file_max_queue_size":   *cfg.ExperimentalAuditSettings.FileMaxQueueSize,
 		"advanced_logging_json": len(cfg.ExperimentalAuditSettings.AdvancedLoggingJSON) != 0,
+		"database_enabled":      *cfg.ExperimentalAuditSettings.DatabaseEnabled,
 	})
 
 	ts.SendTelemetry(TrackConfigNotificationLog, map[string]any{
@@ -647,11 +654,16 @@ func (ts *TelemetryService) trackConfig() {
 	})
 
 	ts.SendTelemetry(TrackConfigPassword, map[string]any{
-		"minimum_length": *cfg.PasswordSettings.MinimumLength,
-		"lowercase":      *cfg.PasswordSettings.Lowercase,
-		"number":         *cfg.PasswordSettings.Number,
-		"uppercase":      *cfg.PasswordSettings.Uppercase,
-		"symbol":         *cfg.PasswordSettings.Symbol,
+		"minimum_length":                    *cfg.PasswordSettings.MinimumLength,
+		"lowercase":                         *cfg.PasswordSettings.Lowercase,
+		"number":                            *cfg.PasswordSettings.Number,
+		"uppercase":                         *cfg.PasswordSettings.Uppercase,
+		"symbol":                            *cfg.PasswordSettings.Symbol,
+		"hashing_algorithm":                 *cfg.PasswordSettings.HashingAlgorithm,
+		"argon2id_memory_kib":               *cfg.PasswordSettings.Argon2idMemoryKiB,
+		"argon2id_iterations":               *cfg.PasswordSettings.Argon2idIterations,
+		"argon2id_parallelism":              *cfg.PasswordSettings.Argon2idParallelism,
+		"isdefault_breached_passwords_file": isDefault(*cfg.PasswordSettings.BreachedPasswordsFile, ""),
 	})
 
 	ts.SendTelemetry(TrackConfigFile, map[string]any{
@@ -661,6 +673,11 @@ func (ts *TelemetryService) trackConfig() {
 		"isabsolute_directory":          filepath.IsAbs(*cfg.FileSettings.Directory),
 		"extract_content":               *cfg.FileSettings.ExtractContent,
 		"archive_recursion":             *cfg.FileSettings.ArchiveRecursion,
+		"enable_ocr":                    *cfg.FileSettings.EnableOCR,
+		"ocr_max_pages":                 *cfg.FileSettings.OCRMaxPages,
+		"ocr_timeout_seconds":           *cfg.FileSettings.OCRTimeoutSeconds,
+		"enable_content_deduplication":  *cfg.FileSettings.EnableContentDeduplication,
+		"enable_at_rest_encryption":     *cfg.FileSettings.EnableAtRestEncryption,
 		"amazon_s3_ssl":                 *cfg.FileSettings.AmazonS3SSL,
 		"amazon_s3_sse":                 *cfg.FileSettings.AmazonS3SSE,
 		"amazon_s3_signv2":              *cfg.FileSettings.AmazonS3SignV2,
@@ -684,6 +701,7 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_smtp_auth":                     *cfg.EmailSettings.EnableSMTPAuth,
 		"connection_security":                  cfg.EmailSettings.ConnectionSecurity,
 		"send_push_notifications":              *cfg.EmailSettings.SendPushNotifications,
+		"enable_web_push_notifications":        *cfg.EmailSettings.EnableWebPushNotifications,
 		"push_notification_contents":           *cfg.EmailSettings.PushNotificationContents,
 		"enable_email_batching":                *cfg.EmailSettings.EnableEmailBatching,
 		"email_batching_buffer_size":           *cfg.EmailSettings.EmailBatchingBufferSize,
@@ -708,6 +726,7 @@ func (ts *TelemetryService) trackConfig() {
 		"max_burst":                *cfg.RateLimitSettings.MaxBurst,
 		"memory_store_size":        *cfg.RateLimitSettings.MemoryStoreSize,
 		"isdefault_vary_by_header": isDefault(cfg.RateLimitSettings.VaryByHeader, ""),
+		"use_cache_store":          *cfg.RateLimitSettings.UseCacheStore,
 	})
 
 	ts.SendTelemetry(TrackConfigPrivacy, map[string]any{
@@ -824,6 +843,7 @@ func (ts *TelemetryService) trackConfig() {
 		"enable_experimental_gossip_encryption": *cfg.ClusterSettings.EnableExperimentalGossipEncryption,
 		"enable_gossip_compression":             *cfg.ClusterSettings.EnableGossipCompression,
 		"read_only_config":                      *cfg.ClusterSettings.ReadOnlyConfig,
+		"enable_redis_message_bus":              *cfg.ClusterSettings.EnableRedisMessageBus,
 	})
 
 	ts.SendTelemetry(TrackConfigMetrics, map[string]any{
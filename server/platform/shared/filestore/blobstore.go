@@ -0,0 +1,92 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filestore
+
+import (
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// BlobFileBackend stores file content in the wrapped backend once per distinct content, at the
+// path derived from its hash (see model.FileBlobPath). It works with any backend, local or S3. The
+// references held on the blobs are counted by the caller, which decides when a blob can be removed.
+type BlobFileBackend struct {
+	FileBackend
+}
+
+var _ FileBackend = (*BlobFileBackend)(nil)
+
+func NewBlobFileBackend(backend FileBackend) *BlobFileBackend {
+	return &BlobFileBackend{
+		FileBackend: backend,
+	}
+}
+
+// ContentHash hashes the content of the file stored at path.
+func (b *BlobFileBackend) ContentHash(path string) (string, error) {
+	file, err := b.Reader(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to open file %s", path)
+	}
+	defer file.Close()
+
+	h := model.NewFileContentHash()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", errors.Wrapf(err, "unable to hash file %s", path)
+	}
+
+	return model.FileContentHashString(h), nil
+}
+
+// BlobExists checks whether the content with the given hash is stored.
+func (b *BlobFileBackend) BlobExists(contentHash string) (bool, error) {
+	if !model.IsValidFileContentHash(contentHash) {
+		return false, errors.Errorf("invalid content hash %q", contentHash)
+	}
+
+	return b.FileExists(model.FileBlobPath(contentHash))
+}
+
+// MoveToBlob moves the file stored at path, whose content has the given hash, to its blob and
+// returns the path of the blob. An existing blob is overwritten with the same content.
+func (b *BlobFileBackend) MoveToBlob(path, contentHash string) (string, error) {
+	if !model.IsValidFileContentHash(contentHash) {
+		return "", errors.Errorf("invalid content hash %q", contentHash)
+	}
+
+	blobPath := model.FileBlobPath(contentHash)
+	if err := b.MoveFile(path, blobPath); err != nil {
+		return "", err
+	}
+	return blobPath, nil
+}
+
+// CopyToBlob copies the file stored at path, whose content has the given hash, to its blob unless
+// the blob already exists, and returns the path of the blob.
+func (b *BlobFileBackend) CopyToBlob(path, contentHash string) (string, error) {
+	exists, err := b.BlobExists(contentHash)
+	if err != nil {
+		return "", err
+	}
+
+	blobPath := model.FileBlobPath(contentHash)
+	if !exists {
+		if err := b.CopyFile(path, blobPath); err != nil {
+			return "", err
+		}
+	}
+	return blobPath, nil
+}
+
+// RemoveBlob removes the content with the given hash.
+func (b *BlobFileBackend) RemoveBlob(contentHash string) error {
+	if !model.IsValidFileContentHash(contentHash) {
+		return errors.Errorf("invalid content hash %q", contentHash)
+	}
+
+	return b.RemoveFile(model.FileBlobPath(contentHash))
+}
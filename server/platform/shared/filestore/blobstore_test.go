@@ -0,0 +1,79 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filestore
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestBlobFileBackend(t *testing.T) {
+	backend := NewBlobFileBackend(&LocalFileBackend{directory: t.TempDir()})
+
+	content := []byte("the same content")
+	h := model.NewFileContentHash()
+	h.Write(content)
+	contentHash := model.FileContentHashString(h)
+
+	_, err := backend.WriteFile(bytes.NewReader(content), "uploads/a.txt")
+	require.NoError(t, err)
+	_, err = backend.WriteFile(bytes.NewReader(content), "uploads/b.txt")
+	require.NoError(t, err)
+
+	t.Run("content hash", func(t *testing.T) {
+		hash, err := backend.ContentHash("uploads/a.txt")
+		require.NoError(t, err)
+		assert.Equal(t, contentHash, hash)
+
+		_, err = backend.ContentHash("uploads/missing.txt")
+		require.Error(t, err)
+	})
+
+	t.Run("copy then move to the blob", func(t *testing.T) {
+		exists, err := backend.BlobExists(contentHash)
+		require.NoError(t, err)
+		assert.False(t, exists)
+
+		blobPath, err := backend.CopyToBlob("uploads/a.txt", contentHash)
+		require.NoError(t, err)
+		assert.Equal(t, model.FileBlobPath(contentHash), blobPath)
+
+		exists, err = backend.FileExists("uploads/a.txt")
+		require.NoError(t, err)
+		assert.True(t, exists, "the copied file should be kept")
+
+		blobPath, err = backend.MoveToBlob("uploads/b.txt", contentHash)
+		require.NoError(t, err)
+		assert.Equal(t, model.FileBlobPath(contentHash), blobPath)
+
+		exists, err = backend.FileExists("uploads/b.txt")
+		require.NoError(t, err)
+		assert.False(t, exists, "the moved file should be removed")
+
+		data, err := backend.ReadFile(blobPath)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+	})
+
+	t.Run("remove the blob", func(t *testing.T) {
+		require.NoError(t, backend.RemoveBlob(contentHash))
+
+		exists, err := backend.BlobExists(contentHash)
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("invalid content hash", func(t *testing.T) {
+		_, err := backend.BlobExists("../../etc/passwd")
+		require.Error(t, err)
+		_, err = backend.MoveToBlob("uploads/a.txt", "short")
+		require.Error(t, err)
+		require.Error(t, backend.RemoveBlob(""))
+	})
+}
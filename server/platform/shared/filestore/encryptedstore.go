@@ -0,0 +1,727 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filestore
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+// Encrypted files start with a header holding the id of the key encrypting the data key, the data
+// key wrapped by that key, and the nonce prefix of the content. The content follows as a sequence of
+// chunks sealed with the data key, so that it can be read from any offset. Rotating the key only
+// requires rewriting the header.
+const (
+	encryptionMagic        = "MMFE"
+	encryptionVersion      = 1
+	encryptionChunkSize    = 64 * 1024
+	encryptionDataKeySize  = 32
+	encryptionNoncePrefix  = 8
+	encryptionRotateSuffix = ".rotate-"
+)
+
+var (
+	validEncryptionKeyID = regexp.MustCompile(`^[a-zA-Z0-9_.-]{1,64}$`)
+	encryptionTempPath   = regexp.MustCompile(regexp.QuoteMeta(encryptionRotateSuffix) + `[a-z0-9]{26}$`)
+)
+
+// newEncryptionTempPath returns a unique path next to path, to write its new content to before
+// replacing it, so that concurrent rewrites of the same file don't clobber each other.
+func newEncryptionTempPath(path string) string {
+	return path + encryptionRotateSuffix + model.NewId()
+}
+
+// EncryptionKeyRing holds the keys used to encrypt the data keys of the files. New files are
+// encrypted with the primary key, the others are kept to read files not yet rotated.
+type EncryptionKeyRing struct {
+	primaryID string
+	keys      map[string]cipher.AEAD
+}
+
+// ParseEncryptionKeyRing parses keys given as comma or newline separated id:base64 pairs, each
+// holding a 32 bytes key. The first key is the primary one.
+func ParseEncryptionKeyRing(data string) (*EncryptionKeyRing, error) {
+	ring := &EncryptionKeyRing{
+		keys: make(map[string]cipher.AEAD),
+	}
+
+	entries := strings.FieldsFunc(data, func(r rune) bool {
+		return r == ',' || r == '\n' || r == '\r'
+	})
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		id, encodedKey, ok := strings.Cut(entry, ":")
+		if !ok || !validEncryptionKeyID.MatchString(id) {
+			return nil, errors.New("encryption keys must be given as id:base64 pairs")
+		}
+
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to decode encryption key %s", id)
+		}
+		if len(key) != 32 {
+			return nil, errors.Errorf("encryption key %s must be 32 bytes long", id)
+		}
+
+		if _, ok := ring.keys[id]; ok {
+			return nil, errors.Errorf("duplicate encryption key %s", id)
+		}
+
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		ring.keys[id] = aead
+
+		if ring.primaryID == "" {
+			ring.primaryID = id
+		}
+	}
+
+	if ring.primaryID == "" {
+		return nil, errors.New("no encryption key found")
+	}
+
+	return ring, nil
+}
+
+// LoadEncryptionKeyRing reads the keys from keyFile, or from the keyEnvVar environment variable
+// when no file is given.
+func LoadEncryptionKeyRing(keyFile, keyEnvVar string) (*EncryptionKeyRing, error) {
+	if keyFile != "" {
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read the encryption key file %s", keyFile)
+		}
+		return ParseEncryptionKeyRing(string(data))
+	}
+
+	if keyEnvVar != "" {
+		data, ok := os.LookupEnv(keyEnvVar)
+		if !ok {
+			return nil, errors.Errorf("environment variable %s is not set", keyEnvVar)
+		}
+		return ParseEncryptionKeyRing(data)
+	}
+
+	return nil, errors.New("no encryption key file or environment variable configured")
+}
+
+// PrimaryKeyID returns the id of the key used to encrypt new files.
+func (r *EncryptionKeyRing) PrimaryKeyID() string {
+	return r.primaryID
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create the cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create the cipher")
+	}
+	return aead, nil
+}
+
+// encryptionHeader is the header of an encrypted file.
+type encryptionHeader struct {
+	keyID       string
+	wrappedKey  []byte
+	noncePrefix []byte
+
+	// dataKey is the unwrapped data key, when the key wrapping it is in the ring.
+	dataKey []byte
+}
+
+func (h *encryptionHeader) wrapAdditionalData() []byte {
+	return []byte(encryptionMagic + h.keyID)
+}
+
+func (h *encryptionHeader) marshal() []byte {
+	var buf bytes.Buffer
+	buf.WriteString(encryptionMagic)
+	buf.WriteByte(encryptionVersion)
+	buf.WriteByte(byte(len(h.keyID)))
+	buf.WriteString(h.keyID)
+	buf.Write(h.wrappedKey)
+	buf.Write(h.noncePrefix)
+	return buf.Bytes()
+}
+
+func (h *encryptionHeader) size() int64 {
+	return int64(len(encryptionMagic) + 2 + len(h.keyID) + len(h.wrappedKey) + len(h.noncePrefix))
+}
+
+// readEncryptionHeader reads the header of an encrypted file. It returns a nil header when the
+// file doesn't start with a well formed header.
+func readEncryptionHeader(r io.Reader, wrappedKeySize int) (*encryptionHeader, error) {
+	prefix := make([]byte, len(encryptionMagic)+2)
+	_, err := io.ReadFull(r, prefix)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "unable to read the encryption header")
+	}
+	if string(prefix[:len(encryptionMagic)]) != encryptionMagic || prefix[len(encryptionMagic)] != encryptionVersion {
+		return nil, nil
+	}
+
+	rest := make([]byte, int(prefix[len(prefix)-1])+wrappedKeySize+encryptionNoncePrefix)
+	if _, err := io.ReadFull(r, rest); err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "unable to read the encryption header")
+	}
+
+	keyIDLen := int(prefix[len(prefix)-1])
+	if !validEncryptionKeyID.Match(rest[:keyIDLen]) {
+		return nil, nil
+	}
+	return &encryptionHeader{
+		keyID:       string(rest[:keyIDLen]),
+		wrappedKey:  rest[keyIDLen : keyIDLen+wrappedKeySize],
+		noncePrefix: rest[keyIDLen+wrappedKeySize:],
+	}, nil
+}
+
+// EncryptedFileBackend is a FileBackend encrypting the files written to the wrapped backend.
+// Files written before encryption was enabled are read as is.
+type EncryptedFileBackend struct {
+	backend FileBackend
+	keys    *EncryptionKeyRing
+}
+
+var _ FileBackend = (*EncryptedFileBackend)(nil)
+
+func NewEncryptedFileBackend(backend FileBackend, keys *EncryptionKeyRing) *EncryptedFileBackend {
+	return &EncryptedFileBackend{
+		backend: backend,
+		keys:    keys,
+	}
+}
+
+// PrimaryKeyID returns the id of the key used to encrypt new files.
+func (b *EncryptedFileBackend) PrimaryKeyID() string {
+	return b.keys.PrimaryKeyID()
+}
+
+// wrappedKeySize is the size of a data key sealed by a key of the ring.
+func (b *EncryptedFileBackend) wrappedKeySize() int {
+	aead := b.keys.keys[b.keys.primaryID]
+	return aead.NonceSize() + encryptionDataKeySize + aead.Overhead()
+}
+
+func (b *EncryptedFileBackend) newHeader() (*encryptionHeader, cipher.AEAD, error) {
+	dataKey := make([]byte, encryptionDataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to generate a data key")
+	}
+
+	noncePrefix := make([]byte, encryptionNoncePrefix)
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to generate a nonce")
+	}
+
+	header := &encryptionHeader{
+		keyID:       b.keys.primaryID,
+		noncePrefix: noncePrefix,
+	}
+	if err := b.wrapKey(header, dataKey); err != nil {
+		return nil, nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return header, aead, nil
+}
+
+// wrapKey seals the data key with the primary key, updating the header accordingly.
+func (b *EncryptedFileBackend) wrapKey(header *encryptionHeader, dataKey []byte) error {
+	header.keyID = b.keys.primaryID
+	aead := b.keys.keys[header.keyID]
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return errors.Wrap(err, "unable to generate a nonce")
+	}
+	header.wrappedKey = aead.Seal(nonce, nonce, dataKey, header.wrapAdditionalData())
+
+	return nil
+}
+
+func (b *EncryptedFileBackend) unwrapKey(header *encryptionHeader) ([]byte, error) {
+	aead, ok := b.keys.keys[header.keyID]
+	if !ok {
+		return nil, errors.Errorf("unknown encryption key %s", header.keyID)
+	}
+
+	nonceSize := aead.NonceSize()
+	dataKey, err := aead.Open(nil, header.wrappedKey[:nonceSize], header.wrappedKey[nonceSize:], header.wrapAdditionalData())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to decrypt the data key with key %s", header.keyID)
+	}
+
+	return dataKey, nil
+}
+
+// openHeader reads the header of the file from r, returning a nil header and seeking back to
+// the start of the file when it isn't encrypted. As plain text may start like a header, a header
+// wrapped by a key of the ring is only accepted once its data key is authenticated. Headers wrapped
+// by other keys are accepted as is, so that files whose key was removed fail to be read.
+func (b *EncryptedFileBackend) openHeader(r ReadCloseSeeker) (*encryptionHeader, error) {
+	header, err := readEncryptionHeader(r, b.wrappedKeySize())
+	if err != nil {
+		return nil, err
+	}
+
+	if header != nil {
+		if _, ok := b.keys.keys[header.keyID]; ok {
+			if header.dataKey, err = b.unwrapKey(header); err != nil {
+				header = nil
+			}
+		}
+	}
+
+	if header == nil {
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return nil, errors.Wrap(err, "unable to seek to the start of the file")
+		}
+	}
+
+	return header, nil
+}
+
+func (b *EncryptedFileBackend) DriverName() string {
+	return b.backend.DriverName()
+}
+
+func (b *EncryptedFileBackend) TestConnection() error {
+	return b.backend.TestConnection()
+}
+
+func (b *EncryptedFileBackend) Reader(path string) (ReadCloseSeeker, error) {
+	r, err := b.backend.Reader(path)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := b.openHeader(r)
+	if err != nil {
+		r.Close()
+		return nil, errors.Wrapf(err, "unable to read the file %s", path)
+	}
+
+	if header == nil {
+		return r, nil
+	}
+
+	decrypter, err := b.newDecryptingReader(r, header)
+	if err != nil {
+		r.Close()
+		return nil, errors.Wrapf(err, "unable to decrypt the file %s", path)
+	}
+
+	return decrypter, nil
+}
+
+func (b *EncryptedFileBackend) ReadFile(path string) ([]byte, error) {
+	r, err := b.Reader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the file %s", path)
+	}
+
+	return data, nil
+}
+
+func (b *EncryptedFileBackend) FileExists(path string) (bool, error) {
+	return b.backend.FileExists(path)
+}
+
+func (b *EncryptedFileBackend) FileSize(path string) (int64, error) {
+	r, err := b.Reader(path)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	if decrypter, ok := r.(*decryptingReader); ok {
+		return decrypter.size, nil
+	}
+
+	return b.backend.FileSize(path)
+}
+
+func (b *EncryptedFileBackend) CopyFile(oldPath, newPath string) error {
+	return b.backend.CopyFile(oldPath, newPath)
+}
+
+func (b *EncryptedFileBackend) MoveFile(oldPath, newPath string) error {
+	return b.backend.MoveFile(oldPath, newPath)
+}
+
+func (b *EncryptedFileBackend) WriteFile(fr io.Reader, path string) (int64, error) {
+	return b.WriteFileContext(context.Background(), fr, path)
+}
+
+func (b *EncryptedFileBackend) WriteFileContext(ctx context.Context, fr io.Reader, path string) (int64, error) {
+	encrypter, err := b.newEncryptingReader(ctx, fr)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := TryWriteFileContext(ctx, b.backend, encrypter, path); err != nil {
+		return encrypter.written, err
+	}
+
+	return encrypter.written, nil
+}
+
+// AppendFile rewrites the whole file since the last chunk of the content has to be sealed again,
+// and the wrapped backends can't truncate a file. Building a file of size n from appends of size k
+// thus writes O(n²/k) bytes: resumable uploads should use large parts when encryption is enabled.
+func (b *EncryptedFileBackend) AppendFile(fr io.Reader, path string) (int64, error) {
+	r, err := b.Reader(path)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	existing, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, errors.Wrapf(err, "unable to read the file %s", path)
+	}
+	if _, err = r.Seek(0, io.SeekStart); err != nil {
+		return 0, errors.Wrapf(err, "unable to read the file %s", path)
+	}
+
+	tmpPath := newEncryptionTempPath(path)
+	written, err := b.WriteFile(io.MultiReader(r, fr), tmpPath)
+	if err != nil {
+		b.backend.RemoveFile(tmpPath)
+		return 0, errors.Wrapf(err, "unable to append to the file %s", path)
+	}
+
+	if err := b.backend.MoveFile(tmpPath, path); err != nil {
+		b.backend.RemoveFile(tmpPath)
+		return 0, errors.Wrapf(err, "unable to append to the file %s", path)
+	}
+
+	return written - existing, nil
+}
+
+func (b *EncryptedFileBackend) RemoveFile(path string) error {
+	return b.backend.RemoveFile(path)
+}
+
+func (b *EncryptedFileBackend) FileModTime(path string) (time.Time, error) {
+	return b.backend.FileModTime(path)
+}
+
+func (b *EncryptedFileBackend) ListDirectory(path string) ([]string, error) {
+	return b.backend.ListDirectory(path)
+}
+
+func (b *EncryptedFileBackend) ListDirectoryRecursively(path string) ([]string, error) {
+	return b.backend.ListDirectoryRecursively(path)
+}
+
+func (b *EncryptedFileBackend) RemoveDirectory(path string) error {
+	return b.backend.RemoveDirectory(path)
+}
+
+// RotateKey makes sure the file at path is encrypted with the primary key, encrypting it if it
+// was written before encryption was enabled. Only the header of encrypted files is rewritten.
+// It returns whether the file was changed.
+func (b *EncryptedFileBackend) RotateKey(path string) (bool, error) {
+	// Leftover of an interrupted rotation or append.
+	if encryptionTempPath.MatchString(path) {
+		return false, nil
+	}
+
+	r, err := b.backend.Reader(path)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	header, err := b.openHeader(r)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to read the file %s", path)
+	}
+
+	if header != nil && header.keyID == b.keys.primaryID {
+		return false, nil
+	}
+
+	tmpPath := newEncryptionTempPath(path)
+	if header == nil {
+		if _, err := b.WriteFile(r, tmpPath); err != nil {
+			b.backend.RemoveFile(tmpPath)
+			return false, errors.Wrapf(err, "unable to encrypt the file %s", path)
+		}
+	} else {
+		dataKey, err := b.unwrapKey(header)
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to rotate the key of the file %s", path)
+		}
+		if err := b.wrapKey(header, dataKey); err != nil {
+			return false, err
+		}
+
+		if _, err := b.backend.WriteFile(io.MultiReader(bytes.NewReader(header.marshal()), r), tmpPath); err != nil {
+			b.backend.RemoveFile(tmpPath)
+			return false, errors.Wrapf(err, "unable to rotate the key of the file %s", path)
+		}
+	}
+
+	if err := b.backend.MoveFile(tmpPath, path); err != nil {
+		b.backend.RemoveFile(tmpPath)
+		return false, errors.Wrapf(err, "unable to replace the file %s", path)
+	}
+
+	return true, nil
+}
+
+// chunkNonce returns the nonce of the chunk at the given index.
+func chunkNonce(aead cipher.AEAD, noncePrefix []byte, index int64) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, noncePrefix)
+	binary.BigEndian.PutUint32(nonce[len(noncePrefix):], uint32(index))
+	return nonce
+}
+
+// chunkAdditionalData marks the last chunk, so that a truncated file can't be read as a valid one.
+func chunkAdditionalData(last bool) []byte {
+	if last {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// encryptingReader reads the encrypted form of a plain text reader, failing once ctx is done so
+// that deadlines are honored whatever the wrapped backend.
+type encryptingReader struct {
+	ctx         context.Context
+	src         io.Reader
+	aead        cipher.AEAD
+	noncePrefix []byte
+
+	// pending holds encrypted data not yet read.
+	pending []byte
+	// next holds the plain text of the next chunk, read ahead to know if the current one is the last.
+	next    []byte
+	index   int64
+	done    bool
+	written int64
+}
+
+func (b *EncryptedFileBackend) newEncryptingReader(ctx context.Context, src io.Reader) (*encryptingReader, error) {
+	header, aead, err := b.newHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	r := &encryptingReader{
+		ctx:         ctx,
+		src:         src,
+		aead:        aead,
+		noncePrefix: header.noncePrefix,
+		pending:     header.marshal(),
+	}
+
+	if r.next, err = r.readChunk(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *encryptingReader) readChunk() ([]byte, error) {
+	chunk := make([]byte, encryptionChunkSize)
+	n, err := io.ReadFull(r.src, chunk)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	return chunk[:n], nil
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		chunk := r.next
+		var err error
+		if len(chunk) == encryptionChunkSize {
+			if r.next, err = r.readChunk(); err != nil {
+				return 0, err
+			}
+		} else {
+			r.next = nil
+		}
+
+		last := len(r.next) == 0
+		r.pending = r.aead.Seal(nil, chunkNonce(r.aead, r.noncePrefix, r.index), chunk, chunkAdditionalData(last))
+		r.written += int64(len(chunk))
+		r.index++
+		r.done = last
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// decryptingReader reads the plain text of an encrypted file.
+type decryptingReader struct {
+	src         ReadCloseSeeker
+	aead        cipher.AEAD
+	noncePrefix []byte
+	headerSize  int64
+	chunks      int64
+	size        int64
+	offset      int64
+
+	// chunk holds the plain text of the chunk at chunkIndex.
+	chunk      []byte
+	chunkIndex int64
+}
+
+func (b *EncryptedFileBackend) newDecryptingReader(src ReadCloseSeeker, header *encryptionHeader) (*decryptingReader, error) {
+	dataKey := header.dataKey
+	if dataKey == nil {
+		var err error
+		if dataKey, err = b.unwrapKey(header); err != nil {
+			return nil, err
+		}
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := src.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to get the file size")
+	}
+
+	sealedChunkSize := int64(encryptionChunkSize + aead.Overhead())
+	body := total - header.size()
+	if body < int64(aead.Overhead()) {
+		return nil, errors.New("the encrypted file is truncated")
+	}
+	chunks := (body + sealedChunkSize - 1) / sealedChunkSize
+
+	return &decryptingReader{
+		src:         src,
+		aead:        aead,
+		noncePrefix: header.noncePrefix,
+		headerSize:  header.size(),
+		chunks:      chunks,
+		size:        body - chunks*int64(aead.Overhead()),
+		chunkIndex:  -1,
+	}, nil
+}
+
+func (r *decryptingReader) loadChunk(index int64) error {
+	if index == r.chunkIndex {
+		return nil
+	}
+
+	sealedChunkSize := int64(encryptionChunkSize + r.aead.Overhead())
+	if _, err := r.src.Seek(r.headerSize+index*sealedChunkSize, io.SeekStart); err != nil {
+		return errors.Wrap(err, "unable to seek to the chunk")
+	}
+
+	sealed := make([]byte, sealedChunkSize)
+	n, err := io.ReadFull(r.src, sealed)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return errors.Wrap(err, "unable to read the chunk")
+	}
+
+	last := index == r.chunks-1
+	chunk, err := r.aead.Open(sealed[:0], chunkNonce(r.aead, r.noncePrefix, index), sealed[:n], chunkAdditionalData(last))
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt the chunk")
+	}
+
+	r.chunk = chunk
+	r.chunkIndex = index
+	return nil
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	index := r.offset / encryptionChunkSize
+	if err := r.loadChunk(index); err != nil {
+		return 0, err
+	}
+
+	n := copy(p, r.chunk[r.offset-index*encryptionChunkSize:])
+	r.offset += int64(n)
+	return n, nil
+}
+
+func (r *decryptingReader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		abs = r.size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+
+	if abs < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	r.offset = abs
+	return abs, nil
+}
+
+func (r *decryptingReader) Close() error {
+	return r.src.Close()
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package filestore
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEncryptionKey(t *testing.T, id string) string {
+	t.Helper()
+
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+
+	return id + ":" + base64.StdEncoding.EncodeToString(key)
+}
+
+func newTestEncryptedFileBackend(t *testing.T, dir string, keys string) *EncryptedFileBackend {
+	t.Helper()
+
+	ring, err := ParseEncryptionKeyRing(keys)
+	require.NoError(t, err)
+
+	return NewEncryptedFileBackend(&LocalFileBackend{directory: dir}, ring)
+}
+
+func TestParseEncryptionKeyRing(t *testing.T) {
+	key1 := newTestEncryptionKey(t, "key1")
+	key2 := newTestEncryptionKey(t, "key2")
+
+	t.Run("comma separated", func(t *testing.T) {
+		ring, err := ParseEncryptionKeyRing(key2 + "," + key1)
+		require.NoError(t, err)
+		assert.Equal(t, "key2", ring.PrimaryKeyID())
+		assert.Len(t, ring.keys, 2)
+	})
+
+	t.Run("newline separated with comments", func(t *testing.T) {
+		ring, err := ParseEncryptionKeyRing("# current key\n" + key1 + "\n\n" + key2 + "\n")
+		require.NoError(t, err)
+		assert.Equal(t, "key1", ring.PrimaryKeyID())
+		assert.Len(t, ring.keys, 2)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, keys := range map[string]string{
+			"empty":      "",
+			"no id":      base64.StdEncoding.EncodeToString(make([]byte, 32)),
+			"invalid id": "key 1:" + base64.StdEncoding.EncodeToString(make([]byte, 32)),
+			"not base64": "key1:not base64!",
+			"short key":  "key1:" + base64.StdEncoding.EncodeToString(make([]byte, 16)),
+			"duplicate":  key1 + "," + key1,
+		} {
+			_, err := ParseEncryptionKeyRing(keys)
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestLoadEncryptionKeyRing(t *testing.T) {
+	key1 := newTestEncryptionKey(t, "key1")
+
+	t.Run("key file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "keys")
+		require.NoError(t, os.WriteFile(path, []byte(key1+"\n"), 0600))
+
+		ring, err := LoadEncryptionKeyRing(path, "MM_TEST_UNSET_KEYS")
+		require.NoError(t, err)
+		assert.Equal(t, "key1", ring.PrimaryKeyID())
+	})
+
+	t.Run("environment variable", func(t *testing.T) {
+		t.Setenv("MM_TEST_FILE_ENCRYPTION_KEYS", key1)
+
+		ring, err := LoadEncryptionKeyRing("", "MM_TEST_FILE_ENCRYPTION_KEYS")
+		require.NoError(t, err)
+		assert.Equal(t, "key1", ring.PrimaryKeyID())
+	})
+
+	t.Run("unset environment variable", func(t *testing.T) {
+		_, err := LoadEncryptionKeyRing("", "MM_TEST_UNSET_KEYS")
+		assert.Error(t, err)
+	})
+
+	t.Run("no source", func(t *testing.T) {
+		_, err := LoadEncryptionKeyRing("", "")
+		assert.Error(t, err)
+	})
+}
+
+func TestEncryptedFileBackend(t *testing.T) {
+	dir := t.TempDir()
+	key1 := newTestEncryptionKey(t, "key1")
+	backend := newTestEncryptedFileBackend(t, dir, key1)
+
+	data := make([]byte, 3*encryptionChunkSize+100)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	written, err := backend.WriteFile(bytes.NewReader(data), "tests/large")
+	require.NoError(t, err)
+	assert.EqualValues(t, len(data), written)
+
+	t.Run("stored encrypted", func(t *testing.T) {
+		stored, err := os.ReadFile(filepath.Join(dir, "tests/large"))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(stored, []byte(encryptionMagic)))
+		assert.False(t, bytes.Contains(stored, data[:64]))
+	})
+
+	t.Run("read", func(t *testing.T) {
+		read, err := backend.ReadFile("tests/large")
+		require.NoError(t, err)
+		assert.Equal(t, data, read)
+
+		size, err := backend.FileSize("tests/large")
+		require.NoError(t, err)
+		assert.EqualValues(t, len(data), size)
+	})
+
+	t.Run("seek", func(t *testing.T) {
+		r, err := backend.Reader("tests/large")
+		require.NoError(t, err)
+		defer r.Close()
+
+		for _, offset := range []int64{encryptionChunkSize - 10, 2*encryptionChunkSize + 5, 10} {
+			pos, err := r.Seek(offset, io.SeekStart)
+			require.NoError(t, err)
+			assert.Equal(t, offset, pos)
+
+			buf := make([]byte, 50)
+			_, err = io.ReadFull(r, buf)
+			require.NoError(t, err)
+			assert.Equal(t, data[offset:offset+50], buf)
+		}
+
+		end, err := r.Seek(0, io.SeekEnd)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(data), end)
+	})
+
+	t.Run("exact chunk multiple and empty files", func(t *testing.T) {
+		for _, size := range []int{0, encryptionChunkSize, 2 * encryptionChunkSize} {
+			content := data[:size]
+			_, err := backend.WriteFile(bytes.NewReader(content), "tests/sized")
+			require.NoError(t, err)
+
+			read, err := backend.ReadFile("tests/sized")
+			require.NoError(t, err)
+			assert.Equal(t, len(content), len(read))
+			assert.True(t, bytes.Equal(content, read))
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		stored, err := os.ReadFile(filepath.Join(dir, "tests/large"))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "tests/truncated"), stored[:len(stored)-encryptionChunkSize/2], 0600))
+
+		_, err = backend.ReadFile("tests/truncated")
+		assert.Error(t, err)
+	})
+
+	t.Run("plain text", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "tests/plain"), []byte("plain text"), 0600))
+
+		read, err := backend.ReadFile("tests/plain")
+		require.NoError(t, err)
+		assert.Equal(t, "plain text", string(read))
+	})
+
+	t.Run("plain text starting like a header", func(t *testing.T) {
+		header, _, err := backend.newHeader()
+		require.NoError(t, err)
+		content := append(header.marshal(), []byte("not encrypted")...)
+		// the wrapped key doesn't authenticate anymore.
+		content[len(encryptionMagic)+2+len(header.keyID)] ^= 0xff
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "tests/fake"), content, 0600))
+
+		read, err := backend.ReadFile("tests/fake")
+		require.NoError(t, err)
+		assert.Equal(t, content, read)
+
+		short := []byte(encryptionMagic + "\x01\x04key1")
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "tests/short"), short, 0600))
+		read, err = backend.ReadFile("tests/short")
+		require.NoError(t, err)
+		assert.Equal(t, short, read)
+	})
+
+	t.Run("append", func(t *testing.T) {
+		_, err := backend.WriteFile(bytes.NewReader([]byte("hello")), "tests/append")
+		require.NoError(t, err)
+
+		written, err := backend.AppendFile(bytes.NewReader([]byte(" world")), "tests/append")
+		require.NoError(t, err)
+		assert.EqualValues(t, 6, written)
+
+		read, err := backend.ReadFile("tests/append")
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(read))
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		other := newTestEncryptedFileBackend(t, dir, newTestEncryptionKey(t, "key2"))
+
+		_, err := other.ReadFile("tests/large")
+		assert.Error(t, err)
+	})
+}
+
+func TestEncryptedFileBackendRotateKey(t *testing.T) {
+	dir := t.TempDir()
+	key1 := newTestEncryptionKey(t, "key1")
+	key2 := newTestEncryptionKey(t, "key2")
+
+	oldBackend := newTestEncryptedFileBackend(t, dir, key1)
+	_, err := oldBackend.WriteFile(bytes.NewReader([]byte("encrypted with key1")), "tests/encrypted")
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "tests/plain"), []byte("plain text"), 0600))
+
+	backend := newTestEncryptedFileBackend(t, dir, key2+","+key1)
+
+	changed, err := backend.RotateKey("tests/encrypted")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = backend.RotateKey("tests/plain")
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = backend.RotateKey("tests/encrypted")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	// The old key is no longer needed.
+	newBackend := newTestEncryptedFileBackend(t, dir, key2)
+
+	read, err := newBackend.ReadFile("tests/encrypted")
+	require.NoError(t, err)
+	assert.Equal(t, "encrypted with key1", string(read))
+
+	read, err = newBackend.ReadFile("tests/plain")
+	require.NoError(t, err)
+	assert.Equal(t, "plain text", string(read))
+
+	_, err = oldBackend.ReadFile("tests/encrypted")
+	assert.Error(t, err)
+
+	files, err := backend.ListDirectory("tests")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"tests/encrypted", "tests/plain"}, files, "no temporary file should be left")
+
+	changed, err = backend.RotateKey(newEncryptionTempPath("tests/plain"))
+	require.NoError(t, err)
+	assert.False(t, changed, "leftover temporary files should be skipped")
+}
@@ -64,13 +64,19 @@ type FileBackendSettings struct {
 	AmazonS3PresignExpiresSeconds      int64
 	AmazonS3UploadPartSizeBytes        int64
 	AmazonS3StorageClass               string
+	EnableAtRestEncryption             bool
+	AtRestEncryptionKeyFile            string
+	AtRestEncryptionKeyEnvVar          string
 }
 
 func NewFileBackendSettingsFromConfig(fileSettings *model.FileSettings, enableComplianceFeature bool, skipVerify bool) FileBackendSettings {
 	if *fileSettings.DriverName == model.ImageDriverLocal {
 		return FileBackendSettings{
-			DriverName: *fileSettings.DriverName,
-			Directory:  *fileSettings.Directory,
+			DriverName:                *fileSettings.DriverName,
+			Directory:                 *fileSettings.Directory,
+			EnableAtRestEncryption:    *fileSettings.EnableAtRestEncryption,
+			AtRestEncryptionKeyFile:   *fileSettings.AtRestEncryptionKeyFile,
+			AtRestEncryptionKeyEnvVar: *fileSettings.AtRestEncryptionKeyEnvVar,
 		}
 	}
 	return FileBackendSettings{
@@ -89,6 +95,9 @@ func NewFileBackendSettingsFromConfig(fileSettings *model.FileSettings, enableCo
 		SkipVerify:                         skipVerify,
 		AmazonS3UploadPartSizeBytes:        *fileSettings.AmazonS3UploadPartSizeBytes,
 		AmazonS3StorageClass:               *fileSettings.AmazonS3StorageClass,
+		EnableAtRestEncryption:             *fileSettings.EnableAtRestEncryption,
+		AtRestEncryptionKeyFile:            *fileSettings.AtRestEncryptionKeyFile,
+		AtRestEncryptionKeyEnvVar:          *fileSettings.AtRestEncryptionKeyEnvVar,
 	}
 }
 
@@ -143,6 +152,24 @@ func NewExportFileBackend(settings FileBackendSettings) (FileBackend, error) {
 }
 
 func newFileBackend(settings FileBackendSettings, canBeCloud bool) (FileBackend, error) {
+	backend, err := newDriverFileBackend(settings, canBeCloud)
+	if err != nil {
+		return nil, err
+	}
+
+	if !settings.EnableAtRestEncryption {
+		return backend, nil
+	}
+
+	keys, err := LoadEncryptionKeyRing(settings.AtRestEncryptionKeyFile, settings.AtRestEncryptionKeyEnvVar)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to load the file encryption keys")
+	}
+
+	return NewEncryptedFileBackend(backend, keys), nil
+}
+
+func newDriverFileBackend(settings FileBackendSettings, canBeCloud bool) (FileBackend, error) {
 	switch settings.DriverName {
 	case driverS3:
 		newBackendFn := NewS3FileBackend
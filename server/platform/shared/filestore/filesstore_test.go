@@ -6,6 +6,7 @@ package filestore
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"math"
@@ -53,6 +54,23 @@ func TestLocalFileBackendTestSuite(t *testing.T) {
 	})
 }
 
+func TestLocalEncryptedFileBackendTestSuite(t *testing.T) {
+	dir, err := os.MkdirTemp("", "")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Setenv("MM_TEST_FILE_ENCRYPTION_KEYS", "key1:"+base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+
+	suite.Run(t, &FileBackendTestSuite{
+		settings: FileBackendSettings{
+			DriverName:                driverLocal,
+			Directory:                 dir,
+			EnableAtRestEncryption:    true,
+			AtRestEncryptionKeyEnvVar: "MM_TEST_FILE_ENCRYPTION_KEYS",
+		},
+	})
+}
+
 func TestS3FileBackendTestSuite(t *testing.T) {
 	runBackendTest(t, false)
 }
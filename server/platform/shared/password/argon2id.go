@@ -0,0 +1,105 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"strconv"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idID = "argon2id"
+
+	argon2idSaltLength = 16
+	argon2idKeyLength  = 32
+)
+
+// Argon2id hashes passwords with argon2id, as recommended by OWASP. Memory is given in KiB.
+type Argon2id struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+}
+
+func (a *Argon2id) Hash(password string) (string, error) {
+	salt := make([]byte, argon2idSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+
+	p := &phc{
+		ID:      argon2idID,
+		Version: argon2.Version,
+		Params: map[string]string{
+			"m": strconv.FormatUint(uint64(a.Memory), 10),
+			"t": strconv.FormatUint(uint64(a.Iterations), 10),
+			"p": strconv.FormatUint(uint64(a.Parallelism), 10),
+		},
+		Salt: salt,
+		Hash: argon2.IDKey([]byte(password), salt, a.Iterations, a.Memory, a.Parallelism, argon2idKeyLength),
+	}
+	return p.String(), nil
+}
+
+// Compare checks the password with the parameters stored in the hash, not the ones of the hasher.
+func (a *Argon2id) Compare(hash, password string) error {
+	p, params, err := parseArgon2id(hash)
+	if err != nil {
+		return err
+	}
+
+	key := argon2.IDKey([]byte(password), p.Salt, params.Iterations, params.Memory, params.Parallelism, uint32(len(p.Hash)))
+	if subtle.ConstantTimeCompare(key, p.Hash) != 1 {
+		return ErrMismatchedHashAndPassword
+	}
+	return nil
+}
+
+func (a *Argon2id) NeedsRehash(hash string) bool {
+	_, params, err := parseArgon2id(hash)
+	if err != nil {
+		return true
+	}
+
+	return *params != *a
+}
+
+func parseArgon2id(hash string) (*phc, *Argon2id, error) {
+	p, err := parsePHC(hash)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p.ID != argon2idID {
+		return nil, nil, ErrUnknownHash
+	}
+	if p.Version != argon2.Version {
+		return nil, nil, fmt.Errorf("unsupported argon2id version %d", p.Version)
+	}
+	if len(p.Salt) == 0 || len(p.Hash) == 0 {
+		return nil, nil, fmt.Errorf("missing argon2id salt or hash")
+	}
+
+	memory, err := strconv.ParseUint(p.Params["m"], 10, 32)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid argon2id memory: %w", err)
+	}
+	iterations, err := strconv.ParseUint(p.Params["t"], 10, 32)
+	if err != nil || iterations == 0 {
+		return nil, nil, fmt.Errorf("invalid argon2id iterations %q", p.Params["t"])
+	}
+	parallelism, err := strconv.ParseUint(p.Params["p"], 10, 8)
+	if err != nil || parallelism == 0 {
+		return nil, nil, fmt.Errorf("invalid argon2id parallelism %q", p.Params["p"])
+	}
+
+	return p, &Argon2id{
+		Memory:      uint32(memory),
+		Iterations:  uint32(iterations),
+		Parallelism: uint8(parallelism),
+	}, nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// IsBreached tells whether the password is listed in the breached passwords file at path.
+//
+// The file holds one SHA-1 hash per line in hexadecimal, optionally followed by ":" and the
+// number of times it was seen, sorted by hash. This is the format of the hash lists published
+// by Have I Been Pwned, so its k-anonymity ranges can be downloaded and concatenated to build
+// the file without ever sending a password, or its hash, to a third party. The file is searched
+// in place, so it isn't loaded in memory.
+func IsBreached(path, password string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to open breached passwords file: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return false, fmt.Errorf("failed to stat breached passwords file: %w", err)
+	}
+
+	sum := sha1.Sum([]byte(password))
+	target := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	// Binary search over byte offsets, keeping the invariant that the line of the target,
+	// if any, starts in [lo, hi).
+	lo, hi := int64(0), info.Size()
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+
+		start, line, err := lineAfter(f, mid)
+		if errors.Is(err, io.EOF) || start >= hi {
+			hi = mid
+			continue
+		} else if err != nil {
+			return false, fmt.Errorf("failed to read breached passwords file: %w", err)
+		}
+
+		hash, _, _ := strings.Cut(strings.TrimRight(line, "\r\n"), ":")
+		switch hash = strings.ToUpper(hash); {
+		case hash == target:
+			return true, nil
+		case hash < target:
+			lo = start + int64(len(line))
+		default:
+			hi = mid
+		}
+	}
+
+	return false, nil
+}
+
+// lineAfter returns the first line of f starting at offset or later, along with its offset.
+func lineAfter(f *os.File, offset int64) (int64, string, error) {
+	start := offset
+	if offset > 0 {
+		start--
+	}
+	if _, err := f.Seek(start, io.SeekStart); err != nil {
+		return 0, "", err
+	}
+
+	r := bufio.NewReader(f)
+	if offset > 0 {
+		// Skip the rest of the line the previous byte belongs to. If that byte is a newline,
+		// the line starting at offset is the one we want.
+		skipped, err := r.ReadString('\n')
+		if err != nil {
+			return 0, "", io.EOF
+		}
+		start += int64(len(skipped))
+	}
+
+	line, err := r.ReadString('\n')
+	if line == "" {
+		if err == nil {
+			err = io.EOF
+		}
+		return 0, "", err
+	}
+	return start, line, nil
+}
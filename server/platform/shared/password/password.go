@@ -0,0 +1,64 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package password hashes and verifies user passwords.
+//
+// Hashes are stored in the PHC string format ($id$v=version$params$salt$hash), except for
+// bcrypt, which keeps its own modular crypt format so existing hashes stay valid.
+package password
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+var (
+	// ErrMismatchedHashAndPassword is returned when the password doesn't match the hash.
+	ErrMismatchedHashAndPassword = errors.New("hash and password don't match")
+	// ErrUnknownHash is returned when the hash wasn't generated by a supported algorithm.
+	ErrUnknownHash = errors.New("unknown password hash format")
+	// ErrEmptyPassword is returned when the password or the hash are empty.
+	ErrEmptyPassword = errors.New("empty password or hash")
+)
+
+// Hasher hashes passwords with a single algorithm and set of parameters.
+type Hasher interface {
+	// Hash returns the hash of the password, including the algorithm, its parameters and a random salt.
+	Hash(password string) (string, error)
+	// Compare checks the password against a hash generated by this hasher.
+	Compare(hash, password string) error
+	// NeedsRehash tells whether the hash wasn't generated by this hasher or with weaker parameters,
+	// in which case it should be replaced next time the password is known.
+	NeedsRehash(hash string) bool
+}
+
+// NewHasher returns the hasher configured in the password settings.
+func NewHasher(settings *model.PasswordSettings) Hasher {
+	if settings.HashingAlgorithm != nil && *settings.HashingAlgorithm == model.PasswordHashingAlgorithmArgon2id {
+		return &Argon2id{
+			Memory:      uint32(*settings.Argon2idMemoryKiB),
+			Iterations:  uint32(*settings.Argon2idIterations),
+			Parallelism: uint8(*settings.Argon2idParallelism),
+		}
+	}
+
+	return &Bcrypt{Cost: BcryptDefaultCost}
+}
+
+// Compare checks the password against a hash generated by any of the supported algorithms.
+func Compare(hash, password string) error {
+	if password == "" || hash == "" {
+		return ErrEmptyPassword
+	}
+
+	switch {
+	case isBcryptHash(hash):
+		return (&Bcrypt{}).Compare(hash, password)
+	case strings.HasPrefix(hash, "$"+argon2idID+"$"):
+		return (&Argon2id{}).Compare(hash, password)
+	}
+
+	return ErrUnknownHash
+}
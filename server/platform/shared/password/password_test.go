@@ -0,0 +1,173 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package password
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func newTestSettings(algorithm string) *model.PasswordSettings {
+	settings := &model.PasswordSettings{HashingAlgorithm: model.NewPointer(algorithm)}
+	settings.SetDefaults()
+	// Keep the tests fast.
+	*settings.Argon2idMemoryKiB = 64
+	*settings.Argon2idIterations = 1
+	return settings
+}
+
+func TestHashers(t *testing.T) {
+	for _, algorithm := range []string{model.PasswordHashingAlgorithmBcrypt, model.PasswordHashingAlgorithmArgon2id} {
+		t.Run(algorithm, func(t *testing.T) {
+			hasher := NewHasher(newTestSettings(algorithm))
+
+			hash, err := hasher.Hash("Passw0rd!")
+			require.NoError(t, err)
+			assert.NotContains(t, hash, "Passw0rd!")
+
+			other, err := hasher.Hash("Passw0rd!")
+			require.NoError(t, err)
+			assert.NotEqual(t, hash, other, "hashes should be salted")
+
+			require.NoError(t, hasher.Compare(hash, "Passw0rd!"))
+			require.NoError(t, Compare(hash, "Passw0rd!"))
+			assert.ErrorIs(t, hasher.Compare(hash, "passw0rd!"), ErrMismatchedHashAndPassword)
+			assert.ErrorIs(t, Compare(hash, "passw0rd!"), ErrMismatchedHashAndPassword)
+			assert.False(t, hasher.NeedsRehash(hash))
+		})
+	}
+
+	t.Run("empty password or hash", func(t *testing.T) {
+		assert.ErrorIs(t, Compare("", "Passw0rd!"), ErrEmptyPassword)
+		assert.ErrorIs(t, Compare("$2a$10$abc", ""), ErrEmptyPassword)
+	})
+
+	t.Run("unknown hash", func(t *testing.T) {
+		assert.ErrorIs(t, Compare("Passw0rd!", "Passw0rd!"), ErrUnknownHash)
+		assert.ErrorIs(t, Compare("$scrypt$ln=15,r=8,p=1$c2FsdA$aGFzaA", "Passw0rd!"), ErrUnknownHash)
+	})
+}
+
+func TestArgon2id(t *testing.T) {
+	t.Run("hash format", func(t *testing.T) {
+		hash, err := (&Argon2id{Memory: 64, Iterations: 3, Parallelism: 2}).Hash("Passw0rd!")
+		require.NoError(t, err)
+
+		fields := strings.Split(hash, "$")
+		require.Len(t, fields, 6)
+		assert.Equal(t, "argon2id", fields[1])
+		assert.Equal(t, "v=19", fields[2])
+		assert.Equal(t, "m=64,t=3,p=2", fields[3])
+		assert.Len(t, fields[4], 22)
+		assert.Len(t, fields[5], 43)
+	})
+
+	t.Run("rehash when the parameters change", func(t *testing.T) {
+		settings := newTestSettings(model.PasswordHashingAlgorithmArgon2id)
+		hash, err := NewHasher(settings).Hash("Passw0rd!")
+		require.NoError(t, err)
+
+		*settings.Argon2idIterations = 2
+		hasher := NewHasher(settings)
+		assert.True(t, hasher.NeedsRehash(hash))
+		require.NoError(t, hasher.Compare(hash, "Passw0rd!"), "old parameters should still be accepted")
+	})
+
+	t.Run("rehash bcrypt hashes", func(t *testing.T) {
+		hash, err := model.HashPassword("Passw0rd!")
+		require.NoError(t, err)
+
+		assert.True(t, NewHasher(newTestSettings(model.PasswordHashingAlgorithmArgon2id)).NeedsRehash(hash))
+		assert.False(t, NewHasher(newTestSettings(model.PasswordHashingAlgorithmBcrypt)).NeedsRehash(hash))
+	})
+
+	t.Run("invalid hashes", func(t *testing.T) {
+		for _, hash := range []string{
+			"$argon2id$v=19$m=64,t=1,p=1$c29tZXNhbHQ",
+			"$argon2id$v=16$m=64,t=1,p=1$c29tZXNhbHQ$aGFzaA",
+			"$argon2id$v=19$m=64,t=0,p=1$c29tZXNhbHQ$aGFzaA",
+			"$argon2id$v=19$m=64,t=1$c29tZXNhbHQ$aGFzaA",
+			"$argon2id$v=19$m=64,t=1,p=1$!!!$aGFzaA",
+		} {
+			assert.Error(t, Compare(hash, "Passw0rd!"), hash)
+			assert.True(t, (&Argon2id{}).NeedsRehash(hash), hash)
+		}
+	})
+}
+
+func TestIsBreached(t *testing.T) {
+	breached := []string{"123456", "password", "qwerty", "letmein", "Passw0rd!"}
+
+	var lines []string
+	for i, password := range breached {
+		sum := sha1.Sum([]byte(password))
+		lines = append(lines, fmt.Sprintf("%s:%d", strings.ToUpper(hex.EncodeToString(sum[:])), i+1))
+	}
+	for i := 0; i < 200; i++ {
+		sum := sha1.Sum([]byte(fmt.Sprintf("filler-%d", i)))
+		lines = append(lines, strings.ToUpper(hex.EncodeToString(sum[:])))
+	}
+	sort.Strings(lines)
+
+	writeFile := func(t *testing.T, content string) string {
+		path := filepath.Join(t.TempDir(), "pwned-passwords.txt")
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+		return path
+	}
+
+	for name, path := range map[string]string{
+		"unix line endings":    writeFile(t, strings.Join(lines, "\n")+"\n"),
+		"windows line endings": writeFile(t, strings.Join(lines, "\r\n")+"\r\n"),
+		"no trailing newline":  writeFile(t, strings.Join(lines, "\n")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for _, password := range breached {
+				ok, err := IsBreached(path, password)
+				require.NoError(t, err)
+				assert.True(t, ok, password)
+			}
+
+			for _, password := range []string{"correct horse battery staple", "Password!", ""} {
+				ok, err := IsBreached(path, password)
+				require.NoError(t, err)
+				assert.False(t, ok, password)
+			}
+		})
+	}
+
+	t.Run("single line", func(t *testing.T) {
+		sum := sha1.Sum([]byte("qwerty"))
+		path := writeFile(t, hex.EncodeToString(sum[:])+"\n")
+
+		ok, err := IsBreached(path, "qwerty")
+		require.NoError(t, err)
+		assert.True(t, ok, "lowercase hashes should be accepted")
+
+		ok, err = IsBreached(path, "123456")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		ok, err := IsBreached(writeFile(t, ""), "qwerty")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		_, err := IsBreached(filepath.Join(t.TempDir(), "missing.txt"), "qwerty")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package password
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// phc is a hash in the PHC string format:
+//
+//	$<id>[$v=<version>][$<param>=<value>(,<param>=<value>)*][$<salt>[$<hash>]]
+//
+// See https://github.com/P-H-C/phc-string-format/blob/master/phc-sf-spec.md.
+type phc struct {
+	ID      string
+	Version int
+	Params  map[string]string
+	Salt    []byte
+	Hash    []byte
+}
+
+// phcEncoding is the base64 encoding used by the PHC format for salts and hashes.
+var phcEncoding = base64.RawStdEncoding
+
+func (p *phc) String() string {
+	var sb strings.Builder
+	sb.WriteString("$" + p.ID)
+	if p.Version != 0 {
+		sb.WriteString("$v=" + strconv.Itoa(p.Version))
+	}
+	if len(p.Params) > 0 {
+		sb.WriteString("$")
+		for i, name := range p.paramNames() {
+			if i > 0 {
+				sb.WriteString(",")
+			}
+			sb.WriteString(name + "=" + p.Params[name])
+		}
+	}
+	sb.WriteString("$" + phcEncoding.EncodeToString(p.Salt))
+	sb.WriteString("$" + phcEncoding.EncodeToString(p.Hash))
+	return sb.String()
+}
+
+// paramNames returns the names of the parameters, keeping the conventional m,t,p order of argon2
+// first and sorting any other name after them so the output is stable.
+func (p *phc) paramNames() []string {
+	rank := func(name string) int {
+		switch name {
+		case "m":
+			return 0
+		case "t":
+			return 1
+		case "p":
+			return 2
+		}
+		return 3
+	}
+
+	names := make([]string, 0, len(p.Params))
+	for name := range p.Params {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if rank(names[i]) != rank(names[j]) {
+			return rank(names[i]) < rank(names[j])
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// parsePHC parses a hash in the PHC string format. The salt and the hash are required.
+func parsePHC(s string) (*phc, error) {
+	fields := strings.Split(s, "$")
+	if len(fields) < 4 || fields[0] != "" || fields[1] == "" {
+		return nil, ErrUnknownHash
+	}
+
+	p := &phc{ID: fields[1], Params: map[string]string{}}
+	fields = fields[2:]
+
+	if strings.HasPrefix(fields[0], "v=") {
+		version, err := strconv.Atoi(strings.TrimPrefix(fields[0], "v="))
+		if err != nil {
+			return nil, fmt.Errorf("invalid version: %w", err)
+		}
+		p.Version = version
+		fields = fields[1:]
+	}
+
+	if len(fields) == 3 {
+		for _, param := range strings.Split(fields[0], ",") {
+			name, value, ok := strings.Cut(param, "=")
+			if !ok || name == "" {
+				return nil, fmt.Errorf("invalid parameter %q", param)
+			}
+			p.Params[name] = value
+		}
+		fields = fields[1:]
+	}
+
+	if len(fields) != 2 {
+		return nil, ErrUnknownHash
+	}
+
+	var err error
+	if p.Salt, err = phcEncoding.DecodeString(fields[0]); err != nil {
+		return nil, fmt.Errorf("invalid salt: %w", err)
+	}
+	if p.Hash, err = phcEncoding.DecodeString(fields[1]); err != nil {
+		return nil, fmt.Errorf("invalid hash: %w", err)
+	}
+	return p, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package webauthn
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	cborMajorUnsigned = 0
+	cborMajorNegative = 1
+	cborMajorBytes    = 2
+	cborMajorText     = 3
+	cborMajorArray    = 4
+	cborMajorMap      = 5
+	cborMajorTag      = 6
+	cborMajorSimple   = 7
+
+	// cborMaxDepth bounds the nesting of the items sent by authenticators, which is shallow.
+	cborMaxDepth = 16
+)
+
+// decodeCBOR decodes the first CBOR item of data and returns it along with the bytes that follow it.
+// It supports the subset of CBOR used by WebAuthn: integers, byte and text strings, arrays, maps,
+// booleans and null, all of definite length. Integers are returned as int64, maps as map[any]any.
+func decodeCBOR(data []byte) (any, []byte, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (any, []byte, error) {
+	if depth > cborMaxDepth {
+		return nil, nil, errors.New("cbor: maximum nesting depth exceeded")
+	}
+	if len(data) == 0 {
+		return nil, nil, errors.New("cbor: unexpected end of data")
+	}
+
+	major := data[0] >> 5
+	info := data[0] & 0x1f
+	data = data[1:]
+
+	if major == cborMajorSimple {
+		switch info {
+		case 20:
+			return false, data, nil
+		case 21:
+			return true, data, nil
+		case 22, 23:
+			return nil, data, nil
+		default:
+			return nil, nil, errors.Errorf("cbor: unsupported simple value %d", info)
+		}
+	}
+
+	arg, data, err := decodeCBORArgument(info, data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case cborMajorUnsigned:
+		if arg > 1<<63-1 {
+			return nil, nil, errors.New("cbor: integer overflow")
+		}
+		return int64(arg), data, nil
+
+	case cborMajorNegative:
+		if arg > 1<<63-1 {
+			return nil, nil, errors.New("cbor: integer overflow")
+		}
+		return -1 - int64(arg), data, nil
+
+	case cborMajorBytes, cborMajorText:
+		if arg > uint64(len(data)) {
+			return nil, nil, errors.New("cbor: unexpected end of data")
+		}
+		value := data[:arg]
+		if major == cborMajorText {
+			return string(value), data[arg:], nil
+		}
+		return append([]byte(nil), value...), data[arg:], nil
+
+	case cborMajorArray:
+		// Every item takes at least a byte, which bounds the allocation.
+		if arg > uint64(len(data)) {
+			return nil, nil, errors.New("cbor: unexpected end of data")
+		}
+		items := make([]any, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			var item any
+			if item, data, err = decodeCBORItem(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, data, nil
+
+	case cborMajorMap:
+		if arg > uint64(len(data)) {
+			return nil, nil, errors.New("cbor: unexpected end of data")
+		}
+		items := make(map[any]any, arg)
+		for i := uint64(0); i < arg; i++ {
+			var key, value any
+			if key, data, err = decodeCBORItem(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, errors.New("cbor: unsupported map key type")
+			}
+			if value, data, err = decodeCBORItem(data, depth+1); err != nil {
+				return nil, nil, err
+			}
+			if _, ok := items[key]; ok {
+				return nil, nil, errors.New("cbor: duplicate map key")
+			}
+			items[key] = value
+		}
+		return items, data, nil
+
+	case cborMajorTag:
+		return decodeCBORItem(data, depth+1)
+	}
+
+	return nil, nil, errors.Errorf("cbor: unsupported major type %d", major)
+}
+
+func decodeCBORArgument(info byte, data []byte) (uint64, []byte, error) {
+	switch {
+	case info < 24:
+		return uint64(info), data, nil
+	case info == 24:
+		if len(data) < 1 {
+			return 0, nil, errors.New("cbor: unexpected end of data")
+		}
+		return uint64(data[0]), data[1:], nil
+	case info == 25:
+		if len(data) < 2 {
+			return 0, nil, errors.New("cbor: unexpected end of data")
+		}
+		return uint64(binary.BigEndian.Uint16(data)), data[2:], nil
+	case info == 26:
+		if len(data) < 4 {
+			return 0, nil, errors.New("cbor: unexpected end of data")
+		}
+		return uint64(binary.BigEndian.Uint32(data)), data[4:], nil
+	case info == 27:
+		if len(data) < 8 {
+			return 0, nil, errors.New("cbor: unexpected end of data")
+		}
+		return binary.BigEndian.Uint64(data), data[8:], nil
+	}
+
+	return 0, nil, errors.New("cbor: indefinite lengths are not supported")
+}
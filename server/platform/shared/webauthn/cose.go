@@ -0,0 +1,115 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// The COSE algorithms accepted for credentials, in order of preference.
+const (
+	AlgorithmES256 = -7
+	AlgorithmEdDSA = -8
+	AlgorithmRS256 = -257
+)
+
+var SupportedAlgorithms = []int{AlgorithmES256, AlgorithmEdDSA, AlgorithmRS256}
+
+const (
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+	coseKeyTypeRSA = 3
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+
+	// rsaMinBits is the minimum size of RSA credential keys.
+	rsaMinBits = 2048
+)
+
+// publicKey is a credential public key decoded from its COSE_Key encoding.
+type publicKey struct {
+	algorithm int
+	key       crypto.PublicKey
+}
+
+func parsePublicKey(coseKey []byte) (*publicKey, error) {
+	decoded, rest, err := decodeCBOR(coseKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to decode the public key")
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("unexpected data after the public key")
+	}
+
+	params, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, errors.New("the public key isn't a map")
+	}
+
+	keyType, _ := params[int64(1)].(int64)
+	algorithm, _ := params[int64(3)].(int64)
+
+	switch {
+	case algorithm == AlgorithmES256 && keyType == coseKeyTypeEC2:
+		curve, _ := params[int64(-1)].(int64)
+		x, _ := params[int64(-2)].([]byte)
+		y, _ := params[int64(-3)].([]byte)
+		if curve != coseCurveP256 || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid EC2 public key")
+		}
+
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("the EC2 public key isn't on its curve")
+		}
+		return &publicKey{algorithm: AlgorithmES256, key: key}, nil
+
+	case algorithm == AlgorithmEdDSA && keyType == coseKeyTypeOKP:
+		curve, _ := params[int64(-1)].(int64)
+		x, _ := params[int64(-2)].([]byte)
+		if curve != coseCurveEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid OKP public key")
+		}
+		return &publicKey{algorithm: AlgorithmEdDSA, key: ed25519.PublicKey(x)}, nil
+
+	case algorithm == AlgorithmRS256 && keyType == coseKeyTypeRSA:
+		n, _ := params[int64(-1)].([]byte)
+		e, _ := params[int64(-2)].([]byte)
+		if len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid RSA public key exponent")
+		}
+
+		key := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if key.N.BitLen() < rsaMinBits || key.E < 3 {
+			return nil, errors.New("invalid RSA public key")
+		}
+		return &publicKey{algorithm: AlgorithmRS256, key: key}, nil
+	}
+
+	return nil, errors.Errorf("unsupported public key algorithm %d", algorithm)
+}
+
+func (k *publicKey) verify(data, signature []byte) bool {
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(data)
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, data, signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	}
+
+	return false
+}
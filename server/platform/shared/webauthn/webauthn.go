@@ -0,0 +1,252 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package webauthn implements the server side of the WebAuthn registration and authentication
+// ceremonies, for security keys and passkeys.
+//
+// Attestation statements aren't verified: any authenticator model is accepted, so the server
+// asks browsers for no attestation.
+package webauthn
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// InvalidResponse indicates that the response of an authenticator failed verification.
+var InvalidResponse = errors.New("invalid webauthn response")
+
+// ClonedAuthenticator indicates that the signature counter of an authenticator went backwards,
+// which hints at a cloned authenticator.
+var ClonedAuthenticator = errors.New("webauthn signature counter went backwards")
+
+const (
+	// challengeSize results in a 64 characters challenge once base64url encoded.
+	challengeSize = 48
+
+	ceremonyCreate = "webauthn.create"
+	ceremonyGet    = "webauthn.get"
+
+	flagUserPresent      = 0x01
+	flagUserVerified     = 0x04
+	flagAttestedCredData = 0x40
+
+	authDataMinLength = 37
+	aaguidLength      = 16
+)
+
+// Credential is a public key credential created by an authenticator.
+type Credential struct {
+	ID []byte
+	// PublicKey is the COSE_Key encoding of the public key.
+	PublicKey []byte
+	SignCount uint32
+	AAGUID    []byte
+}
+
+type WebAuthn struct {
+	rpID   string
+	origin string
+}
+
+// New returns a relying party for the site at siteURL, which is both its origin and,
+// by its host name, its ID.
+func New(siteURL string) (*WebAuthn, error) {
+	u, err := url.Parse(strings.TrimSpace(siteURL))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse the site URL")
+	}
+	if (u.Scheme != "https" && u.Scheme != "http") || u.Hostname() == "" {
+		return nil, errors.New("the site URL must be an absolute http(s) URL")
+	}
+
+	return &WebAuthn{
+		rpID:   strings.ToLower(u.Hostname()),
+		origin: u.Scheme + "://" + strings.ToLower(u.Host),
+	}, nil
+}
+
+// RPID returns the relying party ID credentials are scoped to.
+func (w *WebAuthn) RPID() string {
+	return w.rpID
+}
+
+// NewChallenge returns a random base64url encoded challenge.
+func NewChallenge() string {
+	data := make([]byte, challengeSize)
+	rand.Read(data)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Challenge returns the challenge the client data was signed for, so that the ceremony it answers
+// can be looked up before verifying it.
+func Challenge(clientDataJSON []byte) (string, error) {
+	var clientData clientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return "", errors.Wrap(InvalidResponse, "unable to parse the client data")
+	}
+
+	return clientData.Challenge, nil
+}
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+func (w *WebAuthn) verifyClientData(clientDataJSON []byte, ceremony, challenge string) error {
+	var clientData clientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return errors.Wrap(InvalidResponse, "unable to parse the client data")
+	}
+
+	if clientData.Type != ceremony {
+		return errors.Wrapf(InvalidResponse, "unexpected ceremony %q", clientData.Type)
+	}
+	if challenge == "" || subtle.ConstantTimeCompare([]byte(clientData.Challenge), []byte(challenge)) != 1 {
+		return errors.Wrap(InvalidResponse, "challenge mismatch")
+	}
+	if !strings.EqualFold(clientData.Origin, w.origin) || clientData.CrossOrigin {
+		return errors.Wrapf(InvalidResponse, "unexpected origin %q", clientData.Origin)
+	}
+
+	return nil
+}
+
+type authenticatorData struct {
+	flags     byte
+	signCount uint32
+	// Only set during registration.
+	credential *Credential
+}
+
+func (w *WebAuthn) parseAuthenticatorData(data []byte, requireUserVerification bool) (*authenticatorData, error) {
+	if len(data) < authDataMinLength {
+		return nil, errors.Wrap(InvalidResponse, "authenticator data too short")
+	}
+
+	rpIDHash := sha256.Sum256([]byte(w.rpID))
+	if subtle.ConstantTimeCompare(data[:32], rpIDHash[:]) != 1 {
+		return nil, errors.Wrap(InvalidResponse, "relying party ID mismatch")
+	}
+
+	authData := &authenticatorData{
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	if authData.flags&flagUserPresent == 0 {
+		return nil, errors.Wrap(InvalidResponse, "user not present")
+	}
+	if requireUserVerification && authData.flags&flagUserVerified == 0 {
+		return nil, errors.Wrap(InvalidResponse, "user not verified")
+	}
+
+	if authData.flags&flagAttestedCredData == 0 {
+		return authData, nil
+	}
+
+	rest := data[authDataMinLength:]
+	if len(rest) < aaguidLength+2 {
+		return nil, errors.Wrap(InvalidResponse, "attested credential data too short")
+	}
+	aaguid := rest[:aaguidLength]
+	idLength := int(binary.BigEndian.Uint16(rest[aaguidLength:]))
+	rest = rest[aaguidLength+2:]
+	if idLength == 0 || idLength > len(rest) {
+		return nil, errors.Wrap(InvalidResponse, "invalid credential ID length")
+	}
+	id := rest[:idLength]
+	rest = rest[idLength:]
+
+	_, extensions, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, errors.Wrap(InvalidResponse, err.Error())
+	}
+
+	authData.credential = &Credential{
+		ID:        append([]byte(nil), id...),
+		PublicKey: append([]byte(nil), rest[:len(rest)-len(extensions)]...),
+		SignCount: authData.signCount,
+		AAGUID:    append([]byte(nil), aaguid...),
+	}
+
+	return authData, nil
+}
+
+// VerifyRegistration verifies the response of an authenticator to a registration challenge and
+// returns the credential it created.
+func (w *WebAuthn) VerifyRegistration(challenge string, clientDataJSON, attestationObject []byte, requireUserVerification bool) (*Credential, error) {
+	if err := w.verifyClientData(clientDataJSON, ceremonyCreate, challenge); err != nil {
+		return nil, err
+	}
+
+	decoded, _, err := decodeCBOR(attestationObject)
+	if err != nil {
+		return nil, errors.Wrap(InvalidResponse, err.Error())
+	}
+	attestation, ok := decoded.(map[any]any)
+	if !ok {
+		return nil, errors.Wrap(InvalidResponse, "the attestation object isn't a map")
+	}
+	format, _ := attestation["fmt"].(string)
+	rawAuthData, _ := attestation["authData"].([]byte)
+	if format == "" {
+		return nil, errors.Wrap(InvalidResponse, "missing attestation format")
+	}
+
+	authData, err := w.parseAuthenticatorData(rawAuthData, requireUserVerification)
+	if err != nil {
+		return nil, err
+	}
+	if authData.credential == nil {
+		return nil, errors.Wrap(InvalidResponse, "missing attested credential data")
+	}
+
+	if _, err := parsePublicKey(authData.credential.PublicKey); err != nil {
+		return nil, errors.Wrap(InvalidResponse, err.Error())
+	}
+
+	return authData.credential, nil
+}
+
+// VerifyAssertion verifies the response of an authenticator to an authentication challenge,
+// signed with the given credential, and returns the new signature counter of the credential.
+func (w *WebAuthn) VerifyAssertion(challenge string, credential *Credential, clientDataJSON, rawAuthData, signature []byte, requireUserVerification bool) (uint32, error) {
+	if err := w.verifyClientData(clientDataJSON, ceremonyGet, challenge); err != nil {
+		return 0, err
+	}
+
+	authData, err := w.parseAuthenticatorData(rawAuthData, requireUserVerification)
+	if err != nil {
+		return 0, err
+	}
+
+	key, err := parsePublicKey(credential.PublicKey)
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to parse the stored public key")
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := bytes.Join([][]byte{rawAuthData, clientDataHash[:]}, nil)
+	if !key.verify(signed, signature) {
+		return 0, errors.Wrap(InvalidResponse, "invalid signature")
+	}
+
+	// Authenticators that don't implement a counter always return 0.
+	if (authData.signCount != 0 || credential.SignCount != 0) && authData.signCount <= credential.SignCount {
+		return 0, ClonedAuthenticator
+	}
+
+	return authData.signCount, nil
+}
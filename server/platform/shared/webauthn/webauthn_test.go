@@ -0,0 +1,345 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// encodeCBOR encodes the values decoded by decodeCBOR, for building authenticator responses.
+func encodeCBOR(value any) []byte {
+	head := func(major byte, arg uint64) []byte {
+		switch {
+		case arg < 24:
+			return []byte{major<<5 | byte(arg)}
+		case arg <= 0xff:
+			return []byte{major<<5 | 24, byte(arg)}
+		case arg <= 0xffff:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(arg))
+		default:
+			return binary.BigEndian.AppendUint32([]byte{major<<5 | 26}, uint32(arg))
+		}
+	}
+
+	switch v := value.(type) {
+	case int:
+		return encodeCBOR(int64(v))
+	case int64:
+		if v < 0 {
+			return head(cborMajorNegative, uint64(-1-v))
+		}
+		return head(cborMajorUnsigned, uint64(v))
+	case []byte:
+		return append(head(cborMajorBytes, uint64(len(v))), v...)
+	case string:
+		return append(head(cborMajorText, uint64(len(v))), v...)
+	case map[any]any:
+		keys := make([]any, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool { return string(encodeCBOR(keys[i])) < string(encodeCBOR(keys[j])) })
+
+		out := head(cborMajorMap, uint64(len(v)))
+		for _, key := range keys {
+			out = append(out, encodeCBOR(key)...)
+			out = append(out, encodeCBOR(v[key])...)
+		}
+		return out
+	}
+
+	panic("unsupported value")
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	for encoded, expected := range map[string]any{
+		"00":               int64(0),
+		"17":               int64(23),
+		"1818":             int64(24),
+		"1903e8":           int64(1000),
+		"1a000f4240":       int64(1000000),
+		"20":               int64(-1),
+		"3863":             int64(-100),
+		"4401020304":       []byte{1, 2, 3, 4},
+		"6449455446":       "IETF",
+		"83010203":         []any{int64(1), int64(2), int64(3)},
+		"a201020304":       map[any]any{int64(1): int64(2), int64(3): int64(4)},
+		"a1616101":         map[any]any{"a": int64(1)},
+		"f4":               false,
+		"f5":               true,
+		"f6":               nil,
+		"c11a514b67b0":     int64(1363896240),
+		"8301820203820405": []any{int64(1), []any{int64(2), int64(3)}, []any{int64(4), int64(5)}},
+	} {
+		t.Run(encoded, func(t *testing.T) {
+			data, err := hex.DecodeString(encoded)
+			require.NoError(t, err)
+
+			value, rest, err := decodeCBOR(data)
+			require.NoError(t, err)
+			assert.Empty(t, rest)
+			assert.Equal(t, expected, value)
+		})
+	}
+
+	t.Run("the bytes after the item are returned", func(t *testing.T) {
+		value, rest, err := decodeCBOR([]byte{0x01, 0x02})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), value)
+		assert.Equal(t, []byte{0x02}, rest)
+	})
+
+	for name, encoded := range map[string]string{
+		"integer overflow":     "3bffffffffffffffff",
+		"truncated argument":   "19",
+		"truncated string":     "4401",
+		"truncated array":      "8301",
+		"huge array":           "9bffffffffffffffff",
+		"indefinite string":    "5f41014102ff",
+		"duplicate map key":    "a201020103",
+		"unsupported key type": "a1400102",
+		"float":                "f93c00",
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := hex.DecodeString(encoded)
+			require.NoError(t, err)
+
+			_, _, err = decodeCBOR(data)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("nesting is bounded", func(t *testing.T) {
+		data := append(make([]byte, 0, 100), 0x00)
+		for i := 0; i < 100; i++ {
+			data = append([]byte{0x81}, data...)
+		}
+		_, _, err := decodeCBOR(data)
+		assert.Error(t, err)
+	})
+}
+
+func TestNew(t *testing.T) {
+	w, err := New("https://Chat.Example.com:8443/subpath")
+	require.NoError(t, err)
+	assert.Equal(t, "chat.example.com", w.RPID())
+	assert.Equal(t, "https://chat.example.com:8443", w.origin)
+
+	for _, siteURL := range []string{"", "chat.example.com", "ftp://chat.example.com", "https://"} {
+		_, err := New(siteURL)
+		assert.Error(t, err, siteURL)
+	}
+}
+
+// testAuthenticator is a software authenticator holding a single credential.
+type testAuthenticator struct {
+	id        []byte
+	sign      func(data []byte) []byte
+	coseKey   []byte
+	signCount uint32
+	flags     byte
+}
+
+func newES256Authenticator(t *testing.T) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &testAuthenticator{
+		id: []byte("es256-credential"),
+		sign: func(data []byte) []byte {
+			digest := sha256.Sum256(data)
+			signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+			require.NoError(t, err)
+			return signature
+		},
+		coseKey: encodeCBOR(map[any]any{
+			1:  coseKeyTypeEC2,
+			3:  AlgorithmES256,
+			-1: coseCurveP256,
+			-2: key.X.FillBytes(make([]byte, 32)),
+			-3: key.Y.FillBytes(make([]byte, 32)),
+		}),
+		flags: flagUserPresent | flagUserVerified,
+	}
+}
+
+func newEdDSAAuthenticator(t *testing.T) *testAuthenticator {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return &testAuthenticator{
+		id: []byte("eddsa-credential"),
+		sign: func(data []byte) []byte {
+			return ed25519.Sign(private, data)
+		},
+		coseKey: encodeCBOR(map[any]any{
+			1:  coseKeyTypeOKP,
+			3:  AlgorithmEdDSA,
+			-1: coseCurveEd25519,
+			-2: []byte(public),
+		}),
+		flags: flagUserPresent,
+	}
+}
+
+func (a *testAuthenticator) authData(rpID string, attested bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append([]byte(nil), rpIDHash[:]...)
+
+	flags := a.flags
+	if attested {
+		flags |= flagAttestedCredData
+	}
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+
+	if attested {
+		data = append(data, make([]byte, aaguidLength)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.id)))
+		data = append(data, a.id...)
+		data = append(data, a.coseKey...)
+	}
+	return data
+}
+
+func clientDataJSON(t *testing.T, ceremony, challenge, origin string) []byte {
+	data, err := json.Marshal(clientData{Type: ceremony, Challenge: challenge, Origin: origin})
+	require.NoError(t, err)
+	return data
+}
+
+func (a *testAuthenticator) register(t *testing.T, challenge, origin, rpID string) ([]byte, []byte) {
+	attestationObject := encodeCBOR(map[any]any{
+		"fmt":      "none",
+		"attStmt":  map[any]any{},
+		"authData": a.authData(rpID, true),
+	})
+	return clientDataJSON(t, ceremonyCreate, challenge, origin), attestationObject
+}
+
+func (a *testAuthenticator) assert(t *testing.T, challenge, origin, rpID string) ([]byte, []byte, []byte) {
+	a.signCount++
+	clientData := clientDataJSON(t, ceremonyGet, challenge, origin)
+	authData := a.authData(rpID, false)
+	clientDataHash := sha256.Sum256(clientData)
+	return clientData, authData, a.sign(append(append([]byte(nil), authData...), clientDataHash[:]...))
+}
+
+func TestCeremonies(t *testing.T) {
+	w, err := New("https://chat.example.com")
+	require.NoError(t, err)
+	origin := "https://chat.example.com"
+	rpID := "chat.example.com"
+
+	for name, newAuthenticator := range map[string]func(t *testing.T) *testAuthenticator{
+		"ES256": newES256Authenticator,
+		"EdDSA": newEdDSAAuthenticator,
+	} {
+		t.Run(name, func(t *testing.T) {
+			authenticator := newAuthenticator(t)
+
+			challenge := NewChallenge()
+			assert.Len(t, challenge, 64)
+			clientData, attestationObject := authenticator.register(t, challenge, origin, rpID)
+
+			actualChallenge, err := Challenge(clientData)
+			require.NoError(t, err)
+			assert.Equal(t, challenge, actualChallenge)
+
+			credential, err := w.VerifyRegistration(challenge, clientData, attestationObject, false)
+			require.NoError(t, err)
+			assert.Equal(t, authenticator.id, credential.ID)
+			assert.Equal(t, authenticator.coseKey, credential.PublicKey)
+
+			challenge = NewChallenge()
+			clientData, authData, signature := authenticator.assert(t, challenge, origin, rpID)
+			signCount, err := w.VerifyAssertion(challenge, credential, clientData, authData, signature, false)
+			require.NoError(t, err)
+			assert.Equal(t, uint32(1), signCount)
+			credential.SignCount = signCount
+
+			t.Run("a replayed assertion is rejected", func(t *testing.T) {
+				_, err := w.VerifyAssertion(challenge, credential, clientData, authData, signature, false)
+				assert.True(t, errors.Is(err, ClonedAuthenticator))
+			})
+
+			t.Run("an assertion for another challenge is rejected", func(t *testing.T) {
+				clientData, authData, signature := authenticator.assert(t, NewChallenge(), origin, rpID)
+				_, err := w.VerifyAssertion(challenge, credential, clientData, authData, signature, false)
+				assert.True(t, errors.Is(err, InvalidResponse))
+			})
+
+			t.Run("an assertion for another origin is rejected", func(t *testing.T) {
+				clientData, authData, signature := authenticator.assert(t, challenge, "https://chat.example.com.evil.com", rpID)
+				_, err := w.VerifyAssertion(challenge, credential, clientData, authData, signature, false)
+				assert.True(t, errors.Is(err, InvalidResponse))
+			})
+
+			t.Run("an assertion for another relying party is rejected", func(t *testing.T) {
+				clientData, authData, signature := authenticator.assert(t, challenge, origin, "example.com")
+				_, err := w.VerifyAssertion(challenge, credential, clientData, authData, signature, false)
+				assert.True(t, errors.Is(err, InvalidResponse))
+			})
+
+			t.Run("a tampered assertion is rejected", func(t *testing.T) {
+				clientData, authData, signature := authenticator.assert(t, challenge, origin, rpID)
+				authData[len(authData)-1]++
+				_, err := w.VerifyAssertion(challenge, credential, clientData, authData, signature, false)
+				assert.True(t, errors.Is(err, InvalidResponse))
+			})
+
+			t.Run("a registration response isn't an assertion", func(t *testing.T) {
+				clientData, _ := authenticator.register(t, challenge, origin, rpID)
+				_, authData, signature := authenticator.assert(t, challenge, origin, rpID)
+				_, err := w.VerifyAssertion(challenge, credential, clientData, authData, signature, false)
+				assert.True(t, errors.Is(err, InvalidResponse))
+			})
+
+			t.Run("user verification", func(t *testing.T) {
+				clientData, authData, signature := authenticator.assert(t, challenge, origin, rpID)
+				_, err := w.VerifyAssertion(challenge, credential, clientData, authData, signature, true)
+				if authenticator.flags&flagUserVerified != 0 {
+					assert.NoError(t, err)
+				} else {
+					assert.True(t, errors.Is(err, InvalidResponse))
+				}
+			})
+		})
+	}
+
+	t.Run("a registration for another challenge is rejected", func(t *testing.T) {
+		clientData, attestationObject := newES256Authenticator(t).register(t, NewChallenge(), origin, rpID)
+		_, err := w.VerifyRegistration(NewChallenge(), clientData, attestationObject, false)
+		assert.True(t, errors.Is(err, InvalidResponse))
+	})
+
+	t.Run("a registration with an unsupported key is rejected", func(t *testing.T) {
+		authenticator := newES256Authenticator(t)
+		authenticator.coseKey = encodeCBOR(map[any]any{1: coseKeyTypeEC2, 3: -35})
+		challenge := NewChallenge()
+		clientData, attestationObject := authenticator.register(t, challenge, origin, rpID)
+		_, err := w.VerifyRegistration(challenge, clientData, attestationObject, false)
+		assert.True(t, errors.Is(err, InvalidResponse))
+	})
+
+	t.Run("a registration without user verification is rejected when it's required", func(t *testing.T) {
+		challenge := NewChallenge()
+		clientData, attestationObject := newEdDSAAuthenticator(t).register(t, challenge, origin, rpID)
+		_, err := w.VerifyRegistration(challenge, clientData, attestationObject, true)
+		assert.True(t, errors.Is(err, InvalidResponse))
+	})
+}
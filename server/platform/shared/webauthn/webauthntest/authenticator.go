@@ -0,0 +1,200 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package webauthntest provides a software authenticator to test WebAuthn ceremonies end to end.
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"net/url"
+	"sort"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	flagUserPresent      = 0x01
+	flagUserVerified     = 0x04
+	flagAttestedCredData = 0x40
+)
+
+// Authenticator is a software authenticator holding a single ES256 credential.
+type Authenticator struct {
+	ID               []byte
+	UserHandle       string
+	SignCount        uint32
+	UserVerification bool
+
+	key *ecdsa.PrivateKey
+}
+
+func NewAuthenticator(userVerification bool) *Authenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Authenticator{
+		ID:               []byte(model.NewId()),
+		UserVerification: userVerification,
+		key:              key,
+	}
+}
+
+// CredentialId returns the ID of the credential as it's sent to the server.
+func (a *Authenticator) CredentialId() string {
+	return base64.RawURLEncoding.EncodeToString(a.ID)
+}
+
+// Register creates the credential for the registration options given by the server of siteURL.
+func (a *Authenticator) Register(siteURL string, options *model.WebAuthnCreationOptions) model.WebAuthnAttestation {
+	a.UserHandle = options.User.Id
+
+	coseKey := encodeCBOR(map[any]any{
+		1:  2,  // EC2 key type
+		3:  -7, // ES256
+		-1: 1,  // P-256
+		-2: a.key.X.FillBytes(make([]byte, 32)),
+		-3: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	authData := a.authData(options.RelyingParty.Id, coseKey)
+	attestationObject := encodeCBOR(map[any]any{
+		"fmt":      "none",
+		"attStmt":  map[any]any{},
+		"authData": authData,
+	})
+
+	return model.WebAuthnAttestation{
+		Id:   a.CredentialId(),
+		Type: model.WebAuthnCredentialTypePublicKey,
+		Response: model.WebAuthnAttestationResponse{
+			ClientDataJSON:    encode(clientDataJSON("webauthn.create", options.Challenge, siteURL)),
+			AttestationObject: encode(attestationObject),
+		},
+	}
+}
+
+// Assert signs the challenge of the login options given by the server of siteURL.
+func (a *Authenticator) Assert(siteURL string, options *model.WebAuthnRequestOptions) *model.WebAuthnAssertion {
+	a.SignCount++
+
+	clientData := clientDataJSON("webauthn.get", options.Challenge, siteURL)
+	authData := a.authData(options.RelyingPartyId, nil)
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		panic(err)
+	}
+
+	return &model.WebAuthnAssertion{
+		Id:   a.CredentialId(),
+		Type: model.WebAuthnCredentialTypePublicKey,
+		Response: model.WebAuthnAssertionResponse{
+			ClientDataJSON:    encode(clientData),
+			AuthenticatorData: encode(authData),
+			Signature:         encode(signature),
+			UserHandle:        a.UserHandle,
+		},
+	}
+}
+
+// AssertJSON returns the assertion of Assert as the MFA token of a login.
+func (a *Authenticator) AssertJSON(siteURL string, options *model.WebAuthnRequestOptions) string {
+	data, err := json.Marshal(a.Assert(siteURL, options))
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+func (a *Authenticator) authData(rpID string, coseKey []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append([]byte(nil), rpIDHash[:]...)
+
+	flags := byte(flagUserPresent)
+	if a.UserVerification {
+		flags |= flagUserVerified
+	}
+	if coseKey != nil {
+		flags |= flagAttestedCredData
+	}
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.SignCount)
+
+	if coseKey != nil {
+		data = append(data, make([]byte, 16)...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.ID)))
+		data = append(data, a.ID...)
+		data = append(data, coseKey...)
+	}
+	return data
+}
+
+func clientDataJSON(ceremony, challenge, siteURL string) []byte {
+	u, err := url.Parse(siteURL)
+	if err != nil {
+		panic(err)
+	}
+
+	data, err := json.Marshal(map[string]string{
+		"type":      ceremony,
+		"challenge": challenge,
+		"origin":    u.Scheme + "://" + u.Host,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// encodeCBOR encodes the integers, byte and text strings and maps of authenticator responses.
+func encodeCBOR(value any) []byte {
+	head := func(major byte, arg uint64) []byte {
+		switch {
+		case arg < 24:
+			return []byte{major<<5 | byte(arg)}
+		case arg <= 0xff:
+			return []byte{major<<5 | 24, byte(arg)}
+		default:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(arg))
+		}
+	}
+
+	switch v := value.(type) {
+	case int:
+		if v < 0 {
+			return head(1, uint64(-1-v))
+		}
+		return head(0, uint64(v))
+	case []byte:
+		return append(head(2, uint64(len(v))), v...)
+	case string:
+		return append(head(3, uint64(len(v))), v...)
+	case map[any]any:
+		keys := make([]any, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool { return string(encodeCBOR(keys[i])) < string(encodeCBOR(keys[j])) })
+
+		out := head(5, uint64(len(v)))
+		for _, key := range keys {
+			out = append(out, encodeCBOR(key)...)
+			out = append(out, encodeCBOR(v[key])...)
+		}
+		return out
+	}
+
+	panic("unsupported value")
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+// Package webpush sends notifications to browsers through their push service, implementing
+// the Web Push protocol (RFC 8030), message encryption (RFC 8291) and VAPID authentication
+// (RFC 8292).
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+const (
+	saltLength   = 16
+	recordSize   = 4096
+	keyLength    = 65
+	gcmTagLength = 16
+
+	// MaxPayloadSize is the largest payload that fits in the single 4096 bytes record push
+	// services are required to accept.
+	MaxPayloadSize = recordSize - saltLength - 4 - 1 - keyLength - gcmTagLength - 1
+
+	// vapidExpiry is how long VAPID tokens are valid for, which must not exceed 24 hours.
+	vapidExpiry = 12 * time.Hour
+
+	maxErrorBodySize = 1024
+)
+
+// ErrSubscriptionGone is returned when the push service reports that the subscription has
+// expired or was unsubscribed, and should no longer be used.
+var ErrSubscriptionGone = errors.New("push subscription is no longer valid")
+
+// Urgency hints the push service how to prioritize a message, as defined by RFC 8030.
+type Urgency string
+
+const (
+	UrgencyVeryLow Urgency = "very-low"
+	UrgencyLow     Urgency = "low"
+	UrgencyNormal  Urgency = "normal"
+	UrgencyHigh    Urgency = "high"
+)
+
+// Options controls how a push service delivers a message.
+type Options struct {
+	// TTL is how long the push service keeps the message if the browser is offline.
+	TTL time.Duration
+	// Urgency is the message urgency, or normal if unset.
+	Urgency Urgency
+	// Topic, if set, replaces any pending message with the same topic.
+	Topic string
+}
+
+// Sender sends messages to push subscriptions, authenticating with its VAPID key.
+type Sender struct {
+	client  *http.Client
+	key     *ecdsa.PrivateKey
+	subject string
+}
+
+// NewSender creates a sender using the given VAPID key. The subject is a mailto: or https: URL
+// push services can use to contact the sender.
+func NewSender(client *http.Client, key *ecdsa.PrivateKey, subject string) *Sender {
+	return &Sender{
+		client:  client,
+		key:     key,
+		subject: subject,
+	}
+}
+
+// Send encrypts the payload for the subscription and sends it to its push service.
+func (s *Sender) Send(ctx context.Context, subscription *model.WebPushSubscription, payload []byte, options Options) error {
+	uaPublic, err := subscription.P256dhKey()
+	if err != nil {
+		return fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	authSecret, err := subscription.AuthKey()
+	if err != nil {
+		return fmt.Errorf("invalid auth key: %w", err)
+	}
+
+	body, err := Encrypt(payload, uaPublic, authSecret)
+	if err != nil {
+		return err
+	}
+
+	authorization, err := s.vapidAuthorization(subscription.Endpoint, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	urgency := options.Urgency
+	if urgency == "" {
+		urgency = UrgencyNormal
+	}
+
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(options.TTL.Seconds())))
+	req.Header.Set("Urgency", string(urgency))
+	if options.Topic != "" {
+		req.Header.Set("Topic", options.Topic)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrSubscriptionGone
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return fmt.Errorf("push service returned status %d: %s", resp.StatusCode, bytes.TrimSpace(message))
+	}
+
+	_, err = io.Copy(io.Discard, resp.Body)
+	return err
+}
+
+// vapidAuthorization returns the Authorization header authenticating the sender with the push
+// service hosting the endpoint.
+func (s *Sender) vapidAuthorization(endpoint string, now time.Time) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	claims := jwt.MapClaims{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": now.Add(vapidExpiry).Unix(),
+	}
+	if s.subject != "" {
+		claims["sub"] = s.subject
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(s.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign VAPID token: %w", err)
+	}
+
+	publicKey, err := PublicKey(s.key)
+	if err != nil {
+		return "", err
+	}
+
+	return "vapid t=" + token + ", k=" + publicKey, nil
+}
+
+// PublicKey returns the base64url-encoded uncompressed public key of a VAPID key, as expected
+// by browsers as the applicationServerKey of a subscription.
+func PublicKey(key *ecdsa.PrivateKey) (string, error) {
+	publicKey, err := key.PublicKey.ECDH()
+	if err != nil {
+		return "", fmt.Errorf("invalid VAPID key: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(publicKey.Bytes()), nil
+}
+
+// Encrypt encrypts the payload for the user agent owning the given public key and
+// authentication secret, using the aes128gcm content coding in a single record.
+func Encrypt(payload, uaPublic, authSecret []byte) ([]byte, error) {
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	return encrypt(payload, uaPublic, authSecret, asPrivate, salt)
+}
+
+func encrypt(payload, uaPublic, authSecret []byte, asPrivate *ecdh.PrivateKey, salt []byte) ([]byte, error) {
+	if len(payload) > MaxPayloadSize {
+		return nil, fmt.Errorf("payload of %d bytes exceeds the maximum of %d bytes", len(payload), MaxPayloadSize)
+	}
+
+	uaPublicKey, err := ecdh.P256().NewPublicKey(uaPublic)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user agent public key: %w", err)
+	}
+
+	ecdhSecret, err := asPrivate.ECDH(uaPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	asPublic := asPrivate.PublicKey().Bytes()
+
+	// Combine the ECDH shared secret with the authentication secret (RFC 8291, section 3.4).
+	keyInfo := append([]byte("WebPush: info\x00"), uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+	ikm, err := hkdfExpand(ecdhSecret, authSecret, keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	// Derive the content encryption key and nonce (RFC 8188, section 2.2 and 2.3).
+	cek, err := hkdfExpand(ikm, salt, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdfExpand(ikm, salt, []byte("Content-Encoding: nonce\x00"), 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, saltLength+4+1+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	// The only record is also the last one, which is marked by a 0x02 padding delimiter.
+	plaintext := append(append(make([]byte, 0, len(payload)+1), payload...), 0x02)
+
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+func hkdfExpand(secret, salt, info []byte, length int) ([]byte, error) {
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
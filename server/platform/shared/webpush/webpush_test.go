@@ -0,0 +1,134 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package webpush
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func decode(t *testing.T, value string) []byte {
+	t.Helper()
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	require.NoError(t, err)
+	return b
+}
+
+func TestEncrypt(t *testing.T) {
+	t.Run("RFC 8291 example", func(t *testing.T) {
+		// https://www.rfc-editor.org/rfc/rfc8291#appendix-A
+		asPrivate, err := ecdh.P256().NewPrivateKey(decode(t, "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"))
+		require.NoError(t, err)
+
+		body, err := encrypt(
+			[]byte("When I grow up, I want to be a watermelon"),
+			decode(t, "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"),
+			decode(t, "BTBZMqHH6r4Tts7J_aSIgg"),
+			asPrivate,
+			decode(t, "DGv6ra1nlYgDCS1FRnbzlw"),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN", base64.RawURLEncoding.EncodeToString(body))
+	})
+
+	t.Run("payload too large", func(t *testing.T) {
+		uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		_, err = Encrypt(make([]byte, MaxPayloadSize), uaPrivate.PublicKey().Bytes(), make([]byte, 16))
+		require.NoError(t, err)
+
+		_, err = Encrypt(make([]byte, MaxPayloadSize+1), uaPrivate.PublicKey().Bytes(), make([]byte, 16))
+		require.Error(t, err)
+	})
+}
+
+func TestSend(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	publicKey, err := PublicKey(key)
+	require.NoError(t, err)
+
+	uaPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	newSubscription := func(endpoint string) *model.WebPushSubscription {
+		return &model.WebPushSubscription{
+			Endpoint: endpoint,
+			Keys: model.WebPushSubscriptionKeys{
+				P256dh: base64.RawURLEncoding.EncodeToString(uaPrivate.PublicKey().Bytes()),
+				Auth:   base64.RawURLEncoding.EncodeToString(make([]byte, 16)),
+			},
+		}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+			assert.Equal(t, "60", r.Header.Get("TTL"))
+			assert.Equal(t, "high", r.Header.Get("Urgency"))
+			assert.Equal(t, "channel", r.Header.Get("Topic"))
+
+			token, k, _ := strings.Cut(strings.TrimPrefix(r.Header.Get("Authorization"), "vapid t="), ", k=")
+			assert.Equal(t, publicKey, k)
+
+			claims := jwt.MapClaims{}
+			_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (any, error) {
+				return &key.PublicKey, nil
+			}, jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}))
+			assert.NoError(t, err)
+			assert.Equal(t, "http://"+r.Host, claims["aud"])
+			assert.Equal(t, "mailto:admin@example.com", claims["sub"])
+
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		sender := NewSender(server.Client(), key, "mailto:admin@example.com")
+		err := sender.Send(context.Background(), newSubscription(server.URL+"/push/id"), []byte("payload"), Options{
+			TTL:     time.Minute,
+			Urgency: UrgencyHigh,
+			Topic:   "channel",
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("subscription gone", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusGone)
+		}))
+		defer server.Close()
+
+		sender := NewSender(server.Client(), key, "")
+		err := sender.Send(context.Background(), newSubscription(server.URL), []byte("payload"), Options{})
+		require.ErrorIs(t, err, ErrSubscriptionGone)
+	})
+
+	t.Run("push service error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte("slow down"))
+		}))
+		defer server.Close()
+
+		sender := NewSender(server.Client(), key, "")
+		err := sender.Send(context.Background(), newSubscription(server.URL), []byte("payload"), Options{})
+		require.EqualError(t, err, "push service returned status 429: slow down")
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+const (
+	AuditRecordSearchDefaultLimit = 60
+	AuditRecordSearchMaxLimit     = 1000
+	AuditRecordEventNameMaxLength = 256
+	AuditRecordUserIdMaxLength    = 128
+)
+
+// AuditRecord is an audit event persisted by the database audit target. Each record stores the
+// hash of the record before it, so that deleting or altering a record breaks the chain.
+type AuditRecord struct {
+	Sequence  int64  `json:"sequence"`
+	Id        string `json:"id"`
+	CreateAt  int64  `json:"create_at"`
+	Level     string `json:"level"`
+	EventName string `json:"event_name"`
+	Status    string `json:"status"`
+	UserId    string `json:"user_id"`
+	SessionId string `json:"session_id"`
+	IpAddress string `json:"ip_address"`
+	Data      string `json:"data"`
+	PrevHash  string `json:"prev_hash"`
+	Hash      string `json:"hash"`
+}
+
+// ComputeHash returns the hash of the record's content chained to PrevHash.
+func (r *AuditRecord) ComputeHash() string {
+	h := sha256.New()
+	for _, field := range []string{
+		r.PrevHash,
+		strconv.FormatInt(r.Sequence, 10),
+		r.Id,
+		strconv.FormatInt(r.CreateAt, 10),
+		r.Level,
+		r.EventName,
+		r.Status,
+		r.UserId,
+		r.SessionId,
+		r.IpAddress,
+		r.Data,
+	} {
+		// Prefixing each field with its length keeps the encoding unambiguous.
+		h.Write([]byte(strconv.Itoa(len(field))))
+		h.Write([]byte{':'})
+		h.Write([]byte(field))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// PreSave chains the record to the previous one and computes its hash.
+func (r *AuditRecord) PreSave(prev *AuditRecord) {
+	if r.Id == "" {
+		r.Id = NewId()
+	}
+
+	if r.CreateAt == 0 {
+		r.CreateAt = GetMillis()
+	}
+
+	r.EventName = truncateString(r.EventName, AuditRecordEventNameMaxLength)
+	r.UserId = truncateString(r.UserId, AuditRecordUserIdMaxLength)
+
+	r.Sequence = 1
+	r.PrevHash = ""
+	if prev != nil {
+		r.Sequence = prev.Sequence + 1
+		r.PrevHash = prev.Hash
+	}
+	r.Hash = r.ComputeHash()
+}
+
+func truncateString(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+	return strings.ToValidUTF8(s[:maxLength], "")
+}
+
+type AuditRecordSearchOpts struct {
+	UserId    string
+	EventName string
+	Status    string
+	StartTime int64
+	EndTime   int64
+	Page      int
+	PerPage   int
+}
+
+// AuditRecordVerification is the result of checking the audit record hash chain.
+type AuditRecordVerification struct {
+	Valid bool `json:"valid"`
+	// Checked is the number of records that were verified.
+	Checked int64 `json:"checked"`
+	// LastSequence is the sequence number of the last record that was verified.
+	LastSequence int64 `json:"last_sequence"`
+	// BrokenSequence is the sequence number of the first record that failed verification.
+	BrokenSequence int64  `json:"broken_sequence,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+}
+
+const (
+	AuditRecordChainReasonHashMismatch     = "hash_mismatch"
+	AuditRecordChainReasonPrevHashMismatch = "prev_hash_mismatch"
+	AuditRecordChainReasonSequenceGap      = "sequence_gap"
+)
+
+// VerifyAuditRecordChain checks a batch of records sorted by sequence, continuing from the
+// previous verified record, if any. It returns false and updates the verification with the
+// first broken record when the chain does not hold.
+func VerifyAuditRecordChain(prev *AuditRecord, records []*AuditRecord, result *AuditRecordVerification) bool {
+	for _, record := range records {
+		expectedSequence, expectedPrevHash := int64(1), ""
+		if prev != nil {
+			expectedSequence, expectedPrevHash = prev.Sequence+1, prev.Hash
+		}
+
+		switch {
+		case record.Sequence != expectedSequence:
+			result.Reason = AuditRecordChainReasonSequenceGap
+		case record.PrevHash != expectedPrevHash:
+			result.Reason = AuditRecordChainReasonPrevHashMismatch
+		case record.ComputeHash() != record.Hash:
+			result.Reason = AuditRecordChainReasonHashMismatch
+		}
+
+		if result.Reason != "" {
+			result.Valid = false
+			result.BrokenSequence = record.Sequence
+			return false
+		}
+
+		result.Checked++
+		result.LastSequence = record.Sequence
+		prev = record
+	}
+
+	result.Valid = true
+	return true
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeAuditRecordChain(n int) []*AuditRecord {
+	var prev *AuditRecord
+	records := make([]*AuditRecord, 0, n)
+	for i := 0; i < n; i++ {
+		record := &AuditRecord{
+			EventName: "login",
+			Status:    "success",
+			UserId:    NewId(),
+			Data:      `{"event_name":"login"}`,
+		}
+		record.PreSave(prev)
+		records = append(records, record)
+		prev = record
+	}
+	return records
+}
+
+func TestAuditRecordPreSave(t *testing.T) {
+	t.Run("first record", func(t *testing.T) {
+		record := &AuditRecord{EventName: "login"}
+		record.PreSave(nil)
+
+		assert.True(t, IsValidId(record.Id))
+		assert.NotZero(t, record.CreateAt)
+		assert.Equal(t, int64(1), record.Sequence)
+		assert.Empty(t, record.PrevHash)
+		assert.Equal(t, record.ComputeHash(), record.Hash)
+	})
+
+	t.Run("chained record", func(t *testing.T) {
+		prev := &AuditRecord{EventName: "login"}
+		prev.PreSave(nil)
+
+		record := &AuditRecord{EventName: "logout"}
+		record.PreSave(prev)
+
+		assert.Equal(t, int64(2), record.Sequence)
+		assert.Equal(t, prev.Hash, record.PrevHash)
+		assert.NotEqual(t, prev.Hash, record.Hash)
+	})
+
+	t.Run("long fields are truncated", func(t *testing.T) {
+		record := &AuditRecord{
+			EventName: strings.Repeat("a", AuditRecordEventNameMaxLength+1),
+			UserId:    strings.Repeat("b", AuditRecordUserIdMaxLength+1),
+		}
+		record.PreSave(nil)
+
+		assert.Len(t, record.EventName, AuditRecordEventNameMaxLength)
+		assert.Len(t, record.UserId, AuditRecordUserIdMaxLength)
+	})
+}
+
+func TestAuditRecordComputeHash(t *testing.T) {
+	a := &AuditRecord{EventName: "ab", Status: "c"}
+	b := &AuditRecord{EventName: "a", Status: "bc"}
+
+	assert.NotEqual(t, a.ComputeHash(), b.ComputeHash(), "field boundaries must be part of the hash")
+	assert.Equal(t, a.ComputeHash(), a.ComputeHash())
+}
+
+func TestVerifyAuditRecordChain(t *testing.T) {
+	t.Run("intact chain", func(t *testing.T) {
+		records := makeAuditRecordChain(5)
+
+		var result AuditRecordVerification
+		require.True(t, VerifyAuditRecordChain(nil, records, &result))
+		assert.True(t, result.Valid)
+		assert.Equal(t, int64(5), result.Checked)
+		assert.Equal(t, int64(5), result.LastSequence)
+		assert.Zero(t, result.BrokenSequence)
+	})
+
+	t.Run("intact chain verified in batches", func(t *testing.T) {
+		records := makeAuditRecordChain(5)
+
+		var result AuditRecordVerification
+		require.True(t, VerifyAuditRecordChain(nil, records[:2], &result))
+		require.True(t, VerifyAuditRecordChain(records[1], records[2:], &result))
+		assert.True(t, result.Valid)
+		assert.Equal(t, int64(5), result.Checked)
+	})
+
+	t.Run("altered record", func(t *testing.T) {
+		records := makeAuditRecordChain(5)
+		records[2].Status = "fail"
+
+		var result AuditRecordVerification
+		require.False(t, VerifyAuditRecordChain(nil, records, &result))
+		assert.False(t, result.Valid)
+		assert.Equal(t, int64(2), result.Checked)
+		assert.Equal(t, int64(3), result.BrokenSequence)
+		assert.Equal(t, AuditRecordChainReasonHashMismatch, result.Reason)
+	})
+
+	t.Run("rehashed record", func(t *testing.T) {
+		records := makeAuditRecordChain(5)
+		records[2].Status = "fail"
+		records[2].Hash = records[2].ComputeHash()
+
+		var result AuditRecordVerification
+		require.False(t, VerifyAuditRecordChain(nil, records, &result))
+		assert.Equal(t, int64(4), result.BrokenSequence)
+		assert.Equal(t, AuditRecordChainReasonPrevHashMismatch, result.Reason)
+	})
+
+	t.Run("removed record", func(t *testing.T) {
+		records := makeAuditRecordChain(5)
+		records = append(records[:2], records[3:]...)
+
+		var result AuditRecordVerification
+		require.False(t, VerifyAuditRecordChain(nil, records, &result))
+		assert.Equal(t, int64(4), result.BrokenSequence)
+		assert.Equal(t, AuditRecordChainReasonSequenceGap, result.Reason)
+	})
+
+	t.Run("removed first record", func(t *testing.T) {
+		records := makeAuditRecordChain(3)
+
+		var result AuditRecordVerification
+		require.False(t, VerifyAuditRecordChain(nil, records[1:], &result))
+		assert.Equal(t, int64(2), result.BrokenSequence)
+		assert.Equal(t, AuditRecordChainReasonSequenceGap, result.Reason)
+	})
+}
@@ -12,5 +12,10 @@ type BulkExportOpts struct {
 	IncludeProfilePictures  bool
 	IncludeArchivedChannels bool
 	IncludeRolesAndSchemes  bool
-	CreateArchive           bool
+	IncludeIntegrations     bool
+	// IncludeIntegrationSecrets carries over the incoming webhook ids, the outgoing webhook and
+	// command tokens, and the OAuth app client ids and secrets. When false, the importing server
+	// generates new ones and the integrations have to be reconfigured.
+	IncludeIntegrationSecrets bool
+	CreateArchive             bool
 }
@@ -10,6 +10,8 @@ import (
 
 type ChannelBookmarkType string
 
+type ChannelBookmarkLinkStatus string
+
 const (
 	ChannelBookmarkLink    ChannelBookmarkType = "link"
 	ChannelBookmarkFile    ChannelBookmarkType = "file"
@@ -19,6 +21,11 @@ const (
 	LinkMaxRunes                               = 1024
 )
 
+const (
+	ChannelBookmarkLinkStatusOk     ChannelBookmarkLinkStatus = "ok"
+	ChannelBookmarkLinkStatusBroken ChannelBookmarkLinkStatus = "broken"
+)
+
 type ChannelBookmark struct {
 	Id          string              `json:"id"`
 	CreateAt    int64               `json:"create_at"`
@@ -35,6 +42,13 @@ type ChannelBookmark struct {
 	Type        ChannelBookmarkType `json:"type"`
 	OriginalId  string              `json:"original_id,omitempty"`
 	ParentId    string              `json:"parent_id,omitempty"`
+
+	// LinkStatus and LinkCheckedAt are set by the job revalidating link bookmarks.
+	LinkStatus    ChannelBookmarkLinkStatus `json:"link_status,omitempty"`
+	LinkCheckedAt int64                     `json:"link_checked_at,omitempty"`
+
+	// RemoteId is set when the last change was received from a shared channel remote.
+	RemoteId *string `json:"remote_id,omitempty"`
 }
 
 func (o *ChannelBookmark) Auditable() map[string]interface{} {
@@ -52,6 +66,13 @@ func (o *ChannelBookmark) Auditable() map[string]interface{} {
 	}
 }
 
+func (o *ChannelBookmark) GetRemoteID() string {
+	if o.RemoteId != nil {
+		return *o.RemoteId
+	}
+	return ""
+}
+
 // Clone returns a shallow copy of the channel bookmark.
 func (o *ChannelBookmark) Clone() *ChannelBookmark {
 	bCopy := *o
@@ -69,6 +90,7 @@ func (o *ChannelBookmark) SetOriginal(newOwnerId string) *ChannelBookmark {
 	bCopy.UpdateAt = 0
 	bCopy.OriginalId = o.Id
 	bCopy.OwnerId = newOwnerId
+	bCopy.RemoteId = nil
 	return &bCopy
 }
 
@@ -133,6 +155,10 @@ func (o *ChannelBookmark) IsValid() *AppError {
 		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.parent_id.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if !(o.LinkStatus == "" || o.LinkStatus == ChannelBookmarkLinkStatusOk || o.LinkStatus == ChannelBookmarkLinkStatusBroken) {
+		return NewAppError("ChannelBookmark.IsValid", "model.channel_bookmark.is_valid.link_status.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -156,21 +182,24 @@ func (o *ChannelBookmark) PreUpdate() {
 func (o *ChannelBookmark) ToBookmarkWithFileInfo(f *FileInfo) *ChannelBookmarkWithFileInfo {
 	bwf := ChannelBookmarkWithFileInfo{
 		ChannelBookmark: &ChannelBookmark{
-			Id:          o.Id,
-			CreateAt:    o.CreateAt,
-			UpdateAt:    o.UpdateAt,
-			DeleteAt:    o.DeleteAt,
-			ChannelId:   o.ChannelId,
-			OwnerId:     o.OwnerId,
-			FileId:      o.FileId,
-			DisplayName: o.DisplayName,
-			SortOrder:   o.SortOrder,
-			LinkUrl:     o.LinkUrl,
-			ImageUrl:    o.ImageUrl,
-			Emoji:       o.Emoji,
-			Type:        o.Type,
-			OriginalId:  o.OriginalId,
-			ParentId:    o.ParentId,
+			Id:            o.Id,
+			CreateAt:      o.CreateAt,
+			UpdateAt:      o.UpdateAt,
+			DeleteAt:      o.DeleteAt,
+			ChannelId:     o.ChannelId,
+			OwnerId:       o.OwnerId,
+			FileId:        o.FileId,
+			DisplayName:   o.DisplayName,
+			SortOrder:     o.SortOrder,
+			LinkUrl:       o.LinkUrl,
+			ImageUrl:      o.ImageUrl,
+			Emoji:         o.Emoji,
+			Type:          o.Type,
+			OriginalId:    o.OriginalId,
+			ParentId:      o.ParentId,
+			LinkStatus:    o.LinkStatus,
+			LinkCheckedAt: o.LinkCheckedAt,
+			RemoteId:      o.RemoteId,
 		},
 	}
 
@@ -208,6 +237,11 @@ func (o *ChannelBookmark) Patch(patch *ChannelBookmarkPatch) {
 		o.SortOrder = *patch.SortOrder
 	}
 	if patch.LinkUrl != nil {
+		if *patch.LinkUrl != o.LinkUrl {
+			// The new link hasn't been checked yet
+			o.LinkStatus = ""
+			o.LinkCheckedAt = 0
+		}
 		o.LinkUrl = *patch.LinkUrl
 	}
 	if patch.ImageUrl != nil {
@@ -280,6 +314,9 @@ type ChannelBookmarkAndFileInfo struct {
 	Type            ChannelBookmarkType
 	OriginalId      string
 	ParentId        string
+	LinkStatus      ChannelBookmarkLinkStatus
+	LinkCheckedAt   int64
+	RemoteId        *string
 	FileId          string
 	FileName        string
 	Extension       string
@@ -294,21 +331,24 @@ type ChannelBookmarkAndFileInfo struct {
 func (o *ChannelBookmarkAndFileInfo) ToChannelBookmarkWithFileInfo() *ChannelBookmarkWithFileInfo {
 	bwf := &ChannelBookmarkWithFileInfo{
 		ChannelBookmark: &ChannelBookmark{
-			Id:          o.Id,
-			CreateAt:    o.CreateAt,
-			UpdateAt:    o.UpdateAt,
-			DeleteAt:    o.DeleteAt,
-			ChannelId:   o.ChannelId,
-			OwnerId:     o.OwnerId,
-			FileId:      o.FileInfoId,
-			DisplayName: o.DisplayName,
-			SortOrder:   o.SortOrder,
-			LinkUrl:     o.LinkUrl,
-			ImageUrl:    o.ImageUrl,
-			Emoji:       o.Emoji,
-			Type:        o.Type,
-			OriginalId:  o.OriginalId,
-			ParentId:    o.ParentId,
+			Id:            o.Id,
+			CreateAt:      o.CreateAt,
+			UpdateAt:      o.UpdateAt,
+			DeleteAt:      o.DeleteAt,
+			ChannelId:     o.ChannelId,
+			OwnerId:       o.OwnerId,
+			FileId:        o.FileInfoId,
+			DisplayName:   o.DisplayName,
+			SortOrder:     o.SortOrder,
+			LinkUrl:       o.LinkUrl,
+			ImageUrl:      o.ImageUrl,
+			Emoji:         o.Emoji,
+			Type:          o.Type,
+			OriginalId:    o.OriginalId,
+			ParentId:      o.ParentId,
+			LinkStatus:    o.LinkStatus,
+			LinkCheckedAt: o.LinkCheckedAt,
+			RemoteId:      o.RemoteId,
 		},
 	}
 
@@ -519,6 +519,21 @@ func TestChannelBookmarkIsValid(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"bookmark with invalid link status",
+			&ChannelBookmark{
+				Id:          NewId(),
+				OwnerId:     NewId(),
+				ChannelId:   NewId(),
+				DisplayName: "link status test",
+				LinkUrl:     "https://mattermost.com",
+				Type:        ChannelBookmarkLink,
+				LinkStatus:  "unknown",
+				CreateAt:    3,
+				UpdateAt:    3,
+			},
+			false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -620,3 +635,30 @@ func TestChannelBookmarkPatch(t *testing.T) {
 	require.Equal(t, *p.LinkUrl, b.LinkUrl)
 	require.Equal(t, ChannelBookmarkLink, b.Type)
 }
+
+func TestChannelBookmarkPatchLinkStatus(t *testing.T) {
+	newBookmark := func() ChannelBookmark {
+		return ChannelBookmark{
+			Id:            NewId(),
+			DisplayName:   NewId(),
+			Type:          ChannelBookmarkLink,
+			LinkUrl:       "https://mattermost.com",
+			LinkStatus:    ChannelBookmarkLinkStatusBroken,
+			LinkCheckedAt: 1,
+		}
+	}
+
+	t.Run("same link keeps the status", func(t *testing.T) {
+		b := newBookmark()
+		b.Patch(&ChannelBookmarkPatch{LinkUrl: NewPointer(b.LinkUrl)})
+		require.Equal(t, ChannelBookmarkLinkStatusBroken, b.LinkStatus)
+		require.Equal(t, int64(1), b.LinkCheckedAt)
+	})
+
+	t.Run("new link resets the status", func(t *testing.T) {
+		b := newBookmark()
+		b.Patch(&ChannelBookmarkPatch{LinkUrl: NewPointer("https://docs.mattermost.com")})
+		require.Empty(t, b.LinkStatus)
+		require.Zero(t, b.LinkCheckedAt)
+	})
+}
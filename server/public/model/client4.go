@@ -456,6 +456,10 @@ func (c *Client4) draftsRoute() string {
 	return "/drafts"
 }
 
+func (c *Client4) sharedDraftRoute(draftID string) string {
+	return fmt.Sprintf(c.draftsRoute()+"/shared/%v", draftID)
+}
+
 func (c *Client4) emojisRoute() string {
 	return "/emoji"
 }
@@ -683,6 +687,20 @@ func (c *Client4) DeleteScheduledPost(ctx context.Context, scheduledPostId strin
 	return &deletedScheduledPost, BuildResponse(r), nil
 }
 
+func (c *Client4) UpdateScheduledPostSeries(ctx context.Context, scheduledPostId string, action ScheduledPostSeriesAction) (*ScheduledPost, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.postsRoute()+"/schedule/"+scheduledPostId+"/series/"+string(action), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var scheduledPost ScheduledPost
+	if err := json.NewDecoder(r.Body).Decode(&scheduledPost); err != nil {
+		return nil, nil, NewAppError("UpdateScheduledPostSeries", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &scheduledPost, BuildResponse(r), nil
+}
+
 func (c *Client4) bookmarksRoute(channelId string) string {
 	return c.channelRoute(channelId) + "/bookmarks"
 }
@@ -911,6 +929,55 @@ func (c *Client4) LoginWithMFA(ctx context.Context, loginId, password, mfaToken
 	return c.login(ctx, m)
 }
 
+// GetWebAuthnLoginOptions returns the challenge to log in with a security key or passkey. Given
+// the login id and password of a user, it lists the credentials of the user to use as a second
+// factor. Without a login id, it starts a passwordless login with a passkey.
+func (c *Client4) GetWebAuthnLoginOptions(ctx context.Context, loginId, password string) (*WebAuthnRequestOptions, *Response, error) {
+	m := make(map[string]string)
+	m["login_id"] = loginId
+	m["password"] = password
+	r, err := c.DoAPIPost(ctx, "/users/login/webauthn/options", MapToJSON(m))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var options WebAuthnRequestOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		return nil, nil, NewAppError("GetWebAuthnLoginOptions", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &options, BuildResponse(r), nil
+}
+
+// LoginWithWebAuthn logs a user in with a security key or passkey as the second factor.
+func (c *Client4) LoginWithWebAuthn(ctx context.Context, loginId, password string, assertion *WebAuthnAssertion) (*User, *Response, error) {
+	token, err := json.Marshal(assertion)
+	if err != nil {
+		return nil, nil, NewAppError("LoginWithWebAuthn", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return c.LoginWithMFA(ctx, loginId, password, string(token))
+}
+
+// LoginWithPasskey logs in the user whose passkey made the assertion, without a password.
+func (c *Client4) LoginWithPasskey(ctx context.Context, assertion *WebAuthnAssertion, deviceId string) (*User, *Response, error) {
+	buf, err := json.Marshal(map[string]any{"credential": assertion, "device_id": deviceId})
+	if err != nil {
+		return nil, nil, NewAppError("LoginWithPasskey", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, "/users/login/passkey", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	c.AuthToken = r.Header.Get(HeaderToken)
+	c.AuthType = HeaderBearer
+
+	var user User
+	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
+		return nil, nil, NewAppError("LoginWithPasskey", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &user, BuildResponse(r), nil
+}
+
 func (c *Client4) login(ctx context.Context, m map[string]string) (*User, *Response, error) {
 	r, err := c.DoAPIPost(ctx, "/users/login", MapToJSON(m))
 	if err != nil {
@@ -1597,6 +1664,63 @@ func (c *Client4) GenerateMfaSecret(ctx context.Context, userId string) (*MfaSec
 	return &secret, BuildResponse(r), nil
 }
 
+// GetWebAuthnRegistrationOptions returns the options to create a security key or passkey
+// credential for a user with the browser.
+func (c *Client4) GetWebAuthnRegistrationOptions(ctx context.Context, userId string) (*WebAuthnCreationOptions, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.userRoute(userId)+"/webauthn/credentials/options", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var options WebAuthnCreationOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+		return nil, nil, NewAppError("GetWebAuthnRegistrationOptions", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &options, BuildResponse(r), nil
+}
+
+// RegisterWebAuthnCredential registers the security key or passkey credential created for a user.
+func (c *Client4) RegisterWebAuthnCredential(ctx context.Context, userId string, registration *WebAuthnRegistration) (*WebAuthnCredential, *Response, error) {
+	buf, err := json.Marshal(registration)
+	if err != nil {
+		return nil, nil, NewAppError("RegisterWebAuthnCredential", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPostBytes(ctx, c.userRoute(userId)+"/webauthn/credentials", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var credential WebAuthnCredential
+	if err := json.NewDecoder(r.Body).Decode(&credential); err != nil {
+		return nil, nil, NewAppError("RegisterWebAuthnCredential", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &credential, BuildResponse(r), nil
+}
+
+// GetWebAuthnCredentials returns the security keys and passkeys of a user.
+func (c *Client4) GetWebAuthnCredentials(ctx context.Context, userId string) ([]*WebAuthnCredential, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userId)+"/webauthn/credentials", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var credentials []*WebAuthnCredential
+	if err := json.NewDecoder(r.Body).Decode(&credentials); err != nil {
+		return nil, nil, NewAppError("GetWebAuthnCredentials", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return credentials, BuildResponse(r), nil
+}
+
+// DeleteWebAuthnCredential removes a security key or passkey of a user.
+func (c *Client4) DeleteWebAuthnCredential(ctx context.Context, userId, credentialId string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.userRoute(userId)+"/webauthn/credentials/"+credentialId)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // UpdateUserPassword updates a user's password. Must be logged in as the user or be a system administrator.
 func (c *Client4) UpdateUserPassword(ctx context.Context, userId, currentPassword, newPassword string) (*Response, error) {
 	requestBody := map[string]string{"current_password": currentPassword, "new_password": newPassword}
@@ -1808,6 +1932,31 @@ func (c *Client4) AttachDeviceProps(ctx context.Context, newProps map[string]str
 	return BuildResponse(r), nil
 }
 
+// AttachWebPushSubscription attaches a browser's push subscription to the current session, so
+// that it receives the user's push notifications.
+func (c *Client4) AttachWebPushSubscription(ctx context.Context, subscription *WebPushSubscription) (*Response, error) {
+	buf, err := json.Marshal(subscription)
+	if err != nil {
+		return nil, NewAppError("AttachWebPushSubscription", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPutBytes(ctx, c.usersRoute()+"/sessions/web_push", buf)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// DetachWebPushSubscription removes the push subscription attached to the current session.
+func (c *Client4) DetachWebPushSubscription(ctx context.Context) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.usersRoute()+"/sessions/web_push")
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
 // GetTeamsUnreadForUser will return an array with TeamUnread objects that contain the amount
 // of unread messages and mentions the current user has for the teams it belongs to.
 // An optional team ID can be set to exclude that team from the results.
@@ -4026,6 +4175,86 @@ func (c *Client4) SetPostReminder(ctx context.Context, reminder *PostReminder) (
 	return BuildResponse(r), nil
 }
 
+// GetPostReminders returns the pending reminders of the user, soonest first.
+func (c *Client4) GetPostReminders(ctx context.Context, userID string) ([]*PostReminder, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.userRoute(userID)+"/reminders", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var reminders []*PostReminder
+	if jsonErr := json.NewDecoder(r.Body).Decode(&reminders); jsonErr != nil {
+		return nil, nil, NewAppError("GetPostReminders", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+	}
+	return reminders, BuildResponse(r), nil
+}
+
+// CreateStandalonePostReminder creates a reminder about its own message rather than about a post.
+// The time needs to be in UTC epoch in seconds.
+func (c *Client4) CreateStandalonePostReminder(ctx context.Context, reminder *PostReminder) (*PostReminder, *Response, error) {
+	b, err := json.Marshal(reminder)
+	if err != nil {
+		return nil, nil, NewAppError("CreateStandalonePostReminder", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPostBytes(ctx, c.userRoute(reminder.UserId)+"/reminders", b)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodePostReminder("CreateStandalonePostReminder", r)
+}
+
+// ReschedulePostReminder moves the reminder with the given post id, or standalone reminder id, to
+// the given time, in UTC epoch in seconds.
+func (c *Client4) ReschedulePostReminder(ctx context.Context, userID, postID string, targetTime int64) (*PostReminder, *Response, error) {
+	b, err := json.Marshal(&PostReminder{TargetTime: targetTime})
+	if err != nil {
+		return nil, nil, NewAppError("ReschedulePostReminder", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPutBytes(ctx, c.userRoute(userID)+"/reminders/"+postID, b)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodePostReminder("ReschedulePostReminder", r)
+}
+
+func (c *Client4) DeletePostReminder(ctx context.Context, userID, postID string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.userRoute(userID)+"/reminders/"+postID)
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+// SnoozePostReminder reminds the user again, in the given number of minutes, of the reminder
+// message the system bot sent them.
+func (c *Client4) SnoozePostReminder(ctx context.Context, userID, reminderPostID string, minutes int) (*PostReminder, *Response, error) {
+	b, err := json.Marshal(map[string]int{"minutes": minutes})
+	if err != nil {
+		return nil, nil, NewAppError("SnoozePostReminder", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPostBytes(ctx, c.userRoute(userID)+c.postRoute(reminderPostID)+"/reminder/snooze", b)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodePostReminder("SnoozePostReminder", r)
+}
+
+func decodePostReminder(where string, r *http.Response) (*PostReminder, *Response, error) {
+	var reminder *PostReminder
+	if jsonErr := json.NewDecoder(r.Body).Decode(&reminder); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+	}
+	return reminder, BuildResponse(r), nil
+}
+
 // PinPost pin a post based on provided post id string.
 func (c *Client4) PinPost(ctx context.Context, postId string) (*Response, error) {
 	r, err := c.DoAPIPost(ctx, c.postRoute(postId)+"/pin", "")
@@ -4833,6 +5062,21 @@ func (c *Client4) TestNotifications(ctx context.Context) (*Response, error) {
 	return BuildResponse(r), nil
 }
 
+// GetWebPushPublicKey returns the server's VAPID public key, which browsers need to subscribe
+// to web push notifications.
+func (c *Client4) GetWebPushPublicKey(ctx context.Context) (*WebPushPublicKey, *Response, error) {
+	r, err := c.DoAPIGet(ctx, "/notifications/web_push/public_key", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var publicKey WebPushPublicKey
+	if err := json.NewDecoder(r.Body).Decode(&publicKey); err != nil {
+		return nil, nil, NewAppError("GetWebPushPublicKey", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &publicKey, BuildResponse(r), nil
+}
+
 // TestSiteURL will test the validity of a site URL.
 func (c *Client4) TestSiteURL(ctx context.Context, siteURL string) (*Response, error) {
 	requestBody := make(map[string]string)
@@ -4905,6 +5149,52 @@ func (c *Client4) GetEnvironmentConfig(ctx context.Context) (map[string]any, *Re
 	return StringInterfaceFromJSON(r.Body), BuildResponse(r), nil
 }
 
+// GetConfigHistory will retrieve a page of the saved server configurations, most recent first,
+// along with the settings each of them changed. Sensitive values are redacted.
+func (c *Client4) GetConfigHistory(ctx context.Context, page, perPage int) ([]*ConfigHistoryEntry, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, c.configRoute()+"/history"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var entries []*ConfigHistoryEntry
+	if err := json.NewDecoder(r.Body).Decode(&entries); err != nil {
+		return nil, BuildResponse(r), NewAppError("GetConfigHistory", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return entries, BuildResponse(r), nil
+}
+
+// GetConfigVersionDiff will retrieve the settings that restoring the given saved server
+// configuration would change. Sensitive values are redacted.
+func (c *Client4) GetConfigVersionDiff(ctx context.Context, configID string) ([]*ConfigChange, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.configRoute()+"/history/"+configID+"/diff", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var changes []*ConfigChange
+	if err := json.NewDecoder(r.Body).Decode(&changes); err != nil {
+		return nil, BuildResponse(r), NewAppError("GetConfigVersionDiff", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return changes, BuildResponse(r), nil
+}
+
+// RestoreConfigVersion will make the given saved server configuration the active one again.
+func (c *Client4) RestoreConfigVersion(ctx context.Context, configID string) (*Config, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.configRoute()+"/history/"+configID+"/restore", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var cfg *Config
+	d := json.NewDecoder(r.Body)
+	return cfg, BuildResponse(r), d.Decode(&cfg)
+}
+
 // GetOldClientLicense will retrieve the parts of the server license needed by the
 // client, formatted in the old format.
 func (c *Client4) GetOldClientLicense(ctx context.Context, etag string) (map[string]string, *Response, error) {
@@ -5280,6 +5570,35 @@ func (c *Client4) RegenOutgoingHookToken(ctx context.Context, hookId string) (*O
 	return &ow, BuildResponse(r), nil
 }
 
+// RegenOutgoingHookSecret regenerates the secret used to sign the outgoing webhook's requests.
+func (c *Client4) RegenOutgoingHookSecret(ctx context.Context, hookId string) (*OutgoingWebhook, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.outgoingWebhookRoute(hookId)+"/regen_secret", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var ow OutgoingWebhook
+	if err := json.NewDecoder(r.Body).Decode(&ow); err != nil {
+		return nil, nil, NewAppError("RegenOutgoingHookSecret", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &ow, BuildResponse(r), nil
+}
+
+// GetOutgoingWebhookDeliveries returns a page of delivery attempts for an outgoing webhook, newest first. Page counting starts at 0.
+func (c *Client4) GetOutgoingWebhookDeliveries(ctx context.Context, hookId string, page int, perPage int) ([]*OutgoingWebhookDelivery, *Response, error) {
+	query := fmt.Sprintf("?page=%v&per_page=%v", page, perPage)
+	r, err := c.DoAPIGet(ctx, c.outgoingWebhookRoute(hookId)+"/deliveries"+query, "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var deliveries []*OutgoingWebhookDelivery
+	if err := json.NewDecoder(r.Body).Decode(&deliveries); err != nil {
+		return nil, nil, NewAppError("GetOutgoingWebhookDeliveries", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return deliveries, BuildResponse(r), nil
+}
+
 // DeleteOutgoingWebhook delete the outgoing webhook on the system requested by Hook Id.
 func (c *Client4) DeleteOutgoingWebhook(ctx context.Context, hookId string) (*Response, error) {
 	r, err := c.DoAPIDelete(ctx, c.outgoingWebhookRoute(hookId))
@@ -5932,6 +6251,56 @@ func (c *Client4) GetAudits(ctx context.Context, page int, perPage int, etag str
 	return audits, BuildResponse(r), nil
 }
 
+// SearchAuditRecords returns a page of the audit records persisted by the database audit target,
+// newest first.
+func (c *Client4) SearchAuditRecords(ctx context.Context, opts AuditRecordSearchOpts) ([]*AuditRecord, *Response, error) {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(opts.Page))
+	values.Set("per_page", strconv.Itoa(opts.PerPage))
+	if opts.UserId != "" {
+		values.Set("user_id", opts.UserId)
+	}
+	if opts.EventName != "" {
+		values.Set("event_name", opts.EventName)
+	}
+	if opts.Status != "" {
+		values.Set("status", opts.Status)
+	}
+	if opts.StartTime > 0 {
+		values.Set("start_time", strconv.FormatInt(opts.StartTime, 10))
+	}
+	if opts.EndTime > 0 {
+		values.Set("end_time", strconv.FormatInt(opts.EndTime, 10))
+	}
+
+	r, err := c.DoAPIGet(ctx, "/audits/records?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var records []*AuditRecord
+	if err := json.NewDecoder(r.Body).Decode(&records); err != nil {
+		return nil, BuildResponse(r), NewAppError("SearchAuditRecords", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return records, BuildResponse(r), nil
+}
+
+// VerifyAuditRecords checks the hash chain of the audit records persisted by the database audit target.
+func (c *Client4) VerifyAuditRecords(ctx context.Context) (*AuditRecordVerification, *Response, error) {
+	r, err := c.DoAPIGet(ctx, "/audits/records/verify", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var result AuditRecordVerification
+	if err := json.NewDecoder(r.Body).Decode(&result); err != nil {
+		return nil, BuildResponse(r), NewAppError("VerifyAuditRecords", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return &result, BuildResponse(r), nil
+}
+
 // Brand Section
 
 // GetBrandImage retrieves the previously uploaded brand image.
@@ -6652,6 +7021,122 @@ func (c *Client4) DeleteDraft(ctx context.Context, userId, channelId, rootId str
 	return df, BuildResponse(r), nil
 }
 
+// CreateSharedDraft creates a draft shared with the given users.
+func (c *Client4) CreateSharedDraft(ctx context.Context, draft *SharedDraft) (*SharedDraft, *Response, error) {
+	buf, err := json.Marshal(draft)
+	if err != nil {
+		return nil, nil, NewAppError("CreateSharedDraft", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPostBytes(ctx, c.draftsRoute()+"/shared", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodeSharedDraft("CreateSharedDraft", r)
+}
+
+// GetSharedDrafts gets the shared drafts the current user is a member of.
+func (c *Client4) GetSharedDrafts(ctx context.Context) ([]*SharedDraft, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.draftsRoute()+"/shared", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var drafts []*SharedDraft
+	if jsonErr := json.NewDecoder(r.Body).Decode(&drafts); jsonErr != nil {
+		return nil, nil, NewAppError("GetSharedDrafts", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+	}
+	return drafts, BuildResponse(r), nil
+}
+
+func (c *Client4) GetSharedDraft(ctx context.Context, draftID string) (*SharedDraft, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.sharedDraftRoute(draftID), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodeSharedDraft("GetSharedDraft", r)
+}
+
+// UpdateSharedDraft edits the shared draft. The patch must carry the UpdateAt of the
+// version it's based on, otherwise the edit is rejected with a conflict.
+func (c *Client4) UpdateSharedDraft(ctx context.Context, draftID string, patch *SharedDraftPatch) (*SharedDraft, *Response, error) {
+	buf, err := json.Marshal(patch)
+	if err != nil {
+		return nil, nil, NewAppError("UpdateSharedDraft", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPutBytes(ctx, c.sharedDraftRoute(draftID), buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodeSharedDraft("UpdateSharedDraft", r)
+}
+
+func (c *Client4) DeleteSharedDraft(ctx context.Context, draftID string) (*Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.sharedDraftRoute(draftID))
+	if err != nil {
+		return BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return BuildResponse(r), nil
+}
+
+func (c *Client4) AddSharedDraftMembers(ctx context.Context, draftID string, userIDs []string) (*SharedDraft, *Response, error) {
+	buf, err := json.Marshal(userIDs)
+	if err != nil {
+		return nil, nil, NewAppError("AddSharedDraftMembers", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPostBytes(ctx, c.sharedDraftRoute(draftID)+"/members", buf)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodeSharedDraft("AddSharedDraftMembers", r)
+}
+
+func (c *Client4) RemoveSharedDraftMember(ctx context.Context, draftID, userID string) (*SharedDraft, *Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.sharedDraftRoute(draftID)+"/members/"+userID)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodeSharedDraft("RemoveSharedDraftMember", r)
+}
+
+// PublishSharedDraft posts the shared draft to its channel as the current user. The draft
+// must not have been updated since updateAt, otherwise it's rejected with a conflict.
+func (c *Client4) PublishSharedDraft(ctx context.Context, draftID string, updateAt int64) (*Post, *Response, error) {
+	b, err := json.Marshal(map[string]int64{"update_at": updateAt})
+	if err != nil {
+		return nil, nil, NewAppError("PublishSharedDraft", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+
+	r, err := c.DoAPIPostBytes(ctx, c.sharedDraftRoute(draftID)+"/publish", b)
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var post Post
+	if jsonErr := json.NewDecoder(r.Body).Decode(&post); jsonErr != nil {
+		return nil, nil, NewAppError("PublishSharedDraft", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+	}
+	return &post, BuildResponse(r), nil
+}
+
+func decodeSharedDraft(where string, r *http.Response) (*SharedDraft, *Response, error) {
+	var draft *SharedDraft
+	if jsonErr := json.NewDecoder(r.Body).Decode(&draft); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+	}
+	return draft, BuildResponse(r), nil
+}
+
 // Commands Section
 
 // CreateCommand will create a new command if the user have the right permissions.
@@ -8839,6 +9324,22 @@ func (c *Client4) GetRemoteClusterInfo(ctx context.Context, remoteID string) (Re
 	return rci, BuildResponse(r), nil
 }
 
+// SearchRemoteUsers searches the user directories of the remotes a shared channel is shared with.
+func (c *Client4) SearchRemoteUsers(ctx context.Context, channelID, term string) ([]*User, *Response, error) {
+	values := url.Values{}
+	values.Set("term", term)
+	r, err := c.DoAPIGet(ctx, c.sharedChannelsRoute()+"/"+channelID+"/remote_users/search?"+values.Encode(), "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+
+	var users []*User
+	json.NewDecoder(r.Body).Decode(&users)
+
+	return users, BuildResponse(r), nil
+}
+
 func (c *Client4) GetRemoteClusters(ctx context.Context, page, perPage int, filter RemoteClusterQueryFilter) ([]*RemoteCluster, *Response, error) {
 	v := url.Values{}
 	if page != 0 {
@@ -9174,6 +9675,58 @@ func (c *Client4) UnacknowledgePost(ctx context.Context, postId, userId string)
 	return BuildResponse(r), nil
 }
 
+// GetPostEscalation returns the notification escalation of a post.
+func (c *Client4) GetPostEscalation(ctx context.Context, postId string) (*PostEscalation, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.postRoute(postId)+"/escalation", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodePostEscalation("GetPostEscalation", r)
+}
+
+// SetPostEscalation starts escalating the notifications of a post until it gets acknowledged.
+func (c *Client4) SetPostEscalation(ctx context.Context, postId string, escalation *PostEscalation) (*PostEscalation, *Response, error) {
+	buf, err := json.Marshal(escalation)
+	if err != nil {
+		return nil, nil, NewAppError("SetPostEscalation", "api.marshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	r, err := c.DoAPIPut(ctx, c.postRoute(postId)+"/escalation", string(buf))
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodePostEscalation("SetPostEscalation", r)
+}
+
+// CancelPostEscalation stops escalating the notifications of a post.
+func (c *Client4) CancelPostEscalation(ctx context.Context, postId string) (*PostEscalation, *Response, error) {
+	r, err := c.DoAPIDelete(ctx, c.postRoute(postId)+"/escalation")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodePostEscalation("CancelPostEscalation", r)
+}
+
+// EscalatePost notifies the next level of the escalation of a post right away.
+func (c *Client4) EscalatePost(ctx context.Context, postId string) (*PostEscalation, *Response, error) {
+	r, err := c.DoAPIPost(ctx, c.postRoute(postId)+"/escalation/escalate", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	return decodePostEscalation("EscalatePost", r)
+}
+
+func decodePostEscalation(where string, r *http.Response) (*PostEscalation, *Response, error) {
+	var escalation *PostEscalation
+	if jsonErr := json.NewDecoder(r.Body).Decode(&escalation); jsonErr != nil {
+		return nil, nil, NewAppError(where, "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(jsonErr)
+	}
+	return escalation, BuildResponse(r), nil
+}
+
 func (c *Client4) AddUserToGroupSyncables(ctx context.Context, userID string) (*Response, error) {
 	r, err := c.DoAPIPost(ctx, c.ldapRoute()+"/users/"+userID+"/group_sync_memberships", "")
 	if err != nil {
@@ -9276,6 +9829,20 @@ func (c *Client4) ListChannelBookmarksForChannel(ctx context.Context, channelId
 	return b, BuildResponse(r), nil
 }
 
+// ListBrokenChannelBookmarksForChannel returns the channel's link bookmarks whose link was found to be broken.
+func (c *Client4) ListBrokenChannelBookmarksForChannel(ctx context.Context, channelId string) ([]*ChannelBookmarkWithFileInfo, *Response, error) {
+	r, err := c.DoAPIGet(ctx, c.bookmarksRoute(channelId)+"/broken", "")
+	if err != nil {
+		return nil, BuildResponse(r), err
+	}
+	defer closeBody(r)
+	var b []*ChannelBookmarkWithFileInfo
+	if err := json.NewDecoder(r.Body).Decode(&b); err != nil {
+		return nil, nil, NewAppError("ListBrokenChannelBookmarksForChannel", "api.unmarshal_error", nil, "", http.StatusInternalServerError).Wrap(err)
+	}
+	return b, BuildResponse(r), nil
+}
+
 func (c *Client4) SubmitClientMetrics(ctx context.Context, report *PerformanceReport) (*Response, error) {
 	buf, err := json.Marshal(report)
 	if err != nil {
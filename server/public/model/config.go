@@ -47,6 +47,16 @@ const (
 	PasswordMaximumLength = 72
 	PasswordMinimumLength = 5
 
+	PasswordHashingAlgorithmBcrypt   = "bcrypt"
+	PasswordHashingAlgorithmArgon2id = "argon2id"
+
+	PasswordArgon2idDefaultMemoryKiB   = 19456
+	PasswordArgon2idDefaultIterations  = 2
+	PasswordArgon2idDefaultParallelism = 1
+	PasswordArgon2idMaxMemoryKiB       = 4 * 1024 * 1024
+	PasswordArgon2idMaxIterations      = 64
+	PasswordArgon2idMaxParallelism     = 255
+
 	ServiceGitlab    = "gitlab"
 	ServiceGoogle    = "google"
 	ServiceOffice365 = "office365"
@@ -102,6 +112,8 @@ const (
 	CacheTypeLRU   = "lru"
 	CacheTypeRedis = "redis"
 
+	CacheSettingsDefaultRedisLocalCacheTTLSeconds = 60
+
 	SitenameMaxLength = 30
 
 	ServiceSettingsDefaultSiteURL                = "http://localhost:8065"
@@ -130,6 +142,12 @@ const (
 	FileSettingsDefaultDirectory                   = "./data/"
 	FileSettingsDefaultS3UploadPartSizeBytes       = 5 * 1024 * 1024   // 5MB
 	FileSettingsDefaultS3ExportUploadPartSizeBytes = 100 * 1024 * 1024 // 100MB
+	FileSettingsDefaultAtRestEncryptionKeyEnvVar   = "MM_FILE_ENCRYPTION_KEYS"
+	FileSettingsDefaultOCRCommand                  = "tesseract"
+	FileSettingsDefaultOCRLanguages                = "eng"
+	FileSettingsDefaultOCRPDFRasterizeCommand      = "pdftoppm"
+	FileSettingsDefaultOCRMaxPages                 = 10
+	FileSettingsDefaultOCRTimeoutSeconds           = 60
 
 	ImportSettingsDefaultDirectory     = "./import"
 	ImportSettingsDefaultRetentionDays = 30
@@ -227,6 +245,8 @@ const (
 	DataRetentionSettingsDefaultRetentionIdsBatchSize          = 100
 
 	OutgoingIntegrationRequestsDefaultTimeout = 30
+	OutgoingWebhookDefaultMaxRetries          = 0
+	OutgoingWebhookMaxRetriesLimit            = 10
 
 	PluginSettingsDefaultDirectory         = "./plugins"
 	PluginSettingsDefaultClientDirectory   = "./client/plugins"
@@ -238,6 +258,8 @@ const (
 	ComplianceExportTypeActiance       = "actiance"
 	ComplianceExportTypeGlobalrelay    = "globalrelay"
 	ComplianceExportTypeGlobalrelayZip = "globalrelay-zip"
+	ComplianceExportTypeEml            = "eml"
+	ComplianceExportTypeMbox           = "mbox"
 	GlobalrelayCustomerTypeA9          = "A9"
 	GlobalrelayCustomerTypeA10         = "A10"
 	GlobalrelayCustomerTypeCustom      = "CUSTOM"
@@ -340,12 +362,14 @@ type ServiceSettings struct {
 	EnableOutgoingOAuthConnections      *bool    `access:"integrations_integration_management"`
 	EnableCommands                      *bool    `access:"integrations_integration_management"`
 	OutgoingIntegrationRequestsTimeout  *int64   `access:"integrations_integration_management"` // In seconds.
+	OutgoingWebhookMaxRetries           *int     `access:"integrations_integration_management"`
 	EnablePostUsernameOverride          *bool    `access:"integrations_integration_management"`
 	EnablePostIconOverride              *bool    `access:"integrations_integration_management"`
 	GoogleDeveloperKey                  *string  `access:"site_posts,write_restrictable,cloud_restrictable"`
 	EnableLinkPreviews                  *bool    `access:"site_posts"`
 	EnablePermalinkPreviews             *bool    `access:"site_posts"`
 	RestrictLinkPreviews                *string  `access:"site_posts"`
+	EnableChannelBookmarkLinkChecks     *bool    `access:"site_posts"`
 	EnableTesting                       *bool    `access:"environment_developer,write_restrictable,cloud_restrictable"`
 	EnableDeveloper                     *bool    `access:"environment_developer,write_restrictable,cloud_restrictable"`
 	DeveloperFlags                      *string  `access:"environment_developer,cloud_restrictable"`
@@ -356,6 +380,9 @@ type ServiceSettings struct {
 	AllowedUntrustedInternalConnections *string  `access:"environment_web_server,write_restrictable,cloud_restrictable"`
 	EnableMultifactorAuthentication     *bool    `access:"authentication_mfa"`
 	EnforceMultifactorAuthentication    *bool    `access:"authentication_mfa"`
+	EnableWebAuthn                      *bool    `access:"authentication_mfa"`
+	EnforceWebAuthn                     *bool    `access:"authentication_mfa"`
+	EnablePasskeyLogin                  *bool    `access:"authentication_mfa"`
 	EnableUserAccessTokens              *bool    `access:"integrations_integration_management"`
 	AllowCorsFrom                       *string  `access:"integrations_cors,write_restrictable,cloud_restrictable"`
 	CorsExposedHeaders                  *string  `access:"integrations_cors,write_restrictable,cloud_restrictable"`
@@ -417,6 +444,7 @@ type ServiceSettings struct {
 	PersistentNotificationIntervalMinutes             *int  `access:"site_posts"`
 	PersistentNotificationMaxCount                    *int  `access:"site_posts"`
 	PersistentNotificationMaxRecipients               *int  `access:"site_posts"`
+	AllowPostEscalations                              *bool `access:"site_posts"`
 	EnableAPIChannelDeletion                          *bool
 	EnableLocalMode                                   *bool   `access:"cloud_restrictable"`
 	LocalModeSocketLocation                           *string `access:"cloud_restrictable"` // telemetry: none
@@ -480,6 +508,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.RestrictLinkPreviews = NewPointer("")
 	}
 
+	if s.EnableChannelBookmarkLinkChecks == nil {
+		s.EnableChannelBookmarkLinkChecks = NewPointer(false)
+	}
+
 	if s.EnableTesting == nil {
 		s.EnableTesting = NewPointer(false)
 	}
@@ -520,6 +552,18 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.EnforceMultifactorAuthentication = NewPointer(false)
 	}
 
+	if s.EnableWebAuthn == nil {
+		s.EnableWebAuthn = NewPointer(false)
+	}
+
+	if s.EnforceWebAuthn == nil {
+		s.EnforceWebAuthn = NewPointer(false)
+	}
+
+	if s.EnablePasskeyLogin == nil {
+		s.EnablePasskeyLogin = NewPointer(false)
+	}
+
 	if s.EnableUserAccessTokens == nil {
 		s.EnableUserAccessTokens = NewPointer(false)
 	}
@@ -552,6 +596,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.OutgoingIntegrationRequestsTimeout = NewPointer(int64(OutgoingIntegrationRequestsDefaultTimeout))
 	}
 
+	if s.OutgoingWebhookMaxRetries == nil {
+		s.OutgoingWebhookMaxRetries = NewPointer(OutgoingWebhookDefaultMaxRetries)
+	}
+
 	if s.ConnectionSecurity == nil {
 		s.ConnectionSecurity = NewPointer("")
 	}
@@ -934,6 +982,10 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 		s.PersistentNotificationMaxRecipients = NewPointer(5)
 	}
 
+	if s.AllowPostEscalations == nil {
+		s.AllowPostEscalations = NewPointer(false)
+	}
+
 	if s.AllowSyncedDrafts == nil {
 		s.AllowSyncedDrafts = NewPointer(true)
 	}
@@ -964,11 +1016,13 @@ func (s *ServiceSettings) SetDefaults(isUpdate bool) {
 }
 
 type CacheSettings struct {
-	CacheType          *string `access:",write_restrictable,cloud_restrictable"`
-	RedisAddress       *string `access:",write_restrictable,cloud_restrictable"` // telemetry: none
-	RedisPassword      *string `access:",write_restrictable,cloud_restrictable"` // telemetry: none
-	RedisDB            *int    `access:",write_restrictable,cloud_restrictable"` // telemetry: none
-	DisableClientCache *bool   `access:",write_restrictable,cloud_restrictable"` // telemetry: none
+	CacheType                 *string `access:",write_restrictable,cloud_restrictable"`
+	RedisAddress              *string `access:",write_restrictable,cloud_restrictable"` // telemetry: none
+	RedisPassword             *string `access:",write_restrictable,cloud_restrictable"` // telemetry: none
+	RedisDB                   *int    `access:",write_restrictable,cloud_restrictable"` // telemetry: none
+	DisableClientCache        *bool   `access:",write_restrictable,cloud_restrictable"` // telemetry: none
+	EnableRedisLocalCache     *bool   `access:",write_restrictable,cloud_restrictable"` // telemetry: none
+	RedisLocalCacheTTLSeconds *int    `access:",write_restrictable,cloud_restrictable"` // telemetry: none
 }
 
 func (s *CacheSettings) SetDefaults() {
@@ -991,6 +1045,14 @@ func (s *CacheSettings) SetDefaults() {
 	if s.DisableClientCache == nil {
 		s.DisableClientCache = NewPointer(false)
 	}
+
+	if s.EnableRedisLocalCache == nil {
+		s.EnableRedisLocalCache = NewPointer(false)
+	}
+
+	if s.RedisLocalCacheTTLSeconds == nil {
+		s.RedisLocalCacheTTLSeconds = NewPointer(CacheSettingsDefaultRedisLocalCacheTTLSeconds)
+	}
 }
 
 func (s *CacheSettings) isValid() *AppError {
@@ -1006,6 +1068,10 @@ func (s *CacheSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.invalid_redis_db.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.CacheType == CacheTypeRedis && *s.EnableRedisLocalCache && *s.RedisLocalCacheTTLSeconds <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.redis_local_cache_ttl.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -1021,6 +1087,7 @@ type ClusterSettings struct {
 	EnableExperimentalGossipEncryption *bool   `access:"environment_high_availability,write_restrictable,cloud_restrictable"`
 	ReadOnlyConfig                     *bool   `access:"environment_high_availability,write_restrictable,cloud_restrictable"`
 	GossipPort                         *int    `access:"environment_high_availability,write_restrictable,cloud_restrictable"` // telemetry: none
+	EnableRedisMessageBus              *bool   `access:"environment_high_availability,write_restrictable,cloud_restrictable"`
 }
 
 func (s *ClusterSettings) SetDefaults() {
@@ -1067,6 +1134,10 @@ func (s *ClusterSettings) SetDefaults() {
 	if s.GossipPort == nil {
 		s.GossipPort = NewPointer(8074)
 	}
+
+	if s.EnableRedisMessageBus == nil {
+		s.EnableRedisMessageBus = NewPointer(false)
+	}
 }
 
 type MetricsSettings struct {
@@ -1498,6 +1569,7 @@ type ExperimentalAuditSettings struct {
 	FileCompress        *bool           `access:"experimental_features,write_restrictable,cloud_restrictable"`
 	FileMaxQueueSize    *int            `access:"experimental_features,write_restrictable,cloud_restrictable"`
 	AdvancedLoggingJSON json.RawMessage `access:"experimental_features,write_restrictable"`
+	DatabaseEnabled     *bool           `access:"experimental_features,write_restrictable,cloud_restrictable"`
 }
 
 func (s *ExperimentalAuditSettings) SetDefaults() {
@@ -1532,6 +1604,10 @@ func (s *ExperimentalAuditSettings) SetDefaults() {
 	if utils.IsEmptyJSON(s.AdvancedLoggingJSON) {
 		s.AdvancedLoggingJSON = []byte("{}")
 	}
+
+	if s.DatabaseEnabled == nil {
+		s.DatabaseEnabled = NewPointer(false)
+	}
 }
 
 // GetAdvancedLoggingConfig returns the advanced logging config as a []byte.
@@ -1603,12 +1679,17 @@ func (s *NotificationLogSettings) GetAdvancedLoggingConfig() []byte {
 }
 
 type PasswordSettings struct {
-	MinimumLength    *int  `access:"authentication_password"`
-	Lowercase        *bool `access:"authentication_password"`
-	Number           *bool `access:"authentication_password"`
-	Uppercase        *bool `access:"authentication_password"`
-	Symbol           *bool `access:"authentication_password"`
-	EnableForgotLink *bool `access:"authentication_password"`
+	MinimumLength         *int    `access:"authentication_password"`
+	Lowercase             *bool   `access:"authentication_password"`
+	Number                *bool   `access:"authentication_password"`
+	Uppercase             *bool   `access:"authentication_password"`
+	Symbol                *bool   `access:"authentication_password"`
+	EnableForgotLink      *bool   `access:"authentication_password"`
+	HashingAlgorithm      *string `access:"authentication_password"`
+	Argon2idMemoryKiB     *int    `access:"authentication_password"`
+	Argon2idIterations    *int    `access:"authentication_password"`
+	Argon2idParallelism   *int    `access:"authentication_password"`
+	BreachedPasswordsFile *string `access:"authentication_password"`
 }
 
 func (s *PasswordSettings) SetDefaults() {
@@ -1635,6 +1716,53 @@ func (s *PasswordSettings) SetDefaults() {
 	if s.EnableForgotLink == nil {
 		s.EnableForgotLink = NewPointer(true)
 	}
+
+	if s.HashingAlgorithm == nil {
+		s.HashingAlgorithm = NewPointer(PasswordHashingAlgorithmBcrypt)
+	}
+
+	if s.Argon2idMemoryKiB == nil {
+		s.Argon2idMemoryKiB = NewPointer(PasswordArgon2idDefaultMemoryKiB)
+	}
+
+	if s.Argon2idIterations == nil {
+		s.Argon2idIterations = NewPointer(PasswordArgon2idDefaultIterations)
+	}
+
+	if s.Argon2idParallelism == nil {
+		s.Argon2idParallelism = NewPointer(PasswordArgon2idDefaultParallelism)
+	}
+
+	if s.BreachedPasswordsFile == nil {
+		s.BreachedPasswordsFile = NewPointer("")
+	}
+}
+
+func (s *PasswordSettings) isValid() *AppError {
+	if *s.MinimumLength < PasswordMinimumLength || *s.MinimumLength > PasswordMaximumLength {
+		return NewAppError("Config.IsValid", "model.config.is_valid.password_length.app_error", map[string]any{"MinLength": PasswordMinimumLength, "MaxLength": PasswordMaximumLength}, "", http.StatusBadRequest)
+	}
+
+	switch *s.HashingAlgorithm {
+	case PasswordHashingAlgorithmBcrypt, PasswordHashingAlgorithmArgon2id:
+	default:
+		return NewAppError("Config.IsValid", "model.config.is_valid.password_hashing_algorithm.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.Argon2idIterations < 1 || *s.Argon2idIterations > PasswordArgon2idMaxIterations {
+		return NewAppError("Config.IsValid", "model.config.is_valid.password_argon2id_iterations.app_error", map[string]any{"Max": PasswordArgon2idMaxIterations}, "", http.StatusBadRequest)
+	}
+
+	if *s.Argon2idParallelism < 1 || *s.Argon2idParallelism > PasswordArgon2idMaxParallelism {
+		return NewAppError("Config.IsValid", "model.config.is_valid.password_argon2id_parallelism.app_error", map[string]any{"Max": PasswordArgon2idMaxParallelism}, "", http.StatusBadRequest)
+	}
+
+	// Argon2 requires at least 8KiB of memory per lane.
+	if *s.Argon2idMemoryKiB < 8*(*s.Argon2idParallelism) || *s.Argon2idMemoryKiB > PasswordArgon2idMaxMemoryKiB {
+		return NewAppError("Config.IsValid", "model.config.is_valid.password_argon2id_memory.app_error", map[string]any{"Min": 8 * *s.Argon2idParallelism, "Max": PasswordArgon2idMaxMemoryKiB}, "", http.StatusBadRequest)
+	}
+
+	return nil
 }
 
 type FileSettings struct {
@@ -1649,6 +1777,16 @@ type FileSettings struct {
 	EnablePublicLink                   *bool   `access:"site_public_links,cloud_restrictable"`
 	ExtractContent                     *bool   `access:"environment_file_storage,write_restrictable"`
 	ArchiveRecursion                   *bool   `access:"environment_file_storage,write_restrictable"`
+	EnableOCR                          *bool   `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	OCRCommand                         *string `access:"write_restrictable,cloud_restrictable"`                          // telemetry: none
+	OCRLanguages                       *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	OCRPDFRasterizeCommand             *string `access:"write_restrictable,cloud_restrictable"`                          // telemetry: none
+	OCRMaxPages                        *int    `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	OCRTimeoutSeconds                  *int    `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	EnableContentDeduplication         *bool   `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	EnableAtRestEncryption             *bool   `access:"environment_file_storage,write_restrictable,cloud_restrictable"`
+	AtRestEncryptionKeyFile            *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
+	AtRestEncryptionKeyEnvVar          *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
 	PublicLinkSalt                     *string `access:"site_public_links,cloud_restrictable"`                           // telemetry: none
 	InitialFont                        *string `access:"environment_file_storage,cloud_restrictable"`                    // telemetry: none
 	AmazonS3AccessKeyId                *string `access:"environment_file_storage,write_restrictable,cloud_restrictable"` // telemetry: none
@@ -1729,6 +1867,46 @@ func (s *FileSettings) SetDefaults(isUpdate bool) {
 		s.ArchiveRecursion = NewPointer(false)
 	}
 
+	if s.EnableOCR == nil {
+		s.EnableOCR = NewPointer(false)
+	}
+
+	if s.OCRCommand == nil {
+		s.OCRCommand = NewPointer(FileSettingsDefaultOCRCommand)
+	}
+
+	if s.OCRLanguages == nil {
+		s.OCRLanguages = NewPointer(FileSettingsDefaultOCRLanguages)
+	}
+
+	if s.OCRPDFRasterizeCommand == nil {
+		s.OCRPDFRasterizeCommand = NewPointer(FileSettingsDefaultOCRPDFRasterizeCommand)
+	}
+
+	if s.OCRMaxPages == nil {
+		s.OCRMaxPages = NewPointer(FileSettingsDefaultOCRMaxPages)
+	}
+
+	if s.OCRTimeoutSeconds == nil {
+		s.OCRTimeoutSeconds = NewPointer(FileSettingsDefaultOCRTimeoutSeconds)
+	}
+
+	if s.EnableContentDeduplication == nil {
+		s.EnableContentDeduplication = NewPointer(false)
+	}
+
+	if s.EnableAtRestEncryption == nil {
+		s.EnableAtRestEncryption = NewPointer(false)
+	}
+
+	if s.AtRestEncryptionKeyFile == nil {
+		s.AtRestEncryptionKeyFile = NewPointer("")
+	}
+
+	if s.AtRestEncryptionKeyEnvVar == nil {
+		s.AtRestEncryptionKeyEnvVar = NewPointer(FileSettingsDefaultAtRestEncryptionKeyEnvVar)
+	}
+
 	if isUpdate {
 		// When updating an existing configuration, ensure link salt has been specified.
 		if s.PublicLinkSalt == nil || *s.PublicLinkSalt == "" {
@@ -1891,6 +2069,7 @@ type EmailSettings struct {
 	PushNotificationServer            *string `access:"environment_push_notification_server"` // telemetry: none
 	PushNotificationContents          *string `access:"site_notifications"`
 	PushNotificationBuffer            *int    // telemetry: none
+	EnableWebPushNotifications        *bool   `access:"environment_push_notification_server"`
 	EnableEmailBatching               *bool   `access:"site_notifications"`
 	EmailBatchingBufferSize           *int    `access:"experimental_features"`
 	EmailBatchingInterval             *int    `access:"experimental_features"`
@@ -1995,6 +2174,10 @@ func (s *EmailSettings) SetDefaults(isUpdate bool) {
 		s.PushNotificationBuffer = NewPointer(1000)
 	}
 
+	if s.EnableWebPushNotifications == nil {
+		s.EnableWebPushNotifications = NewPointer(false)
+	}
+
 	if s.EnableEmailBatching == nil {
 		s.EnableEmailBatching = NewPointer(false)
 	}
@@ -2044,6 +2227,48 @@ func (s *EmailSettings) SetDefaults(isUpdate bool) {
 	}
 }
 
+// RateLimitClass is a rate limit applied, in addition to the global one, to requests matching
+// any of its routes and made by users with any of its roles.
+//
+// Classes without roles do not need a user: they are applied before the session is looked up and
+// count requests per remote address, so that unauthenticated and invalid-token requests cannot reach
+// the database unthrottled. Classes with roles are applied once the session is resolved and count
+// requests per user.
+type RateLimitClass struct {
+	Name *string `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	// Routes are path prefixes relative to the site URL, e.g. /api/v4/users/login. Empty matches all routes.
+	Routes []string `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	// Methods are the HTTP methods the class applies to. Empty matches all methods.
+	Methods []string `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	// Roles are the roles the class applies to. Empty matches all requests, including unauthenticated
+	// ones, keyed by remote address.
+	Roles    []string `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	PerSec   *int     `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	MaxBurst *int     `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+}
+
+func (c *RateLimitClass) isValid() *AppError {
+	if c.Name == nil || *c.Name == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.rate_limit_class.name.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if c.PerSec == nil || *c.PerSec <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.rate_limit_class.per_sec.app_error", map[string]any{"Name": *c.Name}, "", http.StatusBadRequest)
+	}
+
+	if c.MaxBurst == nil || *c.MaxBurst < 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.rate_limit_class.max_burst.app_error", map[string]any{"Name": *c.Name}, "", http.StatusBadRequest)
+	}
+
+	for _, route := range c.Routes {
+		if !strings.HasPrefix(route, "/") {
+			return NewAppError("Config.IsValid", "model.config.is_valid.rate_limit_class.route.app_error", map[string]any{"Name": *c.Name, "Route": route}, "", http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
 type RateLimitSettings struct {
 	Enable           *bool  `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
 	PerSec           *int   `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
@@ -2052,6 +2277,10 @@ type RateLimitSettings struct {
 	VaryByRemoteAddr *bool  `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
 	VaryByUser       *bool  `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
 	VaryByHeader     string `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	// UseCacheStore keeps the rate limit state in the external cache, when one is configured, so
+	// that limits are enforced across all nodes of a cluster.
+	UseCacheStore *bool             `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"`
+	Classes       []*RateLimitClass `access:"environment_rate_limiting,write_restrictable,cloud_restrictable"` // telemetry: none
 }
 
 func (s *RateLimitSettings) SetDefaults() {
@@ -2078,6 +2307,29 @@ func (s *RateLimitSettings) SetDefaults() {
 	if s.VaryByUser == nil {
 		s.VaryByUser = NewPointer(false)
 	}
+
+	if s.UseCacheStore == nil {
+		s.UseCacheStore = NewPointer(true)
+	}
+
+	if s.Classes == nil {
+		s.Classes = []*RateLimitClass{
+			{
+				Name:     NewPointer("login"),
+				Routes:   []string{"/api/v4/users/login"},
+				Methods:  []string{http.MethodPost},
+				PerSec:   NewPointer(1),
+				MaxBurst: NewPointer(10),
+			},
+			{
+				Name:     NewPointer("file_uploads"),
+				Routes:   []string{"/api/v4/files", "/api/v4/uploads"},
+				Methods:  []string{http.MethodPost},
+				PerSec:   NewPointer(2),
+				MaxBurst: NewPointer(20),
+			},
+		}
+	}
 }
 
 type PrivacySettings struct {
@@ -3799,6 +4051,10 @@ func (o *Config) IsValid() *AppError {
 		return appErr
 	}
 
+	if *o.ClusterSettings.EnableRedisMessageBus && *o.CacheSettings.RedisAddress == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.cluster_redis_address.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	if *o.ServiceSettings.SiteURL == "" && *o.ServiceSettings.AllowCookiesForSubdomains {
 		return NewAppError("Config.IsValid", "model.config.is_valid.allow_cookies_for_subdomains.app_error", nil, "", http.StatusBadRequest)
 	}
@@ -3831,8 +4087,8 @@ func (o *Config) IsValid() *AppError {
 		return appErr
 	}
 
-	if *o.PasswordSettings.MinimumLength < PasswordMinimumLength || *o.PasswordSettings.MinimumLength > PasswordMaximumLength {
-		return NewAppError("Config.IsValid", "model.config.is_valid.password_length.app_error", map[string]any{"MinLength": PasswordMinimumLength, "MaxLength": PasswordMaximumLength}, "", http.StatusBadRequest)
+	if appErr := o.PasswordSettings.isValid(); appErr != nil {
+		return appErr
 	}
 
 	if appErr := o.RateLimitSettings.isValid(); appErr != nil {
@@ -3995,6 +4251,22 @@ func (s *FileSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.storage_class.app_error", map[string]any{"Value": *s.ExportAmazonS3StorageClass}, "", http.StatusBadRequest)
 	}
 
+	if *s.EnableOCR && (*s.OCRCommand == "" || *s.OCRPDFRasterizeCommand == "") {
+		return NewAppError("Config.IsValid", "model.config.is_valid.ocr_command.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if *s.OCRMaxPages <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.ocr_max_pages.app_error", map[string]any{"Value": *s.OCRMaxPages}, "", http.StatusBadRequest)
+	}
+
+	if *s.OCRTimeoutSeconds <= 0 {
+		return NewAppError("Config.IsValid", "model.config.is_valid.ocr_timeout.app_error", map[string]any{"Value": *s.OCRTimeoutSeconds}, "", http.StatusBadRequest)
+	}
+
+	if *s.EnableAtRestEncryption && *s.AtRestEncryptionKeyFile == "" && *s.AtRestEncryptionKeyEnvVar == "" {
+		return NewAppError("Config.IsValid", "model.config.is_valid.at_rest_encryption_key.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -4031,6 +4303,22 @@ func (s *RateLimitSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.max_burst.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	names := make(map[string]bool, len(s.Classes))
+	for _, class := range s.Classes {
+		if class == nil {
+			continue
+		}
+
+		if appErr := class.isValid(); appErr != nil {
+			return appErr
+		}
+
+		if names[*class.Name] {
+			return NewAppError("Config.IsValid", "model.config.is_valid.rate_limit_class.duplicate_name.app_error", map[string]any{"Name": *class.Name}, "", http.StatusBadRequest)
+		}
+		names[*class.Name] = true
+	}
+
 	return nil
 }
 
@@ -4252,6 +4540,10 @@ func (s *ServiceSettings) isValid() *AppError {
 		return NewAppError("Config.IsValid", "model.config.is_valid.outgoing_integrations_request_timeout.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if *s.OutgoingWebhookMaxRetries < 0 || *s.OutgoingWebhookMaxRetries > OutgoingWebhookMaxRetriesLimit {
+		return NewAppError("Config.IsValid", "model.config.is_valid.outgoing_webhook_max_retries.app_error", map[string]any{"Max": OutgoingWebhookMaxRetriesLimit}, "", http.StatusBadRequest)
+	}
+
 	if *s.ExperimentalGroupUnreadChannels != GroupUnreadChannelsDisabled &&
 		*s.ExperimentalGroupUnreadChannels != GroupUnreadChannelsDefaultOn &&
 		*s.ExperimentalGroupUnreadChannels != GroupUnreadChannelsDefaultOff {
@@ -4428,7 +4720,7 @@ func (s *MessageExportSettings) isValid() *AppError {
 			return NewAppError("Config.IsValid", "model.config.is_valid.message_export.daily_runtime.app_error", nil, "", http.StatusBadRequest).Wrap(err)
 		} else if s.BatchSize == nil || *s.BatchSize < 0 {
 			return NewAppError("Config.IsValid", "model.config.is_valid.message_export.batch_size.app_error", nil, "", http.StatusBadRequest)
-		} else if s.ExportFormat == nil || (*s.ExportFormat != ComplianceExportTypeActiance && *s.ExportFormat != ComplianceExportTypeGlobalrelay && *s.ExportFormat != ComplianceExportTypeCsv) {
+		} else if s.ExportFormat == nil || (*s.ExportFormat != ComplianceExportTypeActiance && *s.ExportFormat != ComplianceExportTypeGlobalrelay && *s.ExportFormat != ComplianceExportTypeCsv && *s.ExportFormat != ComplianceExportTypeEml && *s.ExportFormat != ComplianceExportTypeMbox) {
 			return NewAppError("Config.IsValid", "model.config.is_valid.message_export.export_type.app_error", nil, "", http.StatusBadRequest)
 		}
 
@@ -0,0 +1,29 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+const (
+	ConfigHistoryDefaultPerPage = 20
+	ConfigHistoryMaxPerPage     = 100
+)
+
+// ConfigHistoryEntry is a saved version of the configuration, along with the settings
+// it changed compared to the version saved before it.
+type ConfigHistoryEntry struct {
+	Id       string `json:"id"`
+	CreateAt int64  `json:"create_at"`
+	// UserId is the user who saved this version. It is empty for changes made by the
+	// server itself or through local mode.
+	UserId  string          `json:"user_id"`
+	Active  bool            `json:"active"`
+	Changes []*ConfigChange `json:"changes"`
+}
+
+// ConfigChange is a setting that differs between two versions of the configuration.
+// Sensitive values are redacted.
+type ConfigChange struct {
+	Path      string `json:"path"`
+	BaseVal   any    `json:"base_val"`
+	ActualVal any    `json:"actual_val"`
+}
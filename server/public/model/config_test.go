@@ -115,6 +115,61 @@ func TestServiceSettingsIsValid(t *testing.T) {
 	}
 }
 
+func TestRateLimitSettingsIsValid(t *testing.T) {
+	for name, test := range map[string]struct {
+		Classes     []*RateLimitClass
+		ExpectError bool
+	}{
+		"default classes": {
+			Classes:     nil,
+			ExpectError: false,
+		},
+		"no classes": {
+			Classes:     []*RateLimitClass{},
+			ExpectError: false,
+		},
+		"class without a name": {
+			Classes:     []*RateLimitClass{{PerSec: NewPointer(1), MaxBurst: NewPointer(1)}},
+			ExpectError: true,
+		},
+		"class without a rate": {
+			Classes:     []*RateLimitClass{{Name: NewPointer("login"), MaxBurst: NewPointer(1)}},
+			ExpectError: true,
+		},
+		"class with a negative burst": {
+			Classes:     []*RateLimitClass{{Name: NewPointer("login"), PerSec: NewPointer(1), MaxBurst: NewPointer(-1)}},
+			ExpectError: true,
+		},
+		"class with a relative route": {
+			Classes:     []*RateLimitClass{{Name: NewPointer("login"), Routes: []string{"api/v4/users/login"}, PerSec: NewPointer(1), MaxBurst: NewPointer(1)}},
+			ExpectError: true,
+		},
+		"classes with the same name": {
+			Classes: []*RateLimitClass{
+				{Name: NewPointer("login"), PerSec: NewPointer(1), MaxBurst: NewPointer(1)},
+				{Name: NewPointer("login"), PerSec: NewPointer(2), MaxBurst: NewPointer(1)},
+			},
+			ExpectError: true,
+		},
+		"valid class": {
+			Classes:     []*RateLimitClass{{Name: NewPointer("guests"), Roles: []string{SystemGuestRoleId}, PerSec: NewPointer(1), MaxBurst: NewPointer(0)}},
+			ExpectError: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			settings := RateLimitSettings{Classes: test.Classes}
+			settings.SetDefaults()
+
+			appErr := settings.isValid()
+			if test.ExpectError {
+				assert.NotNil(t, appErr)
+			} else {
+				assert.Nil(t, appErr)
+			}
+		})
+	}
+}
+
 func TestConfigEnableDeveloper(t *testing.T) {
 	testCases := []struct {
 		Description     string
@@ -202,6 +257,57 @@ func TestWranglerSettingsIsValid(t *testing.T) {
 	}
 }
 
+func TestPasswordSettingsIsValid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		Update        func(*PasswordSettings)
+		ExpectedError string
+	}{
+		"defaults": {
+			Update: func(*PasswordSettings) {},
+		},
+		"argon2id": {
+			Update: func(s *PasswordSettings) { *s.HashingAlgorithm = PasswordHashingAlgorithmArgon2id },
+		},
+		"unknown algorithm": {
+			Update:        func(s *PasswordSettings) { *s.HashingAlgorithm = "md5" },
+			ExpectedError: "model.config.is_valid.password_hashing_algorithm.app_error",
+		},
+		"no iterations": {
+			Update:        func(s *PasswordSettings) { *s.Argon2idIterations = 0 },
+			ExpectedError: "model.config.is_valid.password_argon2id_iterations.app_error",
+		},
+		"no parallelism": {
+			Update:        func(s *PasswordSettings) { *s.Argon2idParallelism = 0 },
+			ExpectedError: "model.config.is_valid.password_argon2id_parallelism.app_error",
+		},
+		"too little memory per lane": {
+			Update: func(s *PasswordSettings) {
+				*s.Argon2idParallelism = 4
+				*s.Argon2idMemoryKiB = 16
+			},
+			ExpectedError: "model.config.is_valid.password_argon2id_memory.app_error",
+		},
+		"too much memory": {
+			Update:        func(s *PasswordSettings) { *s.Argon2idMemoryKiB = PasswordArgon2idMaxMemoryKiB + 1 },
+			ExpectedError: "model.config.is_valid.password_argon2id_memory.app_error",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			s := &PasswordSettings{}
+			s.SetDefaults()
+			tc.Update(s)
+
+			appErr := s.isValid()
+			if tc.ExpectedError == "" {
+				assert.Nil(t, appErr)
+			} else {
+				require.NotNil(t, appErr)
+				assert.Equal(t, tc.ExpectedError, appErr.Id)
+			}
+		})
+	}
+}
+
 func TestConfigIsValidDefaultAlgorithms(t *testing.T) {
 	c1 := Config{}
 	c1.SetDefaults()
@@ -500,6 +606,21 @@ func TestMessageExportSettingsIsValidActiance(t *testing.T) {
 	require.Nil(t, mes.isValid())
 }
 
+func TestMessageExportSettingsIsValidEml(t *testing.T) {
+	for _, format := range []string{ComplianceExportTypeEml, ComplianceExportTypeMbox} {
+		mes := &MessageExportSettings{
+			EnableExport:        NewPointer(true),
+			ExportFormat:        NewPointer(format),
+			ExportFromTimestamp: NewPointer(int64(0)),
+			DailyRunTime:        NewPointer("15:04"),
+			BatchSize:           NewPointer(100),
+		}
+
+		// should pass because everything is valid
+		require.Nil(t, mes.isValid(), format)
+	}
+}
+
 func TestMessageExportSettingsIsValidGlobalRelaySettingsMissing(t *testing.T) {
 	mes := &MessageExportSettings{
 		EnableExport:        NewPointer(true),
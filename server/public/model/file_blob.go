@@ -0,0 +1,48 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"path"
+	"regexp"
+)
+
+// FileBlobsDirectory is the file store directory holding deduplicated file content.
+const FileBlobsDirectory = "blobs"
+
+var validFileContentHash = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// FileBlob is a piece of file content shared by every FileInfo with the same content hash. RefCount
+// is the number of FileInfos referencing it; blobs no longer referenced are removed from the file
+// store by the file deduplication job.
+type FileBlob struct {
+	Hash     string `json:"hash"`
+	Path     string `json:"-"`
+	Size     int64  `json:"size"`
+	RefCount int64  `json:"ref_count"`
+	CreateAt int64  `json:"create_at"`
+	UpdateAt int64  `json:"update_at"`
+}
+
+// NewFileContentHash returns the hash used to address file content.
+func NewFileContentHash() hash.Hash {
+	return sha256.New()
+}
+
+// FileContentHashString encodes the sum of a hash returned by NewFileContentHash.
+func FileContentHashString(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func IsValidFileContentHash(contentHash string) bool {
+	return validFileContentHash.MatchString(contentHash)
+}
+
+// FileBlobPath returns the file store path of the content with the given hash.
+func FileBlobPath(contentHash string) string {
+	return path.Join(FileBlobsDirectory, contentHash[0:2], contentHash[2:4], contentHash)
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileContentHash(t *testing.T) {
+	h := NewFileContentHash()
+	_, err := h.Write([]byte("hello"))
+	require.NoError(t, err)
+
+	contentHash := FileContentHashString(h)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", contentHash)
+	assert.True(t, IsValidFileContentHash(contentHash))
+	assert.Equal(t, "blobs/2c/f2/2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", FileBlobPath(contentHash))
+
+	assert.False(t, IsValidFileContentHash(""))
+	assert.False(t, IsValidFileContentHash("2CF24DBA5FB0A30E26E83B2AC5B9E29E1B161E5C1FA7425E73043362938B9824"))
+	assert.False(t, IsValidFileContentHash(contentHash[1:]))
+}
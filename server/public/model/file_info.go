@@ -57,6 +57,8 @@ type FileInfo struct {
 	Content         string  `json:"-"`
 	RemoteId        *string `json:"remote_id"`
 	Archived        bool    `json:"archived"`
+	// ContentHash is the hash of the file content when it is stored as a deduplicated FileBlob.
+	ContentHash string `json:"-"` // not sent back to the client
 }
 
 func (fi *FileInfo) Auditable() map[string]interface{} {
@@ -117,6 +119,10 @@ func (fi *FileInfo) IsValid() *AppError {
 		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.path.app_error", nil, "id="+fi.Id, http.StatusBadRequest)
 	}
 
+	if fi.ContentHash != "" && !IsValidFileContentHash(fi.ContentHash) {
+		return NewAppError("FileInfo.IsValid", "model.file_info.is_valid.content_hash.app_error", nil, "id="+fi.Id, http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -60,6 +60,14 @@ func TestFileInfoIsValid(t *testing.T) {
 		assert.Nil(t, info.IsValid(), "creatorId isn't valid")
 		info.CreatorId = creatorId
 	})
+
+	t.Run("Content hash must be a hex encoded sha256", func(t *testing.T) {
+		info.ContentHash = "invalid"
+		assert.NotNil(t, info.IsValid(), "invalid ContentHash isn't valid")
+		info.ContentHash = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+		assert.Nil(t, info.IsValid())
+		info.ContentHash = ""
+	})
 }
 
 func TestFileInfoIsImage(t *testing.T) {
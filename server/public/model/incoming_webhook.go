@@ -9,10 +9,15 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"strings"
+	"text/template"
 )
 
 const (
 	DefaultWebhookUsername = "webhook"
+
+	IncomingWebhookPayloadTemplateMaxLength = 16384
+	IncomingWebhookRateLimitMaxPerMinute    = 60000
 )
 
 type IncomingWebhook struct {
@@ -28,6 +33,16 @@ type IncomingWebhook struct {
 	Username      string `json:"username"`
 	IconURL       string `json:"icon_url"`
 	ChannelLocked bool   `json:"channel_locked"`
+
+	// RateLimitPerMinute is the number of posts per minute the hook is allowed to create. Zero
+	// disables the per-hook limit.
+	RateLimitPerMinute int `json:"rate_limit_per_minute"`
+	// RateLimitBurst is the number of requests allowed above the steady rate. Zero defaults to
+	// RateLimitPerMinute.
+	RateLimitBurst int `json:"rate_limit_burst"`
+	// PayloadTemplate is an optional text/template rendering the post message from an arbitrary
+	// JSON payload. Fields referenced by the template are required to be present in the payload.
+	PayloadTemplate string `json:"payload_template"`
 }
 
 func (o *IncomingWebhook) Auditable() map[string]interface{} {
@@ -44,6 +59,10 @@ func (o *IncomingWebhook) Auditable() map[string]interface{} {
 		"username":       o.Username,
 		"icon_url:":      o.IconURL,
 		"channel_locked": o.ChannelLocked,
+
+		"rate_limit_per_minute": o.RateLimitPerMinute,
+		"rate_limit_burst":      o.RateLimitBurst,
+		"payload_template":      o.PayloadTemplate != "",
 	}
 }
 
@@ -105,6 +124,22 @@ func (o *IncomingWebhook) IsValid() *AppError {
 		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.icon_url.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if o.RateLimitPerMinute < 0 || o.RateLimitPerMinute > IncomingWebhookRateLimitMaxPerMinute {
+		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.rate_limit_per_minute.app_error", map[string]any{"Max": IncomingWebhookRateLimitMaxPerMinute}, "", http.StatusBadRequest)
+	}
+
+	if o.RateLimitBurst < 0 || o.RateLimitBurst > IncomingWebhookRateLimitMaxPerMinute {
+		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.rate_limit_burst.app_error", map[string]any{"Max": IncomingWebhookRateLimitMaxPerMinute}, "", http.StatusBadRequest)
+	}
+
+	if len(o.PayloadTemplate) > IncomingWebhookPayloadTemplateMaxLength {
+		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.payload_template.length.app_error", map[string]any{"Max": IncomingWebhookPayloadTemplateMaxLength}, "", http.StatusBadRequest)
+	}
+
+	if _, err := o.parsePayloadTemplate(); err != nil {
+		return NewAppError("IncomingWebhook.IsValid", "model.incoming_hook.payload_template.parse.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
 	return nil
 }
 
@@ -121,6 +156,54 @@ func (o *IncomingWebhook) PreUpdate() {
 	o.UpdateAt = GetMillis()
 }
 
+// HasRateLimit returns true if the hook restricts how many posts it can create per minute.
+func (o *IncomingWebhook) HasRateLimit() bool {
+	return o.RateLimitPerMinute > 0
+}
+
+// RateLimitMaxBurst returns the number of requests the hook may make above its steady rate.
+func (o *IncomingWebhook) RateLimitMaxBurst() int {
+	if o.RateLimitBurst == 0 {
+		return o.RateLimitPerMinute
+	}
+	return o.RateLimitBurst
+}
+
+func (o *IncomingWebhook) parsePayloadTemplate() (*template.Template, error) {
+	if o.PayloadTemplate == "" {
+		return nil, nil
+	}
+
+	return template.New("payload").Option("missingkey=error").Parse(o.PayloadTemplate)
+}
+
+// TransformPayload validates an arbitrary JSON payload against the hook's payload template and
+// renders it into an incoming webhook request. Payloads that don't contain every field used by the
+// template are rejected.
+func (o *IncomingWebhook) TransformPayload(data []byte) (*IncomingWebhookRequest, *AppError) {
+	tmpl, err := o.parsePayloadTemplate()
+	if err != nil {
+		return nil, NewAppError("IncomingWebhook.TransformPayload", "model.incoming_hook.payload_template.parse.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+	if tmpl == nil {
+		return IncomingWebhookRequestFromJSON(bytes.NewReader(data))
+	}
+
+	var payload any
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err = decoder.Decode(&payload); err != nil {
+		return nil, NewAppError("IncomingWebhook.TransformPayload", "model.incoming_hook.parse_data.app_error", nil, "", http.StatusBadRequest).Wrap(err)
+	}
+
+	var text strings.Builder
+	if err = tmpl.Execute(&text, payload); err != nil {
+		return nil, NewAppError("IncomingWebhook.TransformPayload", "model.incoming_hook.payload_template.execute.app_error", nil, err.Error(), http.StatusBadRequest)
+	}
+
+	return &IncomingWebhookRequest{Text: strings.TrimSpace(text.String())}, nil
+}
+
 // escapeControlCharsFromPayload escapes control chars (\n, \t) from a byte slice.
 // Context:
 // JSON strings are not supposed to contain control characters such as \n, \t,
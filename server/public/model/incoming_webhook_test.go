@@ -4,6 +4,7 @@
 package model
 
 import (
+	"net/http"
 	"strings"
 	"testing"
 
@@ -65,6 +66,85 @@ func TestIncomingWebhookIsValid(t *testing.T) {
 
 	o.IconURL = strings.Repeat("1", 1024)
 	require.Nil(t, o.IsValid())
+
+	o.RateLimitPerMinute = -1
+	require.NotNil(t, o.IsValid())
+
+	o.RateLimitPerMinute = IncomingWebhookRateLimitMaxPerMinute + 1
+	require.NotNil(t, o.IsValid())
+
+	o.RateLimitPerMinute = 60
+	require.Nil(t, o.IsValid())
+
+	o.RateLimitBurst = -1
+	require.NotNil(t, o.IsValid())
+
+	o.RateLimitBurst = 10
+	require.Nil(t, o.IsValid())
+
+	o.PayloadTemplate = "{{ .text "
+	require.NotNil(t, o.IsValid())
+
+	o.PayloadTemplate = strings.Repeat("1", IncomingWebhookPayloadTemplateMaxLength+1)
+	require.NotNil(t, o.IsValid())
+
+	o.PayloadTemplate = "{{ .text }}"
+	require.Nil(t, o.IsValid())
+}
+
+func TestIncomingWebhookRateLimitMaxBurst(t *testing.T) {
+	o := IncomingWebhook{}
+	require.False(t, o.HasRateLimit())
+
+	o.RateLimitPerMinute = 30
+	require.True(t, o.HasRateLimit())
+	require.Equal(t, 30, o.RateLimitMaxBurst())
+
+	o.RateLimitBurst = 5
+	require.Equal(t, 5, o.RateLimitMaxBurst())
+}
+
+func TestIncomingWebhookTransformPayload(t *testing.T) {
+	t.Run("without a template", func(t *testing.T) {
+		o := IncomingWebhook{}
+
+		req, err := o.TransformPayload([]byte(`{"text": "hello"}`))
+		require.Nil(t, err)
+		require.Equal(t, "hello", req.Text)
+	})
+
+	t.Run("with a template", func(t *testing.T) {
+		o := IncomingWebhook{PayloadTemplate: `{{ .status | printf "%.1s" }} {{ range .alerts }}[{{ .labels.alertname }}] {{ end }}`}
+
+		req, err := o.TransformPayload([]byte(`{"status": "firing", "alerts": [{"labels": {"alertname": "HighLoad"}}, {"labels": {"alertname": "DiskFull"}}]}`))
+		require.Nil(t, err)
+		require.Equal(t, "f [HighLoad] [DiskFull]", req.Text)
+	})
+
+	t.Run("with numbers", func(t *testing.T) {
+		o := IncomingWebhook{PayloadTemplate: `PR #{{ .number }}`}
+
+		req, err := o.TransformPayload([]byte(`{"number": 12345678901}`))
+		require.Nil(t, err)
+		require.Equal(t, "PR #12345678901", req.Text)
+	})
+
+	t.Run("with a missing field", func(t *testing.T) {
+		o := IncomingWebhook{PayloadTemplate: `{{ .pull_request.title }}`}
+
+		_, err := o.TransformPayload([]byte(`{"pull_request": {"number": 1}}`))
+		require.NotNil(t, err)
+		require.Equal(t, "model.incoming_hook.payload_template.execute.app_error", err.Id)
+		require.Equal(t, http.StatusBadRequest, err.StatusCode)
+	})
+
+	t.Run("with invalid JSON", func(t *testing.T) {
+		o := IncomingWebhook{PayloadTemplate: `{{ .text }}`}
+
+		_, err := o.TransformPayload([]byte(`{"text":`))
+		require.NotNil(t, err)
+		require.Equal(t, "model.incoming_hook.parse_data.app_error", err.Id)
+	})
 }
 
 func TestIncomingWebhookPreSave(t *testing.T) {
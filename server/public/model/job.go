@@ -41,6 +41,11 @@ const (
 	JobTypeExportUsersToCSV              = "export_users_to_csv"
 	JobTypeDeleteDmsPreferencesMigration = "delete_dms_preferences_migration"
 	JobTypeMobileSessionMetadata         = "mobile_session_metadata"
+	JobTypeOutgoingWebhookRetry          = "outgoing_webhook_retry"
+	JobTypeFileDeduplication             = "file_deduplication"
+	JobTypeFileEncryptionKeyRotation     = "file_encryption_key_rotation"
+	JobTypeChannelBookmarkLinkCheck      = "channel_bookmark_link_check"
+	JobTypePostEscalation                = "post_escalation"
 
 	JobStatusPending         = "pending"
 	JobStatusInProgress      = "in_progress"
@@ -26,6 +26,8 @@ const (
 	NotificationReasonPushProxyError                     NotificationReason = "push_proxy_error"
 	NotificationReasonPushProxySendError                 NotificationReason = "push_proxy_send_error"
 	NotificationReasonPushProxyRemoveDevice              NotificationReason = "push_proxy_remove_device"
+	NotificationReasonWebPushSendError                   NotificationReason = "web_push_send_error"
+	NotificationReasonWebPushSubscriptionGone            NotificationReason = "web_push_subscription_gone"
 	NotificationReasonRejectedByPlugin                   NotificationReason = "rejected_by_plugin"
 	NotificationReasonSessionExpired                     NotificationReason = "session_expired"
 	NotificationReasonChannelMuted                       NotificationReason = "channel_muted"
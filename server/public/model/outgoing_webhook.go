@@ -4,6 +4,9 @@
 package model
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -28,6 +31,7 @@ type OutgoingWebhook struct {
 	ContentType  string      `json:"content_type"`
 	Username     string      `json:"username"`
 	IconURL      string      `json:"icon_url"`
+	Secret       string      `json:"secret"`
 }
 
 func (o *OutgoingWebhook) Auditable() map[string]interface{} {
@@ -76,7 +80,14 @@ type OutgoingWebhookResponse struct {
 	Priority     *PostPriority      `json:"priority"`
 }
 
-const OutgoingHookResponseTypeComment = "comment"
+const (
+	OutgoingHookResponseTypeComment = "comment"
+
+	OutgoingHookSignatureHeader = "X-Mattermost-Signature"
+	OutgoingHookTimestampHeader = "X-Mattermost-Timestamp"
+	OutgoingHookSecretLength    = 32
+	outgoingHookSecretMaxLength = 128
+)
 
 func (o *OutgoingWebhookPayload) ToFormValues() string {
 	v := url.Values{}
@@ -171,6 +182,10 @@ func (o *OutgoingWebhook) IsValid() *AppError {
 		return NewAppError("OutgoingWebhook.IsValid", "model.outgoing_hook.icon_url.app_error", nil, "", http.StatusBadRequest)
 	}
 
+	if len(o.Secret) > outgoingHookSecretMaxLength {
+		return NewAppError("OutgoingWebhook.IsValid", "model.outgoing_hook.is_valid.secret.app_error", nil, "", http.StatusBadRequest)
+	}
+
 	return nil
 }
 
@@ -183,6 +198,10 @@ func (o *OutgoingWebhook) PreSave() {
 		o.Token = NewId()
 	}
 
+	if o.Secret == "" {
+		o.Secret = NewRandomString(OutgoingHookSecretLength)
+	}
+
 	o.CreateAt = GetMillis()
 	o.UpdateAt = o.CreateAt
 }
@@ -191,6 +210,23 @@ func (o *OutgoingWebhook) PreUpdate() {
 	o.UpdateAt = GetMillis()
 }
 
+// Sign returns the value of the OutgoingHookSignatureHeader for a request with the given
+// timestamp and body. The signature is an HMAC-SHA256 of "<timestamp>.<body>" keyed with the
+// hook's secret, so receivers can verify both the origin and the freshness of a request.
+// An empty string is returned when the hook has no secret.
+func (o *OutgoingWebhook) Sign(timestamp string, body []byte) string {
+	if o.Secret == "" {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, []byte(o.Secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
 func (o *OutgoingWebhook) TriggerWordExactMatch(word string) bool {
 	if word == "" {
 		return false
@@ -0,0 +1,138 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	OutgoingWebhookDeliveryStatusSuccess = "success"
+	OutgoingWebhookDeliveryStatusFailed  = "failed"
+
+	OutgoingWebhookDeliveryResponseMaxRunes = 1024
+	OutgoingWebhookDeliveryErrorMaxRunes    = 1024
+	OutgoingWebhookDeliveryPayloadMaxBytes  = 65535
+)
+
+// OutgoingWebhookDelivery records a single attempt to deliver an outgoing webhook
+// payload to one of the webhook's callback URLs.
+type OutgoingWebhookDelivery struct {
+	Id          string `json:"id"`
+	DeliveryId  string `json:"delivery_id"` // The id of the first attempt, shared by its retries.
+	HookId      string `json:"hook_id"`
+	PostId      string `json:"post_id"`
+	CallbackURL string `json:"callback_url"`
+	ContentType string `json:"content_type"`
+	Payload     string `json:"-"` // Without the hook's token, truncated to OutgoingWebhookDeliveryPayloadMaxBytes.
+	Attempt     int    `json:"attempt"`
+	Status      string `json:"status"`
+	StatusCode  int    `json:"status_code"`
+	Latency     int64  `json:"latency"` // In milliseconds.
+	Response    string `json:"response"`
+	Error       string `json:"error"`
+	NextRetryAt int64  `json:"next_retry_at"`
+	CreateAt    int64  `json:"create_at"`
+
+	// SignedBody and SignedAt are the exact body sent, with the hook's token, and the time
+	// it was signed at, in seconds. They are only kept while a retry is pending, so that
+	// the retry resends the same payload.
+	SignedBody string `json:"-"`
+	SignedAt   int64  `json:"-"`
+}
+
+func (o *OutgoingWebhookDelivery) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	if o.DeliveryId == "" {
+		o.DeliveryId = o.Id
+	}
+
+	if o.Attempt == 0 {
+		o.Attempt = 1
+	}
+
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+
+	o.Response = truncateRunes(o.Response, OutgoingWebhookDeliveryResponseMaxRunes)
+	o.Error = truncateRunes(o.Error, OutgoingWebhookDeliveryErrorMaxRunes)
+	if len(o.Payload) > OutgoingWebhookDeliveryPayloadMaxBytes {
+		o.Payload = strings.ToValidUTF8(o.Payload[:OutgoingWebhookDeliveryPayloadMaxBytes], "")
+	}
+}
+
+func (o *OutgoingWebhookDelivery) IsValid() *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.DeliveryId) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.delivery_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.HookId) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.hook_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.PostId != "" && !IsValidId(o.PostId) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.post_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidHTTPURL(o.CallbackURL) {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.callback_url.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.Payload) > OutgoingWebhookDeliveryPayloadMaxBytes {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.payload.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.Attempt < 1 {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.attempt.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.Status != OutgoingWebhookDeliveryStatusSuccess && o.Status != OutgoingWebhookDeliveryStatusFailed {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.status.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("OutgoingWebhookDelivery.IsValid", "model.outgoing_hook_delivery.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// IsSuccessful returns true if the attempt reached the callback URL and the
+// receiver answered with a 2xx status code.
+func (o *OutgoingWebhookDelivery) IsSuccessful() bool {
+	return o.Error == "" && o.StatusCode >= 200 && o.StatusCode < 300
+}
+
+// NextAttempt returns a new, unsaved delivery that retries the same payload.
+func (o *OutgoingWebhookDelivery) NextAttempt() *OutgoingWebhookDelivery {
+	return &OutgoingWebhookDelivery{
+		DeliveryId:  o.DeliveryId,
+		HookId:      o.HookId,
+		PostId:      o.PostId,
+		CallbackURL: o.CallbackURL,
+		ContentType: o.ContentType,
+		Payload:     o.Payload,
+		Attempt:     o.Attempt + 1,
+		SignedBody:  o.SignedBody,
+		SignedAt:    o.SignedAt,
+	}
+}
+
+func truncateRunes(s string, maxRunes int) string {
+	if utf8.RuneCountInString(s) <= maxRunes {
+		return s
+	}
+
+	return string([]rune(s)[:maxRunes])
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutgoingWebhookDeliveryIsValid(t *testing.T) {
+	o := OutgoingWebhookDelivery{}
+	assert.NotNil(t, o.IsValid(), "empty declaration should be invalid")
+
+	o.Id = NewId()
+	assert.NotNil(t, o.IsValid(), "missing delivery id should be invalid")
+
+	o.DeliveryId = o.Id
+	assert.NotNil(t, o.IsValid(), "missing hook id should be invalid")
+
+	o.HookId = NewId()
+	assert.NotNil(t, o.IsValid(), "missing callback url should be invalid")
+
+	o.CallbackURL = "http://nowhere.com/"
+	assert.NotNil(t, o.IsValid(), "attempt 0 should be invalid")
+
+	o.Attempt = 1
+	assert.NotNil(t, o.IsValid(), "empty status should be invalid")
+
+	o.Status = OutgoingWebhookDeliveryStatusFailed
+	assert.NotNil(t, o.IsValid(), "missing create at should be invalid")
+
+	o.CreateAt = GetMillis()
+	assert.Nil(t, o.IsValid())
+
+	o.PostId = "123"
+	assert.NotNil(t, o.IsValid(), "invalid post id should be invalid")
+
+	o.PostId = NewId()
+	assert.Nil(t, o.IsValid())
+
+	o.Payload = strings.Repeat("a", OutgoingWebhookDeliveryPayloadMaxBytes+1)
+	assert.NotNil(t, o.IsValid(), "oversized payload should be invalid")
+}
+
+func TestOutgoingWebhookDeliveryPreSave(t *testing.T) {
+	o := OutgoingWebhookDelivery{
+		Response: strings.Repeat("é", OutgoingWebhookDeliveryResponseMaxRunes+10),
+		Error:    "boom",
+		Payload:  strings.Repeat("é", OutgoingWebhookDeliveryPayloadMaxBytes),
+	}
+	o.PreSave()
+
+	assert.True(t, IsValidId(o.Id))
+	assert.Equal(t, o.Id, o.DeliveryId)
+	assert.Equal(t, 1, o.Attempt)
+	assert.NotZero(t, o.CreateAt)
+	assert.Equal(t, OutgoingWebhookDeliveryResponseMaxRunes, len([]rune(o.Response)))
+	assert.Equal(t, "boom", o.Error)
+	assert.LessOrEqual(t, len(o.Payload), OutgoingWebhookDeliveryPayloadMaxBytes)
+	assert.Greater(t, len(o.Payload), OutgoingWebhookDeliveryPayloadMaxBytes-2)
+	assert.True(t, utf8.ValidString(o.Payload))
+}
+
+func TestOutgoingWebhookDeliveryIsSuccessful(t *testing.T) {
+	assert.True(t, (&OutgoingWebhookDelivery{StatusCode: 200}).IsSuccessful())
+	assert.True(t, (&OutgoingWebhookDelivery{StatusCode: 204}).IsSuccessful())
+	assert.False(t, (&OutgoingWebhookDelivery{StatusCode: 500}).IsSuccessful())
+	assert.False(t, (&OutgoingWebhookDelivery{StatusCode: 200, Error: "unmarshal error"}).IsSuccessful())
+	assert.False(t, (&OutgoingWebhookDelivery{Error: "connection refused"}).IsSuccessful())
+}
+
+func TestOutgoingWebhookDeliveryNextAttempt(t *testing.T) {
+	o := &OutgoingWebhookDelivery{
+		Id:          NewId(),
+		DeliveryId:  NewId(),
+		HookId:      NewId(),
+		PostId:      NewId(),
+		CallbackURL: "http://nowhere.com/",
+		ContentType: "application/json",
+		Payload:     `{"text":"hello"}`,
+		SignedBody:  `{"token":"secret","text":"hello"}`,
+		SignedAt:    GetMillis() / 1000,
+		Attempt:     2,
+		Status:      OutgoingWebhookDeliveryStatusFailed,
+		StatusCode:  502,
+		NextRetryAt: GetMillis(),
+		CreateAt:    GetMillis(),
+	}
+
+	next := o.NextAttempt()
+	require.NotNil(t, next)
+	assert.Empty(t, next.Id)
+	assert.Equal(t, o.DeliveryId, next.DeliveryId)
+	assert.Equal(t, o.HookId, next.HookId)
+	assert.Equal(t, o.PostId, next.PostId)
+	assert.Equal(t, o.CallbackURL, next.CallbackURL)
+	assert.Equal(t, o.ContentType, next.ContentType)
+	assert.Equal(t, o.Payload, next.Payload)
+	assert.Equal(t, o.SignedBody, next.SignedBody)
+	assert.Equal(t, o.SignedAt, next.SignedAt)
+	assert.Equal(t, 3, next.Attempt)
+	assert.Empty(t, next.Status)
+	assert.Zero(t, next.StatusCode)
+	assert.Zero(t, next.NextRetryAt)
+}
@@ -83,6 +83,12 @@ func TestOutgoingWebhookIsValid(t *testing.T) {
 
 	o.IconURL = strings.Repeat("1", 1024)
 	assert.Nilf(t, o.IsValid(), "IconURL length %d should be valid", len(o.IconURL))
+
+	o.Secret = strings.Repeat("1", 129)
+	assert.NotNilf(t, o.IsValid(), "Secret length %d should be invalid, max length 128", len(o.Secret))
+
+	o.Secret = strings.Repeat("1", 128)
+	assert.Nilf(t, o.IsValid(), "Secret length %d should be valid", len(o.Secret))
 }
 
 func TestOutgoingWebhookPayloadToFormValues(t *testing.T) {
@@ -121,6 +127,22 @@ func TestOutgoingWebhookPayloadToFormValues(t *testing.T) {
 func TestOutgoingWebhookPreSave(t *testing.T) {
 	o := OutgoingWebhook{}
 	o.PreSave()
+	assert.Len(t, o.Secret, OutgoingHookSecretLength)
+
+	secret := o.Secret
+	o.PreSave()
+	assert.Equal(t, secret, o.Secret, "PreSave should not replace an existing secret")
+}
+
+func TestOutgoingWebhookSign(t *testing.T) {
+	o := OutgoingWebhook{Secret: "secret"}
+
+	// echo -n '1700000000.{"text":"hello"}' | openssl dgst -sha256 -hmac secret
+	assert.Equal(t, "sha256=1898b1f7ee8ff2fe446237422bd9b3afcdb1fff758351d6ee4236bc6f1530852", o.Sign("1700000000", []byte(`{"text":"hello"}`)))
+	assert.NotEqual(t, o.Sign("1700000000", []byte(`{"text":"hello"}`)), o.Sign("1700000001", []byte(`{"text":"hello"}`)))
+
+	o.Secret = ""
+	assert.Empty(t, o.Sign("1700000000", []byte(`{"text":"hello"}`)))
 }
 
 func TestOutgoingWebhookPreUpdate(t *testing.T) {
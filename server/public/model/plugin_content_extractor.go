@@ -0,0 +1,52 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	PluginContentExtractorDefaultTimeoutMillis = 10 * 1000
+	PluginContentExtractorMaxTimeoutMillis     = 5 * 60 * 1000
+)
+
+// PluginContentExtractor describes the files whose content a plugin extracts through the
+// ExtractFileContent hook, so that they can be searched.
+type PluginContentExtractor struct {
+	// Extensions are the file extensions handled by the plugin, without the leading dot.
+	Extensions []string `json:"extensions"`
+	// TimeoutMillis bounds the time spent extracting the content of a file. Defaults to
+	// PluginContentExtractorDefaultTimeoutMillis.
+	TimeoutMillis int64 `json:"timeout_millis"`
+}
+
+func (e *PluginContentExtractor) SetDefaults() {
+	for i, extension := range e.Extensions {
+		e.Extensions[i] = strings.ToLower(strings.TrimPrefix(extension, "."))
+	}
+
+	if e.TimeoutMillis == 0 {
+		e.TimeoutMillis = PluginContentExtractorDefaultTimeoutMillis
+	}
+}
+
+func (e *PluginContentExtractor) IsValid() *AppError {
+	if len(e.Extensions) == 0 {
+		return NewAppError("PluginContentExtractor.IsValid", "model.plugin_content_extractor.is_valid.extensions.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	for _, extension := range e.Extensions {
+		if extension == "" || strings.ContainsAny(extension, "./\\ ") {
+			return NewAppError("PluginContentExtractor.IsValid", "model.plugin_content_extractor.is_valid.extension.app_error", map[string]any{"Extension": extension}, "", http.StatusBadRequest)
+		}
+	}
+
+	if e.TimeoutMillis <= 0 || e.TimeoutMillis > PluginContentExtractorMaxTimeoutMillis {
+		return NewAppError("PluginContentExtractor.IsValid", "model.plugin_content_extractor.is_valid.timeout.app_error", map[string]any{"Max": PluginContentExtractorMaxTimeoutMillis}, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPluginContentExtractorIsValid(t *testing.T) {
+	extractor := &PluginContentExtractor{Extensions: []string{".IPYNB", "eml"}}
+	extractor.SetDefaults()
+	assert.Equal(t, []string{"ipynb", "eml"}, extractor.Extensions)
+	assert.EqualValues(t, PluginContentExtractorDefaultTimeoutMillis, extractor.TimeoutMillis)
+	require.Nil(t, extractor.IsValid())
+
+	for name, invalid := range map[string]*PluginContentExtractor{
+		"no extension":     {TimeoutMillis: 1000},
+		"empty extension":  {Extensions: []string{""}, TimeoutMillis: 1000},
+		"nested extension": {Extensions: []string{"tar.gz"}, TimeoutMillis: 1000},
+		"negative timeout": {Extensions: []string{"eml"}, TimeoutMillis: -1},
+		"timeout too long": {Extensions: []string{"eml"}, TimeoutMillis: PluginContentExtractorMaxTimeoutMillis + 1},
+	} {
+		assert.NotNil(t, invalid.IsValid(), name)
+	}
+}
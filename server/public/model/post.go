@@ -166,9 +166,13 @@ type PostPatch struct {
 
 type PostReminder struct {
 	TargetTime int64 `json:"target_time"`
-	// These fields are only used internally for interacting with DB.
-	PostId string `json:",omitempty"`
-	UserId string `json:",omitempty"`
+	// Message is the text of a standalone reminder, which isn't about a post.
+	Message string `json:"message,omitempty"`
+	// Recurrence reschedules the reminder once sent, see PostReminderRecurrence*.
+	Recurrence string `json:"recurrence,omitempty"`
+	// PostId is the post of the reminder, or the id of a standalone reminder.
+	PostId string `json:"post_id,omitempty"`
+	UserId string `json:"user_id,omitempty"`
 }
 
 type PostPriority struct {
@@ -6,9 +6,18 @@ package model
 import "net/http"
 
 type PostAcknowledgement struct {
-	UserId         string `json:"user_id"`
-	PostId         string `json:"post_id"`
-	AcknowledgedAt int64  `json:"acknowledged_at"`
+	UserId         string  `json:"user_id"`
+	PostId         string  `json:"post_id"`
+	AcknowledgedAt int64   `json:"acknowledged_at"` // zero when the acknowledgement was removed
+	UpdateAt       int64   `json:"update_at"`
+	RemoteId       *string `json:"remote_id,omitempty"` // set when the last change was received from a shared channel remote
+}
+
+func (o *PostAcknowledgement) GetRemoteID() string {
+	if o.RemoteId != nil {
+		return *o.RemoteId
+	}
+	return ""
 }
 
 func (o *PostAcknowledgement) IsValid() *AppError {
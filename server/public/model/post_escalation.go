@@ -0,0 +1,227 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+const (
+	PostEscalationStatusActive       = "active"
+	PostEscalationStatusAcknowledged = "acknowledged"
+	PostEscalationStatusExhausted    = "exhausted"
+	PostEscalationStatusCanceled     = "canceled"
+
+	PostEscalationMaxLevels             = 10
+	PostEscalationMaxRecipientsPerLevel = 50
+	PostEscalationMinDelayMinutes       = 1
+	PostEscalationMaxDelayMinutes       = 7 * 24 * 60
+)
+
+// PostEscalationLevel is a set of users and groups notified when the post
+// hasn't been acknowledged DelayMinutes after the previous level was notified.
+type PostEscalationLevel struct {
+	DelayMinutes int      `json:"delay_minutes"`
+	UserIds      []string `json:"user_ids,omitempty"`
+	GroupIds     []string `json:"group_ids,omitempty"`
+}
+
+type PostEscalationLevels []*PostEscalationLevel
+
+// PostEscalation describes who gets notified, and when, while a post
+// requesting an acknowledgement remains unacknowledged.
+type PostEscalation struct {
+	PostId               string               `json:"post_id"`
+	ChannelId            string               `json:"channel_id"`
+	CreatorId            string               `json:"creator_id"`
+	CreateAt             int64                `json:"create_at"`
+	UpdateAt             int64                `json:"update_at"`
+	Levels               PostEscalationLevels `json:"levels"`
+	FallbackGroupId      string               `json:"fallback_group_id"`
+	FallbackDelayMinutes int                  `json:"fallback_delay_minutes"`
+	NotifiedLevels       int                  `json:"notified_levels"`
+	LastNotifiedAt       int64                `json:"last_notified_at"`
+	NextEscalationAt     int64                `json:"next_escalation_at"`
+	Status               string               `json:"status"`
+}
+
+func (o *PostEscalation) IsValid() *AppError {
+	if !IsValidId(o.PostId) {
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.post_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.channel_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.creator_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.create_at.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.update_at.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if len(o.Levels) > PostEscalationMaxLevels {
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.levels.app_error", map[string]any{"Max": PostEscalationMaxLevels}, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	for _, level := range o.Levels {
+		if level == nil || len(level.UserIds)+len(level.GroupIds) == 0 || len(level.UserIds)+len(level.GroupIds) > PostEscalationMaxRecipientsPerLevel {
+			return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.recipients.app_error", map[string]any{"Max": PostEscalationMaxRecipientsPerLevel}, "post_id="+o.PostId, http.StatusBadRequest)
+		}
+
+		if !isValidPostEscalationDelay(level.DelayMinutes) {
+			return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.delay.app_error", map[string]any{"Min": PostEscalationMinDelayMinutes, "Max": PostEscalationMaxDelayMinutes}, "post_id="+o.PostId, http.StatusBadRequest)
+		}
+
+		for _, userId := range level.UserIds {
+			if !IsValidId(userId) {
+				return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.user_id.app_error", nil, "user_id="+userId, http.StatusBadRequest)
+			}
+		}
+
+		for _, groupId := range level.GroupIds {
+			if !IsValidId(groupId) {
+				return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.group_id.app_error", nil, "group_id="+groupId, http.StatusBadRequest)
+			}
+		}
+	}
+
+	if !IsValidId(o.FallbackGroupId) {
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.fallback_group_id.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if !isValidPostEscalationDelay(o.FallbackDelayMinutes) {
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.delay.app_error", map[string]any{"Min": PostEscalationMinDelayMinutes, "Max": PostEscalationMaxDelayMinutes}, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	if o.NotifiedLevels < 0 || o.NotifiedLevels > len(o.Levels)+1 {
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.notified_levels.app_error", nil, "post_id="+o.PostId, http.StatusBadRequest)
+	}
+
+	switch o.Status {
+	case PostEscalationStatusActive, PostEscalationStatusAcknowledged, PostEscalationStatusExhausted, PostEscalationStatusCanceled:
+	default:
+		return NewAppError("PostEscalation.IsValid", "model.post_escalation.is_valid.status.app_error", nil, "status="+o.Status, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+func isValidPostEscalationDelay(delayMinutes int) bool {
+	return delayMinutes >= PostEscalationMinDelayMinutes && delayMinutes <= PostEscalationMaxDelayMinutes
+}
+
+// PreSave starts the escalation, the first level being notified once its delay
+// has elapsed without the post being acknowledged.
+func (o *PostEscalation) PreSave() {
+	if o.CreateAt == 0 {
+		o.CreateAt = GetMillis()
+	}
+	o.UpdateAt = o.CreateAt
+
+	o.Status = PostEscalationStatusActive
+	o.NotifiedLevels = 0
+	o.LastNotifiedAt = o.CreateAt
+	o.NextEscalationAt = o.nextEscalationAt()
+}
+
+// AllLevels returns the escalation levels, ending with the fallback group.
+func (o *PostEscalation) AllLevels() PostEscalationLevels {
+	levels := make(PostEscalationLevels, 0, len(o.Levels)+1)
+	levels = append(levels, o.Levels...)
+	return append(levels, &PostEscalationLevel{
+		DelayMinutes: o.FallbackDelayMinutes,
+		GroupIds:     []string{o.FallbackGroupId},
+	})
+}
+
+// NextLevel returns the next level to notify, or nil if every level has already been notified.
+func (o *PostEscalation) NextLevel() *PostEscalationLevel {
+	levels := o.AllLevels()
+	if o.NotifiedLevels >= len(levels) {
+		return nil
+	}
+	return levels[o.NotifiedLevels]
+}
+
+// Advance records that the next level was notified at the given time, marking the
+// escalation as exhausted once the fallback group has been notified.
+func (o *PostEscalation) Advance(notifiedAt int64) {
+	o.NotifiedLevels++
+	o.LastNotifiedAt = notifiedAt
+	o.UpdateAt = notifiedAt
+	o.NextEscalationAt = o.nextEscalationAt()
+	if o.NextEscalationAt == 0 {
+		o.Status = PostEscalationStatusExhausted
+	}
+}
+
+// Stop ends an active escalation with the given status.
+func (o *PostEscalation) Stop(status string) {
+	o.Status = status
+	o.NextEscalationAt = 0
+	o.UpdateAt = GetMillis()
+}
+
+func (o *PostEscalation) IsActive() bool {
+	return o.Status == PostEscalationStatusActive
+}
+
+func (o *PostEscalation) nextEscalationAt() int64 {
+	level := o.NextLevel()
+	if level == nil {
+		return 0
+	}
+	return o.LastNotifiedAt + int64(level.DelayMinutes)*60*1000
+}
+
+func (o *PostEscalation) Auditable() map[string]any {
+	return map[string]any{
+		"post_id":                o.PostId,
+		"channel_id":             o.ChannelId,
+		"creator_id":             o.CreatorId,
+		"levels":                 o.Levels,
+		"fallback_group_id":      o.FallbackGroupId,
+		"fallback_delay_minutes": o.FallbackDelayMinutes,
+		"notified_levels":        o.NotifiedLevels,
+		"status":                 o.Status,
+	}
+}
+
+// Value converts PostEscalationLevels to database value
+func (l PostEscalationLevels) Value() (driver.Value, error) {
+	j, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+	return string(j), nil
+}
+
+// Scan converts database column value to PostEscalationLevels
+func (l *PostEscalationLevels) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+
+	buf, ok := value.([]byte)
+	if ok {
+		return json.Unmarshal(buf, l)
+	}
+
+	str, ok := value.(string)
+	if ok {
+		return json.Unmarshal([]byte(str), l)
+	}
+
+	return errors.New("received value is neither a byte slice nor string")
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPostEscalation() *PostEscalation {
+	escalation := &PostEscalation{
+		PostId:    NewId(),
+		ChannelId: NewId(),
+		CreatorId: NewId(),
+		Levels: PostEscalationLevels{
+			{DelayMinutes: 5, UserIds: []string{NewId()}},
+			{DelayMinutes: 10, GroupIds: []string{NewId()}},
+		},
+		FallbackGroupId:      NewId(),
+		FallbackDelayMinutes: 15,
+	}
+	escalation.PreSave()
+	return escalation
+}
+
+func TestPostEscalationIsValid(t *testing.T) {
+	require.Nil(t, newTestPostEscalation().IsValid())
+
+	for name, update := range map[string]func(*PostEscalation){
+		"invalid post id":        func(e *PostEscalation) { e.PostId = "junk" },
+		"invalid creator id":     func(e *PostEscalation) { e.CreatorId = "" },
+		"missing fallback group": func(e *PostEscalation) { e.FallbackGroupId = "" },
+		"invalid fallback delay": func(e *PostEscalation) { e.FallbackDelayMinutes = 0 },
+		"level without recipients": func(e *PostEscalation) {
+			e.Levels[0].UserIds = nil
+		},
+		"level with invalid user": func(e *PostEscalation) {
+			e.Levels[0].UserIds = []string{"junk"}
+		},
+		"level with too long delay": func(e *PostEscalation) {
+			e.Levels[1].DelayMinutes = PostEscalationMaxDelayMinutes + 1
+		},
+		"too many levels": func(e *PostEscalation) {
+			for range PostEscalationMaxLevels {
+				e.Levels = append(e.Levels, &PostEscalationLevel{DelayMinutes: 1, UserIds: []string{NewId()}})
+			}
+		},
+		"invalid status": func(e *PostEscalation) { e.Status = "paused" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			escalation := newTestPostEscalation()
+			update(escalation)
+			require.NotNil(t, escalation.IsValid())
+		})
+	}
+}
+
+func TestPostEscalationAdvance(t *testing.T) {
+	escalation := newTestPostEscalation()
+	assert.Equal(t, PostEscalationStatusActive, escalation.Status)
+	assert.Equal(t, escalation.CreateAt+5*60*1000, escalation.NextEscalationAt)
+	assert.Equal(t, escalation.Levels[0], escalation.NextLevel())
+
+	escalation.Advance(escalation.CreateAt + 1000)
+	assert.Equal(t, escalation.CreateAt+1000+10*60*1000, escalation.NextEscalationAt)
+	assert.Equal(t, escalation.Levels[1], escalation.NextLevel())
+
+	escalation.Advance(escalation.CreateAt + 2000)
+	assert.Equal(t, escalation.CreateAt+2000+15*60*1000, escalation.NextEscalationAt)
+	assert.Equal(t, []string{escalation.FallbackGroupId}, escalation.NextLevel().GroupIds)
+	assert.True(t, escalation.IsActive())
+
+	escalation.Advance(escalation.CreateAt + 3000)
+	assert.Nil(t, escalation.NextLevel())
+	assert.Zero(t, escalation.NextEscalationAt)
+	assert.Equal(t, PostEscalationStatusExhausted, escalation.Status)
+	require.Nil(t, escalation.IsValid())
+}
+
+func TestPostEscalationLevelsScan(t *testing.T) {
+	levels := PostEscalationLevels{{DelayMinutes: 5, UserIds: []string{NewId()}}}
+	value, err := levels.Value()
+	require.NoError(t, err)
+
+	var scanned PostEscalationLevels
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, levels, scanned)
+
+	require.NoError(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, levels, scanned)
+}
@@ -28,6 +28,9 @@ type PostMetadata struct {
 
 	// Acknowledgements holds acknowledgements made by users to the post
 	Acknowledgements []*PostAcknowledgement `json:"acknowledgements,omitempty"`
+
+	// Escalation holds the progress of the notification escalation of the post.
+	Escalation *PostEscalation `json:"escalation,omitempty"`
 }
 
 func (p *PostMetadata) Auditable() map[string]any {
@@ -47,6 +50,7 @@ func (p *PostMetadata) Auditable() map[string]any {
 		"reactions":        p.Reactions,
 		"priority":         p.Priority,
 		"acknowledgements": p.Acknowledgements,
+		"escalation":       p.Escalation,
 	}
 }
 
@@ -94,6 +98,14 @@ func (p *PostMetadata) Copy() *PostMetadata {
 		}
 	}
 
+	var escalationCopy *PostEscalation
+	if p.Escalation != nil {
+		escalationCopy = &PostEscalation{}
+		*escalationCopy = *p.Escalation
+		escalationCopy.Levels = make(PostEscalationLevels, len(p.Escalation.Levels))
+		copy(escalationCopy.Levels, p.Escalation.Levels)
+	}
+
 	return &PostMetadata{
 		Embeds:           embedsCopy,
 		Emojis:           emojisCopy,
@@ -102,5 +114,6 @@ func (p *PostMetadata) Copy() *PostMetadata {
 		Reactions:        reactionsCopy,
 		Priority:         postPriorityCopy,
 		Acknowledgements: acknowledgementsCopy,
+		Escalation:       escalationCopy,
 	}
 }
@@ -0,0 +1,83 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	PostReminderRecurrenceDaily    = "daily"
+	PostReminderRecurrenceWeekdays = "weekdays"
+	PostReminderRecurrenceWeekly   = "weekly"
+
+	PostReminderMessageMaxRunes = 4000
+)
+
+// PostReminderSnoozeMinutes are the snooze options offered on a reminder message.
+var PostReminderSnoozeMinutes = []int{20, 60, 180, 24 * 60}
+
+func IsValidPostReminderRecurrence(recurrence string) bool {
+	switch recurrence {
+	case "", PostReminderRecurrenceDaily, PostReminderRecurrenceWeekdays, PostReminderRecurrenceWeekly:
+		return true
+	}
+	return false
+}
+
+// IsStandalone tells whether the reminder is about its own message instead of a post.
+func (r *PostReminder) IsStandalone() bool {
+	return r.Message != ""
+}
+
+func (r *PostReminder) IsValid() *AppError {
+	if !IsValidId(r.PostId) {
+		return NewAppError("PostReminder.IsValid", "model.post_reminder.is_valid.post_id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(r.UserId) {
+		return NewAppError("PostReminder.IsValid", "model.post_reminder.is_valid.user_id.app_error", nil, "post_id="+r.PostId, http.StatusBadRequest)
+	}
+
+	if r.TargetTime <= 0 {
+		return NewAppError("PostReminder.IsValid", "model.post_reminder.is_valid.target_time.app_error", nil, "post_id="+r.PostId, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(r.Message) > PostReminderMessageMaxRunes {
+		return NewAppError("PostReminder.IsValid", "model.post_reminder.is_valid.message.app_error", map[string]any{"Max": PostReminderMessageMaxRunes}, "post_id="+r.PostId, http.StatusBadRequest)
+	}
+
+	// Only standalone reminders recur, reminders about a post are one-offs.
+	if !IsValidPostReminderRecurrence(r.Recurrence) || (r.Recurrence != "" && !r.IsStandalone()) {
+		return NewAppError("PostReminder.IsValid", "model.post_reminder.is_valid.recurrence.app_error", nil, "post_id="+r.PostId, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// NextTargetTime returns the next occurrence of a recurring reminder after now, keeping the
+// time of day of the reminder in the given location. Occurrences missed while the server was
+// down are skipped rather than sent in a burst. It returns 0 for a reminder that doesn't recur.
+func (r *PostReminder) NextTargetTime(now time.Time, loc *time.Location) int64 {
+	if r.Recurrence == "" {
+		return 0
+	}
+
+	next := time.Unix(r.TargetTime, 0).In(loc)
+	for !next.After(now) || (r.Recurrence == PostReminderRecurrenceWeekdays && isWeekend(next)) {
+		if r.Recurrence == PostReminderRecurrenceWeekly {
+			next = next.AddDate(0, 0, 7)
+		} else {
+			next = next.AddDate(0, 0, 1)
+		}
+	}
+
+	return next.Unix()
+}
+
+func isWeekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
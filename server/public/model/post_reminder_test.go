@@ -0,0 +1,75 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostReminderIsValid(t *testing.T) {
+	reminder := &PostReminder{
+		PostId:     NewId(),
+		UserId:     NewId(),
+		TargetTime: time.Now().Unix(),
+	}
+	require.Nil(t, reminder.IsValid())
+
+	reminder.Recurrence = PostReminderRecurrenceDaily
+	assert.NotNil(t, reminder.IsValid(), "a reminder about a post can't recur")
+
+	reminder.Message = "Water the plants"
+	assert.Nil(t, reminder.IsValid())
+
+	reminder.Recurrence = "monthly"
+	assert.NotNil(t, reminder.IsValid())
+
+	reminder.Recurrence = ""
+	reminder.Message = strings.Repeat("a", PostReminderMessageMaxRunes+1)
+	assert.NotNil(t, reminder.IsValid())
+
+	reminder.Message = ""
+	reminder.TargetTime = 0
+	assert.NotNil(t, reminder.IsValid())
+
+	reminder.TargetTime = time.Now().Unix()
+	reminder.UserId = "junk"
+	assert.NotNil(t, reminder.IsValid())
+
+	reminder.UserId = NewId()
+	reminder.PostId = ""
+	assert.NotNil(t, reminder.IsValid())
+}
+
+func TestPostReminderNextTargetTime(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// A Friday morning.
+	sent := time.Date(2024, time.March, 8, 9, 0, 0, 0, loc)
+	now := sent.Add(time.Minute)
+
+	reminder := &PostReminder{Message: "standup", TargetTime: sent.Unix()}
+	assert.Equal(t, int64(0), reminder.NextTargetTime(now, loc))
+
+	reminder.Recurrence = PostReminderRecurrenceDaily
+	assert.Equal(t, time.Date(2024, time.March, 9, 9, 0, 0, 0, loc).Unix(), reminder.NextTargetTime(now, loc))
+
+	reminder.Recurrence = PostReminderRecurrenceWeekly
+	assert.Equal(t, time.Date(2024, time.March, 15, 9, 0, 0, 0, loc).Unix(), reminder.NextTargetTime(now, loc))
+
+	// The next weekday is after the weekend, across the daylight saving time change, still at 9 am.
+	reminder.Recurrence = PostReminderRecurrenceWeekdays
+	assert.Equal(t, time.Date(2024, time.March, 11, 9, 0, 0, 0, loc).Unix(), reminder.NextTargetTime(now, loc))
+
+	t.Run("missed occurrences are skipped", func(t *testing.T) {
+		reminder.Recurrence = PostReminderRecurrenceDaily
+		later := sent.AddDate(0, 0, 3).Add(time.Hour)
+		assert.Equal(t, time.Date(2024, time.March, 12, 9, 0, 0, 0, loc).Unix(), reminder.NextTargetTime(later, loc))
+	})
+}
@@ -113,6 +113,8 @@ const (
 	PreferenceEmailIntervalFifteenAsSeconds  = "900"
 	PreferenceEmailIntervalHour              = "hour"
 	PreferenceEmailIntervalHourAsSeconds     = "3600"
+	PreferenceEmailIntervalDaily             = "daily"
+	PreferenceEmailIntervalDailyAsSeconds    = "86400"
 	PreferenceCloudUserEphemeralInfo         = "cloud_user_ephemeral_info"
 
 	PreferenceNameRecommendedNextStepsHide = "hide"
@@ -0,0 +1,91 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// QuietHours is a daily time range, in the user's timezone, during which non-urgent push
+// notifications are held and delivered as a single digest once it is over.
+//
+// It is stored in the user's notify props as "HH:MM-HH:MM". A range whose end is before its
+// start spans midnight.
+type QuietHours struct {
+	// Start and End are expressed in minutes since midnight.
+	Start int
+	End   int
+}
+
+// ParseQuietHours parses a quiet hours range formatted as "HH:MM-HH:MM".
+func ParseQuietHours(value string) (*QuietHours, error) {
+	start, end, found := strings.Cut(value, "-")
+	if !found {
+		return nil, fmt.Errorf("quiet hours %q must be formatted as HH:MM-HH:MM", value)
+	}
+
+	startMinutes, err := parseQuietHoursTime(start)
+	if err != nil {
+		return nil, err
+	}
+	endMinutes, err := parseQuietHoursTime(end)
+	if err != nil {
+		return nil, err
+	}
+	if startMinutes == endMinutes {
+		return nil, fmt.Errorf("quiet hours %q must not start and end at the same time", value)
+	}
+
+	return &QuietHours{Start: startMinutes, End: endMinutes}, nil
+}
+
+func parseQuietHoursTime(value string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid quiet hours time %q: %w", value, err)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Contains returns whether the given time, in the location it is expressed in, is within the
+// quiet hours.
+func (q *QuietHours) Contains(t time.Time) bool {
+	minutes := t.Hour()*60 + t.Minute()
+	if q.Start < q.End {
+		return minutes >= q.Start && minutes < q.End
+	}
+	return minutes >= q.Start || minutes < q.End
+}
+
+// String formats the quiet hours as "HH:MM-HH:MM".
+func (q *QuietHours) String() string {
+	return fmt.Sprintf("%02d:%02d-%02d:%02d", q.Start/60, q.Start%60, q.End/60, q.End%60)
+}
+
+// GetPushQuietHours returns the user's push notification quiet hours, or nil if the user has
+// none or they are invalid.
+func (u *User) GetPushQuietHours() *QuietHours {
+	value := u.NotifyProps[PushQuietHoursNotifyProp]
+	if value == "" {
+		return nil
+	}
+
+	quietHours, err := ParseQuietHours(value)
+	if err != nil {
+		return nil
+	}
+	return quietHours
+}
+
+// IsInPushQuietHours returns whether the given time falls within the user's push notification
+// quiet hours, in the user's timezone.
+func (u *User) IsInPushQuietHours(t time.Time) bool {
+	quietHours := u.GetPushQuietHours()
+	if quietHours == nil {
+		return false
+	}
+	return quietHours.Contains(t.In(u.GetTimezoneLocation()))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuietHours(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		quietHours, err := ParseQuietHours("22:30-07:00")
+		require.NoError(t, err)
+		assert.Equal(t, &QuietHours{Start: 22*60 + 30, End: 7 * 60}, quietHours)
+		assert.Equal(t, "22:30-07:00", quietHours.String())
+	})
+
+	for _, value := range []string{"", "22:00", "22:00-", "24:00-07:00", "22:00-7am", "08:00-08:00"} {
+		t.Run("invalid "+value, func(t *testing.T) {
+			_, err := ParseQuietHours(value)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestQuietHoursContains(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, time.March, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	t.Run("same day", func(t *testing.T) {
+		quietHours := &QuietHours{Start: 12 * 60, End: 14 * 60}
+		assert.False(t, quietHours.Contains(at(11, 59)))
+		assert.True(t, quietHours.Contains(at(12, 0)))
+		assert.True(t, quietHours.Contains(at(13, 59)))
+		assert.False(t, quietHours.Contains(at(14, 0)))
+	})
+
+	t.Run("spanning midnight", func(t *testing.T) {
+		quietHours := &QuietHours{Start: 22 * 60, End: 7 * 60}
+		assert.False(t, quietHours.Contains(at(21, 59)))
+		assert.True(t, quietHours.Contains(at(22, 0)))
+		assert.True(t, quietHours.Contains(at(0, 0)))
+		assert.True(t, quietHours.Contains(at(6, 59)))
+		assert.False(t, quietHours.Contains(at(7, 0)))
+	})
+}
+
+func TestUserIsInPushQuietHours(t *testing.T) {
+	user := &User{
+		NotifyProps: StringMap{PushQuietHoursNotifyProp: "22:00-07:00"},
+		Timezone: StringMap{
+			"useAutomaticTimezone": "false",
+			"manualTimezone":       "America/New_York",
+		},
+	}
+
+	// 03:00 UTC is 22:00 or 23:00 in New York depending on daylight saving time.
+	assert.True(t, user.IsInPushQuietHours(time.Date(2024, time.January, 15, 3, 0, 0, 0, time.UTC)))
+	// 15:00 UTC is during the day in New York.
+	assert.False(t, user.IsInPushQuietHours(time.Date(2024, time.January, 15, 15, 0, 0, 0, time.UTC)))
+
+	user.NotifyProps[PushQuietHoursNotifyProp] = ""
+	assert.False(t, user.IsInPushQuietHours(time.Date(2024, time.January, 15, 3, 0, 0, 0, time.UTC)))
+
+	user.NotifyProps[PushQuietHoursNotifyProp] = "invalid"
+	assert.Nil(t, user.GetPushQuietHours())
+	assert.False(t, user.IsInPushQuietHours(time.Date(2024, time.January, 15, 3, 0, 0, 0, time.UTC)))
+}
@@ -32,6 +32,17 @@ const (
 	BitflagOptionAutoInvited                      // Remote is automatically invited to all shared channels
 )
 
+const (
+	RemoteCapabilityBookmarks        Bitmask = 1 << iota // Remote can receive channel bookmarks via sync
+	RemoteCapabilityAcknowledgements                     // Remote can receive post acknowledgements via sync
+	RemoteCapabilityMembership                           // Remote can receive channel membership changes via sync
+	RemoteCapabilityUserDirectory                        // Remote answers user directory searches
+
+	// RemoteCapabilities is the set of capabilities this server advertises to remotes in ping responses.
+	RemoteCapabilities = RemoteCapabilityBookmarks | RemoteCapabilityAcknowledgements | RemoteCapabilityMembership |
+		RemoteCapabilityUserDirectory
+)
+
 var (
 	validRemoteNameChars = regexp.MustCompile(`^[a-zA-Z0-9\.\-\_]+$`)
 
@@ -41,7 +52,7 @@ var (
 type Bitmask uint32
 
 func (bm *Bitmask) IsBitSet(flag Bitmask) bool {
-	return *bm != 0
+	return *bm&flag != 0
 }
 
 func (bm *Bitmask) SetBit(flag Bitmask) {
@@ -66,8 +77,9 @@ type RemoteCluster struct {
 	RemoteToken   string  `json:"remote_token"`
 	Topics        string  `json:"topics"`
 	CreatorId     string  `json:"creator_id"`
-	PluginID      string  `json:"plugin_id"` // non-empty when sync message are to be delivered via plugin API
-	Options       Bitmask `json:"options"`   // bit-flag set of options
+	PluginID      string  `json:"plugin_id"`    // non-empty when sync message are to be delivered via plugin API
+	Options       Bitmask `json:"options"`      // bit-flag set of options
+	Capabilities  Bitmask `json:"capabilities"` // bit-flag set of capabilities advertised by the remote
 }
 
 func (rc *RemoteCluster) Auditable() map[string]interface{} {
@@ -84,6 +96,7 @@ func (rc *RemoteCluster) Auditable() map[string]interface{} {
 		"creator_id":      rc.CreatorId,
 		"plugin_id":       rc.PluginID,
 		"options":         rc.Options,
+		"capabilities":    rc.Capabilities,
 	}
 }
 
@@ -198,6 +211,11 @@ func (rc *RemoteCluster) UnsetOptionFlag(flag Bitmask) {
 	rc.Options.UnsetBit(flag)
 }
 
+// HasCapability returns true if the remote advertised the capability in its last ping response.
+func (rc *RemoteCluster) HasCapability(capability Bitmask) bool {
+	return rc.Capabilities.IsBitSet(capability)
+}
+
 func IsValidRemoteName(s string) bool {
 	if len(s) < RemoteNameMinLength || len(s) > RemoteNameMaxLength {
 		return false
@@ -357,8 +375,9 @@ func (m RemoteClusterMsg) IsValid() *AppError {
 // RemoteClusterPing represents a ping that is sent and received between clusters
 // to indicate a connection is alive. This is the payload for a `RemoteClusterMsg`.
 type RemoteClusterPing struct {
-	SentAt int64 `json:"sent_at"`
-	RecvAt int64 `json:"recv_at"`
+	SentAt       int64   `json:"sent_at"`
+	RecvAt       int64   `json:"recv_at"`
+	Capabilities Bitmask `json:"capabilities,omitempty"` // set by the receiving remote; older remotes leave it empty
 }
 
 // RemoteClusterInvite represents an invitation to establish a simple trust with a remote cluster.
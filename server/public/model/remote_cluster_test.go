@@ -187,3 +187,17 @@ func TestNewIDFromBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoteClusterHasCapability(t *testing.T) {
+	rc := &RemoteCluster{}
+	assert.False(t, rc.HasCapability(RemoteCapabilityBookmarks))
+	assert.False(t, rc.HasCapability(RemoteCapabilityAcknowledgements))
+
+	rc.Capabilities = RemoteCapabilityAcknowledgements
+	assert.False(t, rc.HasCapability(RemoteCapabilityBookmarks))
+	assert.True(t, rc.HasCapability(RemoteCapabilityAcknowledgements))
+
+	rc.Options = BitflagOptionAutoShareDMs
+	assert.True(t, rc.IsOptionFlagSet(BitflagOptionAutoShareDMs))
+	assert.False(t, rc.IsOptionFlagSet(BitflagOptionAutoInvited))
+}
@@ -4,8 +4,10 @@
 package model
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 const (
@@ -32,6 +34,37 @@ type ScheduledPost struct {
 	ScheduledAt int64  `json:"scheduled_at"`
 	ProcessedAt int64  `json:"processed_at"`
 	ErrorCode   string `json:"error_code"`
+
+	// Recurrence is an optional cron expression or RRULE. Recurring scheduled posts are
+	// rescheduled to their next occurrence after each send instead of being deleted.
+	Recurrence       string `json:"recurrence,omitempty"`
+	RecurrencePaused bool   `json:"recurrence_paused,omitempty"`
+	RecurrenceEndAt  int64  `json:"recurrence_end_at,omitempty"`
+
+	// recurrenceFields records which recurrence fields were present in the JSON the scheduled
+	// post was decoded from. It is nil when the scheduled post wasn't decoded from JSON.
+	recurrenceFields *scheduledPostRecurrenceFields `db:"-"`
+}
+
+type scheduledPostRecurrenceFields struct {
+	Recurrence       *json.RawMessage `json:"recurrence"`
+	RecurrencePaused *json.RawMessage `json:"recurrence_paused"`
+	RecurrenceEndAt  *json.RawMessage `json:"recurrence_end_at"`
+}
+
+func (s *ScheduledPost) UnmarshalJSON(data []byte) error {
+	type scheduledPost ScheduledPost
+	if err := json.Unmarshal(data, (*scheduledPost)(s)); err != nil {
+		return err
+	}
+
+	var fields scheduledPostRecurrenceFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	s.recurrenceFields = &fields
+
+	return nil
 }
 
 func (s *ScheduledPost) IsValid(maxMessageSize int) *AppError {
@@ -64,9 +97,57 @@ func (s *ScheduledPost) BaseIsValid() *AppError {
 		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.processed_at.app_error", nil, "id="+s.Id, http.StatusBadRequest)
 	}
 
+	if s.RecurrenceEndAt < 0 {
+		return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.recurrence_end_at.app_error", nil, "id="+s.Id, http.StatusBadRequest)
+	}
+
+	if s.Recurrence != "" {
+		if len(s.Recurrence) > ScheduledPostRecurrenceMaxLength {
+			return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.recurrence.app_error", nil, "id="+s.Id, http.StatusBadRequest)
+		}
+
+		recurrence, err := ParseRecurrence(s.Recurrence, time.UnixMilli(s.ScheduledAt).UTC())
+		if err != nil {
+			return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.recurrence.app_error", nil, "id="+s.Id, http.StatusBadRequest).Wrap(err)
+		}
+
+		first := recurrence.Next(time.UnixMilli(s.ScheduledAt).UTC())
+		if second := recurrence.Next(first); !first.IsZero() && !second.IsZero() && second.Sub(first) < ScheduledPostRecurrenceMinInterval {
+			return NewAppError("ScheduledPost.IsValid", "model.scheduled_post.is_valid.recurrence_interval.app_error", nil, "id="+s.Id, http.StatusBadRequest)
+		}
+	}
+
 	return nil
 }
 
+func (s *ScheduledPost) IsRecurring() bool {
+	return s.Recurrence != ""
+}
+
+// NextOccurrence returns the first occurrence of a recurring scheduled post after the given
+// time, computed in the given location. It returns 0 when the series has ended.
+func (s *ScheduledPost) NextOccurrence(after int64, loc *time.Location) (int64, error) {
+	if !s.IsRecurring() {
+		return 0, nil
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	recurrence, err := ParseRecurrence(s.Recurrence, time.UnixMilli(s.ScheduledAt).In(loc))
+	if err != nil {
+		return 0, err
+	}
+
+	next := recurrence.Next(time.UnixMilli(after))
+	if next.IsZero() || (s.RecurrenceEndAt > 0 && next.UnixMilli() > s.RecurrenceEndAt) {
+		return 0, nil
+	}
+
+	return next.UnixMilli(), nil
+}
+
 func (s *ScheduledPost) PreSave() {
 	if s.Id == "" {
 		s.Id = NewId()
@@ -144,6 +225,7 @@ func (s *ScheduledPost) Auditable() map[string]interface{} {
 		"props":      s.GetProps(),
 		"file_ids":   s.FileIds,
 		"metadata":   metaData,
+		"recurrence": s.Recurrence,
 	}
 }
 
@@ -153,6 +235,20 @@ func (s *ScheduledPost) RestoreNonUpdatableFields(originalScheduledPost *Schedul
 	s.UserId = originalScheduledPost.UserId
 	s.ChannelId = originalScheduledPost.ChannelId
 	s.RootId = originalScheduledPost.RootId
+
+	// Clients unaware of recurrences don't send the recurrence fields, which would otherwise
+	// turn a recurring scheduled post into a one-off one.
+	if s.recurrenceFields != nil {
+		if s.recurrenceFields.Recurrence == nil {
+			s.Recurrence = originalScheduledPost.Recurrence
+		}
+		if s.recurrenceFields.RecurrencePaused == nil {
+			s.RecurrencePaused = originalScheduledPost.RecurrencePaused
+		}
+		if s.recurrenceFields.RecurrenceEndAt == nil {
+			s.RecurrenceEndAt = originalScheduledPost.RecurrenceEndAt
+		}
+	}
 }
 
 func (s *ScheduledPost) SanitizeInput() {
@@ -0,0 +1,378 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ScheduledPostRecurrenceMaxLength = 256
+
+	// ScheduledPostRecurrenceMinInterval is the shortest time allowed between two occurrences
+	// of a recurring scheduled post.
+	ScheduledPostRecurrenceMinInterval = time.Hour
+
+	// recurrenceSearchYears bounds the search for the next occurrence, so that rules that can
+	// never match, such as the 31st of February, do not loop forever.
+	recurrenceSearchYears = 5
+	recurrenceMaxSkips    = 1000
+)
+
+type ScheduledPostSeriesAction string
+
+const (
+	ScheduledPostSeriesPause  ScheduledPostSeriesAction = "pause"
+	ScheduledPostSeriesResume ScheduledPostSeriesAction = "resume"
+	ScheduledPostSeriesSkip   ScheduledPostSeriesAction = "skip"
+	ScheduledPostSeriesEnd    ScheduledPostSeriesAction = "end"
+)
+
+func (a ScheduledPostSeriesAction) IsValid() bool {
+	switch a {
+	case ScheduledPostSeriesPause, ScheduledPostSeriesResume, ScheduledPostSeriesSkip, ScheduledPostSeriesEnd:
+		return true
+	}
+	return false
+}
+
+// Recurrence is a parsed recurrence rule. Rules are either a five field cron expression
+// ("minute hour day-of-month month day-of-week") or an iCalendar RRULE with a DAILY, WEEKLY or
+// MONTHLY frequency. Occurrences are computed in the location of the anchor, which is the
+// first occurrence of the series.
+type Recurrence struct {
+	schedule cronSchedule
+	freq     string
+	interval int
+	until    time.Time
+	anchor   time.Time
+}
+
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+// ParseRecurrence parses a cron expression or an RRULE. Fields an RRULE leaves out, such as the
+// time of day, are taken from the anchor.
+func ParseRecurrence(rule string, anchor time.Time) (*Recurrence, error) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return nil, fmt.Errorf("empty recurrence rule")
+	}
+
+	upper := strings.ToUpper(rule)
+	if strings.HasPrefix(upper, "RRULE:") || strings.Contains(upper, "FREQ=") {
+		return parseRRule(strings.TrimPrefix(upper, "RRULE:"), anchor)
+	}
+
+	schedule, err := parseCron(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recurrence{schedule: schedule, interval: 1, anchor: anchor}, nil
+}
+
+// Next returns the first occurrence strictly after the given time, or the zero time when the
+// series has no further occurrences.
+func (r *Recurrence) Next(after time.Time) time.Time {
+	after = after.In(r.anchor.Location())
+	for i := 0; i < recurrenceMaxSkips; i++ {
+		next := r.schedule.next(after)
+		if next.IsZero() {
+			return next
+		}
+		if !r.until.IsZero() && next.After(r.until) {
+			return time.Time{}
+		}
+		if r.matchesInterval(next) {
+			return next
+		}
+		after = next
+	}
+	return time.Time{}
+}
+
+func (r *Recurrence) matchesInterval(t time.Time) bool {
+	if r.interval <= 1 {
+		return true
+	}
+
+	switch r.freq {
+	case "DAILY":
+		return civilDaysBetween(r.anchor, t)%r.interval == 0
+	case "WEEKLY":
+		anchorWeek := r.anchor.AddDate(0, 0, -((int(r.anchor.Weekday()) + 6) % 7))
+		week := t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+		return (civilDaysBetween(anchorWeek, week)/7)%r.interval == 0
+	case "MONTHLY":
+		months := (t.Year()-r.anchor.Year())*12 + int(t.Month()) - int(r.anchor.Month())
+		return months%r.interval == 0
+	}
+	return true
+}
+
+func civilDaysBetween(from, to time.Time) int {
+	fromDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDay := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(toDay.Sub(fromDay).Hours() / 24)
+	if days < 0 {
+		return -days
+	}
+	return days
+}
+
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	// As in cron, a restricted day of month and day of week match when either of them does.
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func (c *cronSchedule) next(after time.Time) time.Time {
+	loc := after.Location()
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(recurrenceSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			next := time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			if !next.After(t) {
+				// The wall clock went back an hour.
+				next = t.Truncate(time.Hour).Add(time.Hour)
+			}
+			t = next
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+func parseCron(expr string) (cronSchedule, error) {
+	if macro, ok := cronMacros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return cronSchedule{}, fmt.Errorf("cron expression must have 5 fields, got %d", len(fields))
+	}
+
+	var schedule cronSchedule
+	var err error
+	if schedule.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid minute field: %w", err)
+	}
+	if schedule.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid hour field: %w", err)
+	}
+	if schedule.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid day of month field: %w", err)
+	}
+	if schedule.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid month field: %w", err)
+	}
+	if schedule.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return cronSchedule{}, fmt.Errorf("invalid day of week field: %w", err)
+	}
+	// Both 0 and 7 are Sunday.
+	if schedule.dow&(1<<7) != 0 {
+		schedule.dow = (schedule.dow | 1) &^ (1 << 7)
+	}
+	schedule.domStar = fields[2] == "*" || fields[2] == "?"
+	schedule.dowStar = fields[4] == "*" || fields[4] == "?"
+
+	return schedule, nil
+}
+
+func parseCronField(field string, minValue, maxValue int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if rangePart, stepPart, ok := strings.Cut(part, "/"); ok {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepPart)
+			}
+			part = rangePart
+		}
+
+		var low, high int
+		switch {
+		case part == "*" || part == "?":
+			low, high = minValue, maxValue
+		case strings.Contains(part, "-"):
+			lowPart, highPart, _ := strings.Cut(part, "-")
+			var err error
+			if low, err = strconv.Atoi(lowPart); err != nil {
+				return 0, fmt.Errorf("invalid value %q", lowPart)
+			}
+			if high, err = strconv.Atoi(highPart); err != nil {
+				return 0, fmt.Errorf("invalid value %q", highPart)
+			}
+		default:
+			value, err := strconv.Atoi(part)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			low, high = value, value
+			if step > 1 {
+				high = maxValue
+			}
+		}
+
+		if low < minValue || high > maxValue || low > high {
+			return 0, fmt.Errorf("value out of range [%d-%d]: %q", minValue, maxValue, part)
+		}
+
+		for value := low; value <= high; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+func parseRRule(rule string, anchor time.Time) (*Recurrence, error) {
+	r := &Recurrence{interval: 1, anchor: anchor}
+	var byDay, byMonthDay, byHour, byMinute uint64
+
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid RRULE part %q", part)
+		}
+
+		var err error
+		switch key {
+		case "FREQ":
+			if value != "DAILY" && value != "WEEKLY" && value != "MONTHLY" {
+				return nil, fmt.Errorf("unsupported RRULE frequency %q", value)
+			}
+			r.freq = value
+		case "INTERVAL":
+			if r.interval, err = strconv.Atoi(value); err != nil || r.interval <= 0 {
+				return nil, fmt.Errorf("invalid RRULE interval %q", value)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := rruleWeekdays[day]
+				if !ok {
+					return nil, fmt.Errorf("unsupported RRULE day %q", day)
+				}
+				byDay |= 1 << uint(weekday)
+			}
+		case "BYMONTHDAY":
+			if byMonthDay, err = parseCronField(value, 1, 31); err != nil {
+				return nil, fmt.Errorf("invalid RRULE month day: %w", err)
+			}
+		case "BYHOUR":
+			if byHour, err = parseCronField(value, 0, 23); err != nil {
+				return nil, fmt.Errorf("invalid RRULE hour: %w", err)
+			}
+		case "BYMINUTE":
+			if byMinute, err = parseCronField(value, 0, 59); err != nil {
+				return nil, fmt.Errorf("invalid RRULE minute: %w", err)
+			}
+		case "UNTIL":
+			if r.until, err = time.Parse("20060102T150405Z", value); err != nil {
+				if r.until, err = time.ParseInLocation("20060102", value, anchor.Location()); err != nil {
+					return nil, fmt.Errorf("invalid RRULE until %q", value)
+				}
+				r.until = r.until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			}
+		case "WKST":
+			// Weeks always start on Monday.
+		default:
+			return nil, fmt.Errorf("unsupported RRULE part %q", key)
+		}
+	}
+
+	if r.freq == "" {
+		return nil, fmt.Errorf("RRULE is missing FREQ")
+	}
+
+	s := cronSchedule{month: fullBits(1, 12), minute: byMinute, hour: byHour}
+	if s.minute == 0 {
+		s.minute = 1 << uint(anchor.Minute())
+	}
+	if s.hour == 0 {
+		s.hour = 1 << uint(anchor.Hour())
+	}
+
+	switch r.freq {
+	case "DAILY":
+		s.dom, s.domStar = fullBits(1, 31), true
+		s.dow, s.dowStar = fullBits(0, 6), true
+		if byDay != 0 {
+			s.dow, s.dowStar = byDay, false
+		}
+	case "WEEKLY":
+		s.dom, s.domStar = fullBits(1, 31), true
+		s.dow = byDay
+		if s.dow == 0 {
+			s.dow = 1 << uint(anchor.Weekday())
+		}
+	case "MONTHLY":
+		s.dow, s.dowStar = fullBits(0, 6), true
+		s.dom = byMonthDay
+		if s.dom == 0 {
+			s.dom = 1 << uint(anchor.Day())
+		}
+	}
+	r.schedule = s
+
+	return r, nil
+}
+
+func fullBits(minValue, maxValue int) uint64 {
+	var bits uint64
+	for value := minValue; value <= maxValue; value++ {
+		bits |= 1 << uint(value)
+	}
+	return bits
+}
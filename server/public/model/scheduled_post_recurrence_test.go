@@ -0,0 +1,209 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	anchor := time.Date(2024, time.January, 15, 9, 30, 0, 0, time.UTC)
+
+	for _, rule := range []string{
+		"0 9 * * 1-5",
+		"*/30 * * * *",
+		"0 0 1,15 * *",
+		"@daily",
+		"FREQ=DAILY",
+		"RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR;INTERVAL=2",
+		"FREQ=MONTHLY;BYMONTHDAY=1;BYHOUR=8;BYMINUTE=0;UNTIL=20241231T000000Z",
+	} {
+		_, err := ParseRecurrence(rule, anchor)
+		assert.NoError(t, err, rule)
+	}
+
+	for _, rule := range []string{
+		"",
+		"0 9 * *",
+		"60 * * * *",
+		"0 24 * * *",
+		"0 0 0 * *",
+		"0 0 * 13 *",
+		"0 0 * * 8",
+		"0 0 5-1 * *",
+		"*/0 * * * *",
+		"FREQ=YEARLY",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=DAILY;COUNT=3",
+		"FREQ=DAILY;INTERVAL=0",
+		"INTERVAL=2",
+	} {
+		_, err := ParseRecurrence(rule, anchor)
+		assert.Error(t, err, rule)
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	anchor := time.Date(2024, time.January, 15, 9, 30, 0, 0, time.UTC) // a Monday
+
+	next := func(t *testing.T, rule string, after time.Time) time.Time {
+		t.Helper()
+		recurrence, err := ParseRecurrence(rule, anchor)
+		require.NoError(t, err)
+		return recurrence.Next(after)
+	}
+
+	t.Run("cron weekdays", func(t *testing.T) {
+		friday := time.Date(2024, time.January, 19, 9, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, time.January, 22, 9, 0, 0, 0, time.UTC), next(t, "0 9 * * 1-5", friday))
+	})
+
+	t.Run("cron day of month or day of week", func(t *testing.T) {
+		assert.Equal(t, time.Date(2024, time.January, 16, 0, 0, 0, 0, time.UTC), next(t, "0 0 1 * 2", anchor))
+	})
+
+	t.Run("cron sunday as 7", func(t *testing.T) {
+		assert.Equal(t, time.Date(2024, time.January, 21, 0, 0, 0, 0, time.UTC), next(t, "0 0 * * 7", anchor))
+	})
+
+	t.Run("rrule daily defaults to anchor time", func(t *testing.T) {
+		assert.Equal(t, time.Date(2024, time.January, 16, 9, 30, 0, 0, time.UTC), next(t, "FREQ=DAILY", anchor))
+	})
+
+	t.Run("rrule daily interval", func(t *testing.T) {
+		assert.Equal(t, time.Date(2024, time.January, 18, 9, 30, 0, 0, time.UTC), next(t, "FREQ=DAILY;INTERVAL=3", anchor))
+	})
+
+	t.Run("rrule weekly interval", func(t *testing.T) {
+		wednesday := time.Date(2024, time.January, 17, 9, 30, 0, 0, time.UTC)
+		assert.Equal(t, wednesday, next(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", anchor))
+		assert.Equal(t, time.Date(2024, time.January, 29, 9, 30, 0, 0, time.UTC), next(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", wednesday))
+	})
+
+	t.Run("rrule monthly skips short months", func(t *testing.T) {
+		monthEnd := time.Date(2024, time.January, 31, 9, 30, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, time.March, 31, 9, 30, 0, 0, time.UTC), next(t, "FREQ=MONTHLY;BYMONTHDAY=31", monthEnd))
+	})
+
+	t.Run("rrule until", func(t *testing.T) {
+		assert.True(t, next(t, "FREQ=DAILY;UNTIL=20240115", anchor).IsZero())
+		assert.False(t, next(t, "FREQ=DAILY;UNTIL=20240116", anchor).IsZero())
+	})
+
+	t.Run("impossible date", func(t *testing.T) {
+		assert.True(t, next(t, "0 0 30 2 *", anchor).IsZero())
+	})
+
+	t.Run("timezone", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		require.NoError(t, err)
+
+		recurrence, err := ParseRecurrence("0 9 * * *", anchor.In(loc))
+		require.NoError(t, err)
+
+		// Across the spring DST change the wall clock time stays the same.
+		after := time.Date(2024, time.March, 9, 12, 0, 0, 0, loc)
+		assert.Equal(t, time.Date(2024, time.March, 10, 9, 0, 0, 0, loc), recurrence.Next(after))
+		assert.Equal(t, time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC), recurrence.Next(after).UTC())
+	})
+}
+
+func TestScheduledPostRecurrence(t *testing.T) {
+	scheduledAt := GetMillis() + 60*60*1000
+
+	newScheduledPost := func(recurrence string) *ScheduledPost {
+		return &ScheduledPost{
+			Id:          NewId(),
+			ScheduledAt: scheduledAt,
+			Recurrence:  recurrence,
+			Draft: Draft{
+				CreateAt:  GetMillis(),
+				UpdateAt:  GetMillis(),
+				UserId:    NewId(),
+				ChannelId: NewId(),
+				Message:   "hello",
+			},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.Nil(t, newScheduledPost("").BaseIsValid())
+		assert.Nil(t, newScheduledPost("0 9 * * 1-5").BaseIsValid())
+		assert.Nil(t, newScheduledPost("FREQ=WEEKLY").BaseIsValid())
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		appErr := newScheduledPost("not a rule").BaseIsValid()
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scheduled_post.is_valid.recurrence.app_error", appErr.Id)
+	})
+
+	t.Run("too frequent", func(t *testing.T) {
+		appErr := newScheduledPost("*/5 * * * *").BaseIsValid()
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.scheduled_post.is_valid.recurrence_interval.app_error", appErr.Id)
+	})
+
+	t.Run("next occurrence", func(t *testing.T) {
+		scheduledPost := newScheduledPost("FREQ=DAILY")
+		next, err := scheduledPost.NextOccurrence(scheduledPost.ScheduledAt, time.UTC)
+		require.NoError(t, err)
+		assert.Equal(t, scheduledAt/60000*60000+24*60*60*1000, next)
+
+		scheduledPost.RecurrenceEndAt = scheduledAt + 60*60*1000
+		next, err = scheduledPost.NextOccurrence(scheduledPost.ScheduledAt, time.UTC)
+		require.NoError(t, err)
+		assert.Zero(t, next)
+	})
+
+	t.Run("not recurring", func(t *testing.T) {
+		next, err := newScheduledPost("").NextOccurrence(scheduledAt, time.UTC)
+		require.NoError(t, err)
+		assert.Zero(t, next)
+	})
+}
+
+func TestScheduledPostRestoreRecurrence(t *testing.T) {
+	original := &ScheduledPost{
+		Id:               NewId(),
+		Recurrence:       "@daily",
+		RecurrencePaused: true,
+		RecurrenceEndAt:  1000,
+	}
+
+	t.Run("keeps the recurrence missing from the update", func(t *testing.T) {
+		var update ScheduledPost
+		require.NoError(t, json.Unmarshal([]byte(`{"id": "`+original.Id+`", "message": "updated"}`), &update))
+
+		update.RestoreNonUpdatableFields(original)
+		assert.Equal(t, "updated", update.Message)
+		assert.Equal(t, "@daily", update.Recurrence)
+		assert.True(t, update.RecurrencePaused)
+		assert.EqualValues(t, 1000, update.RecurrenceEndAt)
+	})
+
+	t.Run("applies the recurrence set explicitly", func(t *testing.T) {
+		var update ScheduledPost
+		require.NoError(t, json.Unmarshal([]byte(`{"id": "`+original.Id+`", "recurrence": "", "recurrence_paused": false}`), &update))
+
+		update.RestoreNonUpdatableFields(original)
+		assert.Empty(t, update.Recurrence)
+		assert.False(t, update.RecurrencePaused)
+		assert.EqualValues(t, 1000, update.RecurrenceEndAt)
+	})
+
+	t.Run("applies the recurrence of a scheduled post not decoded from JSON", func(t *testing.T) {
+		update := &ScheduledPost{Id: original.Id}
+
+		update.RestoreNonUpdatableFields(original)
+		assert.Empty(t, update.Recurrence)
+		assert.False(t, update.RecurrencePaused)
+		assert.Zero(t, update.RecurrenceEndAt)
+	})
+}
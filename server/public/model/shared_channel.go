@@ -118,6 +118,9 @@ type SharedChannelRemote struct {
 	LastPostUpdateID  string `json:"last_post_id"`
 	LastPostCreateAt  int64  `json:"last_post_create_at"`
 	LastPostCreateID  string `json:"last_post_create_id"`
+
+	LastBookmarkUpdateAt int64 `json:"last_bookmark_update_at"`
+	LastMembersSyncAt    int64 `json:"last_members_sync_at"`
 }
 
 func (sc *SharedChannelRemote) IsValid() *AppError {
@@ -273,12 +276,24 @@ type SharedChannelRemoteFilterOpts struct {
 // SyncMsg represents a change in content (post add/edit/delete, reaction add/remove, users).
 // It is sent to remote clusters as the payload of a `RemoteClusterMsg`.
 type SyncMsg struct {
-	Id        string           `json:"id"`
-	ChannelId string           `json:"channel_id"`
-	Users     map[string]*User `json:"users,omitempty"`
-	Posts     []*Post          `json:"posts,omitempty"`
-	Reactions []*Reaction      `json:"reactions,omitempty"`
-	Statuses  []*Status        `json:"statuses,omitempty"`
+	Id               string                 `json:"id"`
+	ChannelId        string                 `json:"channel_id"`
+	Users            map[string]*User       `json:"users,omitempty"`
+	Posts            []*Post                `json:"posts,omitempty"`
+	Reactions        []*Reaction            `json:"reactions,omitempty"`
+	Statuses         []*Status              `json:"statuses,omitempty"`
+	Bookmarks        []*ChannelBookmark     `json:"bookmarks,omitempty"`        // only sent to remotes with RemoteCapabilityBookmarks
+	Acknowledgements []*PostAcknowledgement `json:"acknowledgements,omitempty"` // only sent to remotes with RemoteCapabilityAcknowledgements
+
+	MembershipChanges []*MembershipChangeMsg `json:"membership_changes,omitempty"` // only sent to remotes with RemoteCapabilityMembership
+}
+
+// MembershipChangeMsg represents a user joining or leaving a shared channel.
+type MembershipChangeMsg struct {
+	ChannelId  string `json:"channel_id"`
+	UserId     string `json:"user_id"`
+	IsAdd      bool   `json:"is_add"`
+	ChangeTime int64  `json:"change_time"`
 }
 
 func NewSyncMsg(channelID string) *SyncMsg {
@@ -316,6 +331,14 @@ type SyncResponse struct {
 	ReactionsLastUpdateAt int64    `json:"reactions_last_update_at"`
 	ReactionErrors        []string `json:"reaction_errors"`
 
+	BookmarksLastUpdateAt int64    `json:"bookmarks_last_update_at"`
+	BookmarkErrors        []string `json:"bookmark_errors"`
+
+	AcknowledgementErrors []string `json:"acknowledgement_errors"` // post IDs for which an acknowledgement sync failed
+
+	MembershipsLastUpdateAt int64    `json:"memberships_last_update_at"`
+	MembershipErrors        []string `json:"membership_errors"` // user IDs for which a membership change failed
+
 	StatusErrors []string `json:"status_errors"` // user IDs for which the status sync failed
 }
 
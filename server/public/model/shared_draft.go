@@ -0,0 +1,147 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"net/http"
+	"unicode/utf8"
+)
+
+const SharedDraftMaxMembers = 50
+
+// SharedDraft is a draft that its members can edit together before one of
+// them publishes it to the channel.
+type SharedDraft struct {
+	Id           string          `json:"id"`
+	CreatorId    string          `json:"creator_id"`
+	ChannelId    string          `json:"channel_id"`
+	RootId       string          `json:"root_id"`
+	CreateAt     int64           `json:"create_at"`
+	UpdateAt     int64           `json:"update_at"`
+	LastEditedBy string          `json:"last_edited_by"`
+	Message      string          `json:"message"`
+	Props        StringInterface `json:"props"`
+
+	// UserIds are the members of the draft, including its creator.
+	UserIds []string `json:"user_ids" db:"-"`
+}
+
+// SharedDraftPatch is an edit of a shared draft, based on the version of
+// the draft last updated at UpdateAt.
+type SharedDraftPatch struct {
+	UpdateAt int64            `json:"update_at"`
+	Message  *string          `json:"message"`
+	Props    *StringInterface `json:"props"`
+}
+
+func (o *SharedDraft) IsValid(maxDraftSize int) *AppError {
+	if !IsValidId(o.Id) {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.CreatorId) {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.creator_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.ChannelId) {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.channel_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !(IsValidId(o.RootId) || o.RootId == "") {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.root_id.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.CreateAt == 0 {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.create_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if o.UpdateAt == 0 {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.update_at.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if !IsValidId(o.LastEditedBy) {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.last_edited_by.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(o.Message) > maxDraftSize {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.msg.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if utf8.RuneCountInString(StringInterfaceToJSON(o.Props)) > PostPropsMaxRunes {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.props.app_error", nil, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	if len(o.UserIds) > SharedDraftMaxMembers {
+		return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.user_ids.app_error", map[string]any{"Max": SharedDraftMaxMembers}, "id="+o.Id, http.StatusBadRequest)
+	}
+
+	for _, userID := range o.UserIds {
+		if !IsValidId(userID) {
+			return NewAppError("SharedDraft.IsValid", "model.shared_draft.is_valid.user_ids.app_error", map[string]any{"Max": SharedDraftMaxMembers}, "id="+o.Id, http.StatusBadRequest)
+		}
+	}
+
+	return nil
+}
+
+func (o *SharedDraft) PreSave() {
+	if o.Id == "" {
+		o.Id = NewId()
+	}
+
+	o.CreateAt = GetMillis()
+	o.UpdateAt = o.CreateAt
+	o.LastEditedBy = o.CreatorId
+
+	if o.Props == nil {
+		o.Props = StringInterface{}
+	}
+
+	o.UserIds = RemoveDuplicateStringsNonSort(append([]string{o.CreatorId}, o.UserIds...))
+}
+
+// Patch applies the edit made by the given user.
+func (o *SharedDraft) Patch(patch *SharedDraftPatch, userID string) {
+	if patch.Message != nil {
+		o.Message = *patch.Message
+	}
+
+	if patch.Props != nil {
+		o.Props = *patch.Props
+	}
+
+	o.LastEditedBy = userID
+}
+
+func (o *SharedDraft) HasMember(userID string) bool {
+	for _, id := range o.UserIds {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// ToPost returns the post published by the given user from the draft.
+func (o *SharedDraft) ToPost(userID string) *Post {
+	post := &Post{
+		UserId:    userID,
+		ChannelId: o.ChannelId,
+		RootId:    o.RootId,
+		Message:   o.Message,
+	}
+	post.SetProps(o.Props)
+	return post
+}
+
+func (o *SharedDraft) Auditable() map[string]any {
+	return map[string]any{
+		"id":             o.Id,
+		"creator_id":     o.CreatorId,
+		"channel_id":     o.ChannelId,
+		"root_id":        o.RootId,
+		"last_edited_by": o.LastEditedBy,
+		"user_ids":       o.UserIds,
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedDraftPreSave(t *testing.T) {
+	creatorID := NewId()
+	userID := NewId()
+	draft := &SharedDraft{
+		CreatorId: creatorID,
+		ChannelId: NewId(),
+		Message:   "draft",
+		UserIds:   []string{userID, creatorID, userID},
+	}
+	draft.PreSave()
+
+	assert.True(t, IsValidId(draft.Id))
+	assert.NotZero(t, draft.CreateAt)
+	assert.Equal(t, draft.CreateAt, draft.UpdateAt)
+	assert.Equal(t, creatorID, draft.LastEditedBy)
+	assert.Equal(t, []string{creatorID, userID}, draft.UserIds)
+	assert.NotNil(t, draft.Props)
+	require.Nil(t, draft.IsValid(PostMessageMaxRunesV2))
+}
+
+func TestSharedDraftIsValid(t *testing.T) {
+	newDraft := func() *SharedDraft {
+		draft := &SharedDraft{CreatorId: NewId(), ChannelId: NewId(), Message: "draft"}
+		draft.PreSave()
+		return draft
+	}
+
+	for name, update := range map[string]func(*SharedDraft){
+		"invalid id":          func(d *SharedDraft) { d.Id = "junk" },
+		"invalid channel id":  func(d *SharedDraft) { d.ChannelId = "" },
+		"invalid root id":     func(d *SharedDraft) { d.RootId = "junk" },
+		"missing update at":   func(d *SharedDraft) { d.UpdateAt = 0 },
+		"invalid last editor": func(d *SharedDraft) { d.LastEditedBy = "" },
+		"too long message":    func(d *SharedDraft) { d.Message = "0123456789a" },
+		"invalid member":      func(d *SharedDraft) { d.UserIds = append(d.UserIds, "junk") },
+		"too many members": func(d *SharedDraft) {
+			for range SharedDraftMaxMembers {
+				d.UserIds = append(d.UserIds, NewId())
+			}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			draft := newDraft()
+			update(draft)
+			require.NotNil(t, draft.IsValid(10))
+		})
+	}
+}
+
+func TestSharedDraftPatch(t *testing.T) {
+	draft := &SharedDraft{CreatorId: NewId(), ChannelId: NewId(), Message: "draft"}
+	draft.PreSave()
+
+	editorID := NewId()
+	draft.Patch(&SharedDraftPatch{Message: NewPointer("edited")}, editorID)
+	assert.Equal(t, "edited", draft.Message)
+	assert.Equal(t, StringInterface{}, draft.Props)
+	assert.Equal(t, editorID, draft.LastEditedBy)
+
+	post := draft.ToPost(editorID)
+	assert.Equal(t, editorID, post.UserId)
+	assert.Equal(t, draft.ChannelId, post.ChannelId)
+	assert.Equal(t, "edited", post.Message)
+}
@@ -15,6 +15,7 @@ const (
 	SystemLastComplianceTime               = "LastComplianceTime"
 	SystemAsymmetricSigningKeyKey          = "AsymmetricSigningKey"
 	SystemPostActionCookieSecretKey        = "PostActionCookieSecret"
+	SystemWebPushVAPIDKey                  = "WebPushVAPIDKey"
 	SystemInstallationDateKey              = "InstallationDate"
 	SystemOrganizationName                 = "OrganizationName"
 	SystemFirstAdminRole                   = "FirstAdminRole"
@@ -49,6 +49,7 @@ const (
 	DesktopThreadsNotifyProp       = "desktop_threads"
 	PushThreadsNotifyProp          = "push_threads"
 	EmailThreadsNotifyProp         = "email_threads"
+	PushQuietHoursNotifyProp       = "push_quiet_hours"
 
 	DefaultLocale        = "en"
 	UserAuthServiceEmail = "email"
@@ -110,6 +111,9 @@ type User struct {
 	DisableWelcomeEmail    bool        `json:"disable_welcome_email"`
 	LastLogin              int64       `json:"last_login,omitempty"`
 	MfaUsedTimestamps      StringArray `json:"mfa_used_timestamps,omitempty"`
+
+	// passwordHashed is set by SetHashedPassword for PreSave to save the password as is.
+	passwordHashed bool
 }
 
 func (u *User) Auditable() map[string]interface{} {
@@ -417,6 +421,12 @@ func (u *User) IsValid() *AppError {
 			map[string]any{"Limit": UserRolesMaxLength}, "user_id="+u.Id+" roles_limit="+u.Roles, http.StatusBadRequest)
 	}
 
+	if quietHours := u.NotifyProps[PushQuietHoursNotifyProp]; quietHours != "" {
+		if _, err := ParseQuietHours(quietHours); err != nil {
+			return InvalidUserError("push_quiet_hours", u.Id, quietHours)
+		}
+	}
+
 	if u.Props != nil {
 		if !u.ValidateCustomStatus() {
 			return NewAppError("User.IsValid", "model.user.is_valid.invalidProperty.app_error",
@@ -494,7 +504,9 @@ func (u *User) PreSave() *AppError {
 		u.Timezone = timezones.DefaultUserTimezone()
 	}
 
-	if u.Password != "" {
+	if u.passwordHashed {
+		u.passwordHashed = false
+	} else if u.Password != "" {
 		hashed, err := HashPassword(u.Password)
 		if errors.Is(err, bcrypt.ErrPasswordTooLong) {
 			return NewAppError("User.PreSave", "model.user.pre_save.password_too_long.app_error",
@@ -975,6 +987,13 @@ func HashPassword(password string) (string, error) {
 	return string(hash), nil
 }
 
+// SetHashedPassword sets a password the caller already hashed, which PreSave then saves as is
+// instead of hashing it again.
+func (u *User) SetHashedPassword(hash string) {
+	u.Password = hash
+	u.passwordHashed = true
+}
+
 var validUsernameChars = regexp.MustCompile(`^[a-z0-9\.\-_]+$`)
 var validUsernameCharsForRemote = regexp.MustCompile(`^[a-z0-9\.\-_:]*$`)
 
@@ -223,6 +223,22 @@ func TestUserPreSavePwdTooLong(t *testing.T) {
 	assert.ErrorIs(t, err, bcrypt.ErrPasswordTooLong)
 }
 
+func TestUserPreSaveHashedPassword(t *testing.T) {
+	hash := "$argon2id$v=19$m=19456,t=2,p=1$c29tZXNhbHRzb21lc2FsdA$Q+9pUz0pUBbIt3l2mMrfA1JoEUkPxIgGDaXGvNIWsiA"
+
+	user := User{}
+	user.SetHashedPassword(hash)
+	require.Nil(t, user.PreSave())
+	assert.Equal(t, hash, user.Password, "hashed passwords should be saved as is")
+
+	bcryptHash, err := HashPassword("Passw0rd!")
+	require.NoError(t, err)
+
+	user = User{Password: bcryptHash}
+	require.Nil(t, user.PreSave())
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(bcryptHash)), "passwords looking like hashes should still be hashed")
+}
+
 func TestUserPreUpdate(t *testing.T) {
 	user := User{Password: "test"}
 	user.PreUpdate()
@@ -331,6 +347,14 @@ func TestUserIsValid(t *testing.T) {
 	user.Roles = strings.Repeat("a", UserRolesMaxLength+1)
 	appErr = user.IsValid()
 	require.True(t, HasExpectedUserIsValidError(appErr, "roles_limit", user.Id, user.Roles), "expected user is valid error: %s", appErr.Error())
+	user.Roles = ""
+
+	user.NotifyProps = StringMap{PushQuietHoursNotifyProp: "22:00-07:00"}
+	require.Nil(t, user.IsValid())
+
+	user.NotifyProps[PushQuietHoursNotifyProp] = "22:00"
+	appErr = user.IsValid()
+	require.True(t, HasExpectedUserIsValidError(appErr, "push_quiet_hours", user.Id, "22:00"), "expected user is valid error: %s", appErr.Error())
 }
 
 func TestUserSanitizeInput(t *testing.T) {
@@ -0,0 +1,76 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// WebPushSubscriptionEndpointMaxLength is the maximum length of a push service endpoint.
+	WebPushSubscriptionEndpointMaxLength = 1024
+
+	webPushP256dhKeyLength = 65
+	webPushAuthKeyLength   = 16
+)
+
+// WebPushSubscription is a browser's push subscription, as serialized by PushSubscription.toJSON.
+// It is attached to the session of the browser that created it.
+type WebPushSubscription struct {
+	Endpoint string                  `json:"endpoint"`
+	Keys     WebPushSubscriptionKeys `json:"keys"`
+}
+
+// WebPushSubscriptionKeys holds the base64url-encoded keys used to encrypt the payloads sent
+// to a push subscription, as defined by RFC 8291.
+type WebPushSubscriptionKeys struct {
+	P256dh string `json:"p256dh"`
+	Auth   string `json:"auth"`
+}
+
+// WebPushPublicKey is the server's VAPID public key, which browsers need to create a push
+// subscription.
+type WebPushPublicKey struct {
+	PublicKey string `json:"public_key"`
+}
+
+func (s *WebPushSubscription) IsValid() *AppError {
+	if len(s.Endpoint) > WebPushSubscriptionEndpointMaxLength {
+		return NewAppError("WebPushSubscription.IsValid", "model.web_push_subscription.is_valid.endpoint.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	u, err := url.Parse(s.Endpoint)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return NewAppError("WebPushSubscription.IsValid", "model.web_push_subscription.is_valid.endpoint.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if key, err := s.P256dhKey(); err != nil || len(key) != webPushP256dhKeyLength || key[0] != 0x04 {
+		return NewAppError("WebPushSubscription.IsValid", "model.web_push_subscription.is_valid.p256dh.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if key, err := s.AuthKey(); err != nil || len(key) != webPushAuthKeyLength {
+		return NewAppError("WebPushSubscription.IsValid", "model.web_push_subscription.is_valid.auth.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// P256dhKey returns the subscription's decoded P-256 public key.
+func (s *WebPushSubscription) P256dhKey() ([]byte, error) {
+	return decodeWebPushKey(s.Keys.P256dh)
+}
+
+// AuthKey returns the subscription's decoded authentication secret.
+func (s *WebPushSubscription) AuthKey() ([]byte, error) {
+	return decodeWebPushKey(s.Keys.Auth)
+}
+
+// decodeWebPushKey decodes a base64url-encoded key, tolerating padding since not all browsers
+// omit it.
+func decodeWebPushKey(value string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebPushSubscriptionIsValid(t *testing.T) {
+	newSubscription := func() *WebPushSubscription {
+		return &WebPushSubscription{
+			Endpoint: "https://push.example.com/send/abc",
+			Keys: WebPushSubscriptionKeys{
+				P256dh: "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4",
+				Auth:   "BTBZMqHH6r4Tts7J_aSIgg",
+			},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.Nil(t, newSubscription().IsValid())
+	})
+
+	t.Run("padded keys", func(t *testing.T) {
+		subscription := newSubscription()
+		subscription.Keys.P256dh += "="
+		subscription.Keys.Auth += "=="
+		require.Nil(t, subscription.IsValid())
+	})
+
+	for name, endpoint := range map[string]string{
+		"empty":     "",
+		"http":      "http://push.example.com/send/abc",
+		"no host":   "https:///send/abc",
+		"too long":  "https://push.example.com/" + strings.Repeat("a", WebPushSubscriptionEndpointMaxLength),
+		"malformed": "https://push.example.com/%zz",
+	} {
+		t.Run("invalid endpoint "+name, func(t *testing.T) {
+			subscription := newSubscription()
+			subscription.Endpoint = endpoint
+			appErr := subscription.IsValid()
+			require.NotNil(t, appErr)
+			assert.Equal(t, "model.web_push_subscription.is_valid.endpoint.app_error", appErr.Id)
+		})
+	}
+
+	t.Run("invalid p256dh", func(t *testing.T) {
+		subscription := newSubscription()
+		subscription.Keys.P256dh = "BTBZMqHH6r4Tts7J_aSIgg"
+		appErr := subscription.IsValid()
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.web_push_subscription.is_valid.p256dh.app_error", appErr.Id)
+	})
+
+	t.Run("invalid auth", func(t *testing.T) {
+		subscription := newSubscription()
+		subscription.Keys.Auth = "not base64!"
+		appErr := subscription.IsValid()
+		require.NotNil(t, appErr)
+		assert.Equal(t, "model.web_push_subscription.is_valid.auth.app_error", appErr.Id)
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+package model
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	WebAuthnCredentialNameMaxRunes = 64
+	// WebAuthnCredentialIdMaxLength is the base64url encoded length of the longest credential ID.
+	WebAuthnCredentialIdMaxLength = 1400
+	WebAuthnMaxCredentialsPerUser = 20
+	WebAuthnCeremonyTimeout       = 1000 * 60 * 5 // 5 minutes
+
+	WebAuthnUserVerificationRequired  = "required"
+	WebAuthnUserVerificationPreferred = "preferred"
+	WebAuthnResidentKeyPreferred      = "preferred"
+	WebAuthnAttestationNone           = "none"
+	WebAuthnCredentialTypePublicKey   = "public-key"
+)
+
+// WebAuthnCredential is a security key or passkey registered by a user, used as a second
+// factor or, if allowed, to log in without a password.
+type WebAuthnCredential struct {
+	Id     string `json:"id"`
+	UserId string `json:"user_id"`
+	// CredentialId is the base64url encoded ID of the credential on the authenticator.
+	CredentialId string `json:"credential_id"`
+	// PublicKey is the COSE_Key encoding of the public key of the credential.
+	PublicKey  []byte `json:"-"`
+	SignCount  int64  `json:"-"`
+	Name       string `json:"name"`
+	CreateAt   int64  `json:"create_at"`
+	LastUsedAt int64  `json:"last_used_at"`
+}
+
+func (c *WebAuthnCredential) PreSave() {
+	if c.Id == "" {
+		c.Id = NewId()
+	}
+
+	if c.CreateAt == 0 {
+		c.CreateAt = GetMillis()
+	}
+
+	c.Name = strings.TrimSpace(c.Name)
+}
+
+func (c *WebAuthnCredential) IsValid() *AppError {
+	if !IsValidId(c.Id) {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.id.app_error", nil, "", http.StatusBadRequest)
+	}
+
+	if !IsValidId(c.UserId) {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.user_id.app_error", nil, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	if c.CredentialId == "" || len(c.CredentialId) > WebAuthnCredentialIdMaxLength {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.credential_id.app_error", nil, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	if len(c.PublicKey) == 0 {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.public_key.app_error", nil, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	if c.Name == "" || utf8.RuneCountInString(c.Name) > WebAuthnCredentialNameMaxRunes {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.name.app_error", map[string]any{"Max": WebAuthnCredentialNameMaxRunes}, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	if c.CreateAt == 0 {
+		return NewAppError("WebAuthnCredential.IsValid", "model.webauthn_credential.is_valid.create_at.app_error", nil, "id="+c.Id, http.StatusBadRequest)
+	}
+
+	return nil
+}
+
+// The options of the WebAuthn ceremonies are shaped as the PublicKeyCredentialCreationOptions
+// and PublicKeyCredentialRequestOptions of browsers, with binary fields base64url encoded.
+
+type WebAuthnRelyingParty struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type WebAuthnUserEntity struct {
+	// Id is the base64url encoded user handle, which is the ID of the user.
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type WebAuthnCredentialParameter struct {
+	Type      string `json:"type"`
+	Algorithm int    `json:"alg"`
+}
+
+type WebAuthnCredentialDescriptor struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+type WebAuthnAuthenticatorSelection struct {
+	ResidentKey      string `json:"residentKey"`
+	UserVerification string `json:"userVerification"`
+}
+
+type WebAuthnCreationOptions struct {
+	Challenge              string                         `json:"challenge"`
+	RelyingParty           WebAuthnRelyingParty           `json:"rp"`
+	User                   WebAuthnUserEntity             `json:"user"`
+	Parameters             []WebAuthnCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                          `json:"timeout"`
+	ExcludeCredentials     []WebAuthnCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection WebAuthnAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                         `json:"attestation"`
+}
+
+type WebAuthnRequestOptions struct {
+	Challenge        string                         `json:"challenge"`
+	Timeout          int64                          `json:"timeout"`
+	RelyingPartyId   string                         `json:"rpId"`
+	AllowCredentials []WebAuthnCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                         `json:"userVerification"`
+}
+
+// The responses of authenticators are shaped as serialized by PublicKeyCredential.toJSON().
+
+type WebAuthnAttestationResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AttestationObject string `json:"attestationObject"`
+}
+
+type WebAuthnAssertionResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"userHandle,omitempty"`
+}
+
+type WebAuthnAttestation struct {
+	Id       string                      `json:"id"`
+	Type     string                      `json:"type"`
+	Response WebAuthnAttestationResponse `json:"response"`
+}
+
+type WebAuthnAssertion struct {
+	Id       string                    `json:"id"`
+	Type     string                    `json:"type"`
+	Response WebAuthnAssertionResponse `json:"response"`
+}
+
+// WebAuthnRegistration is the request to register the credential created by an authenticator.
+type WebAuthnRegistration struct {
+	Name        string              `json:"name"`
+	Attestation WebAuthnAttestation `json:"credential"`
+}
+
+// IsWebAuthnAssertion tells whether the MFA token of a login is a WebAuthn assertion rather than
+// an authenticator app code.
+func IsWebAuthnAssertion(token string) bool {
+	return strings.HasPrefix(strings.TrimSpace(token), "{")
+}
+
+func WebAuthnAssertionFromJSON(data string) (*WebAuthnAssertion, error) {
+	var assertion WebAuthnAssertion
+	if err := json.Unmarshal([]byte(data), &assertion); err != nil {
+		return nil, err
+	}
+
+	return &assertion, nil
+}
@@ -81,9 +81,12 @@ const (
 	WebsocketEventDraftCreated                        WebsocketEventType = "draft_created"
 	WebsocketEventDraftUpdated                        WebsocketEventType = "draft_updated"
 	WebsocketEventDraftDeleted                        WebsocketEventType = "draft_deleted"
+	WebsocketEventSharedDraftUpdated                  WebsocketEventType = "shared_draft_updated"
+	WebsocketEventSharedDraftDeleted                  WebsocketEventType = "shared_draft_deleted"
 	WebsocketEventAcknowledgementAdded                WebsocketEventType = "post_acknowledgement_added"
 	WebsocketEventAcknowledgementRemoved              WebsocketEventType = "post_acknowledgement_removed"
 	WebsocketEventPersistentNotificationTriggered     WebsocketEventType = "persistent_notification_triggered"
+	WebsocketEventPostEscalationUpdated               WebsocketEventType = "post_escalation_updated"
 	WebsocketEventHostedCustomerSignupProgressUpdated WebsocketEventType = "hosted_customer_signup_progress_updated"
 	WebsocketEventChannelBookmarkCreated              WebsocketEventType = "channel_bookmark_created"
 	WebsocketEventChannelBookmarkUpdated              WebsocketEventType = "channel_bookmark_updated"
@@ -1312,6 +1312,23 @@ type API interface {
 	// @tag Plugin
 	// Minimum server version: 10.1
 	GetPluginID() string
+
+	// RegisterContentExtractor registers the plugin as the content extractor of the files with the
+	// given extensions. Their text is then extracted through the ExtractFileContent hook. Registering
+	// again replaces the previous registration of the plugin.
+	//
+	// @tag Plugin
+	// @tag File
+	// Minimum server version: 10.3
+	RegisterContentExtractor(extractor *model.PluginContentExtractor) error
+
+	// UnregisterContentExtractor unregisters the content extractor previously registered via
+	// RegisterContentExtractor.
+	//
+	// @tag Plugin
+	// @tag File
+	// Minimum server version: 10.3
+	UnregisterContentExtractor() error
 }
 
 var handshake = plugin.HandshakeConfig{
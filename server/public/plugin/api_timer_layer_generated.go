@@ -1385,3 +1385,17 @@ func (api *apiTimerLayer) GetPluginID() string {
 	api.recordTime(startTime, "GetPluginID", true)
 	return _returnsA
 }
+
+func (api *apiTimerLayer) RegisterContentExtractor(extractor *model.PluginContentExtractor) error {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.RegisterContentExtractor(extractor)
+	api.recordTime(startTime, "RegisterContentExtractor", _returnsA == nil)
+	return _returnsA
+}
+
+func (api *apiTimerLayer) UnregisterContentExtractor() error {
+	startTime := timePkg.Now()
+	_returnsA := api.apiImpl.UnregisterContentExtractor()
+	api.recordTime(startTime, "UnregisterContentExtractor", _returnsA == nil)
+	return _returnsA
+}
@@ -1161,6 +1161,44 @@ func (s *hooksRPCServer) GenerateSupportData(args *Z_GenerateSupportDataArgs, re
 	return nil
 }
 
+func init() {
+	hookNameToId["ExtractFileContent"] = ExtractFileContentID
+}
+
+type Z_ExtractFileContentArgs struct {
+	A *Context
+	B string
+	C []byte
+}
+
+type Z_ExtractFileContentReturns struct {
+	A string
+	B error
+}
+
+func (g *hooksRPCClient) ExtractFileContent(c *Context, filename string, content []byte) (string, error) {
+	_args := &Z_ExtractFileContentArgs{c, filename, content}
+	_returns := &Z_ExtractFileContentReturns{}
+	if g.implemented[ExtractFileContentID] {
+		if err := g.client.Call("Plugin.ExtractFileContent", _args, _returns); err != nil {
+			g.log.Error("RPC call ExtractFileContent to plugin failed.", mlog.Err(err))
+		}
+	}
+	return _returns.A, _returns.B
+}
+
+func (s *hooksRPCServer) ExtractFileContent(args *Z_ExtractFileContentArgs, returns *Z_ExtractFileContentReturns) error {
+	if hook, ok := s.impl.(interface {
+		ExtractFileContent(c *Context, filename string, content []byte) (string, error)
+	}); ok {
+		returns.A, returns.B = hook.ExtractFileContent(args.A, args.B, args.C)
+		returns.B = encodableError(returns.B)
+	} else {
+		return encodableError(fmt.Errorf("Hook ExtractFileContent called but not implemented."))
+	}
+	return nil
+}
+
 type Z_RegisterCommandArgs struct {
 	A *model.Command
 }
@@ -6657,3 +6695,60 @@ func (s *apiRPCServer) GetPluginID(args *Z_GetPluginIDArgs, returns *Z_GetPlugin
 	}
 	return nil
 }
+
+type Z_RegisterContentExtractorArgs struct {
+	A *model.PluginContentExtractor
+}
+
+type Z_RegisterContentExtractorReturns struct {
+	A error
+}
+
+func (g *apiRPCClient) RegisterContentExtractor(extractor *model.PluginContentExtractor) error {
+	_args := &Z_RegisterContentExtractorArgs{extractor}
+	_returns := &Z_RegisterContentExtractorReturns{}
+	if err := g.client.Call("Plugin.RegisterContentExtractor", _args, _returns); err != nil {
+		log.Printf("RPC call to RegisterContentExtractor API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) RegisterContentExtractor(args *Z_RegisterContentExtractorArgs, returns *Z_RegisterContentExtractorReturns) error {
+	if hook, ok := s.impl.(interface {
+		RegisterContentExtractor(extractor *model.PluginContentExtractor) error
+	}); ok {
+		returns.A = hook.RegisterContentExtractor(args.A)
+		returns.A = encodableError(returns.A)
+	} else {
+		return encodableError(fmt.Errorf("API RegisterContentExtractor called but not implemented."))
+	}
+	return nil
+}
+
+type Z_UnregisterContentExtractorArgs struct {
+}
+
+type Z_UnregisterContentExtractorReturns struct {
+	A error
+}
+
+func (g *apiRPCClient) UnregisterContentExtractor() error {
+	_args := &Z_UnregisterContentExtractorArgs{}
+	_returns := &Z_UnregisterContentExtractorReturns{}
+	if err := g.client.Call("Plugin.UnregisterContentExtractor", _args, _returns); err != nil {
+		log.Printf("RPC call to UnregisterContentExtractor API failed: %s", err.Error())
+	}
+	return _returns.A
+}
+
+func (s *apiRPCServer) UnregisterContentExtractor(args *Z_UnregisterContentExtractorArgs, returns *Z_UnregisterContentExtractorReturns) error {
+	if hook, ok := s.impl.(interface {
+		UnregisterContentExtractor() error
+	}); ok {
+		returns.A = hook.UnregisterContentExtractor()
+		returns.A = encodableError(returns.A)
+	} else {
+		return encodableError(fmt.Errorf("API UnregisterContentExtractor called but not implemented."))
+	}
+	return nil
+}
@@ -61,6 +61,7 @@ const (
 	OnSharedChannelsAttachmentSyncMsgID       = 43
 	OnSharedChannelsProfileImageSyncMsgID     = 44
 	GenerateSupportDataID                     = 45
+	ExtractFileContentID                      = 46
 	TotalHooksID                              = iota
 )
 
@@ -395,4 +396,14 @@ type Hooks interface {
 	//
 	// Minimum server version: 9.8
 	GenerateSupportData(c *Context) ([]*model.FileData, error)
+
+	// ExtractFileContent is invoked to extract the text of a file, so that it can be searched, for
+	// plugins that registered a content extractor handling its extension through API.RegisterContentExtractor.
+	// It is called when a file is uploaded and by the content extraction job, including for the files
+	// found in archives when archive recursion is enabled.
+	//
+	// Return the extracted text, or an error to let the server try its own extractors.
+	//
+	// Minimum server version: 10.3
+	ExtractFileContent(c *Context, filename string, content []byte) (string, error)
 }
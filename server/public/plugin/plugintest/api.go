@@ -3959,6 +3959,24 @@ func (_m *API) RegisterCommand(command *model.Command) error {
 	return r0
 }
 
+// RegisterContentExtractor provides a mock function with given fields: extractor
+func (_m *API) RegisterContentExtractor(extractor *model.PluginContentExtractor) error {
+	ret := _m.Called(extractor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterContentExtractor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.PluginContentExtractor) error); ok {
+		r0 = rf(extractor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RegisterPluginForSharedChannels provides a mock function with given fields: opts
 func (_m *API) RegisterPluginForSharedChannels(opts model.RegisterPluginOpts) (string, error) {
 	ret := _m.Called(opts)
@@ -4581,6 +4599,24 @@ func (_m *API) UnregisterCommand(teamID string, trigger string) error {
 	return r0
 }
 
+// UnregisterContentExtractor provides a mock function with given fields:
+func (_m *API) UnregisterContentExtractor() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnregisterContentExtractor")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UnregisterPluginForSharedChannels provides a mock function with given fields: pluginID
 func (_m *API) UnregisterPluginForSharedChannels(pluginID string) error {
 	ret := _m.Called(pluginID)
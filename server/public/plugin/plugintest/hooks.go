@@ -87,6 +87,34 @@ func (_m *Hooks) ExecuteCommand(c *plugin.Context, args *model.CommandArgs) (*mo
 	return r0, r1
 }
 
+// ExtractFileContent provides a mock function with given fields: c, filename, content
+func (_m *Hooks) ExtractFileContent(c *plugin.Context, filename string, content []byte) (string, error) {
+	ret := _m.Called(c, filename, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExtractFileContent")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*plugin.Context, string, []byte) (string, error)); ok {
+		return rf(c, filename, content)
+	}
+	if rf, ok := ret.Get(0).(func(*plugin.Context, string, []byte) string); ok {
+		r0 = rf(c, filename, content)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*plugin.Context, string, []byte) error); ok {
+		r1 = rf(c, filename, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FileWillBeUploaded provides a mock function with given fields: c, info, file, output
 func (_m *Hooks) FileWillBeUploaded(c *plugin.Context, info *model.FileInfo, file io.Reader, output io.Writer) (*model.FileInfo, string) {
 	ret := _m.Called(c, info, file, output)
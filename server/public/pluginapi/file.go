@@ -91,3 +91,20 @@ func (f *FileService) CopyInfos(ids []string, userID string) ([]string, error) {
 
 	return newIDs, normalizeAppErr(appErr)
 }
+
+// RegisterContentExtractor registers the plugin as the content extractor of the files with the
+// given extensions. Their text is then extracted through the ExtractFileContent hook, so that
+// they can be searched.
+//
+// Minimum server version: 10.2
+func (f *FileService) RegisterContentExtractor(extractor *model.PluginContentExtractor) error {
+	return f.api.RegisterContentExtractor(extractor)
+}
+
+// UnregisterContentExtractor unregisters the content extractor previously registered via
+// RegisterContentExtractor.
+//
+// Minimum server version: 10.2
+func (f *FileService) UnregisterContentExtractor() error {
+	return f.api.UnregisterContentExtractor()
+}